@@ -39,3 +39,32 @@ func InternalAuthUnaryClientInterceptor(token string) grpc.UnaryClientIntercepto
 		return invoker(ctx, method, req, reply, cc, opts...)
 	}
 }
+
+func InternalAuthStreamServerInterceptor(expectedToken string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if expectedToken == "" {
+			return handler(srv, ss)
+		}
+
+		md, ok := metadata.FromIncomingContext(ss.Context())
+		if !ok {
+			return status.Error(codes.Unauthenticated, "missing metadata")
+		}
+
+		tokens := md.Get(InternalAuthHeader)
+		if len(tokens) == 0 || tokens[0] != expectedToken {
+			return status.Error(codes.Unauthenticated, "invalid internal token")
+		}
+
+		return handler(srv, ss)
+	}
+}
+
+func InternalAuthStreamClientInterceptor(token string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if token != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, InternalAuthHeader, token)
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
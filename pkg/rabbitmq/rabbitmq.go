@@ -1,6 +1,12 @@
 package rabbitmq
 
-import amqp "github.com/rabbitmq/amqp091-go"
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+)
 
 type RabbitMQ struct {
 	connection *amqp.Connection
@@ -36,3 +42,24 @@ func (r *RabbitMQ) Close() error {
 func (r *RabbitMQ) GetChannel() *amqp.Channel {
 	return r.channel
 }
+
+// DeclareTopicExchange declares a durable topic exchange, so consumers can
+// bind queues to routing key patterns such as "inventory.*".
+func (r *RabbitMQ) DeclareTopicExchange(name string) error {
+	return r.channel.ExchangeDeclare(name, amqp.ExchangeTopic, true, false, false, false, nil)
+}
+
+// PublishJSON publishes payload as a persistent JSON message.
+func (r *RabbitMQ) PublishJSON(ctx context.Context, exchange, routingKey string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return r.channel.PublishWithContext(ctx, exchange, routingKey, false, false, amqp.Publishing{
+		ContentType:  "application/json",
+		DeliveryMode: amqp.Persistent,
+		Timestamp:    time.Now().UTC(),
+		Body:         body,
+	})
+}
@@ -68,9 +68,9 @@ func main() {
 	defer closeClients()
 
 	// Initialize handlers
-	userHandler := handlers.NewUserHandler(serviceClients.UserClient)
+	userHandler := handlers.NewUserHandler(serviceClients.UserClient, serviceClients.CartClient)
 	productHandler := handlers.NewProductHandler(serviceClients.ProductClient)
-	cartHandler := handlers.NewCartHandler(serviceClients.CartClient)
+	cartHandler := handlers.NewCartHandler(serviceClients.CartClient, serviceClients.OrderClient)
 	orderHandler := handlers.NewOrderHandler(serviceClients.OrderClient)
 
 	routerEngine := gin.Default()
@@ -86,7 +86,7 @@ func Load() (*Config, error) {
 		// CORS
 		AllowedOrigins: getEnvArray("ALLOWED_ORIGINS", []string{"*"}),
 		AllowedMethods: getEnvArray("ALLOWED_METHODS", []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}),
-		AllowedHeaders: getEnvArray("ALLOWED_HEADERS", []string{"Accept", "Authorization", "Content-Type", "X-Request-ID"}),
+		AllowedHeaders: getEnvArray("ALLOWED_HEADERS", []string{"Accept", "Authorization", "Content-Type", "X-Request-ID", "X-Cart-Token", "If-Match"}),
 
 		// Rate Limiting
 		RateLimitRequests: getEnvInt("RATE_LIMIT_REQUESTS", 100),
@@ -82,6 +82,9 @@ func createGRPCConnection(target, internalAuthToken string, cbConfig grpcmiddlew
 			grpcmiddleware.InternalAuthUnaryClientInterceptor(internalAuthToken),
 			grpcmiddleware.CircuitBreakerUnaryClientInterceptor("api-gateway->"+target, cbConfig),
 		),
+		grpc.WithChainStreamInterceptor(
+			grpcmiddleware.InternalAuthStreamClientInterceptor(internalAuthToken),
+		),
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallRecvMsgSize(10*1024*1024), // 10MB
 			grpc.MaxCallSendMsgSize(10*1024*1024), // 10MB
@@ -1,43 +1,69 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/kareemhamed001/e-commerce/pkg/logger"
 	"github.com/kareemhamed001/e-commerce/services/ApiGateway/internal/middleware"
 	cartpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/cart"
+	orderpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/order"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CartTokenHeader carries the opaque token of a guest cart. Anonymous
+// shoppers send it back on every cart request and on login or registration,
+// where their guest cart is merged into their account's cart.
+const CartTokenHeader = "X-Cart-Token"
+
+// Cart responses carry the cart version as their ETag. A mutation sent with
+// that ETag in If-Match fails with 412 Precondition Failed when the cart was
+// changed in the meantime, instead of silently overwriting the other change.
+const (
+	etagHeader    = "ETag"
+	ifMatchHeader = "If-Match"
 )
 
 // CartHandler handles cart-related HTTP requests
 type CartHandler struct {
-	cartClient cartpb.CartServiceClient
+	cartClient  cartpb.CartServiceClient
+	orderClient orderpb.OrderServiceClient
 }
 
-// NewCartHandler creates a new cart handler
-func NewCartHandler(cartClient cartpb.CartServiceClient) *CartHandler {
+// NewCartHandler creates a new cart handler. The order client prices
+// shipping for the cart.
+func NewCartHandler(cartClient cartpb.CartServiceClient, orderClient orderpb.OrderServiceClient) *CartHandler {
 	return &CartHandler{
-		cartClient: cartClient,
+		cartClient:  cartClient,
+		orderClient: orderClient,
 	}
 }
 
 // GetCart godoc
-// @Summary Get user cart
-// @Description Get the current user's cart
+// @Summary Get cart
+// @Description Get the current user's cart, or the guest cart of the X-Cart-Token header
 // @Tags cart
 // @Produce json
 // @Security BearerAuth
+// @Param X-Cart-Token header string false "Guest cart token"
 // @Success 200 {object} CartResponse
 // @Router /api/v1/cart [get]
 func (h *CartHandler) GetCart(w http.ResponseWriter, r *http.Request) {
-	userID, ok := middleware.GetUserID(r.Context())
-	if !ok {
-		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+	userID, guestToken := cartOwner(r)
+	if userID == 0 && guestToken == "" {
+		// An anonymous shopper without a guest cart has an empty cart.
+		writeJSON(w, http.StatusOK, &cartpb.CartResponse{Items: []*cartpb.CartItem{}})
 		return
 	}
 
 	resp, err := h.cartClient.GetCart(r.Context(), &cartpb.GetCartRequest{
-		UserId: int64(userID),
+		UserId:     int64(userID),
+		GuestToken: guestToken,
 	})
 
 	if err != nil {
@@ -46,28 +72,29 @@ func (h *CartHandler) GetCart(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	setCartETag(w, resp)
 	writeJSON(w, http.StatusOK, resp)
 }
 
 // AddItem godoc
 // @Summary Add item to cart
-// @Description Add a product to the user's cart
+// @Description Add a product to the user's cart or to a guest cart. Anonymous requests without X-Cart-Token start a new guest cart whose token is returned in the X-Cart-Token header and the guest_token field.
 // @Tags cart
 // @Accept json
 // @Produce json
 // @Security BearerAuth
+// @Param X-Cart-Token header string false "Guest cart token"
+// @Param If-Match header string false "ETag of the cart the change is based on"
 // @Param request body AddItemRequest true "Item details"
 // @Success 200 {object} CartResponse
+// @Failure 412 {object} ErrorResponse
 // @Router /api/v1/cart/items [post]
 func (h *CartHandler) AddItem(w http.ResponseWriter, r *http.Request) {
-	userID, ok := middleware.GetUserID(r.Context())
-	if !ok {
-		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
-		return
-	}
+	userID, guestToken := cartOwner(r)
 
 	var req struct {
 		ProductID int64 `json:"product_id"`
+		VariantID int64 `json:"variant_id"`
 		Quantity  int32 `json:"quantity"`
 	}
 
@@ -76,18 +103,32 @@ func (h *CartHandler) AddItem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	expectedVersion, err := ifMatchVersion(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	resp, err := h.cartClient.AddItem(r.Context(), &cartpb.AddItemRequest{
-		UserId:    int64(userID),
-		ProductId: req.ProductID,
-		Quantity:  req.Quantity,
+		UserId:          int64(userID),
+		GuestToken:      guestToken,
+		ProductId:       req.ProductID,
+		VariantId:       req.VariantID,
+		Quantity:        req.Quantity,
+		ExpectedVersion: expectedVersion,
 	})
 
 	if err != nil {
 		logger.Errorf("failed to add item to cart: %v", err)
-		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		writeCartMutationError(w, err)
 		return
 	}
 
+	if resp.GetGuestToken() != "" {
+		w.Header().Set(CartTokenHeader, resp.GetGuestToken())
+	}
+
+	setCartETag(w, resp)
 	writeJSON(w, http.StatusOK, resp)
 }
 
@@ -98,18 +139,22 @@ func (h *CartHandler) AddItem(w http.ResponseWriter, r *http.Request) {
 // @Accept json
 // @Produce json
 // @Security BearerAuth
+// @Param X-Cart-Token header string false "Guest cart token"
+// @Param If-Match header string false "ETag of the cart the change is based on"
 // @Param request body UpdateItemRequest true "Item update details"
 // @Success 200 {object} CartResponse
+// @Failure 412 {object} ErrorResponse
 // @Router /api/v1/cart/items [put]
 func (h *CartHandler) UpdateItem(w http.ResponseWriter, r *http.Request) {
-	userID, ok := middleware.GetUserID(r.Context())
-	if !ok {
-		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+	userID, guestToken := cartOwner(r)
+	if userID == 0 && guestToken == "" {
+		writeJSONError(w, http.StatusUnauthorized, "missing authorization header or cart token")
 		return
 	}
 
 	var req struct {
 		ProductID int64 `json:"product_id"`
+		VariantID int64 `json:"variant_id"`
 		Quantity  int32 `json:"quantity"`
 	}
 
@@ -118,40 +163,54 @@ func (h *CartHandler) UpdateItem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	expectedVersion, err := ifMatchVersion(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	resp, err := h.cartClient.UpdateItem(r.Context(), &cartpb.UpdateItemRequest{
-		UserId:    int64(userID),
-		ProductId: req.ProductID,
-		Quantity:  req.Quantity,
+		UserId:          int64(userID),
+		GuestToken:      guestToken,
+		ProductId:       req.ProductID,
+		VariantId:       req.VariantID,
+		Quantity:        req.Quantity,
+		ExpectedVersion: expectedVersion,
 	})
 
 	if err != nil {
 		logger.Errorf("failed to update cart item: %v", err)
-		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		writeCartMutationError(w, err)
 		return
 	}
 
+	setCartETag(w, resp)
 	writeJSON(w, http.StatusOK, resp)
 }
 
 // RemoveItem godoc
 // @Summary Remove item from cart
-// @Description Remove a product from the user's cart
+// @Description Remove a product from the user's cart or a guest cart
 // @Tags cart
 // @Accept json
 // @Produce json
 // @Security BearerAuth
+// @Param X-Cart-Token header string false "Guest cart token"
+// @Param If-Match header string false "ETag of the cart the change is based on"
 // @Param request body RemoveItemRequest true "Product ID"
 // @Success 200 {object} CartResponse
+// @Failure 412 {object} ErrorResponse
 // @Router /api/v1/cart/items [delete]
 func (h *CartHandler) RemoveItem(w http.ResponseWriter, r *http.Request) {
-	userID, ok := middleware.GetUserID(r.Context())
-	if !ok {
-		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+	userID, guestToken := cartOwner(r)
+	if userID == 0 && guestToken == "" {
+		writeJSONError(w, http.StatusUnauthorized, "missing authorization header or cart token")
 		return
 	}
 
 	var req struct {
 		ProductID int64 `json:"product_id"`
+		VariantID int64 `json:"variant_id"`
 	}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -159,44 +218,231 @@ func (h *CartHandler) RemoveItem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	expectedVersion, err := ifMatchVersion(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	resp, err := h.cartClient.RemoveItem(r.Context(), &cartpb.RemoveItemRequest{
-		UserId:    int64(userID),
-		ProductId: req.ProductID,
+		UserId:          int64(userID),
+		GuestToken:      guestToken,
+		ProductId:       req.ProductID,
+		VariantId:       req.VariantID,
+		ExpectedVersion: expectedVersion,
 	})
 
 	if err != nil {
 		logger.Errorf("failed to remove item from cart: %v", err)
-		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		writeCartMutationError(w, err)
 		return
 	}
 
+	setCartETag(w, resp)
 	writeJSON(w, http.StatusOK, resp)
 }
 
 // ClearCart godoc
 // @Summary Clear cart
-// @Description Remove all items from the user's cart
+// @Description Remove all items from the user's cart or a guest cart
 // @Tags cart
 // @Produce json
 // @Security BearerAuth
+// @Param X-Cart-Token header string false "Guest cart token"
+// @Param If-Match header string false "ETag of the cart the change is based on"
 // @Success 200 {object} ClearCartResponse
+// @Failure 412 {object} ErrorResponse
 // @Router /api/v1/cart [delete]
 func (h *CartHandler) ClearCart(w http.ResponseWriter, r *http.Request) {
+	userID, guestToken := cartOwner(r)
+	if userID == 0 && guestToken == "" {
+		writeJSONError(w, http.StatusUnauthorized, "missing authorization header or cart token")
+		return
+	}
+
+	expectedVersion, err := ifMatchVersion(r)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.cartClient.ClearCart(r.Context(), &cartpb.ClearCartRequest{
+		UserId:          int64(userID),
+		GuestToken:      guestToken,
+		ExpectedVersion: expectedVersion,
+	})
+
+	if err != nil {
+		logger.Errorf("failed to clear cart: %v", err)
+		writeCartMutationError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// MergeCart godoc
+// @Summary Merge guest cart
+// @Description Merge a guest cart into the authenticated user's cart and delete the guest cart. strategy decides lines present in both carts: sum, max or guest; empty uses the service default.
+// @Tags cart
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param X-Cart-Token header string false "Guest cart token, when not given in the body"
+// @Param request body MergeCartRequest false "Guest token and strategy"
+// @Success 200 {object} CartResponse
+// @Router /api/v1/cart/merge [post]
+func (h *CartHandler) MergeCart(w http.ResponseWriter, r *http.Request) {
 	userID, ok := middleware.GetUserID(r.Context())
 	if !ok {
 		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
 		return
 	}
 
-	resp, err := h.cartClient.ClearCart(r.Context(), &cartpb.ClearCartRequest{
-		UserId: int64(userID),
+	var req struct {
+		GuestToken string `json:"guest_token"`
+		Strategy   string `json:"strategy"`
+	}
+
+	if r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+	}
+	if req.GuestToken == "" {
+		req.GuestToken = r.Header.Get(CartTokenHeader)
+	}
+	if req.GuestToken == "" {
+		writeJSONError(w, http.StatusBadRequest, "guest_token is required")
+		return
+	}
+
+	resp, err := h.cartClient.MergeCart(r.Context(), &cartpb.MergeCartRequest{
+		UserId:     int64(userID),
+		GuestToken: req.GuestToken,
+		Strategy:   req.Strategy,
 	})
 
 	if err != nil {
-		logger.Errorf("failed to clear cart: %v", err)
+		logger.Errorf("failed to merge cart: %v", err)
 		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
 		return
 	}
 
+	setCartETag(w, resp)
 	writeJSON(w, http.StatusOK, resp)
 }
+
+// ShippingOptions godoc
+// @Summary Get shipping options
+// @Description Price the shipping options for sending the authenticated user's cart to one of their addresses. Pass the id of the chosen option as shipping_option when creating the order.
+// @Tags cart
+// @Produce json
+// @Security BearerAuth
+// @Param address_id query int true "Shipping address ID"
+// @Success 200 {object} GetShippingOptionsResponse
+// @Router /api/v1/cart/shipping-options [get]
+func (h *CartHandler) ShippingOptions(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	addressID, err := strconv.ParseInt(r.URL.Query().Get("address_id"), 10, 64)
+	if err != nil || addressID <= 0 {
+		writeJSONError(w, http.StatusBadRequest, "invalid address ID")
+		return
+	}
+
+	cart, err := h.cartClient.GetCart(r.Context(), &cartpb.GetCartRequest{UserId: int64(userID)})
+	if err != nil {
+		logger.Errorf("failed to get cart: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+	if len(cart.GetItems()) == 0 {
+		writeJSONError(w, http.StatusBadRequest, "cart is empty")
+		return
+	}
+
+	items := make([]*orderpb.OrderItemInput, 0, len(cart.GetItems()))
+	for _, item := range cart.GetItems() {
+		items = append(items, &orderpb.OrderItemInput{
+			ProductId: item.GetProductId(),
+			VariantId: item.GetVariantId(),
+			Quantity:  item.GetQuantity(),
+		})
+	}
+
+	resp, err := h.orderClient.GetShippingOptions(r.Context(), &orderpb.GetShippingOptionsRequest{
+		UserId:    int64(userID),
+		AddressId: addressID,
+		Items:     items,
+	})
+	if err != nil {
+		logger.Errorf("failed to get shipping options: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// cartOwner returns the authenticated user, or the guest cart token of an
+// anonymous request. Both are zero for an anonymous shopper without a cart.
+func cartOwner(r *http.Request) (uint, string) {
+	if userID, ok := middleware.GetUserID(r.Context()); ok {
+		return userID, ""
+	}
+	return 0, r.Header.Get(CartTokenHeader)
+}
+
+// mergeGuestCart merges the guest cart of the request, if any, into the
+// user's cart with the service's default strategy. A failed merge leaves the
+// guest cart in place and does not fail the caller.
+func mergeGuestCart(ctx context.Context, cartClient cartpb.CartServiceClient, r *http.Request, userID int64) {
+	guestToken := r.Header.Get(CartTokenHeader)
+	if guestToken == "" || userID == 0 {
+		return
+	}
+
+	if _, err := cartClient.MergeCart(ctx, &cartpb.MergeCartRequest{
+		UserId:     userID,
+		GuestToken: guestToken,
+	}); err != nil {
+		logger.Warnf("failed to merge guest cart into cart of user %d: %v", userID, err)
+	}
+}
+
+// setCartETag exposes the version of the returned cart as its ETag.
+func setCartETag(w http.ResponseWriter, resp *cartpb.CartResponse) {
+	w.Header().Set(etagHeader, strconv.Quote(strconv.FormatInt(resp.GetVersion(), 10)))
+}
+
+// ifMatchVersion reads the cart version a mutation is based on from the
+// If-Match header. It is nil when the header is missing or "*", so the
+// mutation applies to whatever the cart currently holds.
+func ifMatchVersion(r *http.Request) (*int64, error) {
+	etag := strings.TrimSpace(r.Header.Get(ifMatchHeader))
+	if etag == "" || etag == "*" {
+		return nil, nil
+	}
+
+	version, err := strconv.ParseInt(strings.Trim(strings.TrimPrefix(etag, "W/"), `"`), 10, 64)
+	if err != nil || version < 0 {
+		return nil, errors.New("invalid If-Match header: expected a cart ETag")
+	}
+	return &version, nil
+}
+
+// writeCartMutationError reports a cart version mismatch as 412 Precondition
+// Failed, and any other error like the rest of the gateway.
+func writeCartMutationError(w http.ResponseWriter, err error) {
+	if status.Code(err) == codes.Aborted {
+		writeJSONError(w, http.StatusPreconditionFailed, status.Convert(err).Message())
+		return
+	}
+	writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+}
@@ -0,0 +1,362 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/ApiGateway/internal/middleware"
+	cartpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/cart"
+)
+
+// listItemBody is the body of the list item and move routes. An empty
+// list_id addresses the saved-for-later list.
+type listItemBody struct {
+	ListID    string `json:"list_id"`
+	ProductID int64  `json:"product_id"`
+	VariantID int64  `json:"variant_id"`
+}
+
+// ListLists godoc
+// @Summary List saved items and wishlists
+// @Description Get the user's saved-for-later list, followed by their wishlists, with their items
+// @Tags lists
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} ListsResponse
+// @Router /api/v1/lists [get]
+func (h *CartHandler) ListLists(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	resp, err := h.cartClient.ListLists(r.Context(), &cartpb.ListListsRequest{UserId: int64(userID)})
+	if err != nil {
+		logger.Errorf("failed to list lists: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// GetList godoc
+// @Summary Get list
+// @Description Get one of the user's lists; an empty id returns the saved-for-later list
+// @Tags lists
+// @Produce json
+// @Security BearerAuth
+// @Param id query string false "List ID"
+// @Success 200 {object} ListResponse
+// @Router /api/v1/lists/by-id [get]
+func (h *CartHandler) GetList(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	resp, err := h.cartClient.GetList(r.Context(), &cartpb.GetListRequest{
+		UserId: int64(userID),
+		ListId: r.URL.Query().Get("id"),
+	})
+	if err != nil {
+		logger.Errorf("failed to get list: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// CreateWishlist godoc
+// @Summary Create wishlist
+// @Description Create a named wishlist for the user
+// @Tags lists
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateWishlistRequest true "Wishlist name"
+// @Success 201 {object} ListResponse
+// @Router /api/v1/lists/create [post]
+func (h *CartHandler) CreateWishlist(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req struct {
+		Name string `json:"name"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.cartClient.CreateWishlist(r.Context(), &cartpb.CreateWishlistRequest{
+		UserId: int64(userID),
+		Name:   req.Name,
+	})
+	if err != nil {
+		logger.Errorf("failed to create wishlist: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// DeleteList godoc
+// @Summary Delete wishlist
+// @Description Delete one of the user's wishlists; the saved-for-later list cannot be deleted
+// @Tags lists
+// @Produce json
+// @Security BearerAuth
+// @Param id query string true "List ID"
+// @Success 200 {object} DeleteListResponse
+// @Router /api/v1/lists/delete [delete]
+func (h *CartHandler) DeleteList(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	listID := r.URL.Query().Get("id")
+	if listID == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing list ID")
+		return
+	}
+
+	resp, err := h.cartClient.DeleteList(r.Context(), &cartpb.DeleteListRequest{
+		UserId: int64(userID),
+		ListId: listID,
+	})
+	if err != nil {
+		logger.Errorf("failed to delete list: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// AddListItem godoc
+// @Summary Add item to list
+// @Description Add a product to one of the user's lists; an empty list_id adds it to the saved-for-later list
+// @Tags lists
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body AddListItemRequest true "List and item details"
+// @Success 200 {object} ListResponse
+// @Router /api/v1/lists/items/add [post]
+func (h *CartHandler) AddListItem(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req struct {
+		listItemBody
+		Quantity int32 `json:"quantity"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	if req.Quantity == 0 {
+		req.Quantity = 1
+	}
+
+	resp, err := h.cartClient.AddListItem(r.Context(), &cartpb.AddListItemRequest{
+		UserId:    int64(userID),
+		ListId:    req.ListID,
+		ProductId: req.ProductID,
+		VariantId: req.VariantID,
+		Quantity:  req.Quantity,
+	})
+	if err != nil {
+		logger.Errorf("failed to add item to list: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// RemoveListItem godoc
+// @Summary Remove item from list
+// @Description Remove a product from one of the user's lists
+// @Tags lists
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ListItemRequest true "List and item"
+// @Success 200 {object} ListResponse
+// @Router /api/v1/lists/items/remove [delete]
+func (h *CartHandler) RemoveListItem(w http.ResponseWriter, r *http.Request) {
+	req, userID, ok := decodeListItemRequest(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.cartClient.RemoveListItem(r.Context(), listItemRequest(userID, req))
+	if err != nil {
+		logger.Errorf("failed to remove item from list: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// MoveToList godoc
+// @Summary Move cart item to list
+// @Description Move a whole cart line into one of the user's lists; an empty list_id saves it for later
+// @Tags lists
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ListItemRequest true "List and item"
+// @Success 200 {object} MoveItemResponse
+// @Router /api/v1/cart/items/move-to-list [post]
+func (h *CartHandler) MoveToList(w http.ResponseWriter, r *http.Request) {
+	req, userID, ok := decodeListItemRequest(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.cartClient.MoveToList(r.Context(), listItemRequest(userID, req))
+	if err != nil {
+		logger.Errorf("failed to move cart item to list: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// MoveToCart godoc
+// @Summary Move list item to cart
+// @Description Move a whole list item into the user's cart, within the stock and per-order limits
+// @Tags lists
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ListItemRequest true "List and item"
+// @Success 200 {object} MoveItemResponse
+// @Router /api/v1/lists/items/move-to-cart [post]
+func (h *CartHandler) MoveToCart(w http.ResponseWriter, r *http.Request) {
+	req, userID, ok := decodeListItemRequest(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.cartClient.MoveToCart(r.Context(), listItemRequest(userID, req))
+	if err != nil {
+		logger.Errorf("failed to move list item to cart: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// ShareList godoc
+// @Summary Share wishlist
+// @Description Turn the public share link of a wishlist on or off. Turning it on again issues a new share_token and revokes the old link.
+// @Tags lists
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ShareListRequest true "List and whether it is shared"
+// @Success 200 {object} ListResponse
+// @Router /api/v1/lists/share [put]
+func (h *CartHandler) ShareList(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req struct {
+		ListID string `json:"list_id"`
+		Shared bool   `json:"shared"`
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.cartClient.ShareList(r.Context(), &cartpb.ShareListRequest{
+		UserId: int64(userID),
+		ListId: req.ListID,
+		Shared: req.Shared,
+	})
+	if err != nil {
+		logger.Errorf("failed to share list: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// GetSharedList godoc
+// @Summary Get shared wishlist
+// @Description Get a wishlist by its public share token
+// @Tags lists
+// @Produce json
+// @Param token query string true "Share token"
+// @Success 200 {object} ListResponse
+// @Router /api/v1/lists/shared [get]
+func (h *CartHandler) GetSharedList(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if token == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing share token")
+		return
+	}
+
+	resp, err := h.cartClient.GetSharedList(r.Context(), &cartpb.GetSharedListRequest{ShareToken: token})
+	if err != nil {
+		logger.Errorf("failed to get shared list: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// decodeListItemRequest reads the authenticated user and the list item body,
+// writing the error response when either is missing.
+func decodeListItemRequest(w http.ResponseWriter, r *http.Request) (listItemBody, uint, bool) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+		return listItemBody{}, 0, false
+	}
+
+	var req listItemBody
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return listItemBody{}, 0, false
+	}
+	return req, userID, true
+}
+
+func listItemRequest(userID uint, req listItemBody) *cartpb.ListItemRequest {
+	return &cartpb.ListItemRequest{
+		UserId:    int64(userID),
+		ListId:    req.ListID,
+		ProductId: req.ProductID,
+		VariantId: req.VariantID,
+	}
+}
@@ -24,7 +24,7 @@ func NewOrderHandler(orderClient orderpb.OrderServiceClient) *OrderHandler {
 
 // CreateOrder godoc
 // @Summary Create order
-// @Description Create a new order
+// @Description Create a new order. Shipping is priced from shipping_option, one of the options of GET /api/v1/cart/shipping-options for the address.
 // @Tags orders
 // @Accept json
 // @Produce json
@@ -40,11 +40,12 @@ func (h *OrderHandler) CreateOrder(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var req struct {
-		ShippingCost         float32 `json:"shipping_cost"`
-		ShippingDurationDays int32   `json:"shipping_duration_days"`
-		Discount             float32 `json:"discount"`
-		Items                []struct {
+		ShippingOption string  `json:"shipping_option"`
+		Discount       float32 `json:"discount"`
+		AddressID      int64   `json:"address_id"`
+		Items          []struct {
 			ProductID int64 `json:"product_id"`
+			VariantID int64 `json:"variant_id"`
 			Quantity  int32 `json:"quantity"`
 		} `json:"items"`
 	}
@@ -58,16 +59,17 @@ func (h *OrderHandler) CreateOrder(w http.ResponseWriter, r *http.Request) {
 	for _, item := range req.Items {
 		items = append(items, &orderpb.OrderItemInput{
 			ProductId: item.ProductID,
+			VariantId: item.VariantID,
 			Quantity:  item.Quantity,
 		})
 	}
 
 	resp, err := h.orderClient.CreateOrder(r.Context(), &orderpb.CreateOrderRequest{
-		UserId:               int64(userID),
-		ShippingCost:         req.ShippingCost,
-		ShippingDurationDays: req.ShippingDurationDays,
-		Discount:             req.Discount,
-		Items:                items,
+		UserId:         int64(userID),
+		ShippingOption: req.ShippingOption,
+		Discount:       req.Discount,
+		AddressId:      req.AddressID,
+		Items:          items,
 	})
 	if err != nil {
 		logger.Errorf("failed to create order: %v", err)
@@ -0,0 +1,211 @@
+package handlers
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/ApiGateway/internal/middleware"
+	orderpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/order"
+)
+
+const (
+	// paymentSignatureHeader carries the provider's signature of the webhook
+	// body.
+	paymentSignatureHeader = "X-Payment-Signature"
+	// maxWebhookBodySize bounds the webhook bodies accepted from providers.
+	maxWebhookBodySize = 1 << 20
+)
+
+// AuthorizePayment godoc
+// @Summary Authorize order payment
+// @Description Hold the total of a pending order on the customer's payment method. The order becomes paid once an admin captures the payment.
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body AuthorizePaymentRequest true "Order and payment method"
+// @Success 201 {object} PaymentResponse
+// @Router /api/v1/orders/payments/authorize [post]
+func (h *OrderHandler) AuthorizePayment(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req struct {
+		OrderID       int64  `json:"order_id"`
+		PaymentMethod string `json:"payment_method"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.orderClient.AuthorizePayment(r.Context(), &orderpb.AuthorizePaymentRequest{
+		OrderId:       req.OrderID,
+		UserId:        int64(userID),
+		PaymentMethod: req.PaymentMethod,
+	})
+	if err != nil {
+		logger.Errorf("failed to authorize payment: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// CapturePayment godoc
+// @Summary Capture payment
+// @Description Capture an authorized payment in full and mark its order paid (admin only)
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CapturePaymentRequest true "Payment ID"
+// @Success 200 {object} PaymentResponse
+// @Router /api/v1/orders/payments/capture [post]
+func (h *OrderHandler) CapturePayment(w http.ResponseWriter, r *http.Request) {
+	var req orderpb.CapturePaymentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.orderClient.CapturePayment(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to capture payment: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// VoidPayment godoc
+// @Summary Void payment
+// @Description Release an authorized payment without capturing it (admin only)
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body VoidPaymentRequest true "Payment ID"
+// @Success 200 {object} PaymentResponse
+// @Router /api/v1/orders/payments/void [post]
+func (h *OrderHandler) VoidPayment(w http.ResponseWriter, r *http.Request) {
+	var req orderpb.VoidPaymentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.orderClient.VoidPayment(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to void payment: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// RefundPayment godoc
+// @Summary Refund payment
+// @Description Refund part of a captured payment, or all that is left of it when amount is 0 or omitted (admin only)
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body RefundPaymentRequest true "Payment ID and amount"
+// @Success 200 {object} PaymentResponse
+// @Router /api/v1/orders/payments/refund [post]
+func (h *OrderHandler) RefundPayment(w http.ResponseWriter, r *http.Request) {
+	var req orderpb.RefundPaymentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.orderClient.RefundPayment(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to refund payment: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// ListOrderPayments godoc
+// @Summary List order payments
+// @Description List the payments of an order with their transactions. Customers see only their own orders.
+// @Tags payments
+// @Produce json
+// @Security BearerAuth
+// @Param order_id query int true "Order ID"
+// @Success 200 {object} ListOrderPaymentsResponse
+// @Router /api/v1/orders/payments [get]
+func (h *OrderHandler) ListOrderPayments(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	orderID, err := strconv.ParseInt(r.URL.Query().Get("order_id"), 10, 64)
+	if err != nil || orderID <= 0 {
+		writeJSONError(w, http.StatusBadRequest, "invalid order ID")
+		return
+	}
+
+	// A zero user ID lets admins see the payments of any order.
+	ownerID := int64(userID)
+	if role, _ := middleware.GetUserRole(r.Context()); role == "admin" {
+		ownerID = 0
+	}
+
+	resp, err := h.orderClient.ListOrderPayments(r.Context(), &orderpb.ListOrderPaymentsRequest{
+		OrderId: orderID,
+		UserId:  ownerID,
+	})
+	if err != nil {
+		logger.Errorf("failed to list order payments: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// PaymentWebhook godoc
+// @Summary Payment provider webhook
+// @Description Receive a payment provider notification. The raw body must be signed with the webhook secret, hex HMAC-SHA256, in the X-Payment-Signature header.
+// @Tags payments
+// @Accept json
+// @Produce json
+// @Param X-Payment-Signature header string true "Webhook signature"
+// @Success 200 {object} HandlePaymentWebhookResponse
+// @Router /api/v1/payments/webhook [post]
+func (h *OrderHandler) PaymentWebhook(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodySize))
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.orderClient.HandlePaymentWebhook(r.Context(), &orderpb.HandlePaymentWebhookRequest{
+		Payload:   payload,
+		Signature: r.Header.Get(paymentSignatureHeader),
+	})
+	if err != nil {
+		logger.Errorf("failed to handle payment webhook: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
@@ -2,10 +2,19 @@ package handlers
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"path"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/ApiGateway/internal/middleware"
 	productpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/product"
 )
 
@@ -89,6 +98,9 @@ func (h *ProductHandler) GetProductByID(w http.ResponseWriter, r *http.Request)
 // @Produce json
 // @Param page query int false "Page number" default(1)
 // @Param per_page query int false "Items per page" default(10)
+// @Param attr.{code} query string false "Comma-separated values the attribute must match, e.g. attr.color=red,blue"
+// @Param attr.{code}.min query number false "Minimum value of a number attribute, e.g. attr.ram_gb.min=8"
+// @Param attr.{code}.max query number false "Maximum value of a number attribute"
 // @Success 200 {object} ListProductsResponse
 // @Router /api/v1/products [get]
 func (h *ProductHandler) ListProducts(w http.ResponseWriter, r *http.Request) {
@@ -102,9 +114,16 @@ func (h *ProductHandler) ListProducts(w http.ResponseWriter, r *http.Request) {
 		perPage = 10
 	}
 
+	attributes, err := attributeFilters(r.URL.Query())
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
 	resp, err := h.productClient.ListProducts(r.Context(), &productpb.ListProductsRequest{
-		Page:    int32(page),
-		PerPage: int32(perPage),
+		Page:       int32(page),
+		PerPage:    int32(perPage),
+		Attributes: attributes,
 	})
 
 	if err != nil {
@@ -116,6 +135,125 @@ func (h *ProductHandler) ListProducts(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, resp)
 }
 
+// GetCacheStats godoc
+// @Summary Get product cache stats
+// @Description Hit and miss counters of the product and product list caches of the ProductService instance that served the request (admin only)
+// @Tags products
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} GetCacheStatsResponse
+// @Router /api/v1/products/cache/stats [get]
+func (h *ProductHandler) GetCacheStats(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.productClient.GetCacheStats(r.Context(), &productpb.GetCacheStatsRequest{})
+	if err != nil {
+		logger.Errorf("failed to get cache stats: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// SearchProducts godoc
+// @Summary Search products
+// @Description Full-text product search with filters, sorting and facet counts
+// @Tags products
+// @Produce json
+// @Param q query string false "Search text"
+// @Param min_price query number false "Minimum effective price"
+// @Param max_price query number false "Maximum effective price"
+// @Param category_id query int false "Category ID"
+// @Param in_stock query bool false "Only products in stock"
+// @Param on_sale query bool false "Only products with an active discount"
+// @Param sort query string false "relevance, price_asc, price_desc, newest or popularity" default(relevance)
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param attr.{code} query string false "Comma-separated values the attribute must match, e.g. attr.color=red,blue"
+// @Param attr.{code}.min query number false "Minimum value of a number attribute, e.g. attr.ram_gb.min=8"
+// @Param attr.{code}.max query number false "Maximum value of a number attribute"
+// @Success 200 {object} SearchProductsResponse
+// @Router /api/v1/products/search [get]
+func (h *ProductHandler) SearchProducts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	page, _ := strconv.Atoi(query.Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	perPage, _ := strconv.Atoi(query.Get("per_page"))
+	if perPage < 1 || perPage > 100 {
+		perPage = 10
+	}
+
+	req := &productpb.SearchProductsRequest{
+		Query:          query.Get("q"),
+		InStockOnly:    query.Get("in_stock") == "true",
+		DiscountActive: query.Get("on_sale") == "true",
+		Page:           int32(page),
+		PerPage:        int32(perPage),
+	}
+
+	if v := query.Get("min_price"); v != "" {
+		minPrice, err := strconv.ParseFloat(v, 32)
+		if err != nil || minPrice < 0 {
+			writeJSONError(w, http.StatusBadRequest, "invalid min_price")
+			return
+		}
+		req.MinPrice = float32(minPrice)
+	}
+
+	if v := query.Get("max_price"); v != "" {
+		maxPrice, err := strconv.ParseFloat(v, 32)
+		if err != nil || maxPrice < 0 {
+			writeJSONError(w, http.StatusBadRequest, "invalid max_price")
+			return
+		}
+		req.MaxPrice = float32(maxPrice)
+	}
+
+	if v := query.Get("category_id"); v != "" {
+		categoryID, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || categoryID < 1 {
+			writeJSONError(w, http.StatusBadRequest, "invalid category_id")
+			return
+		}
+		req.CategoryId = categoryID
+	}
+
+	attributes, err := attributeFilters(query)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	req.Attributes = attributes
+
+	switch query.Get("sort") {
+	case "", "relevance":
+		req.SortBy = productpb.ProductSortBy_SORT_RELEVANCE
+	case "price_asc":
+		req.SortBy = productpb.ProductSortBy_SORT_PRICE_ASC
+	case "price_desc":
+		req.SortBy = productpb.ProductSortBy_SORT_PRICE_DESC
+	case "newest":
+		req.SortBy = productpb.ProductSortBy_SORT_NEWEST
+	case "popularity":
+		req.SortBy = productpb.ProductSortBy_SORT_POPULARITY
+	default:
+		writeJSONError(w, http.StatusBadRequest, "invalid sort")
+		return
+	}
+
+	resp, err := h.productClient.SearchProducts(r.Context(), req)
+	if err != nil {
+		logger.Errorf("failed to search products: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
 // UpdateProduct godoc
 // @Summary Update product
 // @Description Update product details (admin only)
@@ -334,3 +472,1932 @@ func (h *ProductHandler) DeleteCategory(w http.ResponseWriter, r *http.Request)
 
 	writeJSON(w, http.StatusOK, resp)
 }
+
+// GetCategoryTree godoc
+// @Summary Get category tree
+// @Description Get the full nested category tree for navigation menus
+// @Tags categories
+// @Produce json
+// @Success 200 {object} GetCategoryTreeResponse
+// @Router /api/v1/categories/tree [get]
+func (h *ProductHandler) GetCategoryTree(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.productClient.GetCategoryTree(r.Context(), &productpb.GetCategoryTreeRequest{})
+	if err != nil {
+		logger.Errorf("failed to get category tree: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// ListProductsByCategory godoc
+// @Summary List products in category
+// @Description List products assigned to a category, optionally including its descendants
+// @Tags categories
+// @Produce json
+// @Param id query int true "Category ID"
+// @Param include_descendants query bool false "Include products from sub-categories" default(true)
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Param attr.{code} query string false "Comma-separated values the attribute must match, e.g. attr.color=red,blue"
+// @Param attr.{code}.min query number false "Minimum value of a number attribute, e.g. attr.ram_gb.min=8"
+// @Param attr.{code}.max query number false "Maximum value of a number attribute"
+// @Success 200 {object} ListProductsResponse
+// @Router /api/v1/categories/products [get]
+func (h *ProductHandler) ListProductsByCategory(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing category ID")
+		return
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid category ID")
+		return
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if perPage < 1 || perPage > 100 {
+		perPage = 10
+	}
+
+	attributes, err := attributeFilters(r.URL.Query())
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	resp, err := h.productClient.ListProductsByCategory(r.Context(), &productpb.ListProductsByCategoryRequest{
+		CategoryId:         id,
+		IncludeDescendants: r.URL.Query().Get("include_descendants") != "false",
+		Page:               int32(page),
+		PerPage:            int32(perPage),
+		Attributes:         attributes,
+	})
+	if err != nil {
+		logger.Errorf("failed to list products by category: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// AssignProductCategories godoc
+// @Summary Assign categories to product
+// @Description Assign one or more categories to a product (admin only)
+// @Tags products
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body AssignProductCategoriesRequest true "Product and category IDs"
+// @Success 200 {object} ProductCategoriesResponse
+// @Router /api/v1/products/categories/assign [post]
+func (h *ProductHandler) AssignProductCategories(w http.ResponseWriter, r *http.Request) {
+	var req productpb.AssignProductCategoriesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.AssignProductCategories(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to assign product categories: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// UnassignProductCategories godoc
+// @Summary Unassign categories from product
+// @Description Remove one or more categories from a product (admin only)
+// @Tags products
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body UnassignProductCategoriesRequest true "Product and category IDs"
+// @Success 200 {object} ProductCategoriesResponse
+// @Router /api/v1/products/categories/unassign [delete]
+func (h *ProductHandler) UnassignProductCategories(w http.ResponseWriter, r *http.Request) {
+	var req productpb.UnassignProductCategoriesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.UnassignProductCategories(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to unassign product categories: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Variant handlers
+
+// CreateProductOption godoc
+// @Summary Create product option
+// @Description Add a selectable option such as size or color, with its values, to a product (admin only)
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateProductOptionRequest true "Option details"
+// @Success 201 {object} ProductOptionResponse
+// @Router /api/v1/products/options/create [post]
+func (h *ProductHandler) CreateProductOption(w http.ResponseWriter, r *http.Request) {
+	var req productpb.CreateProductOptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.CreateProductOption(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to create product option: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// DeleteProductOption godoc
+// @Summary Delete product option
+// @Description Remove an option from a product that has no variants yet (admin only)
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body DeleteProductOptionRequest true "Product and option IDs"
+// @Success 200 {object} DeleteProductOptionResponse
+// @Router /api/v1/products/options/delete [delete]
+func (h *ProductHandler) DeleteProductOption(w http.ResponseWriter, r *http.Request) {
+	var req productpb.DeleteProductOptionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.DeleteProductOption(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to delete product option: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// CreateProductVariant godoc
+// @Summary Create product variant
+// @Description Create a variant (SKU) for one combination of option values (admin only)
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateProductVariantRequest true "Variant details"
+// @Success 201 {object} ProductVariantResponse
+// @Router /api/v1/products/variants/create [post]
+func (h *ProductHandler) CreateProductVariant(w http.ResponseWriter, r *http.Request) {
+	var req productpb.CreateProductVariantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.CreateProductVariant(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to create product variant: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// GetProductVariant godoc
+// @Summary Get product variant
+// @Description Get a variant by ID with its effective price and selected options
+// @Tags variants
+// @Produce json
+// @Param id query int true "Variant ID"
+// @Success 200 {object} ProductVariantResponse
+// @Router /api/v1/products/variants/by-id [get]
+func (h *ProductHandler) GetProductVariant(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing variant ID")
+		return
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid variant ID")
+		return
+	}
+
+	resp, err := h.productClient.GetProductVariant(r.Context(), &productpb.GetProductVariantRequest{
+		Id: id,
+	})
+	if err != nil {
+		logger.Errorf("failed to get product variant: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// UpdateProductVariant godoc
+// @Summary Update product variant
+// @Description Update a variant's SKU, price override, stock or image (admin only)
+// @Tags variants
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body UpdateProductVariantRequest true "Variant update details"
+// @Success 200 {object} ProductVariantResponse
+// @Router /api/v1/products/variants/update [put]
+func (h *ProductHandler) UpdateProductVariant(w http.ResponseWriter, r *http.Request) {
+	var req productpb.UpdateProductVariantRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.UpdateProductVariant(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to update product variant: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// DeleteProductVariant godoc
+// @Summary Delete product variant
+// @Description Delete a variant (admin only)
+// @Tags variants
+// @Security BearerAuth
+// @Param id query int true "Variant ID"
+// @Success 200 {object} DeleteProductVariantResponse
+// @Router /api/v1/products/variants/delete [delete]
+func (h *ProductHandler) DeleteProductVariant(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing variant ID")
+		return
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid variant ID")
+		return
+	}
+
+	resp, err := h.productClient.DeleteProductVariant(r.Context(), &productpb.DeleteProductVariantRequest{
+		Id: id,
+	})
+	if err != nil {
+		logger.Errorf("failed to delete product variant: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Inventory handlers
+
+// RestockProduct godoc
+// @Summary Restock product
+// @Description Add stock to a product or one of its variants and record a restock movement (admin only)
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body RestockProductRequest true "Restock details"
+// @Success 200 {object} StockMovementResponse
+// @Router /api/v1/products/restock [post]
+func (h *ProductHandler) RestockProduct(w http.ResponseWriter, r *http.Request) {
+	var req productpb.RestockProductRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	req.Actor = stockActor(r)
+
+	resp, err := h.productClient.RestockProduct(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to restock product: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// RecordStockMovement godoc
+// @Summary Record stock movement
+// @Description Record a sale, reservation, release, return or manual adjustment against product stock (admin only)
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body RecordStockMovementRequest true "Movement details"
+// @Success 201 {object} StockMovementResponse
+// @Router /api/v1/products/stock/movements/create [post]
+func (h *ProductHandler) RecordStockMovement(w http.ResponseWriter, r *http.Request) {
+	var req productpb.RecordStockMovementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	req.Actor = stockActor(r)
+
+	resp, err := h.productClient.RecordStockMovement(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to record stock movement: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// ListStockMovements godoc
+// @Summary List stock movements
+// @Description List the stock movement history of a product, newest first (admin only)
+// @Tags inventory
+// @Produce json
+// @Security BearerAuth
+// @Param product_id query int true "Product ID"
+// @Param variant_id query int false "Variant ID"
+// @Param warehouse_id query int false "Warehouse ID"
+// @Param page query int false "Page number" default(1)
+// @Param per_page query int false "Items per page" default(10)
+// @Success 200 {object} ListStockMovementsResponse
+// @Router /api/v1/products/stock/movements [get]
+func (h *ProductHandler) ListStockMovements(w http.ResponseWriter, r *http.Request) {
+	productID, err := strconv.ParseInt(r.URL.Query().Get("product_id"), 10, 64)
+	if err != nil || productID <= 0 {
+		writeJSONError(w, http.StatusBadRequest, "invalid product ID")
+		return
+	}
+
+	var variantID int64
+	if v := r.URL.Query().Get("variant_id"); v != "" {
+		variantID, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid variant ID")
+			return
+		}
+	}
+
+	var warehouseID int64
+	if v := r.URL.Query().Get("warehouse_id"); v != "" {
+		warehouseID, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid warehouse ID")
+			return
+		}
+	}
+
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if perPage < 1 || perPage > 100 {
+		perPage = 10
+	}
+
+	resp, err := h.productClient.ListStockMovements(r.Context(), &productpb.ListStockMovementsRequest{
+		ProductId:   productID,
+		VariantId:   variantID,
+		WarehouseId: warehouseID,
+		Page:        int32(page),
+		PerPage:     int32(perPage),
+	})
+	if err != nil {
+		logger.Errorf("failed to list stock movements: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// TransferStock godoc
+// @Summary Transfer stock between warehouses
+// @Description Move stock of a product or variant from one warehouse to another (admin only)
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body TransferStockRequest true "Transfer details"
+// @Success 201 {object} TransferStockResponse
+// @Router /api/v1/products/stock/transfer [post]
+func (h *ProductHandler) TransferStock(w http.ResponseWriter, r *http.Request) {
+	var req productpb.TransferStockRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	req.Actor = stockActor(r)
+
+	resp, err := h.productClient.TransferStock(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to transfer stock: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// GetStockAvailability godoc
+// @Summary Get stock availability
+// @Description Get stock of a product or variant per warehouse and summed across warehouses (admin only)
+// @Tags inventory
+// @Produce json
+// @Security BearerAuth
+// @Param product_id query int true "Product ID"
+// @Param variant_id query int false "Variant ID"
+// @Success 200 {object} StockAvailabilityResponse
+// @Router /api/v1/products/stock/availability [get]
+func (h *ProductHandler) GetStockAvailability(w http.ResponseWriter, r *http.Request) {
+	productID, err := strconv.ParseInt(r.URL.Query().Get("product_id"), 10, 64)
+	if err != nil || productID <= 0 {
+		writeJSONError(w, http.StatusBadRequest, "invalid product ID")
+		return
+	}
+
+	var variantID int64
+	if v := r.URL.Query().Get("variant_id"); v != "" {
+		variantID, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid variant ID")
+			return
+		}
+	}
+
+	resp, err := h.productClient.GetStockAvailability(r.Context(), &productpb.GetStockAvailabilityRequest{
+		ProductId: productID,
+		VariantId: variantID,
+	})
+	if err != nil {
+		logger.Errorf("failed to get stock availability: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// SetReorderThreshold godoc
+// @Summary Set reorder threshold
+// @Description Set the stock level below which a product is reported as low stock, 0 disables the check (admin only)
+// @Tags inventory
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body SetReorderThresholdRequest true "Threshold details"
+// @Success 200 {object} SetReorderThresholdResponse
+// @Router /api/v1/products/stock/reorder-threshold [put]
+func (h *ProductHandler) SetReorderThreshold(w http.ResponseWriter, r *http.Request) {
+	var req productpb.SetReorderThresholdRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.SetReorderThreshold(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to set reorder threshold: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// SetMaxPerOrder godoc
+// @Summary Set max per order
+// @Description Cap the quantity of a product, or of each of its variants, in one cart or order, 0 removes the limit (admin only)
+// @Tags products
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body SetMaxPerOrderRequest true "Product ID and limit"
+// @Success 200 {object} SetMaxPerOrderResponse
+// @Router /api/v1/products/max-per-order [put]
+func (h *ProductHandler) SetMaxPerOrder(w http.ResponseWriter, r *http.Request) {
+	var req productpb.SetMaxPerOrderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.SetMaxPerOrder(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to set max per order: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// ListLowStockProducts godoc
+// @Summary List low stock products
+// @Description List products and variants below their reorder threshold with suggested reorder quantities (admin only)
+// @Tags inventory
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} ListLowStockProductsResponse
+// @Router /api/v1/products/stock/low [get]
+func (h *ProductHandler) ListLowStockProducts(w http.ResponseWriter, r *http.Request) {
+	resp, err := h.productClient.ListLowStockProducts(r.Context(), &productpb.ListLowStockProductsRequest{})
+	if err != nil {
+		logger.Errorf("failed to list low stock products: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+const (
+	// maxImportUploadSize bounds the file accepted by ImportProducts.
+	maxImportUploadSize = 50 << 20
+	importChunkSize     = 64 << 10
+)
+
+// ImportProducts godoc
+// @Summary Import products
+// @Description Create or update products by sku or name from a CSV or NDJSON file. Rows are validated one by one and failures are reported per line; dry_run validates without saving (admin only)
+// @Tags products
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "CSV (with header) or NDJSON file"
+// @Param format query string false "csv or ndjson, defaults to the file extension"
+// @Param dry_run query bool false "Validate only, nothing is saved"
+// @Success 200 {object} ImportProductsResponse
+// @Router /api/v1/products/import [post]
+func (h *ProductHandler) ImportProducts(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	dryRun, _ := strconv.ParseBool(query.Get("dry_run"))
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportUploadSize)
+	reader, err := r.MultipartReader()
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "expected multipart/form-data with a file field")
+		return
+	}
+
+	var file *multipart.Part
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid multipart body")
+			return
+		}
+		if part.FormName() == "file" {
+			file = part
+			break
+		}
+	}
+	if file == nil {
+		writeJSONError(w, http.StatusBadRequest, "file is required")
+		return
+	}
+
+	format := query.Get("format")
+	if format == "" {
+		format = importFormatFromFilename(file.FileName())
+	}
+
+	stream, err := h.productClient.ImportProducts(r.Context())
+	if err != nil {
+		logger.Errorf("failed to start product import: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	// The first message carries the options, the file follows in chunks. A
+	// failed Send means the server ended the stream; CloseAndRecv returns why.
+	sendErr := stream.Send(&productpb.ImportProductsRequest{Format: format, DryRun: dryRun})
+	for sendErr == nil {
+		chunk := make([]byte, importChunkSize)
+		n, readErr := file.Read(chunk)
+		if n > 0 {
+			sendErr = stream.Send(&productpb.ImportProductsRequest{Data: chunk[:n]})
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			stream.CloseSend()
+			writeJSONError(w, http.StatusBadRequest, "failed to read uploaded file")
+			return
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		logger.Errorf("failed to import products: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// ExportProducts godoc
+// @Summary Export products
+// @Description Download the catalog as CSV or NDJSON, in the layout ImportProducts accepts (admin only)
+// @Tags products
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Security BearerAuth
+// @Param format query string false "csv (default) or ndjson"
+// @Success 200 {file} file
+// @Router /api/v1/products/export [get]
+func (h *ProductHandler) ExportProducts(w http.ResponseWriter, r *http.Request) {
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "csv"
+	}
+
+	stream, err := h.productClient.ExportProducts(r.Context(), &productpb.ExportProductsRequest{Format: format})
+	if err != nil {
+		logger.Errorf("failed to export products: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	// Wait for the first chunk so errors such as an unknown format still get a
+	// JSON error instead of a truncated download.
+	chunk, err := stream.Recv()
+	if err != nil && err != io.EOF {
+		logger.Errorf("failed to export products: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	contentType := "text/csv"
+	if format == "ndjson" {
+		contentType = "application/x-ndjson"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=products.%s", format))
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	for chunk != nil {
+		if _, err := w.Write(chunk.GetData()); err != nil {
+			logger.Errorf("failed to write product export: %v", err)
+			return
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		chunk, err = stream.Recv()
+		if err != nil && err != io.EOF {
+			// Headers are already sent, so the download ends truncated.
+			logger.Errorf("product export interrupted: %v", err)
+			return
+		}
+	}
+}
+
+func importFormatFromFilename(filename string) string {
+	switch strings.ToLower(path.Ext(filename)) {
+	case ".ndjson", ".jsonl":
+		return "ndjson"
+	default:
+		return "csv"
+	}
+}
+
+// Product image handlers
+
+// maxImageUploadSize bounds the request of UploadProductImage; ProductService
+// enforces its own, usually smaller, limit on the image itself.
+const maxImageUploadSize = 10 << 20
+
+var allowedImageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+}
+
+// UploadProductImage godoc
+// @Summary Upload product image
+// @Description Upload a JPEG, PNG or GIF image for a product. It is added after the existing images and a thumbnail is generated (admin only)
+// @Tags products
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param product_id formData int true "Product ID"
+// @Param alt_text formData string false "Alternative text"
+// @Param file formData file true "Image file"
+// @Success 201 {object} ProductImageResponse
+// @Router /api/v1/products/images/upload [post]
+func (h *ProductHandler) UploadProductImage(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxImageUploadSize)
+	if err := r.ParseMultipartForm(maxImageUploadSize); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			writeJSONError(w, http.StatusRequestEntityTooLarge, "image is too large")
+			return
+		}
+		writeJSONError(w, http.StatusBadRequest, "expected multipart/form-data with a file field")
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	productID, err := strconv.ParseInt(r.FormValue("product_id"), 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid product ID")
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "file is required")
+		return
+	}
+	defer file.Close()
+
+	// ProductService checks the actual bytes; this only rejects uploads that
+	// are declared as something else.
+	contentType := header.Header.Get("Content-Type")
+	if contentType != "" && !allowedImageTypes[contentType] {
+		writeJSONError(w, http.StatusUnsupportedMediaType, "only JPEG, PNG and GIF images are supported")
+		return
+	}
+
+	stream, err := h.productClient.UploadProductImage(r.Context())
+	if err != nil {
+		logger.Errorf("failed to start product image upload: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	sendErr := stream.Send(&productpb.UploadProductImageRequest{
+		ProductId: productID,
+		AltText:   r.FormValue("alt_text"),
+	})
+	for sendErr == nil {
+		chunk := make([]byte, importChunkSize)
+		n, readErr := file.Read(chunk)
+		if n > 0 {
+			sendErr = stream.Send(&productpb.UploadProductImageRequest{Data: chunk[:n]})
+		}
+		if readErr == io.EOF {
+			break
+		}
+		if readErr != nil {
+			stream.CloseSend()
+			writeJSONError(w, http.StatusBadRequest, "failed to read uploaded file")
+			return
+		}
+	}
+
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		logger.Errorf("failed to upload product image: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// ListProductImages godoc
+// @Summary List product images
+// @Description List the images of a product in display order
+// @Tags products
+// @Produce json
+// @Param product_id query int true "Product ID"
+// @Success 200 {object} ListProductImagesResponse
+// @Router /api/v1/products/images [get]
+func (h *ProductHandler) ListProductImages(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("product_id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing product ID")
+		return
+	}
+
+	productID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid product ID")
+		return
+	}
+
+	resp, err := h.productClient.ListProductImages(r.Context(), &productpb.ListProductImagesRequest{
+		ProductId: productID,
+	})
+	if err != nil {
+		logger.Errorf("failed to list product images: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// UpdateProductImage godoc
+// @Summary Update product image
+// @Description Update the alternative text of a product image (admin only)
+// @Tags products
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body UpdateProductImageRequest true "Image details"
+// @Success 200 {object} ProductImageResponse
+// @Router /api/v1/products/images/update [put]
+func (h *ProductHandler) UpdateProductImage(w http.ResponseWriter, r *http.Request) {
+	var req productpb.UpdateProductImageRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.UpdateProductImage(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to update product image: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// ReorderProductImages godoc
+// @Summary Reorder product images
+// @Description Set the display order of a product's images; image_ids must list every image of the product exactly once (admin only)
+// @Tags products
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ReorderProductImagesRequest true "Product ID and ordered image IDs"
+// @Success 200 {object} ListProductImagesResponse
+// @Router /api/v1/products/images/reorder [put]
+func (h *ProductHandler) ReorderProductImages(w http.ResponseWriter, r *http.Request) {
+	var req productpb.ReorderProductImagesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.ReorderProductImages(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to reorder product images: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// DeleteProductImage godoc
+// @Summary Delete product image
+// @Description Delete a product image and its stored files (admin only)
+// @Tags products
+// @Produce json
+// @Security BearerAuth
+// @Param id query int true "Image ID"
+// @Success 200 {object} DeleteProductImageResponse
+// @Router /api/v1/products/images/delete [delete]
+func (h *ProductHandler) DeleteProductImage(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing image ID")
+		return
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid image ID")
+		return
+	}
+
+	resp, err := h.productClient.DeleteProductImage(r.Context(), &productpb.DeleteProductImageRequest{
+		Id: id,
+	})
+	if err != nil {
+		logger.Errorf("failed to delete product image: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Review handlers
+
+// CreateReview godoc
+// @Summary Create product review
+// @Description Rate and review a product from a delivered order. The review is shown once approved by an admin
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateReviewRequest true "Product ID, 1-5 star rating, title and body"
+// @Success 201 {object} ReviewResponse
+// @Router /api/v1/reviews/create [post]
+func (h *ProductHandler) CreateReview(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req productpb.CreateReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	req.UserId = int64(userID)
+
+	resp, err := h.productClient.CreateReview(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to create review: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// ListProductReviews godoc
+// @Summary List product reviews
+// @Description List the approved reviews of a product, newest first
+// @Tags reviews
+// @Produce json
+// @Param product_id query int true "Product ID"
+// @Param page query int false "Page number"
+// @Param per_page query int false "Items per page"
+// @Success 200 {object} ListReviewsResponse
+// @Router /api/v1/products/reviews [get]
+func (h *ProductHandler) ListProductReviews(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	idStr := query.Get("product_id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing product ID")
+		return
+	}
+
+	productID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid product ID")
+		return
+	}
+
+	page, perPage := reviewPagination(r)
+	resp, err := h.productClient.ListProductReviews(r.Context(), &productpb.ListProductReviewsRequest{
+		ProductId: productID,
+		Page:      page,
+		PerPage:   perPage,
+	})
+	if err != nil {
+		logger.Errorf("failed to list product reviews: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// ListMyReviews godoc
+// @Summary List my reviews
+// @Description List the reviews of the authenticated user in any moderation status
+// @Tags reviews
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number"
+// @Param per_page query int false "Items per page"
+// @Success 200 {object} ListReviewsResponse
+// @Router /api/v1/reviews/mine [get]
+func (h *ProductHandler) ListMyReviews(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	page, perPage := reviewPagination(r)
+	resp, err := h.productClient.ListReviews(r.Context(), &productpb.ListReviewsRequest{
+		UserId:  int64(userID),
+		Page:    page,
+		PerPage: perPage,
+	})
+	if err != nil {
+		logger.Errorf("failed to list user reviews: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// UpdateReview godoc
+// @Summary Update review
+// @Description Edit your own review; it goes back to moderation (authenticated)
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body UpdateReviewRequest true "Review ID, rating, title and body"
+// @Success 200 {object} ReviewResponse
+// @Router /api/v1/reviews/update [put]
+func (h *ProductHandler) UpdateReview(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	var req productpb.UpdateReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	req.UserId = int64(userID)
+
+	resp, err := h.productClient.UpdateReview(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to update review: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// DeleteReview godoc
+// @Summary Delete review
+// @Description Delete your own review; admins may delete any review
+// @Tags reviews
+// @Produce json
+// @Security BearerAuth
+// @Param id query int true "Review ID"
+// @Success 200 {object} DeleteReviewResponse
+// @Router /api/v1/reviews/delete [delete]
+func (h *ProductHandler) DeleteReview(w http.ResponseWriter, r *http.Request) {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		writeJSONError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing review ID")
+		return
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid review ID")
+		return
+	}
+
+	// A zero user ID tells ProductService to skip the ownership check.
+	ownerID := int64(userID)
+	if role, _ := middleware.GetUserRole(r.Context()); role == "admin" {
+		ownerID = 0
+	}
+
+	resp, err := h.productClient.DeleteReview(r.Context(), &productpb.DeleteReviewRequest{
+		Id:     id,
+		UserId: ownerID,
+	})
+	if err != nil {
+		logger.Errorf("failed to delete review: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// ListReviewsForModeration godoc
+// @Summary List reviews for moderation
+// @Description List reviews in any status, e.g. status=pending for the moderation queue (admin only)
+// @Tags reviews
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "pending, approved or rejected"
+// @Param product_id query int false "Product ID"
+// @Param user_id query int false "Author user ID"
+// @Param page query int false "Page number"
+// @Param per_page query int false "Items per page"
+// @Success 200 {object} ListReviewsResponse
+// @Router /api/v1/reviews/moderation [get]
+func (h *ProductHandler) ListReviewsForModeration(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var productID, userID int64
+	var err error
+	if v := query.Get("product_id"); v != "" {
+		productID, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid product ID")
+			return
+		}
+	}
+	if v := query.Get("user_id"); v != "" {
+		userID, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid user ID")
+			return
+		}
+	}
+
+	page, perPage := reviewPagination(r)
+	resp, err := h.productClient.ListReviews(r.Context(), &productpb.ListReviewsRequest{
+		ProductId: productID,
+		UserId:    userID,
+		Status:    query.Get("status"),
+		Page:      page,
+		PerPage:   perPage,
+	})
+	if err != nil {
+		logger.Errorf("failed to list reviews: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// ModerateReview godoc
+// @Summary Moderate review
+// @Description Approve or reject a review with an optional note; approved reviews count towards the product rating (admin only)
+// @Tags reviews
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body ModerateReviewRequest true "Review ID, status (approved or rejected) and note"
+// @Success 200 {object} ReviewResponse
+// @Router /api/v1/reviews/moderate [put]
+func (h *ProductHandler) ModerateReview(w http.ResponseWriter, r *http.Request) {
+	var req productpb.ModerateReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.ModerateReview(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to moderate review: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+func reviewPagination(r *http.Request) (int32, int32) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	if page < 1 {
+		page = 1
+	}
+
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	if perPage < 1 || perPage > 100 {
+		perPage = 10
+	}
+	return int32(page), int32(perPage)
+}
+
+// Pricing handlers
+
+// SchedulePriceChange godoc
+// @Summary Schedule price change
+// @Description Schedule a price or discount change that is applied at effective_at (RFC 3339); unset fields keep their value (admin only)
+// @Tags pricing
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body SchedulePriceChangeRequest true "Product ID, new price and/or discount, remove_discount and effective_at"
+// @Success 201 {object} ScheduledPriceChangeResponse
+// @Router /api/v1/products/prices/schedule [post]
+func (h *ProductHandler) SchedulePriceChange(w http.ResponseWriter, r *http.Request) {
+	var req productpb.SchedulePriceChangeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	req.CreatedBy = stockActor(r)
+
+	resp, err := h.productClient.SchedulePriceChange(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to schedule price change: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// CancelScheduledPriceChange godoc
+// @Summary Cancel scheduled price change
+// @Description Cancel a pending scheduled price change (admin only)
+// @Tags pricing
+// @Produce json
+// @Security BearerAuth
+// @Param id query int true "Scheduled change ID"
+// @Success 200 {object} ScheduledPriceChangeResponse
+// @Router /api/v1/products/prices/schedule/cancel [delete]
+func (h *ProductHandler) CancelScheduledPriceChange(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing price change ID")
+		return
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid price change ID")
+		return
+	}
+
+	resp, err := h.productClient.CancelScheduledPriceChange(r.Context(), &productpb.CancelScheduledPriceChangeRequest{Id: id})
+	if err != nil {
+		logger.Errorf("failed to cancel scheduled price change: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// ListScheduledPriceChanges godoc
+// @Summary List scheduled price changes
+// @Description List scheduled price changes in the order they take effect (admin only)
+// @Tags pricing
+// @Produce json
+// @Security BearerAuth
+// @Param product_id query int false "Product ID"
+// @Param status query string false "pending, applied or canceled"
+// @Param page query int false "Page number"
+// @Param per_page query int false "Items per page"
+// @Success 200 {object} ListScheduledPriceChangesResponse
+// @Router /api/v1/products/prices/scheduled [get]
+func (h *ProductHandler) ListScheduledPriceChanges(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var productID int64
+	if v := query.Get("product_id"); v != "" {
+		var err error
+		productID, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			writeJSONError(w, http.StatusBadRequest, "invalid product ID")
+			return
+		}
+	}
+
+	page, perPage := reviewPagination(r)
+	resp, err := h.productClient.ListScheduledPriceChanges(r.Context(), &productpb.ListScheduledPriceChangesRequest{
+		ProductId: productID,
+		Status:    query.Get("status"),
+		Page:      page,
+		PerPage:   perPage,
+	})
+	if err != nil {
+		logger.Errorf("failed to list scheduled price changes: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// GetPriceTimeline godoc
+// @Summary Get price timeline
+// @Description Get the price history of a product followed by its pending scheduled changes (admin only)
+// @Tags pricing
+// @Produce json
+// @Security BearerAuth
+// @Param product_id query int true "Product ID"
+// @Success 200 {object} GetPriceTimelineResponse
+// @Router /api/v1/products/prices/timeline [get]
+func (h *ProductHandler) GetPriceTimeline(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("product_id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing product ID")
+		return
+	}
+
+	productID, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid product ID")
+		return
+	}
+
+	resp, err := h.productClient.GetPriceTimeline(r.Context(), &productpb.GetPriceTimelineRequest{ProductId: productID})
+	if err != nil {
+		logger.Errorf("failed to get price timeline: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Attribute handlers
+
+// CreateAttribute godoc
+// @Summary Create attribute
+// @Description Define a typed product attribute: string, number, boolean or enum with its options (admin only)
+// @Tags attributes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateAttributeRequest true "Attribute definition"
+// @Success 201 {object} AttributeResponse
+// @Router /api/v1/attributes/create [post]
+func (h *ProductHandler) CreateAttribute(w http.ResponseWriter, r *http.Request) {
+	var req productpb.CreateAttributeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.CreateAttribute(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to create attribute: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// ListAttributes godoc
+// @Summary List attributes
+// @Description List every attribute, or the attributes applying to a category including those inherited from its ancestors
+// @Tags attributes
+// @Produce json
+// @Param category_id query int false "Category ID"
+// @Success 200 {object} ListAttributesResponse
+// @Router /api/v1/attributes [get]
+func (h *ProductHandler) ListAttributes(w http.ResponseWriter, r *http.Request) {
+	req := &productpb.ListAttributesRequest{}
+	if v := r.URL.Query().Get("category_id"); v != "" {
+		categoryID, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || categoryID < 1 {
+			writeJSONError(w, http.StatusBadRequest, "invalid category_id")
+			return
+		}
+		req.CategoryId = categoryID
+	}
+
+	resp, err := h.productClient.ListAttributes(r.Context(), req)
+	if err != nil {
+		logger.Errorf("failed to list attributes: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// DeleteAttribute godoc
+// @Summary Delete attribute
+// @Description Delete an attribute along with its category attachments and product values (admin only)
+// @Tags attributes
+// @Produce json
+// @Security BearerAuth
+// @Param id query int true "Attribute ID"
+// @Success 200 {object} DeleteAttributeResponse
+// @Router /api/v1/attributes/delete [delete]
+func (h *ProductHandler) DeleteAttribute(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing attribute ID")
+		return
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid attribute ID")
+		return
+	}
+
+	resp, err := h.productClient.DeleteAttribute(r.Context(), &productpb.DeleteAttributeRequest{Id: id})
+	if err != nil {
+		logger.Errorf("failed to delete attribute: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// AttachCategoryAttribute godoc
+// @Summary Attach attribute to category
+// @Description Attach an attribute to a category and its descendants, optionally as required (admin only)
+// @Tags attributes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body AttachCategoryAttributeRequest true "Category, attribute and whether it is required"
+// @Success 200 {object} ListAttributesResponse
+// @Router /api/v1/categories/attributes/attach [post]
+func (h *ProductHandler) AttachCategoryAttribute(w http.ResponseWriter, r *http.Request) {
+	var req productpb.AttachCategoryAttributeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.AttachCategoryAttribute(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to attach category attribute: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// DetachCategoryAttribute godoc
+// @Summary Detach attribute from category
+// @Description Stop an attribute from applying to a category; values already set on products are kept (admin only)
+// @Tags attributes
+// @Produce json
+// @Security BearerAuth
+// @Param category_id query int true "Category ID"
+// @Param attribute_id query int true "Attribute ID"
+// @Success 200 {object} DetachCategoryAttributeResponse
+// @Router /api/v1/categories/attributes/detach [delete]
+func (h *ProductHandler) DetachCategoryAttribute(w http.ResponseWriter, r *http.Request) {
+	categoryID, err := strconv.ParseInt(r.URL.Query().Get("category_id"), 10, 64)
+	if err != nil || categoryID < 1 {
+		writeJSONError(w, http.StatusBadRequest, "invalid category_id")
+		return
+	}
+
+	attributeID, err := strconv.ParseInt(r.URL.Query().Get("attribute_id"), 10, 64)
+	if err != nil || attributeID < 1 {
+		writeJSONError(w, http.StatusBadRequest, "invalid attribute_id")
+		return
+	}
+
+	resp, err := h.productClient.DetachCategoryAttribute(r.Context(), &productpb.DetachCategoryAttributeRequest{
+		CategoryId:  categoryID,
+		AttributeId: attributeID,
+	})
+	if err != nil {
+		logger.Errorf("failed to detach category attribute: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// SetProductAttributes godoc
+// @Summary Set product attributes
+// @Description Replace the attribute values of a product; every value must belong to an attribute of its categories and every required attribute must be given (admin only)
+// @Tags attributes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body SetProductAttributesRequest true "Product ID and attribute values by code"
+// @Success 200 {object} SetProductAttributesResponse
+// @Router /api/v1/products/attributes [put]
+func (h *ProductHandler) SetProductAttributes(w http.ResponseWriter, r *http.Request) {
+	var req productpb.SetProductAttributesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.SetProductAttributes(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to set product attributes: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// attributeFilters reads the attr.<code>=v1,v2, attr.<code>.min and
+// attr.<code>.max query parameters, ordered by attribute code.
+func attributeFilters(query url.Values) ([]*productpb.AttributeFilter, error) {
+	byCode := make(map[string]*productpb.AttributeFilter)
+	filterFor := func(code string) *productpb.AttributeFilter {
+		f, ok := byCode[code]
+		if !ok {
+			f = &productpb.AttributeFilter{Code: code}
+			byCode[code] = f
+		}
+		return f
+	}
+
+	for key, values := range query {
+		name, ok := strings.CutPrefix(key, "attr.")
+		if !ok || len(values) == 0 {
+			continue
+		}
+		raw := values[len(values)-1]
+
+		if code, ok := strings.CutSuffix(name, ".min"); ok {
+			bound, err := strconv.ParseFloat(raw, 64)
+			if err != nil || code == "" {
+				return nil, fmt.Errorf("invalid %s", key)
+			}
+			filterFor(code).Min = &bound
+			continue
+		}
+		if code, ok := strings.CutSuffix(name, ".max"); ok {
+			bound, err := strconv.ParseFloat(raw, 64)
+			if err != nil || code == "" {
+				return nil, fmt.Errorf("invalid %s", key)
+			}
+			filterFor(code).Max = &bound
+			continue
+		}
+
+		if name == "" {
+			return nil, fmt.Errorf("invalid %s", key)
+		}
+		f := filterFor(name)
+		for _, v := range strings.Split(raw, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				f.Values = append(f.Values, v)
+			}
+		}
+	}
+
+	filters := make([]*productpb.AttributeFilter, 0, len(byCode))
+	for _, f := range byCode {
+		filters = append(filters, f)
+	}
+	sort.Slice(filters, func(i, j int) bool { return filters[i].Code < filters[j].Code })
+	return filters, nil
+}
+
+// Trash handlers
+
+// ListTrashedProducts godoc
+// @Summary List trashed products
+// @Description List soft-deleted products, most recently deleted first, with the time each one is purged (admin only)
+// @Tags trash
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number"
+// @Param per_page query int false "Items per page"
+// @Success 200 {object} ListTrashedProductsResponse
+// @Router /api/v1/products/trash [get]
+func (h *ProductHandler) ListTrashedProducts(w http.ResponseWriter, r *http.Request) {
+	page, perPage := reviewPagination(r)
+	resp, err := h.productClient.ListTrashedProducts(r.Context(), &productpb.ListTrashedProductsRequest{
+		Page:    page,
+		PerPage: perPage,
+	})
+	if err != nil {
+		logger.Errorf("failed to list trashed products: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// RestoreProduct godoc
+// @Summary Restore product
+// @Description Take a product out of the trash; it is cached again and a product.restored event is published (admin only)
+// @Tags trash
+// @Produce json
+// @Security BearerAuth
+// @Param id query int true "Product ID"
+// @Success 200 {object} RestoreProductResponse
+// @Router /api/v1/products/trash/restore [post]
+func (h *ProductHandler) RestoreProduct(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing product ID")
+		return
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid product ID")
+		return
+	}
+
+	resp, err := h.productClient.RestoreProduct(r.Context(), &productpb.RestoreProductRequest{Id: id})
+	if err != nil {
+		logger.Errorf("failed to restore product: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// PurgeProduct godoc
+// @Summary Purge product
+// @Description Permanently delete a trashed product with its variants, stock, images and reviews (admin only)
+// @Tags trash
+// @Produce json
+// @Security BearerAuth
+// @Param id query int true "Product ID"
+// @Success 200 {object} PurgeProductResponse
+// @Router /api/v1/products/trash/purge [delete]
+func (h *ProductHandler) PurgeProduct(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing product ID")
+		return
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid product ID")
+		return
+	}
+
+	resp, err := h.productClient.PurgeProduct(r.Context(), &productpb.PurgeProductRequest{Id: id})
+	if err != nil {
+		logger.Errorf("failed to purge product: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// ListTrashedCategories godoc
+// @Summary List trashed categories
+// @Description List soft-deleted categories, most recently deleted first, with the time each one is purged (admin only)
+// @Tags trash
+// @Produce json
+// @Security BearerAuth
+// @Param page query int false "Page number"
+// @Param per_page query int false "Items per page"
+// @Success 200 {object} ListTrashedCategoriesResponse
+// @Router /api/v1/categories/trash [get]
+func (h *ProductHandler) ListTrashedCategories(w http.ResponseWriter, r *http.Request) {
+	page, perPage := reviewPagination(r)
+	resp, err := h.productClient.ListTrashedCategories(r.Context(), &productpb.ListTrashedCategoriesRequest{
+		Page:    page,
+		PerPage: perPage,
+	})
+	if err != nil {
+		logger.Errorf("failed to list trashed categories: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// RestoreCategory godoc
+// @Summary Restore category
+// @Description Take a category out of the trash; its parent must not be trashed and a category.restored event is published (admin only)
+// @Tags trash
+// @Produce json
+// @Security BearerAuth
+// @Param id query int true "Category ID"
+// @Success 200 {object} RestoreCategoryResponse
+// @Router /api/v1/categories/trash/restore [post]
+func (h *ProductHandler) RestoreCategory(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing category ID")
+		return
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid category ID")
+		return
+	}
+
+	resp, err := h.productClient.RestoreCategory(r.Context(), &productpb.RestoreCategoryRequest{Id: id})
+	if err != nil {
+		logger.Errorf("failed to restore category: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// PurgeCategory godoc
+// @Summary Purge category
+// @Description Permanently delete a trashed category that has no child categories left (admin only)
+// @Tags trash
+// @Produce json
+// @Security BearerAuth
+// @Param id query int true "Category ID"
+// @Success 200 {object} PurgeCategoryResponse
+// @Router /api/v1/categories/trash/purge [delete]
+func (h *ProductHandler) PurgeCategory(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing category ID")
+		return
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid category ID")
+		return
+	}
+
+	resp, err := h.productClient.PurgeCategory(r.Context(), &productpb.PurgeCategoryRequest{Id: id})
+	if err != nil {
+		logger.Errorf("failed to purge category: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// Warehouse handlers
+
+// CreateWarehouse godoc
+// @Summary Create warehouse
+// @Description Create a stock location (admin only)
+// @Tags warehouses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body CreateWarehouseRequest true "Warehouse details"
+// @Success 201 {object} WarehouseResponse
+// @Router /api/v1/warehouses/create [post]
+func (h *ProductHandler) CreateWarehouse(w http.ResponseWriter, r *http.Request) {
+	var req productpb.CreateWarehouseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.CreateWarehouse(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to create warehouse: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, resp)
+}
+
+// GetWarehouse godoc
+// @Summary Get warehouse by ID
+// @Description Get warehouse details by ID (admin only)
+// @Tags warehouses
+// @Produce json
+// @Security BearerAuth
+// @Param id query int true "Warehouse ID"
+// @Success 200 {object} WarehouseResponse
+// @Router /api/v1/warehouses/by-id [get]
+func (h *ProductHandler) GetWarehouse(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing warehouse ID")
+		return
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid warehouse ID")
+		return
+	}
+
+	resp, err := h.productClient.GetWarehouse(r.Context(), &productpb.GetWarehouseRequest{
+		Id: id,
+	})
+	if err != nil {
+		logger.Errorf("failed to get warehouse: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// ListWarehouses godoc
+// @Summary List warehouses
+// @Description List warehouses ordered by priority (admin only)
+// @Tags warehouses
+// @Produce json
+// @Security BearerAuth
+// @Param active_only query bool false "Only active warehouses"
+// @Success 200 {object} ListWarehousesResponse
+// @Router /api/v1/warehouses [get]
+func (h *ProductHandler) ListWarehouses(w http.ResponseWriter, r *http.Request) {
+	activeOnly, _ := strconv.ParseBool(r.URL.Query().Get("active_only"))
+
+	resp, err := h.productClient.ListWarehouses(r.Context(), &productpb.ListWarehousesRequest{
+		ActiveOnly: activeOnly,
+	})
+	if err != nil {
+		logger.Errorf("failed to list warehouses: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// UpdateWarehouse godoc
+// @Summary Update warehouse
+// @Description Update warehouse details, location, priority or active flag (admin only)
+// @Tags warehouses
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body UpdateWarehouseRequest true "Warehouse update details"
+// @Success 200 {object} WarehouseResponse
+// @Router /api/v1/warehouses/update [put]
+func (h *ProductHandler) UpdateWarehouse(w http.ResponseWriter, r *http.Request) {
+	var req productpb.UpdateWarehouseRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.productClient.UpdateWarehouse(r.Context(), &req)
+	if err != nil {
+		logger.Errorf("failed to update warehouse: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// DeleteWarehouse godoc
+// @Summary Delete warehouse
+// @Description Delete a warehouse that holds no stock (admin only)
+// @Tags warehouses
+// @Security BearerAuth
+// @Param id query int true "Warehouse ID"
+// @Success 200 {object} DeleteWarehouseResponse
+// @Router /api/v1/warehouses/delete [delete]
+func (h *ProductHandler) DeleteWarehouse(w http.ResponseWriter, r *http.Request) {
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		writeJSONError(w, http.StatusBadRequest, "missing warehouse ID")
+		return
+	}
+
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		writeJSONError(w, http.StatusBadRequest, "invalid warehouse ID")
+		return
+	}
+
+	resp, err := h.productClient.DeleteWarehouse(r.Context(), &productpb.DeleteWarehouseRequest{
+		Id: id,
+	})
+	if err != nil {
+		logger.Errorf("failed to delete warehouse: %v", err)
+		writeJSONErrorFromGRPC(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, resp)
+}
+
+// stockActor identifies the authenticated user recorded on stock movements
+// and scheduled price changes.
+func stockActor(r *http.Request) string {
+	userID, ok := middleware.GetUserID(r.Context())
+	if !ok {
+		return ""
+	}
+	return fmt.Sprintf("user:%d", userID)
+}
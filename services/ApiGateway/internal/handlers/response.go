@@ -53,7 +53,7 @@ func grpcCodeToHTTP(code codes.Code) int {
 		return http.StatusBadRequest
 	case codes.NotFound:
 		return http.StatusNotFound
-	case codes.AlreadyExists:
+	case codes.AlreadyExists, codes.FailedPrecondition:
 		return http.StatusConflict
 	case codes.PermissionDenied:
 		return http.StatusForbidden
@@ -7,27 +7,31 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/kareemhamed001/e-commerce/pkg/logger"
 	"github.com/kareemhamed001/e-commerce/services/ApiGateway/internal/middleware"
+	cartpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/cart"
 	userpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/user"
 )
 
 // UserHandler handles user-related HTTP requests
 type UserHandler struct {
 	userClient userpb.UserServiceClient
+	cartClient cartpb.CartServiceClient
 }
 
 // NewUserHandler creates a new user handler
-func NewUserHandler(userClient userpb.UserServiceClient) *UserHandler {
+func NewUserHandler(userClient userpb.UserServiceClient, cartClient cartpb.CartServiceClient) *UserHandler {
 	return &UserHandler{
 		userClient: userClient,
+		cartClient: cartClient,
 	}
 }
 
 // Register godoc
 // @Summary Register a new user
-// @Description Create a new user account
+// @Description Create a new user account. A guest cart sent in X-Cart-Token is merged into the new account's cart.
 // @Tags users
 // @Accept json
 // @Produce json
+// @Param X-Cart-Token header string false "Guest cart token"
 // @Param request body CreateUserRequest true "User registration details"
 // @Success 201 {object} CreateUserResponse
 // @Failure 400 {object} ErrorResponse
@@ -64,15 +68,18 @@ func (h *UserHandler) Register(c *gin.Context) {
 		return
 	}
 
+	mergeGuestCart(c.Request.Context(), h.cartClient, c.Request, int64(resp.GetUser().GetId()))
+
 	c.JSON(http.StatusCreated, resp)
 }
 
 // Login godoc
 // @Summary User login
-// @Description Authenticate user and return JWT token
+// @Description Authenticate user and return JWT token. A guest cart sent in X-Cart-Token is merged into the user's cart.
 // @Tags users
 // @Accept json
 // @Produce json
+// @Param X-Cart-Token header string false "Guest cart token"
 // @Param request body LoginRequest true "Login credentials"
 // @Success 200 {object} LoginResponse
 // @Failure 401 {object} ErrorResponse
@@ -99,6 +106,8 @@ func (h *UserHandler) Login(c *gin.Context) {
 		return
 	}
 
+	mergeGuestCart(c.Request.Context(), h.cartClient, c.Request, int64(resp.GetUser().GetId()))
+
 	c.JSON(http.StatusOK, resp)
 }
 
@@ -25,6 +25,8 @@ func CORS(allowedOrigins, allowedMethods, allowedHeaders []string) gin.HandlerFu
 		c.Writer.Header().Set("Access-Control-Allow-Origin", allowedOrigin)
 		c.Writer.Header().Set("Access-Control-Allow-Methods", joinStrings(allowedMethods, ", "))
 		c.Writer.Header().Set("Access-Control-Allow-Headers", joinStrings(allowedHeaders, ", "))
+		// Let browsers read the guest cart token and the cart ETag
+		c.Writer.Header().Set("Access-Control-Expose-Headers", "ETag, X-Cart-Token")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
 		c.Writer.Header().Set("Access-Control-Max-Age", "86400") // 24 hours
 
@@ -74,27 +74,110 @@ func (r *Router) setupRoutes() {
 	// Product routes - Public
 	r.engine.GET("/api/v1/products", gin.WrapF(r.productHandler.ListProducts))
 	r.engine.GET("/api/v1/products/by-id", gin.WrapF(r.productHandler.GetProductByID))
+	r.engine.GET("/api/v1/products/search", gin.WrapF(r.productHandler.SearchProducts))
+	r.engine.GET("/api/v1/products/variants/by-id", gin.WrapF(r.productHandler.GetProductVariant))
+	r.engine.GET("/api/v1/products/images", gin.WrapF(r.productHandler.ListProductImages))
+	r.engine.GET("/api/v1/products/reviews", gin.WrapF(r.productHandler.ListProductReviews))
 
 	// Product routes - Admin only
 	r.engine.POST("/api/v1/products/create", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.CreateProduct))
 	r.engine.PUT("/api/v1/products/update", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.UpdateProduct))
+	r.engine.PUT("/api/v1/products/max-per-order", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.SetMaxPerOrder))
 	r.engine.DELETE("/api/v1/products/delete", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.DeleteProduct))
+	r.engine.POST("/api/v1/products/import", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.ImportProducts))
+	r.engine.GET("/api/v1/products/export", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.ExportProducts))
+	r.engine.GET("/api/v1/products/cache/stats", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.GetCacheStats))
+	r.engine.POST("/api/v1/products/images/upload", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.UploadProductImage))
+	r.engine.PUT("/api/v1/products/images/update", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.UpdateProductImage))
+	r.engine.PUT("/api/v1/products/images/reorder", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.ReorderProductImages))
+	r.engine.DELETE("/api/v1/products/images/delete", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.DeleteProductImage))
+	r.engine.POST("/api/v1/products/categories/assign", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.AssignProductCategories))
+	r.engine.DELETE("/api/v1/products/categories/unassign", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.UnassignProductCategories))
+	r.engine.POST("/api/v1/products/options/create", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.CreateProductOption))
+	r.engine.DELETE("/api/v1/products/options/delete", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.DeleteProductOption))
+	r.engine.POST("/api/v1/products/variants/create", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.CreateProductVariant))
+	r.engine.PUT("/api/v1/products/variants/update", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.UpdateProductVariant))
+	r.engine.DELETE("/api/v1/products/variants/delete", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.DeleteProductVariant))
+	r.engine.POST("/api/v1/products/restock", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.RestockProduct))
+	r.engine.POST("/api/v1/products/stock/movements/create", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.RecordStockMovement))
+	r.engine.GET("/api/v1/products/stock/movements", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.ListStockMovements))
+	r.engine.POST("/api/v1/products/stock/transfer", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.TransferStock))
+	r.engine.GET("/api/v1/products/stock/availability", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.GetStockAvailability))
+	r.engine.PUT("/api/v1/products/stock/reorder-threshold", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.SetReorderThreshold))
+	r.engine.GET("/api/v1/products/stock/low", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.ListLowStockProducts))
+	r.engine.POST("/api/v1/products/prices/schedule", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.SchedulePriceChange))
+	r.engine.DELETE("/api/v1/products/prices/schedule/cancel", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.CancelScheduledPriceChange))
+	r.engine.GET("/api/v1/products/prices/scheduled", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.ListScheduledPriceChanges))
+	r.engine.GET("/api/v1/products/prices/timeline", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.GetPriceTimeline))
+	r.engine.GET("/api/v1/products/trash", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.ListTrashedProducts))
+	r.engine.POST("/api/v1/products/trash/restore", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.RestoreProduct))
+	r.engine.DELETE("/api/v1/products/trash/purge", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.PurgeProduct))
+	r.engine.PUT("/api/v1/products/attributes", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.SetProductAttributes))
+
+	// Attribute routes - Public
+	r.engine.GET("/api/v1/attributes", gin.WrapF(r.productHandler.ListAttributes))
+
+	// Attribute routes - Admin only
+	r.engine.POST("/api/v1/attributes/create", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.CreateAttribute))
+	r.engine.DELETE("/api/v1/attributes/delete", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.DeleteAttribute))
+
+	// Review routes - Authenticated
+	r.engine.POST("/api/v1/reviews/create", r.withAuth(), gin.WrapF(r.productHandler.CreateReview))
+	r.engine.GET("/api/v1/reviews/mine", r.withAuth(), gin.WrapF(r.productHandler.ListMyReviews))
+	r.engine.PUT("/api/v1/reviews/update", r.withAuth(), gin.WrapF(r.productHandler.UpdateReview))
+	r.engine.DELETE("/api/v1/reviews/delete", r.withAuth(), gin.WrapF(r.productHandler.DeleteReview))
+
+	// Review routes - Admin only
+	r.engine.GET("/api/v1/reviews/moderation", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.ListReviewsForModeration))
+	r.engine.PUT("/api/v1/reviews/moderate", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.ModerateReview))
+
+	// Warehouse routes - Admin only
+	r.engine.GET("/api/v1/warehouses", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.ListWarehouses))
+	r.engine.GET("/api/v1/warehouses/by-id", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.GetWarehouse))
+	r.engine.POST("/api/v1/warehouses/create", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.CreateWarehouse))
+	r.engine.PUT("/api/v1/warehouses/update", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.UpdateWarehouse))
+	r.engine.DELETE("/api/v1/warehouses/delete", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.DeleteWarehouse))
 
 	// Category routes - Public
 	r.engine.GET("/api/v1/categories", gin.WrapF(r.productHandler.ListCategories))
 	r.engine.GET("/api/v1/categories/by-id", gin.WrapF(r.productHandler.GetCategoryByID))
+	r.engine.GET("/api/v1/categories/tree", gin.WrapF(r.productHandler.GetCategoryTree))
+	r.engine.GET("/api/v1/categories/products", gin.WrapF(r.productHandler.ListProductsByCategory))
 
 	// Category routes - Admin only
 	r.engine.POST("/api/v1/categories/create", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.CreateCategory))
 	r.engine.PUT("/api/v1/categories/update", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.UpdateCategory))
 	r.engine.DELETE("/api/v1/categories/delete", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.DeleteCategory))
+	r.engine.GET("/api/v1/categories/trash", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.ListTrashedCategories))
+	r.engine.POST("/api/v1/categories/trash/restore", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.RestoreCategory))
+	r.engine.DELETE("/api/v1/categories/trash/purge", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.PurgeCategory))
+	r.engine.POST("/api/v1/categories/attributes/attach", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.AttachCategoryAttribute))
+	r.engine.DELETE("/api/v1/categories/attributes/detach", r.withAuth(), r.withRole("admin"), gin.WrapF(r.productHandler.DetachCategoryAttribute))
+
+	// Cart routes - Authenticated or guest (X-Cart-Token)
+	r.engine.GET("/api/v1/cart", r.withOptionalAuth(), gin.WrapF(r.cartHandler.GetCart))
+	r.engine.POST("/api/v1/cart/items/add", r.withOptionalAuth(), gin.WrapF(r.cartHandler.AddItem))
+	r.engine.PUT("/api/v1/cart/items/update", r.withOptionalAuth(), gin.WrapF(r.cartHandler.UpdateItem))
+	r.engine.DELETE("/api/v1/cart/items/remove", r.withOptionalAuth(), gin.WrapF(r.cartHandler.RemoveItem))
+	r.engine.DELETE("/api/v1/cart/clear", r.withOptionalAuth(), gin.WrapF(r.cartHandler.ClearCart))
 
 	// Cart routes - Authenticated
-	r.engine.GET("/api/v1/cart", r.withAuth(), gin.WrapF(r.cartHandler.GetCart))
-	r.engine.POST("/api/v1/cart/items/add", r.withAuth(), gin.WrapF(r.cartHandler.AddItem))
-	r.engine.PUT("/api/v1/cart/items/update", r.withAuth(), gin.WrapF(r.cartHandler.UpdateItem))
-	r.engine.DELETE("/api/v1/cart/items/remove", r.withAuth(), gin.WrapF(r.cartHandler.RemoveItem))
-	r.engine.DELETE("/api/v1/cart/clear", r.withAuth(), gin.WrapF(r.cartHandler.ClearCart))
+	r.engine.POST("/api/v1/cart/merge", r.withAuth(), gin.WrapF(r.cartHandler.MergeCart))
+	r.engine.POST("/api/v1/cart/items/move-to-list", r.withAuth(), gin.WrapF(r.cartHandler.MoveToList))
+	r.engine.GET("/api/v1/cart/shipping-options", r.withAuth(), gin.WrapF(r.cartHandler.ShippingOptions))
+
+	// List routes - Public
+	r.engine.GET("/api/v1/lists/shared", gin.WrapF(r.cartHandler.GetSharedList))
+
+	// List routes - Authenticated
+	r.engine.GET("/api/v1/lists", r.withAuth(), gin.WrapF(r.cartHandler.ListLists))
+	r.engine.GET("/api/v1/lists/by-id", r.withAuth(), gin.WrapF(r.cartHandler.GetList))
+	r.engine.POST("/api/v1/lists/create", r.withAuth(), gin.WrapF(r.cartHandler.CreateWishlist))
+	r.engine.DELETE("/api/v1/lists/delete", r.withAuth(), gin.WrapF(r.cartHandler.DeleteList))
+	r.engine.PUT("/api/v1/lists/share", r.withAuth(), gin.WrapF(r.cartHandler.ShareList))
+	r.engine.POST("/api/v1/lists/items/add", r.withAuth(), gin.WrapF(r.cartHandler.AddListItem))
+	r.engine.DELETE("/api/v1/lists/items/remove", r.withAuth(), gin.WrapF(r.cartHandler.RemoveListItem))
+	r.engine.POST("/api/v1/lists/items/move-to-cart", r.withAuth(), gin.WrapF(r.cartHandler.MoveToCart))
 
 	// Order routes - Authenticated
 	r.engine.POST("/api/v1/orders/create", r.withAuth(), gin.WrapF(r.orderHandler.CreateOrder))
@@ -102,9 +185,17 @@ func (r *Router) setupRoutes() {
 	r.engine.GET("/api/v1/orders/by-id", r.withAuth(), gin.WrapF(r.orderHandler.GetOrderByID))
 	r.engine.POST("/api/v1/orders/items/add", r.withAuth(), gin.WrapF(r.orderHandler.AddOrderItem))
 	r.engine.DELETE("/api/v1/orders/items/remove", r.withAuth(), gin.WrapF(r.orderHandler.RemoveOrderItem))
+	r.engine.POST("/api/v1/orders/payments/authorize", r.withAuth(), gin.WrapF(r.orderHandler.AuthorizePayment))
+	r.engine.GET("/api/v1/orders/payments", r.withAuth(), gin.WrapF(r.orderHandler.ListOrderPayments))
 
 	// Order routes - Admin only
 	r.engine.PATCH("/api/v1/orders/status", r.withAuth(), r.withRole("admin"), gin.WrapF(r.orderHandler.UpdateOrderStatus))
+	r.engine.POST("/api/v1/orders/payments/capture", r.withAuth(), r.withRole("admin"), gin.WrapF(r.orderHandler.CapturePayment))
+	r.engine.POST("/api/v1/orders/payments/void", r.withAuth(), r.withRole("admin"), gin.WrapF(r.orderHandler.VoidPayment))
+	r.engine.POST("/api/v1/orders/payments/refund", r.withAuth(), r.withRole("admin"), gin.WrapF(r.orderHandler.RefundPayment))
+
+	// Payment provider webhooks - Public, verified by signature
+	r.engine.POST("/api/v1/payments/webhook", gin.WrapF(r.orderHandler.PaymentWebhook))
 }
 
 // Handler returns the configured HTTP handler with all middlewares
@@ -131,6 +222,10 @@ func (r *Router) withAuth() gin.HandlerFunc {
 	return middleware.AuthMiddleware(r.jwtManager)
 }
 
+func (r *Router) withOptionalAuth() gin.HandlerFunc {
+	return middleware.OptionalAuthMiddleware(r.jwtManager)
+}
+
 func (r *Router) withRole(roles ...string) gin.HandlerFunc {
 	return middleware.RequireRole(roles...)
 }
@@ -8,18 +8,26 @@ import (
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/kareemhamed001/e-commerce/pkg/db"
 	"github.com/kareemhamed001/e-commerce/pkg/grpcmiddleware"
 	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/pkg/rabbitmq"
 	redisClient "github.com/kareemhamed001/e-commerce/pkg/redis"
 	"github.com/kareemhamed001/e-commerce/pkg/tracer"
 	"github.com/kareemhamed001/e-commerce/services/CartService/config"
 	"github.com/kareemhamed001/e-commerce/services/CartService/internal/delivery/grpc/handler"
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/domain"
+	rabbitmqEvents "github.com/kareemhamed001/e-commerce/services/CartService/internal/events/rabbitmq"
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/repository/memory"
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/repository/postgresql"
 	"github.com/kareemhamed001/e-commerce/services/CartService/internal/repository/redis"
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/repository/writethrough"
 	"github.com/kareemhamed001/e-commerce/services/CartService/internal/usecase"
 	productpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/product"
 	userpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/user"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"gorm.io/gorm"
 )
 
 func main() {
@@ -37,18 +45,40 @@ func main() {
 	shutdownTracer := initTracing(ctx)
 	defer shutdownTracer()
 
-	redisCfg := &redisClient.Settings{
-		RedisEnabled:  config.RedisEnabled,
-		RedisHost:     config.RedisHost,
-		RedisPort:     config.RedisPort,
-		RedisPassword: config.RedisPassword,
-		RedisDB:       config.RedisDB,
+	var redisConn *redisClient.Client
+	if config.UsesRedis() {
+		redisCfg := &redisClient.Settings{
+			RedisEnabled:  config.RedisEnabled,
+			RedisHost:     config.RedisHost,
+			RedisPort:     config.RedisPort,
+			RedisPassword: config.RedisPassword,
+			RedisDB:       config.RedisDB,
+		}
+
+		redisConn, err = redisClient.NewClientFromSettings(redisCfg)
+		if err != nil {
+			close(done)
+			panic("failed to connect to redis")
+		}
 	}
 
-	redisConn, err := redisClient.NewClientFromSettings(redisCfg)
-	if err != nil {
-		close(done)
-		panic("failed to connect to redis")
+	var cartDB *gorm.DB
+	if config.UsesPostgres() {
+		dbConfig := &db.Config{
+			DBDriver:              config.DBDriver,
+			DSN:                   config.DBDSN,
+			MigrationAutoRun:      config.DBMigrationAutoRun,
+			MigrationDir:          "services/CartService/internal/migrations",
+			ConnectionMaxIdle:     config.DBConnectionMaxIdle,
+			ConnectionMaxOpen:     config.DBConnectionMaxOpen,
+			ConnectionMaxLifeTime: config.DBConnectionMaxLife,
+		}
+
+		cartDB, err = db.InitDB(dbConfig)
+		if err != nil {
+			close(done)
+			panic("failed to connect database")
+		}
 	}
 
 	productConn, err := grpc.NewClient(
@@ -106,11 +136,33 @@ func main() {
 	productClient := productpb.NewProductServiceClient(productConn)
 	userClient := userpb.NewUserServiceClient(userConn)
 
-	cartRepo := redis.NewCartRepository(redisConn)
-	cartUsecase := usecase.NewCartUsecase(cartRepo, productClient, userClient, config.DownstreamTimeout)
+	cartRepo, listRepo := newRepositories(config, redisConn, cartDB)
+	logger.Infof("Keeping carts in the %s store", config.CartStore)
+	cartUsecase := usecase.NewCartUsecase(cartRepo, productClient, userClient, config.DownstreamTimeout, domain.MergeStrategy(config.CartMergeStrategy), config.MaxLineQuantity)
+
+	listUsecase := usecase.NewListUsecase(listRepo, cartUsecase)
+
+	var mq *rabbitmq.RabbitMQ
+	if config.RabbitMQEnabled {
+		mq, err = rabbitmq.NewRabbitMQ(config.RabbitMQURL)
+		if err != nil {
+			close(done)
+			panic("failed to connect to rabbitmq")
+		}
+		defer mq.Close()
+
+		if err := mq.DeclareTopicExchange(config.EventsExchange); err != nil {
+			close(done)
+			panic("failed to declare events exchange")
+		}
+	}
+
+	eventPublisher := rabbitmqEvents.NewPublisher(mq, config.EventsExchange)
+	abandonedCartUsecase := usecase.NewAbandonedCartUsecase(cartRepo, cartUsecase, eventPublisher, config.AbandonedCartAfter)
+	go abandonedCartUsecase.Run(done, config.AbandonedCartScanInterval)
 
 	validate := validator.New()
-	grpcHandler := handler.NewCartGRPCHandler(cartUsecase, validate, config.InternalAuthToken)
+	grpcHandler := handler.NewCartGRPCHandler(cartUsecase, listUsecase, validate, config.InternalAuthToken)
 
 	if err := grpcHandler.Run(done, config.GRPCPort); err != nil {
 		logger.Errorf("failed to start gRPC server: %v", err)
@@ -123,10 +175,38 @@ func main() {
 
 	<-sigChan
 	close(done)
-	_ = redisConn.Close()
+	if redisConn != nil {
+		_ = redisConn.Close()
+	}
 	time.Sleep(200 * time.Millisecond)
 }
 
+// newRepositories builds the cart and list repositories of the configured
+// cart store. Lists live with the carts so items move between the two
+// atomically.
+func newRepositories(cfg *config.Config, redisConn *redisClient.Client, cartDB *gorm.DB) (domain.CartRepository, domain.ListRepository) {
+	switch cfg.CartStore {
+	case config.CartStoreMemory:
+		store := memory.NewStore(cfg.CartTTL, cfg.GuestCartTTL)
+		return memory.NewCartRepository(store), memory.NewListRepository(store)
+	case config.CartStorePostgres:
+		return postgresql.NewCartRepository(cartDB, cfg.CartTTL, cfg.GuestCartTTL), postgresql.NewListRepository(cartDB, cfg.CartTTL)
+	case config.CartStoreWriteThrough:
+		carts := writethrough.NewCartRepository(
+			redis.NewCartRepository(redisConn, cfg.CartTTL, cfg.GuestCartTTL),
+			postgresql.NewCartRepository(cartDB, cfg.CartTTL, cfg.GuestCartTTL),
+		)
+		lists := writethrough.NewListRepository(
+			redis.NewListRepository(redisConn, cfg.CartTTL),
+			postgresql.NewListRepository(cartDB, cfg.CartTTL),
+			carts,
+		)
+		return carts, lists
+	default:
+		return redis.NewCartRepository(redisConn, cfg.CartTTL, cfg.GuestCartTTL), redis.NewListRepository(redisConn, cfg.CartTTL)
+	}
+}
+
 func initTracing(ctx context.Context) func() {
 	jaegerEndpoint := config.GetEnv("JAEGER_ENDPOINT", "ecommece_jaeger:4317")
 	tp, err := tracer.InitTracer(ctx, "cart-service-grpc", jaegerEndpoint)
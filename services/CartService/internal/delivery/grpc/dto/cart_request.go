@@ -1,18 +1,52 @@
 package dto
 
+// Cart requests address either a user's cart (UserID) or a guest cart
+// (GuestToken), never both. Mutations with ExpectedVersion set fail when the
+// cart is at another version.
+
+type GetCartRequest struct {
+	UserID     uint   `json:"user_id" validate:"required_without=GuestToken"`
+	GuestToken string `json:"guest_token" validate:"excluded_with=UserID,omitempty,len=32,hexadecimal"`
+}
+
+// AddItemRequest may leave both UserID and GuestToken empty to start a new
+// guest cart.
 type AddItemRequest struct {
-	UserID    uint `json:"user_id" validate:"required,gt=0"`
-	ProductID uint `json:"product_id" validate:"required,gt=0"`
-	Quantity  int  `json:"quantity" validate:"required,gt=0"`
+	UserID          uint   `json:"user_id" validate:"omitempty"`
+	GuestToken      string `json:"guest_token" validate:"excluded_with=UserID,omitempty,len=32,hexadecimal"`
+	ProductID       uint   `json:"product_id" validate:"required,gt=0"`
+	VariantID       uint   `json:"variant_id" validate:"omitempty"`
+	Quantity        int    `json:"quantity" validate:"required,gt=0"`
+	ExpectedVersion *int64 `json:"expected_version" validate:"omitempty,gte=0"`
 }
 
+// UpdateItemRequest sets the quantity of a line; 0 removes it.
 type UpdateItemRequest struct {
-	UserID    uint `json:"user_id" validate:"required,gt=0"`
-	ProductID uint `json:"product_id" validate:"required,gt=0"`
-	Quantity  int  `json:"quantity" validate:"required,gt=0"`
+	UserID          uint   `json:"user_id" validate:"required_without=GuestToken"`
+	GuestToken      string `json:"guest_token" validate:"excluded_with=UserID,omitempty,len=32,hexadecimal"`
+	ProductID       uint   `json:"product_id" validate:"required,gt=0"`
+	VariantID       uint   `json:"variant_id" validate:"omitempty"`
+	Quantity        int    `json:"quantity" validate:"gte=0"`
+	ExpectedVersion *int64 `json:"expected_version" validate:"omitempty,gte=0"`
 }
 
 type RemoveItemRequest struct {
-	UserID    uint `json:"user_id" validate:"required,gt=0"`
-	ProductID uint `json:"product_id" validate:"required,gt=0"`
+	UserID          uint   `json:"user_id" validate:"required_without=GuestToken"`
+	GuestToken      string `json:"guest_token" validate:"excluded_with=UserID,omitempty,len=32,hexadecimal"`
+	ProductID       uint   `json:"product_id" validate:"required,gt=0"`
+	VariantID       uint   `json:"variant_id" validate:"omitempty"`
+	ExpectedVersion *int64 `json:"expected_version" validate:"omitempty,gte=0"`
+}
+
+type ClearCartRequest struct {
+	UserID          uint   `json:"user_id" validate:"required_without=GuestToken"`
+	GuestToken      string `json:"guest_token" validate:"excluded_with=UserID,omitempty,len=32,hexadecimal"`
+	ExpectedVersion *int64 `json:"expected_version" validate:"omitempty,gte=0"`
+}
+
+// MergeCartRequest leaves Strategy empty to use the service default.
+type MergeCartRequest struct {
+	UserID     uint   `json:"user_id" validate:"required,gt=0"`
+	GuestToken string `json:"guest_token" validate:"required,len=32,hexadecimal"`
+	Strategy   string `json:"strategy" validate:"omitempty,oneof=sum max guest"`
 }
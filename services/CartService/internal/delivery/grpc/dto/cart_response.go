@@ -1,12 +1,37 @@
 package dto
 
+import "time"
+
 type CartItemResponse struct {
-	ProductID uint `json:"product_id"`
-	Quantity  int  `json:"quantity"`
+	ProductID   uint    `json:"product_id"`
+	VariantID   uint    `json:"variant_id,omitempty"`
+	Quantity    int     `json:"quantity"`
+	Name        string  `json:"name,omitempty"`
+	ImageURL    string  `json:"image_url,omitempty"`
+	BasePrice   float32 `json:"base_price"`
+	UnitPrice   float32 `json:"unit_price"`
+	LineTotal   float32 `json:"line_total"`
+	StockStatus string  `json:"stock_status,omitempty"`
+}
+
+type CartWarningResponse struct {
+	Code      string   `json:"code"`
+	ProductID uint     `json:"product_id,omitempty"`
+	VariantID uint     `json:"variant_id,omitempty"`
+	Message   string   `json:"message"`
+	OldPrice  *float32 `json:"old_price,omitempty"`
+	NewPrice  *float32 `json:"new_price,omitempty"`
 }
 
 type CartResponse struct {
-	UserID        uint               `json:"user_id"`
-	Items         []CartItemResponse `json:"items"`
-	TotalQuantity int                `json:"total_quantity"`
+	UserID            uint                  `json:"user_id,omitempty"`
+	GuestToken        string                `json:"guest_token,omitempty"`
+	Items             []CartItemResponse    `json:"items"`
+	TotalQuantity     int                   `json:"total_quantity"`
+	Subtotal          float32               `json:"subtotal"`
+	EstimatedDiscount float32               `json:"estimated_discount"`
+	EstimatedTotal    float32               `json:"estimated_total"`
+	Warnings          []CartWarningResponse `json:"warnings,omitempty"`
+	UpdatedAt         *time.Time            `json:"updated_at,omitempty"`
+	Version           int64                 `json:"version"`
 }
@@ -0,0 +1,47 @@
+package dto
+
+// List requests leave ListID empty to address the user's saved-for-later
+// list.
+
+type ListListsRequest struct {
+	UserID uint `json:"user_id" validate:"required,gt=0"`
+}
+
+type CreateWishlistRequest struct {
+	UserID uint   `json:"user_id" validate:"required,gt=0"`
+	Name   string `json:"name" validate:"required,min=1,max=100"`
+}
+
+type ListRequest struct {
+	UserID uint   `json:"user_id" validate:"required,gt=0"`
+	ListID string `json:"list_id" validate:"omitempty,max=32,alphanum"`
+}
+
+type AddListItemRequest struct {
+	UserID    uint   `json:"user_id" validate:"required,gt=0"`
+	ListID    string `json:"list_id" validate:"omitempty,max=32,alphanum"`
+	ProductID uint   `json:"product_id" validate:"required,gt=0"`
+	VariantID uint   `json:"variant_id" validate:"omitempty"`
+	Quantity  int    `json:"quantity" validate:"required,gt=0"`
+}
+
+// ListItemRequest addresses an item of a list, or the cart line moved into a
+// list.
+type ListItemRequest struct {
+	UserID    uint   `json:"user_id" validate:"required,gt=0"`
+	ListID    string `json:"list_id" validate:"omitempty,max=32,alphanum"`
+	ProductID uint   `json:"product_id" validate:"required,gt=0"`
+	VariantID uint   `json:"variant_id" validate:"omitempty"`
+}
+
+// ShareListRequest turns public sharing of a wishlist on or off. Turning it
+// on again replaces the share token, revoking the old link.
+type ShareListRequest struct {
+	UserID uint   `json:"user_id" validate:"required,gt=0"`
+	ListID string `json:"list_id" validate:"required,max=32,alphanum"`
+	Shared bool   `json:"shared"`
+}
+
+type GetSharedListRequest struct {
+	ShareToken string `json:"share_token" validate:"required,len=32,hexadecimal"`
+}
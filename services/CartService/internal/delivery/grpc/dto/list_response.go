@@ -0,0 +1,42 @@
+package dto
+
+import "time"
+
+// ListItemResponse is a list item with what the catalog currently says about
+// it. Available is false when the item is no longer in the catalog.
+type ListItemResponse struct {
+	ProductID   uint    `json:"product_id"`
+	VariantID   uint    `json:"variant_id,omitempty"`
+	Quantity    int     `json:"quantity"`
+	Name        string  `json:"name,omitempty"`
+	ImageURL    string  `json:"image_url,omitempty"`
+	UnitPrice   float32 `json:"unit_price"`
+	StockStatus string  `json:"stock_status,omitempty"`
+	Available   bool    `json:"available"`
+}
+
+// ListResponse leaves UserID empty for shared lists.
+type ListResponse struct {
+	ID            string             `json:"id"`
+	UserID        uint               `json:"user_id,omitempty"`
+	Name          string             `json:"name"`
+	Kind          string             `json:"kind"`
+	ShareToken    string             `json:"share_token,omitempty"`
+	Items         []ListItemResponse `json:"items"`
+	TotalQuantity int                `json:"total_quantity"`
+	// PricingUnavailable is set when the catalog could not be reached; the
+	// items then carry no names or prices.
+	PricingUnavailable bool      `json:"pricing_unavailable,omitempty"`
+	CreatedAt          time.Time `json:"created_at"`
+	UpdatedAt          time.Time `json:"updated_at"`
+}
+
+type ListsResponse struct {
+	Lists []ListResponse `json:"lists"`
+}
+
+// MoveItemResponse returns both sides of a move between the cart and a list.
+type MoveItemResponse struct {
+	Cart *CartResponse `json:"cart"`
+	List *ListResponse `json:"list"`
+}
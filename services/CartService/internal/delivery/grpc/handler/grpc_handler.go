@@ -2,7 +2,9 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"net"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/kareemhamed001/e-commerce/pkg/grpcmiddleware"
@@ -14,23 +16,27 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type CartGRPCHandler struct {
 	cartpb.UnimplementedCartServiceServer
-	usecase  domain.CartUsecase
-	validate *validator.Validate
-	tracer   trace.Tracer
+	usecase           domain.CartUsecase
+	listUsecase       domain.ListUsecase
+	validate          *validator.Validate
+	tracer            trace.Tracer
 	internalAuthToken string
 }
 
 var _ cartpb.CartServiceServer = (*CartGRPCHandler)(nil)
 
-func NewCartGRPCHandler(usecase domain.CartUsecase, validate *validator.Validate, internalAuthToken string) *CartGRPCHandler {
+func NewCartGRPCHandler(usecase domain.CartUsecase, listUsecase domain.ListUsecase, validate *validator.Validate, internalAuthToken string) *CartGRPCHandler {
 	return &CartGRPCHandler{
-		usecase:  usecase,
-		validate: validate,
-		tracer:   otel.Tracer("cart_GRPC_handler"),
+		usecase:           usecase,
+		listUsecase:       listUsecase,
+		validate:          validate,
+		tracer:            otel.Tracer("cart_GRPC_handler"),
 		internalAuthToken: internalAuthToken,
 	}
 }
@@ -39,8 +45,18 @@ func (h *CartGRPCHandler) GetCart(ctx context.Context, req *cartpb.GetCartReques
 	ctx, span := h.tracer.Start(ctx, "CartHandler.GetCart")
 	defer span.End()
 
-	userID := uint(req.GetUserId())
-	response, err := h.usecase.GetCart(ctx, userID)
+	getReq := dto.GetCartRequest{
+		UserID:     uint(req.GetUserId()),
+		GuestToken: req.GetGuestToken(),
+	}
+
+	if err := h.validate.Struct(&getReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	response, err := h.usecase.GetCart(ctx, domain.CartOwner{UserID: getReq.UserID, GuestToken: getReq.GuestToken})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -55,9 +71,12 @@ func (h *CartGRPCHandler) AddItem(ctx context.Context, req *cartpb.AddItemReques
 	defer span.End()
 
 	addReq := dto.AddItemRequest{
-		UserID:    uint(req.GetUserId()),
-		ProductID: uint(req.GetProductId()),
-		Quantity:  int(req.GetQuantity()),
+		UserID:          uint(req.GetUserId()),
+		GuestToken:      req.GetGuestToken(),
+		ProductID:       uint(req.GetProductId()),
+		VariantID:       uint(req.GetVariantId()),
+		Quantity:        int(req.GetQuantity()),
+		ExpectedVersion: req.ExpectedVersion,
 	}
 
 	if err := h.validate.Struct(&addReq); err != nil {
@@ -70,7 +89,7 @@ func (h *CartGRPCHandler) AddItem(ctx context.Context, req *cartpb.AddItemReques
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, err
+		return nil, grpcError(err)
 	}
 
 	return mapCartResponse(response), nil
@@ -81,9 +100,12 @@ func (h *CartGRPCHandler) UpdateItem(ctx context.Context, req *cartpb.UpdateItem
 	defer span.End()
 
 	updateReq := dto.UpdateItemRequest{
-		UserID:    uint(req.GetUserId()),
-		ProductID: uint(req.GetProductId()),
-		Quantity:  int(req.GetQuantity()),
+		UserID:          uint(req.GetUserId()),
+		GuestToken:      req.GetGuestToken(),
+		ProductID:       uint(req.GetProductId()),
+		VariantID:       uint(req.GetVariantId()),
+		Quantity:        int(req.GetQuantity()),
+		ExpectedVersion: req.ExpectedVersion,
 	}
 
 	if err := h.validate.Struct(&updateReq); err != nil {
@@ -96,7 +118,7 @@ func (h *CartGRPCHandler) UpdateItem(ctx context.Context, req *cartpb.UpdateItem
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, err
+		return nil, grpcError(err)
 	}
 
 	return mapCartResponse(response), nil
@@ -107,8 +129,11 @@ func (h *CartGRPCHandler) RemoveItem(ctx context.Context, req *cartpb.RemoveItem
 	defer span.End()
 
 	removeReq := dto.RemoveItemRequest{
-		UserID:    uint(req.GetUserId()),
-		ProductID: uint(req.GetProductId()),
+		UserID:          uint(req.GetUserId()),
+		GuestToken:      req.GetGuestToken(),
+		ProductID:       uint(req.GetProductId()),
+		VariantID:       uint(req.GetVariantId()),
+		ExpectedVersion: req.ExpectedVersion,
 	}
 
 	if err := h.validate.Struct(&removeReq); err != nil {
@@ -121,7 +146,7 @@ func (h *CartGRPCHandler) RemoveItem(ctx context.Context, req *cartpb.RemoveItem
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, err
+		return nil, grpcError(err)
 	}
 
 	return mapCartResponse(response), nil
@@ -131,15 +156,53 @@ func (h *CartGRPCHandler) ClearCart(ctx context.Context, req *cartpb.ClearCartRe
 	ctx, span := h.tracer.Start(ctx, "CartHandler.ClearCart")
 	defer span.End()
 
-	if err := h.usecase.ClearCart(ctx, uint(req.GetUserId())); err != nil {
+	clearReq := dto.ClearCartRequest{
+		UserID:          uint(req.GetUserId()),
+		GuestToken:      req.GetGuestToken(),
+		ExpectedVersion: req.ExpectedVersion,
+	}
+
+	if err := h.validate.Struct(&clearReq); err != nil {
 		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
+		span.SetStatus(codes.Error, "validation failed")
 		return nil, err
 	}
 
+	if err := h.usecase.ClearCart(ctx, &clearReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, grpcError(err)
+	}
+
 	return &cartpb.ClearCartResponse{Success: true}, nil
 }
 
+func (h *CartGRPCHandler) MergeCart(ctx context.Context, req *cartpb.MergeCartRequest) (*cartpb.CartResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "CartHandler.MergeCart")
+	defer span.End()
+
+	mergeReq := dto.MergeCartRequest{
+		UserID:     uint(req.GetUserId()),
+		GuestToken: req.GetGuestToken(),
+		Strategy:   req.GetStrategy(),
+	}
+
+	if err := h.validate.Struct(&mergeReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	response, err := h.usecase.MergeCart(ctx, &mergeReq)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return mapCartResponse(response), nil
+}
+
 func (h *CartGRPCHandler) Run(done <-chan any, port string) error {
 	lis, err := net.Listen("tcp", ":"+port)
 	if err != nil {
@@ -166,6 +229,15 @@ func (h *CartGRPCHandler) Run(done <-chan any, port string) error {
 	return nil
 }
 
+// grpcError gives errors clients are expected to act on their gRPC status
+// code; other errors are returned as they are.
+func grpcError(err error) error {
+	if errors.Is(err, domain.ErrVersionConflict) {
+		return status.Error(grpcCodes.Aborted, err.Error())
+	}
+	return err
+}
+
 func mapCartResponse(response *dto.CartResponse) *cartpb.CartResponse {
 	if response == nil {
 		return &cartpb.CartResponse{}
@@ -174,14 +246,43 @@ func mapCartResponse(response *dto.CartResponse) *cartpb.CartResponse {
 	items := make([]*cartpb.CartItem, 0, len(response.Items))
 	for _, item := range response.Items {
 		items = append(items, &cartpb.CartItem{
-			ProductId: int64(item.ProductID),
-			Quantity:  int32(item.Quantity),
+			ProductId:   int64(item.ProductID),
+			VariantId:   int64(item.VariantID),
+			Quantity:    int32(item.Quantity),
+			Name:        item.Name,
+			ImageUrl:    item.ImageURL,
+			BasePrice:   item.BasePrice,
+			UnitPrice:   item.UnitPrice,
+			LineTotal:   item.LineTotal,
+			StockStatus: item.StockStatus,
 		})
 	}
 
-	return &cartpb.CartResponse{
-		UserId:        int64(response.UserID),
-		Items:         items,
-		TotalQuantity: int32(response.TotalQuantity),
+	warnings := make([]*cartpb.CartWarning, 0, len(response.Warnings))
+	for _, warning := range response.Warnings {
+		warnings = append(warnings, &cartpb.CartWarning{
+			Code:      warning.Code,
+			ProductId: int64(warning.ProductID),
+			VariantId: int64(warning.VariantID),
+			Message:   warning.Message,
+			OldPrice:  warning.OldPrice,
+			NewPrice:  warning.NewPrice,
+		})
+	}
+
+	cart := &cartpb.CartResponse{
+		UserId:            int64(response.UserID),
+		GuestToken:        response.GuestToken,
+		Items:             items,
+		TotalQuantity:     int32(response.TotalQuantity),
+		Subtotal:          response.Subtotal,
+		EstimatedDiscount: response.EstimatedDiscount,
+		EstimatedTotal:    response.EstimatedTotal,
+		Warnings:          warnings,
+		Version:           response.Version,
+	}
+	if response.UpdatedAt != nil {
+		cart.UpdatedAt = response.UpdatedAt.Format(time.RFC3339)
 	}
+	return cart
 }
@@ -0,0 +1,306 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/delivery/grpc/dto"
+	cartpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/cart"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (h *CartGRPCHandler) ListLists(ctx context.Context, req *cartpb.ListListsRequest) (*cartpb.ListsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "CartHandler.ListLists")
+	defer span.End()
+
+	listReq := dto.ListListsRequest{UserID: uint(req.GetUserId())}
+
+	if err := h.validate.Struct(&listReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	response, err := h.listUsecase.ListLists(ctx, listReq.UserID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	lists := make([]*cartpb.ListResponse, 0, len(response.Lists))
+	for i := range response.Lists {
+		lists = append(lists, mapListResponse(&response.Lists[i]))
+	}
+	return &cartpb.ListsResponse{Lists: lists}, nil
+}
+
+func (h *CartGRPCHandler) CreateWishlist(ctx context.Context, req *cartpb.CreateWishlistRequest) (*cartpb.ListResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "CartHandler.CreateWishlist")
+	defer span.End()
+
+	createReq := dto.CreateWishlistRequest{
+		UserID: uint(req.GetUserId()),
+		Name:   req.GetName(),
+	}
+
+	if err := h.validate.Struct(&createReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	response, err := h.listUsecase.CreateWishlist(ctx, &createReq)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return mapListResponse(response), nil
+}
+
+func (h *CartGRPCHandler) GetList(ctx context.Context, req *cartpb.GetListRequest) (*cartpb.ListResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "CartHandler.GetList")
+	defer span.End()
+
+	getReq := dto.ListRequest{
+		UserID: uint(req.GetUserId()),
+		ListID: req.GetListId(),
+	}
+
+	if err := h.validate.Struct(&getReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	response, err := h.listUsecase.GetList(ctx, &getReq)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return mapListResponse(response), nil
+}
+
+func (h *CartGRPCHandler) DeleteList(ctx context.Context, req *cartpb.DeleteListRequest) (*cartpb.DeleteListResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "CartHandler.DeleteList")
+	defer span.End()
+
+	deleteReq := dto.ListRequest{
+		UserID: uint(req.GetUserId()),
+		ListID: req.GetListId(),
+	}
+
+	if err := h.validate.Struct(&deleteReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	if err := h.listUsecase.DeleteList(ctx, &deleteReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return &cartpb.DeleteListResponse{Success: true}, nil
+}
+
+func (h *CartGRPCHandler) AddListItem(ctx context.Context, req *cartpb.AddListItemRequest) (*cartpb.ListResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "CartHandler.AddListItem")
+	defer span.End()
+
+	addReq := dto.AddListItemRequest{
+		UserID:    uint(req.GetUserId()),
+		ListID:    req.GetListId(),
+		ProductID: uint(req.GetProductId()),
+		VariantID: uint(req.GetVariantId()),
+		Quantity:  int(req.GetQuantity()),
+	}
+
+	if err := h.validate.Struct(&addReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	response, err := h.listUsecase.AddListItem(ctx, &addReq)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return mapListResponse(response), nil
+}
+
+func (h *CartGRPCHandler) RemoveListItem(ctx context.Context, req *cartpb.ListItemRequest) (*cartpb.ListResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "CartHandler.RemoveListItem")
+	defer span.End()
+
+	removeReq := mapListItemRequest(req)
+
+	if err := h.validate.Struct(&removeReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	response, err := h.listUsecase.RemoveListItem(ctx, &removeReq)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return mapListResponse(response), nil
+}
+
+func (h *CartGRPCHandler) MoveToList(ctx context.Context, req *cartpb.ListItemRequest) (*cartpb.MoveItemResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "CartHandler.MoveToList")
+	defer span.End()
+
+	moveReq := mapListItemRequest(req)
+
+	if err := h.validate.Struct(&moveReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	response, err := h.listUsecase.MoveToList(ctx, &moveReq)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return mapMoveItemResponse(response), nil
+}
+
+func (h *CartGRPCHandler) MoveToCart(ctx context.Context, req *cartpb.ListItemRequest) (*cartpb.MoveItemResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "CartHandler.MoveToCart")
+	defer span.End()
+
+	moveReq := mapListItemRequest(req)
+
+	if err := h.validate.Struct(&moveReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	response, err := h.listUsecase.MoveToCart(ctx, &moveReq)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return mapMoveItemResponse(response), nil
+}
+
+func (h *CartGRPCHandler) ShareList(ctx context.Context, req *cartpb.ShareListRequest) (*cartpb.ListResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "CartHandler.ShareList")
+	defer span.End()
+
+	shareReq := dto.ShareListRequest{
+		UserID: uint(req.GetUserId()),
+		ListID: req.GetListId(),
+		Shared: req.GetShared(),
+	}
+
+	if err := h.validate.Struct(&shareReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	response, err := h.listUsecase.ShareList(ctx, &shareReq)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return mapListResponse(response), nil
+}
+
+func (h *CartGRPCHandler) GetSharedList(ctx context.Context, req *cartpb.GetSharedListRequest) (*cartpb.ListResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "CartHandler.GetSharedList")
+	defer span.End()
+
+	getReq := dto.GetSharedListRequest{ShareToken: req.GetShareToken()}
+
+	if err := h.validate.Struct(&getReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	response, err := h.listUsecase.GetSharedList(ctx, getReq.ShareToken)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return mapListResponse(response), nil
+}
+
+func mapListItemRequest(req *cartpb.ListItemRequest) dto.ListItemRequest {
+	return dto.ListItemRequest{
+		UserID:    uint(req.GetUserId()),
+		ListID:    req.GetListId(),
+		ProductID: uint(req.GetProductId()),
+		VariantID: uint(req.GetVariantId()),
+	}
+}
+
+func mapMoveItemResponse(response *dto.MoveItemResponse) *cartpb.MoveItemResponse {
+	return &cartpb.MoveItemResponse{
+		Cart: mapCartResponse(response.Cart),
+		List: mapListResponse(response.List),
+	}
+}
+
+func mapListResponse(response *dto.ListResponse) *cartpb.ListResponse {
+	if response == nil {
+		return &cartpb.ListResponse{}
+	}
+
+	items := make([]*cartpb.ListItem, 0, len(response.Items))
+	for _, item := range response.Items {
+		items = append(items, &cartpb.ListItem{
+			ProductId:   int64(item.ProductID),
+			VariantId:   int64(item.VariantID),
+			Quantity:    int32(item.Quantity),
+			Name:        item.Name,
+			ImageUrl:    item.ImageURL,
+			UnitPrice:   item.UnitPrice,
+			StockStatus: item.StockStatus,
+			Available:   item.Available,
+		})
+	}
+
+	list := &cartpb.ListResponse{
+		Id:                 response.ID,
+		UserId:             int64(response.UserID),
+		Name:               response.Name,
+		Kind:               response.Kind,
+		ShareToken:         response.ShareToken,
+		Items:              items,
+		TotalQuantity:      int32(response.TotalQuantity),
+		PricingUnavailable: response.PricingUnavailable,
+	}
+	if !response.CreatedAt.IsZero() {
+		list.CreatedAt = response.CreatedAt.Format(time.RFC3339)
+	}
+	if !response.UpdatedAt.IsZero() {
+		list.UpdatedAt = response.UpdatedAt.Format(time.RFC3339)
+	}
+	return list
+}
@@ -1,12 +1,93 @@
 package domain
 
+import "time"
+
+// CartItem is a line in the cart. VariantID is zero for products without variants.
+// AddedPrice is the unit price when the line was last added or updated, zero
+// when unknown.
 type CartItem struct {
-	ProductID uint
-	Quantity  int
+	ProductID  uint
+	VariantID  uint
+	Quantity   int
+	AddedPrice float32
 }
 
+// Cart is a shopper's cart. UpdatedAt is the time of its last change, zero
+// when unknown. Version is bumped by every change, for optimistic
+// concurrency; a cart never changed is at version 0.
 type Cart struct {
 	UserID        uint
+	GuestToken    string
 	Items         []CartItem
 	TotalQuantity int
+	UpdatedAt     time.Time
+	Version       int64
+}
+
+// LineKey identifies a cart line. VariantID is zero for products without
+// variants.
+type LineKey struct {
+	ProductID uint
+	VariantID uint
 }
+
+// IdleCart is a cart that has not changed since UpdatedAt.
+type IdleCart struct {
+	Owner     CartOwner
+	UpdatedAt time.Time
+}
+
+// CartOwner identifies a cart: the user's cart when UserID is set, otherwise
+// the guest cart of GuestToken.
+type CartOwner struct {
+	UserID     uint
+	GuestToken string
+}
+
+func (o CartOwner) IsGuest() bool {
+	return o.UserID == 0
+}
+
+// MergeStrategy decides the quantity of a line present in both the guest cart
+// and the user's cart when the two are merged.
+type MergeStrategy string
+
+const (
+	MergeStrategySum   MergeStrategy = "sum"
+	MergeStrategyMax   MergeStrategy = "max"
+	MergeStrategyGuest MergeStrategy = "guest"
+)
+
+func (s MergeStrategy) IsValid() bool {
+	switch s {
+	case MergeStrategySum, MergeStrategyMax, MergeStrategyGuest:
+		return true
+	}
+	return false
+}
+
+// StockStatus is the availability of a cart line.
+type StockStatus string
+
+const (
+	StockInStock StockStatus = "in_stock"
+	// StockLow means the stock is at or below the product's reorder
+	// threshold, or below the quantity in the cart.
+	StockLow StockStatus = "low"
+	StockOut StockStatus = "out"
+)
+
+// CartWarningCode tells why a cart line needs the shopper's attention.
+type CartWarningCode string
+
+const (
+	// CartWarningRemoved marks a line whose product or variant is no longer
+	// in the catalog; it is left out of the priced lines and totals.
+	CartWarningRemoved CartWarningCode = "removed"
+	// CartWarningRepriced marks a line whose unit price changed since it was
+	// added.
+	CartWarningRepriced CartWarningCode = "repriced"
+	// CartWarningPricingUnavailable is returned when the catalog could not be
+	// reached; lines are returned without prices.
+	CartWarningPricingUnavailable CartWarningCode = "pricing_unavailable"
+)
@@ -0,0 +1,14 @@
+package domain
+
+import "errors"
+
+var (
+	ErrOutOfStock            = errors.New("item is out of stock")
+	ErrQuantityExceedsLimit  = errors.New("quantity exceeds the available stock or the per-order limit")
+	ErrVersionConflict       = errors.New("cart was changed by another request")
+	ErrListNotFound          = errors.New("list not found")
+	ErrItemNotInCart         = errors.New("item is not in the cart")
+	ErrItemNotInList         = errors.New("item is not in the list")
+	ErrListNotShareable      = errors.New("only wishlists can be shared")
+	ErrSavedListNotDeletable = errors.New("the saved for later list cannot be deleted")
+)
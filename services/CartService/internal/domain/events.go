@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	EventCartAbandoned = "cart.abandoned"
+)
+
+// EventPublisher delivers domain events to other services. Implementations
+// must be safe for concurrent use.
+type EventPublisher interface {
+	Publish(ctx context.Context, routingKey string, event any) error
+}
+
+// CartAbandonedEvent is published once when a user's cart has been idle for
+// the configured period; it is raised again only after the cart changes and
+// goes idle once more.
+type CartAbandonedEvent struct {
+	UserID        uint                     `json:"user_id"`
+	Items         []AbandonedCartItemEvent `json:"items"`
+	TotalQuantity int                      `json:"total_quantity"`
+	Subtotal      float32                  `json:"subtotal"`
+	// Priced is false when the catalog could not be reached; the items then
+	// carry no names or prices.
+	Priced         bool      `json:"priced"`
+	LastModifiedAt time.Time `json:"last_modified_at"`
+	OccurredAt     time.Time `json:"occurred_at"`
+}
+
+type AbandonedCartItemEvent struct {
+	ProductID uint    `json:"product_id"`
+	VariantID uint    `json:"variant_id,omitempty"`
+	Quantity  int     `json:"quantity"`
+	Name      string  `json:"name,omitempty"`
+	ImageURL  string  `json:"image_url,omitempty"`
+	UnitPrice float32 `json:"unit_price,omitempty"`
+}
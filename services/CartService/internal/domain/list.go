@@ -0,0 +1,36 @@
+package domain
+
+import "time"
+
+// SavedForLaterListID is the ID of every user's default list, created on
+// first use. Wishlists get random IDs.
+const SavedForLaterListID = "saved"
+
+// ListKind tells a user's default saved-for-later list from wishlists.
+type ListKind string
+
+const (
+	ListKindSavedForLater ListKind = "saved_for_later"
+	ListKindWishlist      ListKind = "wishlist"
+)
+
+// ListItem is an item parked in a list. VariantID is zero for products
+// without variants.
+type ListItem struct {
+	ProductID uint
+	VariantID uint
+	Quantity  int
+}
+
+// ItemList is a named, persistent list of items a user keeps outside the
+// cart. ShareToken is set while a wishlist is shared publicly.
+type ItemList struct {
+	ID         string
+	UserID     uint
+	Name       string
+	Kind       ListKind
+	ShareToken string
+	Items      []ListItem
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
@@ -2,22 +2,123 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	"github.com/kareemhamed001/e-commerce/services/CartService/internal/delivery/grpc/dto"
 )
 
 type CartUsecase interface {
-	GetCart(ctx context.Context, userID uint) (*dto.CartResponse, error)
+	GetCart(ctx context.Context, owner CartOwner) (*dto.CartResponse, error)
 	AddItem(ctx context.Context, req *dto.AddItemRequest) (*dto.CartResponse, error)
 	UpdateItem(ctx context.Context, req *dto.UpdateItemRequest) (*dto.CartResponse, error)
 	RemoveItem(ctx context.Context, req *dto.RemoveItemRequest) (*dto.CartResponse, error)
-	ClearCart(ctx context.Context, userID uint) error
+	ClearCart(ctx context.Context, req *dto.ClearCartRequest) error
+	MergeCart(ctx context.Context, req *dto.MergeCartRequest) (*dto.CartResponse, error)
+}
+
+// ListUsecase manages a user's saved-for-later list and wishlists. An empty
+// list ID addresses the saved-for-later list.
+type ListUsecase interface {
+	ListLists(ctx context.Context, userID uint) (*dto.ListsResponse, error)
+	CreateWishlist(ctx context.Context, req *dto.CreateWishlistRequest) (*dto.ListResponse, error)
+	GetList(ctx context.Context, req *dto.ListRequest) (*dto.ListResponse, error)
+	DeleteList(ctx context.Context, req *dto.ListRequest) error
+	AddListItem(ctx context.Context, req *dto.AddListItemRequest) (*dto.ListResponse, error)
+	RemoveListItem(ctx context.Context, req *dto.ListItemRequest) (*dto.ListResponse, error)
+	MoveToList(ctx context.Context, req *dto.ListItemRequest) (*dto.MoveItemResponse, error)
+	MoveToCart(ctx context.Context, req *dto.ListItemRequest) (*dto.MoveItemResponse, error)
+	ShareList(ctx context.Context, req *dto.ShareListRequest) (*dto.ListResponse, error)
+	GetSharedList(ctx context.Context, shareToken string) (*dto.ListResponse, error)
 }
 
 type CartRepository interface {
-	GetCart(ctx context.Context, userID uint) (Cart, error)
-	AddItem(ctx context.Context, userID, productID uint, quantity int) error
-	UpdateItem(ctx context.Context, userID, productID uint, quantity int) error
-	RemoveItem(ctx context.Context, userID, productID uint) error
-	ClearCart(ctx context.Context, userID uint) error
+	GetCart(ctx context.Context, owner CartOwner) (Cart, error)
+	// AddItem and UpdateItem record unitPrice as the line's price snapshot.
+	// AddItem increments the line atomically and fails with
+	// ErrQuantityExceedsLimit, leaving the line unchanged, when the result
+	// would exceed limit.
+	//
+	// Mutations with a non-nil expectedVersion fail with ErrVersionConflict,
+	// changing nothing, when the cart is at another version.
+	AddItem(ctx context.Context, owner CartOwner, productID, variantID uint, quantity int, unitPrice float32, limit int, expectedVersion *int64) error
+	UpdateItem(ctx context.Context, owner CartOwner, productID, variantID uint, quantity int, unitPrice float32, expectedVersion *int64) error
+	RemoveItem(ctx context.Context, owner CartOwner, productID, variantID uint, expectedVersion *int64) error
+	ClearCart(ctx context.Context, owner CartOwner, expectedVersion *int64) error
+	// MergeCart moves the guest cart into the user's cart and deletes the guest cart.
+	// Each merged line is capped at its entry in limits, like AddItem; guest
+	// lines without a positive limit are dropped.
+	MergeCart(ctx context.Context, guestToken string, userID uint, strategy MergeStrategy, limits map[LineKey]int) error
+	// ListIdleCarts returns up to limit carts last changed at or before
+	// idleSince, oldest first.
+	ListIdleCarts(ctx context.Context, idleSince time.Time, limit int) ([]IdleCart, error)
+	// ReleaseIdleCart stops listing the cart as idle until its next change.
+	// It reports false, leaving the cart listed, when the cart changed after
+	// cart.UpdatedAt.
+	ReleaseIdleCart(ctx context.Context, cart IdleCart) (bool, error)
+	// TrackIdleCart lists a released cart as idle again, unless it changed
+	// in the meantime.
+	TrackIdleCart(ctx context.Context, cart IdleCart) error
+}
+
+// CartStore keeps durable copies of carts served by another CartRepository.
+type CartStore interface {
+	// GetCart reads the stored copy; a missing or expired one is empty at
+	// version 0.
+	GetCart(ctx context.Context, owner CartOwner) (Cart, error)
+	// SaveCart replaces the stored copy, unless it is at a newer version.
+	SaveCart(ctx context.Context, cart Cart) error
+	DeleteCart(ctx context.Context, owner CartOwner) error
+	// DeleteExpiredCarts purges the stored copies of expired carts.
+	DeleteExpiredCarts(ctx context.Context) error
+}
+
+// CartCache is a CartRepository that can be refilled from a CartStore.
+type CartCache interface {
+	CartRepository
+	// RestoreCart writes the cart at its version, unless the cache already
+	// holds a cart for its owner.
+	RestoreCart(ctx context.Context, cart Cart) error
+}
+
+// ListRepository stores lists without expiry. Methods addressing a list that
+// does not exist fail with ErrListNotFound.
+type ListRepository interface {
+	// CreateList stores a new list; creating a list that exists is a no-op.
+	CreateList(ctx context.Context, list ItemList) error
+	GetList(ctx context.Context, userID uint, listID string) (ItemList, error)
+	// ListLists returns the user's lists, with their items, oldest first.
+	ListLists(ctx context.Context, userID uint) ([]ItemList, error)
+	DeleteList(ctx context.Context, userID uint, listID string) error
+	AddItem(ctx context.Context, userID uint, listID string, productID, variantID uint, quantity int) error
+	RemoveItem(ctx context.Context, userID uint, listID string, productID, variantID uint) error
+	// MoveFromCart moves a whole line of the user's cart into the list,
+	// atomically. It fails with ErrItemNotInCart when the cart has no such
+	// line.
+	MoveFromCart(ctx context.Context, userID uint, listID string, productID, variantID uint) error
+	// MoveToCart moves a whole list item into the user's cart, atomically,
+	// recording unitPrice as the line's price snapshot. It fails with
+	// ErrItemNotInList, or with ErrQuantityExceedsLimit leaving both
+	// unchanged when the cart line would exceed limit.
+	MoveToCart(ctx context.Context, userID uint, listID string, productID, variantID uint, unitPrice float32, limit int) error
+	// SetShareToken replaces the list's share token; an empty token stops
+	// sharing.
+	SetShareToken(ctx context.Context, userID uint, listID, token string) error
+	GetSharedList(ctx context.Context, shareToken string) (ItemList, error)
+}
+
+// ListStore keeps durable copies of lists served by another ListRepository.
+type ListStore interface {
+	// ListLists returns the user's stored lists, oldest first.
+	ListLists(ctx context.Context, userID uint) ([]ItemList, error)
+	GetSharedList(ctx context.Context, shareToken string) (ItemList, error)
+	// SaveList replaces the stored copy, unless it changed later.
+	SaveList(ctx context.Context, list ItemList) error
+	DeleteList(ctx context.Context, userID uint, listID string) error
+}
+
+// ListCache is a ListRepository that can be refilled from a ListStore.
+type ListCache interface {
+	ListRepository
+	// RestoreLists writes the lists, skipping those the cache already holds.
+	RestoreLists(ctx context.Context, lists []ItemList) error
 }
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+create table carts (
+    user_id bigint not null default 0,
+    guest_token varchar(32) not null default '',
+    version bigint not null default 0,
+    updated_at timestamp with time zone,
+    idle boolean not null default false,
+    expires_at timestamp with time zone,
+    primary key (user_id, guest_token)
+);
+
+create index idx_carts_idle_updated_at on carts (updated_at) where idle;
+create index idx_carts_expires_at on carts (expires_at) where expires_at is not null;
+
+create table cart_items (
+    user_id bigint not null,
+    guest_token varchar(32) not null,
+    product_id int not null,
+    variant_id int not null default 0,
+    quantity int not null,
+    added_price float not null default 0,
+    primary key (user_id, guest_token, product_id, variant_id),
+    foreign key (user_id, guest_token) references carts (user_id, guest_token) on delete cascade
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table cart_items;
+drop table carts;
+-- +goose StatementEnd
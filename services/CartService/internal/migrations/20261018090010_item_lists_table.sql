@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+create table item_lists (
+    user_id bigint not null,
+    id varchar(32) not null,
+    name varchar(100) not null,
+    kind varchar(20) not null,
+    share_token varchar(32),
+    created_at timestamp with time zone not null,
+    updated_at timestamp with time zone not null,
+    primary key (user_id, id)
+);
+
+create unique index idx_item_lists_share_token on item_lists (share_token);
+
+create table item_list_items (
+    user_id bigint not null,
+    list_id varchar(32) not null,
+    product_id int not null,
+    variant_id int not null default 0,
+    quantity int not null,
+    primary key (user_id, list_id, product_id, variant_id),
+    foreign key (user_id, list_id) references item_lists (user_id, id) on delete cascade
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table item_list_items;
+drop table item_lists;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+package repository
+
+import "errors"
+
+var (
+	ErrDatabaseConnection  = errors.New("database connection error")
+	ErrDatabaseQuery       = errors.New("database query failed")
+	ErrForeignKeyViolation = errors.New("related record not found")
+	ErrInvalidData         = errors.New("invalid data provided")
+)
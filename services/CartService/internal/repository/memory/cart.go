@@ -0,0 +1,222 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/domain"
+)
+
+type CartRepository struct {
+	store *Store
+}
+
+var _ domain.CartRepository = (*CartRepository)(nil)
+
+func NewCartRepository(store *Store) *CartRepository {
+	return &CartRepository{store: store}
+}
+
+func (r *CartRepository) GetCart(ctx context.Context, owner domain.CartOwner) (domain.Cart, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	cart := domain.Cart{
+		UserID:     owner.UserID,
+		GuestToken: owner.GuestToken,
+		Items:      []domain.CartItem{},
+	}
+	entry := r.store.cart(owner, now())
+	if entry == nil {
+		return cart, nil
+	}
+
+	keys := make([]itemKey, 0, len(entry.lines))
+	for key := range entry.lines {
+		keys = append(keys, key)
+	}
+	sortItemKeys(keys)
+
+	for _, key := range keys {
+		line := entry.lines[key]
+		cart.Items = append(cart.Items, domain.CartItem{
+			ProductID:  key.productID,
+			VariantID:  key.variantID,
+			Quantity:   line.quantity,
+			AddedPrice: line.price,
+		})
+		cart.TotalQuantity += line.quantity
+	}
+	cart.UpdatedAt = entry.updatedAt
+	cart.Version = entry.version
+	return cart, nil
+}
+
+func (r *CartRepository) AddItem(ctx context.Context, owner domain.CartOwner, productID, variantID uint, quantity int, unitPrice float32, limit int, expectedVersion *int64) error {
+	return r.mutate(owner, expectedVersion, func(entry *cartEntry) error {
+		key := itemKey{productID: productID, variantID: variantID}
+		current := entry.lines[key].quantity
+		if current+quantity > limit {
+			return fmt.Errorf("%w: %d already in cart, at most %d allowed", domain.ErrQuantityExceedsLimit, current, limit)
+		}
+		entry.lines[key] = cartLine{quantity: current + quantity, price: unitPrice}
+		return nil
+	})
+}
+
+func (r *CartRepository) UpdateItem(ctx context.Context, owner domain.CartOwner, productID, variantID uint, quantity int, unitPrice float32, expectedVersion *int64) error {
+	return r.mutate(owner, expectedVersion, func(entry *cartEntry) error {
+		entry.lines[itemKey{productID: productID, variantID: variantID}] = cartLine{quantity: quantity, price: unitPrice}
+		return nil
+	})
+}
+
+func (r *CartRepository) RemoveItem(ctx context.Context, owner domain.CartOwner, productID, variantID uint, expectedVersion *int64) error {
+	return r.mutate(owner, expectedVersion, func(entry *cartEntry) error {
+		delete(entry.lines, itemKey{productID: productID, variantID: variantID})
+		return nil
+	})
+}
+
+func (r *CartRepository) ClearCart(ctx context.Context, owner domain.CartOwner, expectedVersion *int64) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	at := now()
+	entry := r.store.cartOrNew(owner, at)
+	if err := checkVersion(entry, expectedVersion); err != nil {
+		return err
+	}
+
+	// The version is bumped rather than reset, so a client holding a
+	// version from before the clear still conflicts.
+	entry.lines = make(map[itemKey]cartLine)
+	entry.version++
+	entry.updatedAt = time.Time{}
+	entry.idle = false
+	entry.expiresAt = r.store.expiry(owner, at)
+	r.store.carts[owner] = entry
+	return nil
+}
+
+func (r *CartRepository) MergeCart(ctx context.Context, guestToken string, userID uint, strategy domain.MergeStrategy, limits map[domain.LineKey]int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	at := now()
+	guestOwner := domain.CartOwner{GuestToken: guestToken}
+	guest := r.store.cart(guestOwner, at)
+	if guest == nil {
+		return nil
+	}
+	delete(r.store.carts, guestOwner)
+	if len(guest.lines) == 0 {
+		return nil
+	}
+
+	userOwner := domain.CartOwner{UserID: userID}
+	user := r.store.cartOrNew(userOwner, at)
+	merged := 0
+	for key, line := range guest.lines {
+		limit := limits[domain.LineKey{ProductID: key.productID, VariantID: key.variantID}]
+		if limit <= 0 {
+			continue
+		}
+		current, ok := user.lines[key]
+		switch strategy {
+		case domain.MergeStrategySum:
+			current.quantity += line.quantity
+			if !ok {
+				current.price = line.price
+			}
+		case domain.MergeStrategyMax:
+			if line.quantity > current.quantity {
+				current = line
+			}
+		default:
+			current = line
+		}
+		current.quantity = min(current.quantity, limit)
+		user.lines[key] = current
+		merged++
+	}
+	if merged > 0 {
+		r.store.touch(userOwner, user, at)
+	}
+	return nil
+}
+
+func (r *CartRepository) ListIdleCarts(ctx context.Context, idleSince time.Time, limit int) ([]domain.IdleCart, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	at := now()
+	carts := make([]domain.IdleCart, 0)
+	for owner := range r.store.carts {
+		// Looking the cart up drops it when it has expired.
+		entry := r.store.cart(owner, at)
+		if entry == nil || !entry.idle || entry.updatedAt.After(idleSince) {
+			continue
+		}
+		carts = append(carts, domain.IdleCart{Owner: owner, UpdatedAt: entry.updatedAt})
+	}
+
+	sort.Slice(carts, func(i, j int) bool {
+		return carts[i].UpdatedAt.Before(carts[j].UpdatedAt)
+	})
+	if len(carts) > limit {
+		carts = carts[:limit]
+	}
+	return carts, nil
+}
+
+func (r *CartRepository) ReleaseIdleCart(ctx context.Context, cart domain.IdleCart) (bool, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	entry := r.store.cart(cart.Owner, now())
+	if entry == nil || !entry.idle || !entry.updatedAt.Equal(cart.UpdatedAt) {
+		return false, nil
+	}
+	entry.idle = false
+	return true, nil
+}
+
+func (r *CartRepository) TrackIdleCart(ctx context.Context, cart domain.IdleCart) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	// A cart changed in the meantime is tracked already, with its new time.
+	entry := r.store.cart(cart.Owner, now())
+	if entry != nil && entry.updatedAt.Equal(cart.UpdatedAt) {
+		entry.idle = true
+	}
+	return nil
+}
+
+// mutate applies change to the cart of owner under the store lock, after the
+// version check, and records the change when it succeeds.
+func (r *CartRepository) mutate(owner domain.CartOwner, expectedVersion *int64, change func(*cartEntry) error) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	at := now()
+	entry := r.store.cartOrNew(owner, at)
+	if err := checkVersion(entry, expectedVersion); err != nil {
+		return err
+	}
+	if err := change(entry); err != nil {
+		return err
+	}
+	r.store.touch(owner, entry, at)
+	return nil
+}
+
+func checkVersion(entry *cartEntry, expectedVersion *int64) error {
+	if expectedVersion != nil && entry.version != *expectedVersion {
+		return fmt.Errorf("%w: cart is at version %d, expected %d", domain.ErrVersionConflict, entry.version, *expectedVersion)
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/domain"
+)
+
+type ListRepository struct {
+	store *Store
+}
+
+var _ domain.ListRepository = (*ListRepository)(nil)
+
+// NewListRepository keeps lists in the store that holds the carts, so items
+// move between the two atomically.
+func NewListRepository(store *Store) *ListRepository {
+	return &ListRepository{store: store}
+}
+
+func (r *ListRepository) CreateList(ctx context.Context, list domain.ItemList) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	if r.store.list(list.UserID, list.ID) != nil {
+		return nil
+	}
+	if r.store.lists[list.UserID] == nil {
+		r.store.lists[list.UserID] = make(map[string]*listEntry)
+	}
+
+	list.CreatedAt = list.CreatedAt.UTC().Truncate(time.Millisecond)
+	list.ShareToken = ""
+	list.Items = nil
+	list.UpdatedAt = list.CreatedAt
+	r.store.lists[list.UserID][list.ID] = &listEntry{list: list, items: make(map[itemKey]int)}
+	return nil
+}
+
+func (r *ListRepository) GetList(ctx context.Context, userID uint, listID string) (domain.ItemList, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	entry := r.store.list(userID, listID)
+	if entry == nil {
+		return domain.ItemList{}, domain.ErrListNotFound
+	}
+	return entry.snapshot(), nil
+}
+
+func (r *ListRepository) ListLists(ctx context.Context, userID uint) ([]domain.ItemList, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	lists := make([]domain.ItemList, 0, len(r.store.lists[userID]))
+	for _, entry := range r.store.lists[userID] {
+		lists = append(lists, entry.snapshot())
+	}
+
+	sort.Slice(lists, func(i, j int) bool {
+		if !lists[i].CreatedAt.Equal(lists[j].CreatedAt) {
+			return lists[i].CreatedAt.Before(lists[j].CreatedAt)
+		}
+		return lists[i].ID < lists[j].ID
+	})
+	return lists, nil
+}
+
+func (r *ListRepository) DeleteList(ctx context.Context, userID uint, listID string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	entry := r.store.list(userID, listID)
+	if entry == nil {
+		return domain.ErrListNotFound
+	}
+	if entry.list.ShareToken != "" {
+		delete(r.store.shared, entry.list.ShareToken)
+	}
+	delete(r.store.lists[userID], listID)
+	return nil
+}
+
+func (r *ListRepository) AddItem(ctx context.Context, userID uint, listID string, productID, variantID uint, quantity int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	entry := r.store.list(userID, listID)
+	if entry == nil {
+		return domain.ErrListNotFound
+	}
+	entry.items[itemKey{productID: productID, variantID: variantID}] += quantity
+	entry.list.UpdatedAt = now()
+	return nil
+}
+
+func (r *ListRepository) RemoveItem(ctx context.Context, userID uint, listID string, productID, variantID uint) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	entry := r.store.list(userID, listID)
+	if entry == nil {
+		return domain.ErrListNotFound
+	}
+	delete(entry.items, itemKey{productID: productID, variantID: variantID})
+	entry.list.UpdatedAt = now()
+	return nil
+}
+
+func (r *ListRepository) MoveFromCart(ctx context.Context, userID uint, listID string, productID, variantID uint) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	list := r.store.list(userID, listID)
+	if list == nil {
+		return domain.ErrListNotFound
+	}
+
+	at := now()
+	owner := domain.CartOwner{UserID: userID}
+	key := itemKey{productID: productID, variantID: variantID}
+	cart := r.store.cart(owner, at)
+	if cart == nil || cart.lines[key].quantity <= 0 {
+		return domain.ErrItemNotInCart
+	}
+
+	list.items[key] += cart.lines[key].quantity
+	list.list.UpdatedAt = at
+	delete(cart.lines, key)
+	r.store.touch(owner, cart, at)
+	return nil
+}
+
+func (r *ListRepository) MoveToCart(ctx context.Context, userID uint, listID string, productID, variantID uint, unitPrice float32, limit int) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	list := r.store.list(userID, listID)
+	if list == nil {
+		return domain.ErrListNotFound
+	}
+	key := itemKey{productID: productID, variantID: variantID}
+	quantity := list.items[key]
+	if quantity <= 0 {
+		return domain.ErrItemNotInList
+	}
+
+	at := now()
+	owner := domain.CartOwner{UserID: userID}
+	cart := r.store.cartOrNew(owner, at)
+	current := cart.lines[key].quantity
+	if current+quantity > limit {
+		return fmt.Errorf("%w: %d already in cart, at most %d allowed", domain.ErrQuantityExceedsLimit, current, limit)
+	}
+
+	cart.lines[key] = cartLine{quantity: current + quantity, price: unitPrice}
+	r.store.touch(owner, cart, at)
+	delete(list.items, key)
+	list.list.UpdatedAt = at
+	return nil
+}
+
+func (r *ListRepository) SetShareToken(ctx context.Context, userID uint, listID, token string) error {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	entry := r.store.list(userID, listID)
+	if entry == nil {
+		return domain.ErrListNotFound
+	}
+	if entry.list.ShareToken != "" {
+		delete(r.store.shared, entry.list.ShareToken)
+	}
+	entry.list.ShareToken = token
+	if token != "" {
+		r.store.shared[token] = listRef{userID: userID, listID: listID}
+	}
+	return nil
+}
+
+func (r *ListRepository) GetSharedList(ctx context.Context, shareToken string) (domain.ItemList, error) {
+	r.store.mu.Lock()
+	defer r.store.mu.Unlock()
+
+	ref, ok := r.store.shared[shareToken]
+	if !ok {
+		return domain.ItemList{}, domain.ErrListNotFound
+	}
+	entry := r.store.list(ref.userID, ref.listID)
+	if entry == nil {
+		return domain.ItemList{}, domain.ErrListNotFound
+	}
+	return entry.snapshot(), nil
+}
+
+// snapshot copies the list with its items, so callers cannot change the
+// store behind its lock.
+func (e *listEntry) snapshot() domain.ItemList {
+	keys := make([]itemKey, 0, len(e.items))
+	for key := range e.items {
+		keys = append(keys, key)
+	}
+	sortItemKeys(keys)
+
+	list := e.list
+	list.Items = make([]domain.ListItem, 0, len(keys))
+	for _, key := range keys {
+		list.Items = append(list.Items, domain.ListItem{
+			ProductID: key.productID,
+			VariantID: key.variantID,
+			Quantity:  e.items[key],
+		})
+	}
+	return list
+}
@@ -0,0 +1,131 @@
+package memory
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/domain"
+)
+
+// itemKey identifies a cart line or list item. VariantID is zero for
+// products without variants.
+type itemKey struct {
+	productID uint
+	variantID uint
+}
+
+type cartLine struct {
+	quantity int
+	// price is the unit price when the line was last added or updated.
+	price float32
+}
+
+// cartEntry is a cart, or what is left of a cleared one: its version
+// outlives the lines until the cart expires, like the Redis version key.
+type cartEntry struct {
+	lines     map[itemKey]cartLine
+	version   int64
+	updatedAt time.Time
+	// idle is set while the cart is listed by ListIdleCarts once it has been
+	// idle long enough.
+	idle      bool
+	expiresAt time.Time
+}
+
+type listEntry struct {
+	list  domain.ItemList
+	items map[itemKey]int
+}
+
+type listRef struct {
+	userID uint
+	listID string
+}
+
+// Store holds carts and lists in process memory, behind one lock so moves
+// between a cart and a list are atomic. Nothing survives a restart and
+// nothing is shared between replicas: it is meant for tests and single-node
+// development.
+type Store struct {
+	mu       sync.Mutex
+	carts    map[domain.CartOwner]*cartEntry
+	lists    map[uint]map[string]*listEntry
+	shared   map[string]listRef
+	cartTTL  time.Duration
+	guestTTL time.Duration
+}
+
+// NewStore keeps user carts for cartTTL (0 for no expiry) and guest carts for
+// guestTTL after their last change. Lists never expire.
+func NewStore(cartTTL, guestTTL time.Duration) *Store {
+	return &Store{
+		carts:    make(map[domain.CartOwner]*cartEntry),
+		lists:    make(map[uint]map[string]*listEntry),
+		shared:   make(map[string]listRef),
+		cartTTL:  cartTTL,
+		guestTTL: guestTTL,
+	}
+}
+
+// cart returns the live cart of owner, or nil, dropping it when it has
+// expired. Callers hold mu.
+func (s *Store) cart(owner domain.CartOwner, now time.Time) *cartEntry {
+	entry, ok := s.carts[owner]
+	if !ok {
+		return nil
+	}
+	if !entry.expiresAt.IsZero() && !now.Before(entry.expiresAt) {
+		delete(s.carts, owner)
+		return nil
+	}
+	return entry
+}
+
+// touch records a change to the cart: it stores it, bumps its version,
+// stamps it and restarts its expiry.
+func (s *Store) touch(owner domain.CartOwner, entry *cartEntry, now time.Time) {
+	s.carts[owner] = entry
+	entry.version++
+	entry.updatedAt = now
+	entry.idle = true
+	entry.expiresAt = s.expiry(owner, now)
+}
+
+func (s *Store) expiry(owner domain.CartOwner, now time.Time) time.Time {
+	ttl := s.cartTTL
+	if owner.IsGuest() {
+		ttl = s.guestTTL
+	}
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return now.Add(ttl)
+}
+
+// cartOrNew is the live cart of owner, or a new empty one at version 0 that
+// is stored on its first change. Callers hold mu.
+func (s *Store) cartOrNew(owner domain.CartOwner, now time.Time) *cartEntry {
+	if entry := s.cart(owner, now); entry != nil {
+		return entry
+	}
+	return &cartEntry{lines: make(map[itemKey]cartLine)}
+}
+
+func (s *Store) list(userID uint, listID string) *listEntry {
+	return s.lists[userID][listID]
+}
+
+// now is millisecond-precise, like the timestamps of the Redis repository.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+func sortItemKeys(keys []itemKey) {
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].productID != keys[j].productID {
+			return keys[i].productID < keys[j].productID
+		}
+		return keys[i].variantID < keys[j].variantID
+	})
+}
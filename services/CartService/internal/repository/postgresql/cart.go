@@ -0,0 +1,544 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// cartRow is a row of the carts table. Guest carts have user_id 0, user
+// carts an empty guest_token. A row outlives a cleared cart, keeping its
+// version until the cart expires.
+type cartRow struct {
+	UserID     uint
+	GuestToken string
+	Version    int64
+	UpdatedAt  *time.Time
+	// Idle is set while the cart is listed by ListIdleCarts once it has been
+	// idle long enough.
+	Idle      bool
+	ExpiresAt *time.Time
+}
+
+type cartItemRow struct {
+	ProductID  uint
+	VariantID  uint
+	Quantity   int
+	AddedPrice float32
+}
+
+type CartRepository struct {
+	db       *gorm.DB
+	cartTTL  time.Duration
+	guestTTL time.Duration
+	tracer   trace.Tracer
+}
+
+var _ domain.CartRepository = (*CartRepository)(nil)
+var _ domain.CartStore = (*CartRepository)(nil)
+
+// NewCartRepository stores user carts with cartTTL (0 for no expiry) and
+// guest carts with guestTTL. Both are sliding: every write restarts them.
+// Expired carts read as empty and are purged by ListIdleCarts.
+func NewCartRepository(db *gorm.DB, cartTTL, guestTTL time.Duration) *CartRepository {
+	return &CartRepository{db: db, cartTTL: cartTTL, guestTTL: guestTTL, tracer: otel.Tracer("cart-repo")}
+}
+
+func (r *CartRepository) GetCart(ctx context.Context, owner domain.CartOwner) (domain.Cart, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepository.GetCart")
+	defer span.End()
+
+	cart, err := loadCart(r.db.WithContext(ctx), owner, now())
+	if err != nil {
+		return domain.Cart{}, recordError(span, err)
+	}
+
+	span.SetAttributes(attribute.Int("cart.items", len(cart.Items)))
+	span.SetStatus(codes.Ok, "cart retrieved")
+	return cart, nil
+}
+
+func (r *CartRepository) AddItem(ctx context.Context, owner domain.CartOwner, productID, variantID uint, quantity int, unitPrice float32, limit int, expectedVersion *int64) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepository.AddItem")
+	defer span.End()
+
+	err := r.mutate(ctx, owner, expectedVersion, func(tx *gorm.DB) error {
+		current, err := cartItemQuantity(tx, owner, productID, variantID)
+		if err != nil {
+			return err
+		}
+		if current+quantity > limit {
+			return fmt.Errorf("%w: %d already in cart, at most %d allowed", domain.ErrQuantityExceedsLimit, current, limit)
+		}
+		return upsertCartItem(tx, owner, productID, variantID, current+quantity, unitPrice)
+	})
+	if err != nil {
+		return recordError(span, err)
+	}
+
+	span.SetStatus(codes.Ok, "cart item added")
+	return nil
+}
+
+func (r *CartRepository) UpdateItem(ctx context.Context, owner domain.CartOwner, productID, variantID uint, quantity int, unitPrice float32, expectedVersion *int64) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepository.UpdateItem")
+	defer span.End()
+
+	err := r.mutate(ctx, owner, expectedVersion, func(tx *gorm.DB) error {
+		return upsertCartItem(tx, owner, productID, variantID, quantity, unitPrice)
+	})
+	if err != nil {
+		return recordError(span, err)
+	}
+
+	span.SetStatus(codes.Ok, "cart item updated")
+	return nil
+}
+
+func (r *CartRepository) RemoveItem(ctx context.Context, owner domain.CartOwner, productID, variantID uint, expectedVersion *int64) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepository.RemoveItem")
+	defer span.End()
+
+	err := r.mutate(ctx, owner, expectedVersion, func(tx *gorm.DB) error {
+		return deleteCartItem(tx, owner, productID, variantID)
+	})
+	if err != nil {
+		return recordError(span, err)
+	}
+
+	span.SetStatus(codes.Ok, "cart item removed")
+	return nil
+}
+
+func (r *CartRepository) ClearCart(ctx context.Context, owner domain.CartOwner, expectedVersion *int64) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepository.ClearCart")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		at := now()
+		cart, err := lockCart(tx, owner, at)
+		if err != nil {
+			return err
+		}
+		if err := checkVersion(cart, expectedVersion); err != nil {
+			return err
+		}
+
+		if err := tx.Exec("DELETE FROM cart_items WHERE user_id = ? AND guest_token = ?", owner.UserID, owner.GuestToken).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		// The version is bumped rather than reset, so a client holding a
+		// version from before the clear still conflicts.
+		return mapPostgresError(tx.Exec(
+			"UPDATE carts SET version = ?, updated_at = NULL, idle = false, expires_at = ? WHERE user_id = ? AND guest_token = ?",
+			cart.Version+1, expiry(r.ttl(owner), at), owner.UserID, owner.GuestToken,
+		).Error)
+	})
+	if err != nil {
+		return recordError(span, err)
+	}
+
+	span.SetStatus(codes.Ok, "cart cleared")
+	return nil
+}
+
+func (r *CartRepository) MergeCart(ctx context.Context, guestToken string, userID uint, strategy domain.MergeStrategy, limits map[domain.LineKey]int) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepository.MergeCart")
+	defer span.End()
+
+	userOwner := domain.CartOwner{UserID: userID}
+	guestOwner := domain.CartOwner{GuestToken: guestToken}
+	merged := 0
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		at := now()
+		// Always the user's cart first, so concurrent merges cannot deadlock.
+		user, err := lockCart(tx, userOwner, at)
+		if err != nil {
+			return err
+		}
+		if _, err := lockCart(tx, guestOwner, at); err != nil {
+			return err
+		}
+
+		guestItems, err := cartItems(tx, guestOwner)
+		if err != nil {
+			return err
+		}
+		userItems, err := cartItems(tx, userOwner)
+		if err != nil {
+			return err
+		}
+		current := make(map[[2]uint]cartItemRow, len(userItems))
+		for _, item := range userItems {
+			current[[2]uint{item.ProductID, item.VariantID}] = item
+		}
+
+		for _, item := range guestItems {
+			limit := limits[domain.LineKey{ProductID: item.ProductID, VariantID: item.VariantID}]
+			if limit <= 0 {
+				continue
+			}
+			existing, ok := current[[2]uint{item.ProductID, item.VariantID}]
+			switch strategy {
+			case domain.MergeStrategySum:
+				if ok {
+					item.Quantity += existing.Quantity
+					item.AddedPrice = existing.AddedPrice
+				}
+			case domain.MergeStrategyMax:
+				if ok && existing.Quantity >= item.Quantity {
+					item = existing
+				}
+			}
+			item.Quantity = min(item.Quantity, limit)
+			if err := upsertCartItem(tx, userOwner, item.ProductID, item.VariantID, item.Quantity, item.AddedPrice); err != nil {
+				return err
+			}
+			merged++
+		}
+
+		if err := deleteCart(tx, guestOwner); err != nil {
+			return err
+		}
+		if merged == 0 {
+			return nil
+		}
+		return touchCart(tx, user, r.ttl(userOwner), at)
+	})
+	if err != nil {
+		return recordError(span, err)
+	}
+
+	span.SetAttributes(attribute.Int("cart.merged_items", merged))
+	span.SetStatus(codes.Ok, "carts merged")
+	return nil
+}
+
+func (r *CartRepository) ListIdleCarts(ctx context.Context, idleSince time.Time, limit int) ([]domain.IdleCart, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepository.ListIdleCarts")
+	defer span.End()
+
+	// The scan doubles as the cleanup of expired carts.
+	if err := r.DeleteExpiredCarts(ctx); err != nil {
+		return nil, recordError(span, err)
+	}
+
+	var rows []cartRow
+	err := r.db.WithContext(ctx).Raw(
+		"SELECT user_id, guest_token, updated_at FROM carts WHERE idle AND updated_at <= ? ORDER BY updated_at LIMIT ?",
+		idleSince.UTC(), limit,
+	).Scan(&rows).Error
+	if err != nil {
+		return nil, recordError(span, mapPostgresError(err))
+	}
+
+	carts := make([]domain.IdleCart, 0, len(rows))
+	for _, row := range rows {
+		carts = append(carts, domain.IdleCart{
+			Owner:     domain.CartOwner{UserID: row.UserID, GuestToken: row.GuestToken},
+			UpdatedAt: row.UpdatedAt.UTC(),
+		})
+	}
+
+	span.SetAttributes(attribute.Int("carts.count", len(carts)))
+	span.SetStatus(codes.Ok, "idle carts listed")
+	return carts, nil
+}
+
+func (r *CartRepository) ReleaseIdleCart(ctx context.Context, cart domain.IdleCart) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepository.ReleaseIdleCart")
+	defer span.End()
+
+	result := r.db.WithContext(ctx).Exec(
+		"UPDATE carts SET idle = false WHERE user_id = ? AND guest_token = ? AND idle AND updated_at = ?",
+		cart.Owner.UserID, cart.Owner.GuestToken, cart.UpdatedAt.UTC(),
+	)
+	if result.Error != nil {
+		return false, recordError(span, mapPostgresError(result.Error))
+	}
+
+	span.SetStatus(codes.Ok, "idle cart released")
+	return result.RowsAffected == 1, nil
+}
+
+func (r *CartRepository) TrackIdleCart(ctx context.Context, cart domain.IdleCart) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepository.TrackIdleCart")
+	defer span.End()
+
+	// A cart changed in the meantime is tracked already, with its new time.
+	err := r.db.WithContext(ctx).Exec(
+		"UPDATE carts SET idle = true WHERE user_id = ? AND guest_token = ? AND updated_at = ?",
+		cart.Owner.UserID, cart.Owner.GuestToken, cart.UpdatedAt.UTC(),
+	).Error
+	if err != nil {
+		return recordError(span, mapPostgresError(err))
+	}
+
+	span.SetStatus(codes.Ok, "idle cart tracked")
+	return nil
+}
+
+// SaveCart replaces the stored copy of the cart, unless the stored copy is
+// at a newer version: snapshots of concurrent changes may arrive out of
+// order. Stored copies are not listed as idle.
+func (r *CartRepository) SaveCart(ctx context.Context, cart domain.Cart) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepository.SaveCart")
+	defer span.End()
+
+	owner := domain.CartOwner{UserID: cart.UserID, GuestToken: cart.GuestToken}
+	var updatedAt *time.Time
+	if !cart.UpdatedAt.IsZero() {
+		at := cart.UpdatedAt.UTC()
+		updatedAt = &at
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		at := now()
+		result := tx.Exec(`
+			INSERT INTO carts (user_id, guest_token, version, updated_at, idle, expires_at)
+			VALUES (?, ?, ?, ?, false, ?)
+			ON CONFLICT (user_id, guest_token) DO UPDATE
+			SET version = EXCLUDED.version, updated_at = EXCLUDED.updated_at, expires_at = EXCLUDED.expires_at
+			WHERE carts.version <= EXCLUDED.version OR carts.expires_at <= ?`,
+			owner.UserID, owner.GuestToken, cart.Version, updatedAt, expiry(r.ttl(owner), at), at,
+		)
+		if result.Error != nil {
+			return mapPostgresError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := tx.Exec("DELETE FROM cart_items WHERE user_id = ? AND guest_token = ?", owner.UserID, owner.GuestToken).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		for _, item := range cart.Items {
+			if err := upsertCartItem(tx, owner, item.ProductID, item.VariantID, item.Quantity, item.AddedPrice); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return recordError(span, err)
+	}
+
+	span.SetStatus(codes.Ok, "cart saved")
+	return nil
+}
+
+func (r *CartRepository) DeleteCart(ctx context.Context, owner domain.CartOwner) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepository.DeleteCart")
+	defer span.End()
+
+	if err := deleteCart(r.db.WithContext(ctx), owner); err != nil {
+		return recordError(span, err)
+	}
+
+	span.SetStatus(codes.Ok, "cart deleted")
+	return nil
+}
+
+func (r *CartRepository) DeleteExpiredCarts(ctx context.Context) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepository.DeleteExpiredCarts")
+	defer span.End()
+
+	result := r.db.WithContext(ctx).Exec("DELETE FROM carts WHERE expires_at <= ?", now())
+	if result.Error != nil {
+		return recordError(span, mapPostgresError(result.Error))
+	}
+
+	span.SetAttributes(attribute.Int64("carts.deleted", result.RowsAffected))
+	span.SetStatus(codes.Ok, "expired carts deleted")
+	return nil
+}
+
+// mutate runs change on the locked cart of owner in a transaction, after the
+// version check, and records the change when it succeeds.
+func (r *CartRepository) mutate(ctx context.Context, owner domain.CartOwner, expectedVersion *int64, change func(tx *gorm.DB) error) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		at := now()
+		cart, err := lockCart(tx, owner, at)
+		if err != nil {
+			return err
+		}
+		if err := checkVersion(cart, expectedVersion); err != nil {
+			return err
+		}
+		if err := change(tx); err != nil {
+			return err
+		}
+		return touchCart(tx, cart, r.ttl(owner), at)
+	})
+}
+
+func (r *CartRepository) ttl(owner domain.CartOwner) time.Duration {
+	if owner.IsGuest() {
+		return r.guestTTL
+	}
+	return r.cartTTL
+}
+
+// loadCart reads a live cart; a missing or expired cart is empty at version 0.
+func loadCart(db *gorm.DB, owner domain.CartOwner, at time.Time) (domain.Cart, error) {
+	cart := domain.Cart{
+		UserID:     owner.UserID,
+		GuestToken: owner.GuestToken,
+		Items:      []domain.CartItem{},
+	}
+
+	var row cartRow
+	result := db.Raw(
+		"SELECT * FROM carts WHERE user_id = ? AND guest_token = ? AND (expires_at IS NULL OR expires_at > ?)",
+		owner.UserID, owner.GuestToken, at,
+	).Scan(&row)
+	if result.Error != nil {
+		return domain.Cart{}, mapPostgresError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return cart, nil
+	}
+
+	items, err := cartItems(db, owner)
+	if err != nil {
+		return domain.Cart{}, err
+	}
+	for _, item := range items {
+		cart.Items = append(cart.Items, domain.CartItem{
+			ProductID:  item.ProductID,
+			VariantID:  item.VariantID,
+			Quantity:   item.Quantity,
+			AddedPrice: item.AddedPrice,
+		})
+		cart.TotalQuantity += item.Quantity
+	}
+	if row.UpdatedAt != nil {
+		cart.UpdatedAt = row.UpdatedAt.UTC()
+	}
+	cart.Version = row.Version
+	return cart, nil
+}
+
+// lockCart locks the cart row of owner for the rest of the transaction. A
+// missing row is created already expired, so it reads as missing until its
+// first change; an expired cart is emptied and starts over at version 0.
+func lockCart(tx *gorm.DB, owner domain.CartOwner, at time.Time) (*cartRow, error) {
+	err := tx.Exec(
+		"INSERT INTO carts (user_id, guest_token, expires_at) VALUES (?, ?, ?) ON CONFLICT (user_id, guest_token) DO NOTHING",
+		owner.UserID, owner.GuestToken, at,
+	).Error
+	if err != nil {
+		return nil, mapPostgresError(err)
+	}
+
+	var row cartRow
+	if err := tx.Raw(
+		"SELECT * FROM carts WHERE user_id = ? AND guest_token = ? FOR UPDATE",
+		owner.UserID, owner.GuestToken,
+	).Scan(&row).Error; err != nil {
+		return nil, mapPostgresError(err)
+	}
+
+	if row.ExpiresAt != nil && !at.Before(*row.ExpiresAt) {
+		if err := tx.Exec("DELETE FROM cart_items WHERE user_id = ? AND guest_token = ?", owner.UserID, owner.GuestToken).Error; err != nil {
+			return nil, mapPostgresError(err)
+		}
+		row.Version = 0
+		row.UpdatedAt = nil
+		row.Idle = false
+	}
+	return &row, nil
+}
+
+// touchCart records a change to a locked cart: it bumps its version, stamps
+// it and restarts its expiry.
+func touchCart(tx *gorm.DB, cart *cartRow, ttl time.Duration, at time.Time) error {
+	return mapPostgresError(tx.Exec(
+		"UPDATE carts SET version = ?, updated_at = ?, idle = true, expires_at = ? WHERE user_id = ? AND guest_token = ?",
+		cart.Version+1, at, expiry(ttl, at), cart.UserID, cart.GuestToken,
+	).Error)
+}
+
+func checkVersion(cart *cartRow, expectedVersion *int64) error {
+	if expectedVersion != nil && cart.Version != *expectedVersion {
+		return fmt.Errorf("%w: cart is at version %d, expected %d", domain.ErrVersionConflict, cart.Version, *expectedVersion)
+	}
+	return nil
+}
+
+func cartItems(db *gorm.DB, owner domain.CartOwner) ([]cartItemRow, error) {
+	var items []cartItemRow
+	err := db.Raw(
+		"SELECT product_id, variant_id, quantity, added_price FROM cart_items WHERE user_id = ? AND guest_token = ? ORDER BY product_id, variant_id",
+		owner.UserID, owner.GuestToken,
+	).Scan(&items).Error
+	if err != nil {
+		return nil, mapPostgresError(err)
+	}
+	return items, nil
+}
+
+func cartItemQuantity(tx *gorm.DB, owner domain.CartOwner, productID, variantID uint) (int, error) {
+	var quantity int
+	err := tx.Raw(
+		"SELECT COALESCE(MAX(quantity), 0) FROM cart_items WHERE user_id = ? AND guest_token = ? AND product_id = ? AND variant_id = ?",
+		owner.UserID, owner.GuestToken, productID, variantID,
+	).Scan(&quantity).Error
+	if err != nil {
+		return 0, mapPostgresError(err)
+	}
+	return quantity, nil
+}
+
+func upsertCartItem(tx *gorm.DB, owner domain.CartOwner, productID, variantID uint, quantity int, unitPrice float32) error {
+	return mapPostgresError(tx.Exec(`
+		INSERT INTO cart_items (user_id, guest_token, product_id, variant_id, quantity, added_price)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (user_id, guest_token, product_id, variant_id) DO UPDATE
+		SET quantity = EXCLUDED.quantity, added_price = EXCLUDED.added_price`,
+		owner.UserID, owner.GuestToken, productID, variantID, quantity, unitPrice,
+	).Error)
+}
+
+func deleteCartItem(tx *gorm.DB, owner domain.CartOwner, productID, variantID uint) error {
+	return mapPostgresError(tx.Exec(
+		"DELETE FROM cart_items WHERE user_id = ? AND guest_token = ? AND product_id = ? AND variant_id = ?",
+		owner.UserID, owner.GuestToken, productID, variantID,
+	).Error)
+}
+
+// deleteCart drops the cart row; its lines go with it.
+func deleteCart(db *gorm.DB, owner domain.CartOwner) error {
+	return mapPostgresError(db.Exec(
+		"DELETE FROM carts WHERE user_id = ? AND guest_token = ?",
+		owner.UserID, owner.GuestToken,
+	).Error)
+}
+
+// expiry is the expiry of a cart changed at at, nil when it never expires.
+func expiry(ttl time.Duration, at time.Time) *time.Time {
+	if ttl <= 0 {
+		return nil
+	}
+	expiresAt := at.Add(ttl)
+	return &expiresAt
+}
+
+// now is millisecond-precise, like the timestamps of the Redis repository,
+// so times read back compare equal to the ones written.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+func recordError(span trace.Span, err error) error {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	return err
+}
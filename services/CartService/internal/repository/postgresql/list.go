@@ -0,0 +1,411 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// listRow is a row of the item_lists table; share_token is NULL while the
+// list is not shared.
+type listRow struct {
+	UserID     uint
+	ID         string
+	Name       string
+	Kind       string
+	ShareToken *string
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+type listItemRow struct {
+	ListID    string
+	ProductID uint
+	VariantID uint
+	Quantity  int
+}
+
+type ListRepository struct {
+	db      *gorm.DB
+	cartTTL time.Duration
+	tracer  trace.Tracer
+}
+
+var _ domain.ListRepository = (*ListRepository)(nil)
+var _ domain.ListStore = (*ListRepository)(nil)
+
+// NewListRepository stores lists without expiry. cartTTL is restarted on the
+// user's cart when items move between it and a list.
+func NewListRepository(db *gorm.DB, cartTTL time.Duration) *ListRepository {
+	return &ListRepository{db: db, cartTTL: cartTTL, tracer: otel.Tracer("list-repo")}
+}
+
+func (r *ListRepository) CreateList(ctx context.Context, list domain.ItemList) error {
+	ctx, span := r.tracer.Start(ctx, "ListRepository.CreateList")
+	defer span.End()
+
+	createdAt := list.CreatedAt.UTC().Truncate(time.Millisecond)
+	err := r.db.WithContext(ctx).Exec(`
+		INSERT INTO item_lists (user_id, id, name, kind, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON CONFLICT (user_id, id) DO NOTHING`,
+		list.UserID, list.ID, list.Name, string(list.Kind), createdAt, createdAt,
+	).Error
+	if err != nil {
+		return recordError(span, mapPostgresError(err))
+	}
+
+	span.SetStatus(codes.Ok, "list created")
+	return nil
+}
+
+func (r *ListRepository) GetList(ctx context.Context, userID uint, listID string) (domain.ItemList, error) {
+	ctx, span := r.tracer.Start(ctx, "ListRepository.GetList")
+	defer span.End()
+
+	lists, err := loadLists(r.db.WithContext(ctx), "user_id = ? AND id = ?", userID, listID)
+	if err != nil {
+		return domain.ItemList{}, recordError(span, err)
+	}
+	if len(lists) == 0 {
+		return domain.ItemList{}, recordError(span, domain.ErrListNotFound)
+	}
+
+	span.SetStatus(codes.Ok, "list retrieved")
+	return lists[0], nil
+}
+
+func (r *ListRepository) ListLists(ctx context.Context, userID uint) ([]domain.ItemList, error) {
+	ctx, span := r.tracer.Start(ctx, "ListRepository.ListLists")
+	defer span.End()
+
+	lists, err := loadLists(r.db.WithContext(ctx), "user_id = ?", userID)
+	if err != nil {
+		return nil, recordError(span, err)
+	}
+
+	span.SetAttributes(attribute.Int("lists.count", len(lists)))
+	span.SetStatus(codes.Ok, "lists listed")
+	return lists, nil
+}
+
+func (r *ListRepository) DeleteList(ctx context.Context, userID uint, listID string) error {
+	ctx, span := r.tracer.Start(ctx, "ListRepository.DeleteList")
+	defer span.End()
+
+	result := r.db.WithContext(ctx).Exec("DELETE FROM item_lists WHERE user_id = ? AND id = ?", userID, listID)
+	if result.Error != nil {
+		return recordError(span, mapPostgresError(result.Error))
+	}
+	if result.RowsAffected == 0 {
+		return recordError(span, domain.ErrListNotFound)
+	}
+
+	span.SetStatus(codes.Ok, "list deleted")
+	return nil
+}
+
+func (r *ListRepository) AddItem(ctx context.Context, userID uint, listID string, productID, variantID uint, quantity int) error {
+	ctx, span := r.tracer.Start(ctx, "ListRepository.AddItem")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := touchList(tx, userID, listID, now()); err != nil {
+			return err
+		}
+		return addListItem(tx, userID, listID, productID, variantID, quantity)
+	})
+	if err != nil {
+		return recordError(span, err)
+	}
+
+	span.SetStatus(codes.Ok, "list item added")
+	return nil
+}
+
+func (r *ListRepository) RemoveItem(ctx context.Context, userID uint, listID string, productID, variantID uint) error {
+	ctx, span := r.tracer.Start(ctx, "ListRepository.RemoveItem")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := touchList(tx, userID, listID, now()); err != nil {
+			return err
+		}
+		return deleteListItem(tx, userID, listID, productID, variantID)
+	})
+	if err != nil {
+		return recordError(span, err)
+	}
+
+	span.SetStatus(codes.Ok, "list item removed")
+	return nil
+}
+
+func (r *ListRepository) MoveFromCart(ctx context.Context, userID uint, listID string, productID, variantID uint) error {
+	ctx, span := r.tracer.Start(ctx, "ListRepository.MoveFromCart")
+	defer span.End()
+
+	owner := domain.CartOwner{UserID: userID}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		at := now()
+		// The cart first, like every cart change, then the list.
+		cart, err := lockCart(tx, owner, at)
+		if err != nil {
+			return err
+		}
+		if err := touchList(tx, userID, listID, at); err != nil {
+			return err
+		}
+
+		quantity, err := cartItemQuantity(tx, owner, productID, variantID)
+		if err != nil {
+			return err
+		}
+		if quantity <= 0 {
+			return domain.ErrItemNotInCart
+		}
+
+		if err := addListItem(tx, userID, listID, productID, variantID, quantity); err != nil {
+			return err
+		}
+		if err := deleteCartItem(tx, owner, productID, variantID); err != nil {
+			return err
+		}
+		return touchCart(tx, cart, r.cartTTL, at)
+	})
+	if err != nil {
+		return recordError(span, err)
+	}
+
+	span.SetStatus(codes.Ok, "item moved to list")
+	return nil
+}
+
+func (r *ListRepository) MoveToCart(ctx context.Context, userID uint, listID string, productID, variantID uint, unitPrice float32, limit int) error {
+	ctx, span := r.tracer.Start(ctx, "ListRepository.MoveToCart")
+	defer span.End()
+
+	owner := domain.CartOwner{UserID: userID}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		at := now()
+		cart, err := lockCart(tx, owner, at)
+		if err != nil {
+			return err
+		}
+		if err := touchList(tx, userID, listID, at); err != nil {
+			return err
+		}
+
+		var quantity int
+		if err := tx.Raw(
+			"SELECT COALESCE(MAX(quantity), 0) FROM item_list_items WHERE user_id = ? AND list_id = ? AND product_id = ? AND variant_id = ?",
+			userID, listID, productID, variantID,
+		).Scan(&quantity).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		if quantity <= 0 {
+			return domain.ErrItemNotInList
+		}
+
+		current, err := cartItemQuantity(tx, owner, productID, variantID)
+		if err != nil {
+			return err
+		}
+		if current+quantity > limit {
+			return fmt.Errorf("%w: %d already in cart, at most %d allowed", domain.ErrQuantityExceedsLimit, current, limit)
+		}
+
+		if err := upsertCartItem(tx, owner, productID, variantID, current+quantity, unitPrice); err != nil {
+			return err
+		}
+		if err := deleteListItem(tx, userID, listID, productID, variantID); err != nil {
+			return err
+		}
+		return touchCart(tx, cart, r.cartTTL, at)
+	})
+	if err != nil {
+		return recordError(span, err)
+	}
+
+	span.SetStatus(codes.Ok, "item moved to cart")
+	return nil
+}
+
+func (r *ListRepository) SetShareToken(ctx context.Context, userID uint, listID, token string) error {
+	ctx, span := r.tracer.Start(ctx, "ListRepository.SetShareToken")
+	defer span.End()
+
+	var shareToken *string
+	if token != "" {
+		shareToken = &token
+	}
+	result := r.db.WithContext(ctx).Exec(
+		"UPDATE item_lists SET share_token = ? WHERE user_id = ? AND id = ?",
+		shareToken, userID, listID,
+	)
+	if result.Error != nil {
+		return recordError(span, mapPostgresError(result.Error))
+	}
+	if result.RowsAffected == 0 {
+		return recordError(span, domain.ErrListNotFound)
+	}
+
+	span.SetStatus(codes.Ok, "list share token set")
+	return nil
+}
+
+func (r *ListRepository) GetSharedList(ctx context.Context, shareToken string) (domain.ItemList, error) {
+	ctx, span := r.tracer.Start(ctx, "ListRepository.GetSharedList")
+	defer span.End()
+
+	lists, err := loadLists(r.db.WithContext(ctx), "share_token = ?", shareToken)
+	if err != nil {
+		return domain.ItemList{}, recordError(span, err)
+	}
+	if len(lists) == 0 {
+		return domain.ItemList{}, recordError(span, domain.ErrListNotFound)
+	}
+
+	span.SetStatus(codes.Ok, "shared list retrieved")
+	return lists[0], nil
+}
+
+// SaveList replaces the stored copy of the list with its items, unless the
+// stored copy changed later: snapshots of concurrent changes may arrive out
+// of order.
+func (r *ListRepository) SaveList(ctx context.Context, list domain.ItemList) error {
+	ctx, span := r.tracer.Start(ctx, "ListRepository.SaveList")
+	defer span.End()
+
+	var shareToken *string
+	if list.ShareToken != "" {
+		shareToken = &list.ShareToken
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(`
+			INSERT INTO item_lists (user_id, id, name, kind, share_token, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (user_id, id) DO UPDATE
+			SET name = EXCLUDED.name, kind = EXCLUDED.kind, share_token = EXCLUDED.share_token, updated_at = EXCLUDED.updated_at
+			WHERE item_lists.updated_at <= EXCLUDED.updated_at`,
+			list.UserID, list.ID, list.Name, string(list.Kind), shareToken,
+			list.CreatedAt.UTC().Truncate(time.Millisecond), list.UpdatedAt.UTC().Truncate(time.Millisecond),
+		)
+		if result.Error != nil {
+			return mapPostgresError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		if err := tx.Exec("DELETE FROM item_list_items WHERE user_id = ? AND list_id = ?", list.UserID, list.ID).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		for _, item := range list.Items {
+			if err := addListItem(tx, list.UserID, list.ID, item.ProductID, item.VariantID, item.Quantity); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return recordError(span, err)
+	}
+
+	span.SetAttributes(attribute.Int("list.items", len(list.Items)))
+	span.SetStatus(codes.Ok, "list saved")
+	return nil
+}
+
+// loadLists reads the lists matching the condition with their items, oldest
+// first.
+func loadLists(db *gorm.DB, condition string, args ...any) ([]domain.ItemList, error) {
+	var rows []listRow
+	if err := db.Raw(
+		"SELECT * FROM item_lists WHERE "+condition+" ORDER BY created_at, id", args...,
+	).Scan(&rows).Error; err != nil {
+		return nil, mapPostgresError(err)
+	}
+	if len(rows) == 0 {
+		return []domain.ItemList{}, nil
+	}
+
+	listIDs := make([]string, 0, len(rows))
+	for _, row := range rows {
+		listIDs = append(listIDs, row.ID)
+	}
+	var items []listItemRow
+	if err := db.Raw(
+		"SELECT list_id, product_id, variant_id, quantity FROM item_list_items WHERE user_id = ? AND list_id IN ? ORDER BY product_id, variant_id",
+		rows[0].UserID, listIDs,
+	).Scan(&items).Error; err != nil {
+		return nil, mapPostgresError(err)
+	}
+	itemsByList := make(map[string][]domain.ListItem, len(rows))
+	for _, item := range items {
+		itemsByList[item.ListID] = append(itemsByList[item.ListID], domain.ListItem{
+			ProductID: item.ProductID,
+			VariantID: item.VariantID,
+			Quantity:  item.Quantity,
+		})
+	}
+
+	lists := make([]domain.ItemList, 0, len(rows))
+	for _, row := range rows {
+		list := domain.ItemList{
+			ID:        row.ID,
+			UserID:    row.UserID,
+			Name:      row.Name,
+			Kind:      domain.ListKind(row.Kind),
+			Items:     itemsByList[row.ID],
+			CreatedAt: row.CreatedAt.UTC(),
+			UpdatedAt: row.UpdatedAt.UTC(),
+		}
+		if list.Items == nil {
+			list.Items = []domain.ListItem{}
+		}
+		if row.ShareToken != nil {
+			list.ShareToken = *row.ShareToken
+		}
+		lists = append(lists, list)
+	}
+	return lists, nil
+}
+
+// touchList stamps a list as changed, failing with ErrListNotFound when it
+// does not exist. The row stays locked for the rest of the transaction.
+func touchList(tx *gorm.DB, userID uint, listID string, at time.Time) error {
+	result := tx.Exec("UPDATE item_lists SET updated_at = ? WHERE user_id = ? AND id = ?", at, userID, listID)
+	if result.Error != nil {
+		return mapPostgresError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return domain.ErrListNotFound
+	}
+	return nil
+}
+
+func addListItem(tx *gorm.DB, userID uint, listID string, productID, variantID uint, quantity int) error {
+	return mapPostgresError(tx.Exec(`
+		INSERT INTO item_list_items (user_id, list_id, product_id, variant_id, quantity)
+		VALUES (?, ?, ?, ?, ?)
+		ON CONFLICT (user_id, list_id, product_id, variant_id) DO UPDATE
+		SET quantity = item_list_items.quantity + EXCLUDED.quantity`,
+		userID, listID, productID, variantID, quantity,
+	).Error)
+}
+
+func deleteListItem(tx *gorm.DB, userID uint, listID string, productID, variantID uint) error {
+	return mapPostgresError(tx.Exec(
+		"DELETE FROM item_list_items WHERE user_id = ? AND list_id = ? AND product_id = ? AND variant_id = ?",
+		userID, listID, productID, variantID,
+	).Error)
+}
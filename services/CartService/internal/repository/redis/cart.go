@@ -2,40 +2,244 @@ package redis
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	redisClient "github.com/kareemhamed001/e-commerce/pkg/redis"
 	"github.com/kareemhamed001/e-commerce/services/CartService/internal/domain"
+	"github.com/redis/go-redis/v9"
 )
 
-const cartKeyPrefix = "cart:"
+const (
+	cartKeyPrefix      = "cart:"
+	guestCartKeyPrefix = "cart:guest:"
+	// pricesKeySuffix names the hash that keeps, per cart line, the unit
+	// price the shopper saw when the line was last added or updated.
+	pricesKeySuffix = ":prices"
+	// activityKey is a sorted set of cart keys scored by the unix time in
+	// milliseconds of the cart's last change.
+	activityKey = "cart:activity"
+	// versionKeySuffix names the counter bumped on every change to a cart,
+	// used for optimistic concurrency.
+	versionKeySuffix = ":version"
+)
+
+// mergeCartScript folds the guest cart (KEYS[1], prices KEYS[3]) into the
+// user's cart (KEYS[2], prices KEYS[4]) and deletes the guest cart,
+// atomically so that concurrent writes to either cart are not lost. ARGV[1]
+// is the merge strategy, followed after ARGV[3] by a field and line limit
+// per guest line: each merged line is capped at its limit, and guest lines
+// without a positive limit are dropped. The guest price snapshot of a line is
+// kept only when the guest quantity wins or the user had no snapshot. The
+// guest cart's version (KEYS[6]) is deleted with it. When anything was
+// merged, the user's cart is touched in the activity set (KEYS[5]) at
+// ARGV[3], its version (KEYS[7]) bumped and its expiry restarted with ARGV[2]
+// seconds (0 for none). Returns the number of lines merged.
+var mergeCartScript = redis.NewScript(`
+local limits = {}
+for i = 4, #ARGV, 2 do
+	limits[ARGV[i]] = tonumber(ARGV[i + 1])
+end
+local merged = 0
+local items = redis.call('HGETALL', KEYS[1])
+for i = 1, #items, 2 do
+	local field = items[i]
+	local qty = tonumber(items[i + 1])
+	local limit = limits[field]
+	if qty and limit and limit > 0 then
+		local current = tonumber(redis.call('HGET', KEYS[2], field) or '0') or 0
+		local price = redis.call('HGET', KEYS[3], field)
+		local updated, guestWins = qty, true
+		if ARGV[1] == 'sum' then
+			updated, guestWins = current + qty, false
+		elseif ARGV[1] == 'max' and qty <= current then
+			updated, guestWins = current, false
+		end
+		redis.call('HSET', KEYS[2], field, math.min(updated, limit))
+		if price and guestWins then
+			redis.call('HSET', KEYS[4], field, price)
+		elseif price then
+			redis.call('HSETNX', KEYS[4], field, price)
+		end
+		merged = merged + 1
+	end
+end
+redis.call('DEL', KEYS[1], KEYS[3], KEYS[6])
+redis.call('ZREM', KEYS[5], KEYS[1])
+if merged > 0 then
+	redis.call('ZADD', KEYS[5], ARGV[3], KEYS[2])
+	redis.call('INCR', KEYS[7])
+	local ttl = tonumber(ARGV[2])
+	if ttl > 0 then
+		redis.call('EXPIRE', KEYS[2], ttl)
+		redis.call('EXPIRE', KEYS[4], ttl)
+		redis.call('EXPIRE', KEYS[7], ttl)
+	end
+end
+return merged
+`)
+
+// Cart mutation scripts take KEYS[1] the cart, KEYS[2] its prices, KEYS[3]
+// the activity set and KEYS[4] the cart version, and end their ARGV with the
+// TTL in seconds (0 for none), the unix time in milliseconds and the expected
+// version (negative to skip the check). They answer {-1, current version}
+// when the cart is not at the expected version.
+
+// versionCheckLua aborts a mutation script when the cart is not at the
+// expected version.
+const versionCheckLua = `
+local version = tonumber(redis.call('GET', KEYS[4]) or '0') or 0
+if expected >= 0 and version ~= expected then
+	return {-1, version}
+end
+`
+
+// touchLua records a change to the cart: it stamps the cart in the activity
+// set, bumps its version and restarts the expiry of the cart, its prices and
+// its version.
+const touchLua = `
+redis.call('ZADD', KEYS[3], now, KEYS[1])
+version = redis.call('INCR', KEYS[4])
+if ttl > 0 then
+	redis.call('EXPIRE', KEYS[1], ttl)
+	redis.call('EXPIRE', KEYS[2], ttl)
+	redis.call('EXPIRE', KEYS[4], ttl)
+end
+`
+
+// addItemScript increments a cart line unless the result would exceed the
+// limit, so concurrent adds cannot overshoot it. ARGV: field, quantity, unit
+// price, limit. Returns {1, new quantity} or {0, current quantity}.
+var addItemScript = redis.NewScript(`
+local ttl, now, expected = tonumber(ARGV[5]), ARGV[6], tonumber(ARGV[7])
+` + versionCheckLua + `
+local current = tonumber(redis.call('HGET', KEYS[1], ARGV[1]) or '0') or 0
+local updated = current + tonumber(ARGV[2])
+if updated > tonumber(ARGV[4]) then
+	return {0, current}
+end
+redis.call('HSET', KEYS[1], ARGV[1], updated)
+redis.call('HSET', KEYS[2], ARGV[1], ARGV[3])
+` + touchLua + `
+return {1, updated}
+`)
+
+// updateItemScript sets a cart line. ARGV: field, quantity, unit price.
+// Returns {1, new version}.
+var updateItemScript = redis.NewScript(`
+local ttl, now, expected = tonumber(ARGV[4]), ARGV[5], tonumber(ARGV[6])
+` + versionCheckLua + `
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+redis.call('HSET', KEYS[2], ARGV[1], ARGV[3])
+` + touchLua + `
+return {1, version}
+`)
+
+// removeItemScript removes a cart line. ARGV: field. Returns {1, new
+// version}.
+var removeItemScript = redis.NewScript(`
+local ttl, now, expected = tonumber(ARGV[2]), ARGV[3], tonumber(ARGV[4])
+` + versionCheckLua + `
+redis.call('HDEL', KEYS[1], ARGV[1])
+redis.call('HDEL', KEYS[2], ARGV[1])
+` + touchLua + `
+return {1, version}
+`)
+
+// clearCartScript deletes the cart and its prices and drops it from the
+// activity set. The version is bumped rather than reset, so a client holding
+// a version from before the clear still conflicts. Returns {1, new version}.
+var clearCartScript = redis.NewScript(`
+local ttl, now, expected = tonumber(ARGV[1]), ARGV[2], tonumber(ARGV[3])
+` + versionCheckLua + `
+redis.call('DEL', KEYS[1], KEYS[2])
+redis.call('ZREM', KEYS[3], KEYS[1])
+version = redis.call('INCR', KEYS[4])
+if ttl > 0 then
+	redis.call('EXPIRE', KEYS[4], ttl)
+end
+return {1, version}
+`)
+
+// restoreCartScript writes a cart (KEYS as for mutation scripts) unless its
+// cart or version key exists. ARGV: version, TTL in seconds (0 for none),
+// unix time in milliseconds of the last change (0 when unknown), then a
+// field, quantity and unit price per line. Returns 1 when restored.
+var restoreCartScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 or redis.call('EXISTS', KEYS[4]) == 1 then
+	return 0
+end
+for i = 4, #ARGV, 3 do
+	redis.call('HSET', KEYS[1], ARGV[i], ARGV[i + 1])
+	redis.call('HSET', KEYS[2], ARGV[i], ARGV[i + 2])
+end
+redis.call('SET', KEYS[4], ARGV[1])
+local ttl = tonumber(ARGV[2])
+if ttl > 0 then
+	redis.call('EXPIRE', KEYS[1], ttl)
+	redis.call('EXPIRE', KEYS[2], ttl)
+	redis.call('EXPIRE', KEYS[4], ttl)
+end
+if tonumber(ARGV[3]) > 0 then
+	redis.call('ZADD', KEYS[3], ARGV[3], KEYS[1])
+end
+return 1
+`)
+
+// releaseIdleScript removes a cart (ARGV[1]) from the activity set (KEYS[1])
+// only if its score is still ARGV[2], so a cart changed in the meantime stays
+// tracked. Returns 1 when removed.
+var releaseIdleScript = redis.NewScript(`
+local score = redis.call('ZSCORE', KEYS[1], ARGV[1])
+if score and tonumber(score) == tonumber(ARGV[2]) then
+	return redis.call('ZREM', KEYS[1], ARGV[1])
+end
+return 0
+`)
 
 type CartRepository struct {
-	client *redisClient.Client
+	client   *redisClient.Client
+	cartTTL  time.Duration
+	guestTTL time.Duration
 }
 
-var _ domain.CartRepository = (*CartRepository)(nil)
+var _ domain.CartCache = (*CartRepository)(nil)
 
-func NewCartRepository(client *redisClient.Client) *CartRepository {
-	return &CartRepository{client: client}
+// NewCartRepository stores user carts with cartTTL (0 for no expiry) and
+// guest carts with guestTTL. Both are sliding: every write restarts them.
+func NewCartRepository(client *redisClient.Client, cartTTL, guestTTL time.Duration) *CartRepository {
+	return &CartRepository{client: client, cartTTL: cartTTL, guestTTL: guestTTL}
 }
 
-func (r *CartRepository) GetCart(ctx context.Context, userID uint) (domain.Cart, error) {
+func (r *CartRepository) GetCart(ctx context.Context, owner domain.CartOwner) (domain.Cart, error) {
 	if !r.client.IsEnabled() {
 		return domain.Cart{}, fmt.Errorf("redis disabled")
 	}
 
-	key := cartKey(userID)
-	values, err := r.client.HGetAll(ctx, key).Result()
-	if err != nil {
+	key := cartKey(owner)
+	var quantities, prices *redis.MapStringStringCmd
+	var modified *redis.FloatCmd
+	var version *redis.StringCmd
+	if _, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		quantities = pipe.HGetAll(ctx, key)
+		prices = pipe.HGetAll(ctx, key+pricesKeySuffix)
+		modified = pipe.ZScore(ctx, activityKey, key)
+		version = pipe.Get(ctx, key+versionKeySuffix)
+		return nil
+	}); err != nil && !errors.Is(err, redis.Nil) {
 		return domain.Cart{}, err
 	}
 
+	values := quantities.Val()
+	snapshots := prices.Val()
 	items := make([]domain.CartItem, 0, len(values))
 	var totalQty int
-	for productIDStr, qtyStr := range values {
-		productID64, err := strconv.ParseUint(productIDStr, 10, 32)
+	for field, qtyStr := range values {
+		productID, variantID, err := parseItemField(field)
 		if err != nil {
 			continue
 		}
@@ -43,56 +247,265 @@ func (r *CartRepository) GetCart(ctx context.Context, userID uint) (domain.Cart,
 		if err != nil {
 			continue
 		}
-		items = append(items, domain.CartItem{
-			ProductID: uint(productID64),
+		item := domain.CartItem{
+			ProductID: productID,
+			VariantID: variantID,
 			Quantity:  qty,
-		})
+		}
+		// Lines written before price snapshots existed have none.
+		if price, err := strconv.ParseFloat(snapshots[field], 32); err == nil {
+			item.AddedPrice = float32(price)
+		}
+		items = append(items, item)
 		totalQty += qty
 	}
 
-	return domain.Cart{
-		UserID:        userID,
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].ProductID != items[j].ProductID {
+			return items[i].ProductID < items[j].ProductID
+		}
+		return items[i].VariantID < items[j].VariantID
+	})
+
+	cart := domain.Cart{
+		UserID:        owner.UserID,
+		GuestToken:    owner.GuestToken,
 		Items:         items,
 		TotalQuantity: totalQty,
-	}, nil
+	}
+	// Carts untouched since activity tracking was added have no score.
+	if score, err := modified.Result(); err == nil {
+		cart.UpdatedAt = time.UnixMilli(int64(score)).UTC()
+	}
+	// A cart never changed has no version key and is at version 0.
+	if v, err := version.Int64(); err == nil {
+		cart.Version = v
+	}
+	return cart, nil
 }
 
-func (r *CartRepository) AddItem(ctx context.Context, userID, productID uint, quantity int) error {
+func (r *CartRepository) AddItem(ctx context.Context, owner domain.CartOwner, productID, variantID uint, quantity int, unitPrice float32, limit int, expectedVersion *int64) error {
 	if !r.client.IsEnabled() {
 		return fmt.Errorf("redis disabled")
 	}
 
-	key := cartKey(userID)
-	return r.client.HIncrBy(ctx, key, fmt.Sprintf("%d", productID), int64(quantity)).Err()
+	result, err := r.mutate(ctx, addItemScript, owner, expectedVersion,
+		itemField(productID, variantID), quantity, formatPrice(unitPrice), limit,
+	)
+	if err != nil {
+		return err
+	}
+	if result[0] == 0 {
+		return fmt.Errorf("%w: %d already in cart, at most %d allowed", domain.ErrQuantityExceedsLimit, result[1], limit)
+	}
+	return nil
 }
 
-func (r *CartRepository) UpdateItem(ctx context.Context, userID, productID uint, quantity int) error {
+func (r *CartRepository) UpdateItem(ctx context.Context, owner domain.CartOwner, productID, variantID uint, quantity int, unitPrice float32, expectedVersion *int64) error {
 	if !r.client.IsEnabled() {
 		return fmt.Errorf("redis disabled")
 	}
 
-	key := cartKey(userID)
-	return r.client.HSet(ctx, key, fmt.Sprintf("%d", productID), quantity).Err()
+	_, err := r.mutate(ctx, updateItemScript, owner, expectedVersion,
+		itemField(productID, variantID), quantity, formatPrice(unitPrice),
+	)
+	return err
 }
 
-func (r *CartRepository) RemoveItem(ctx context.Context, userID, productID uint) error {
+func (r *CartRepository) RemoveItem(ctx context.Context, owner domain.CartOwner, productID, variantID uint, expectedVersion *int64) error {
 	if !r.client.IsEnabled() {
 		return fmt.Errorf("redis disabled")
 	}
 
-	key := cartKey(userID)
-	return r.client.HDel(ctx, key, fmt.Sprintf("%d", productID)).Err()
+	_, err := r.mutate(ctx, removeItemScript, owner, expectedVersion, itemField(productID, variantID))
+	return err
 }
 
-func (r *CartRepository) ClearCart(ctx context.Context, userID uint) error {
+func (r *CartRepository) ClearCart(ctx context.Context, owner domain.CartOwner, expectedVersion *int64) error {
 	if !r.client.IsEnabled() {
 		return fmt.Errorf("redis disabled")
 	}
 
-	key := cartKey(userID)
-	return r.client.Del(ctx, key).Err()
+	_, err := r.mutate(ctx, clearCartScript, owner, expectedVersion)
+	return err
+}
+
+func (r *CartRepository) MergeCart(ctx context.Context, guestToken string, userID uint, strategy domain.MergeStrategy, limits map[domain.LineKey]int) error {
+	if !r.client.IsEnabled() {
+		return fmt.Errorf("redis disabled")
+	}
+
+	guestKey := cartKey(domain.CartOwner{GuestToken: guestToken})
+	userKey := cartKey(domain.CartOwner{UserID: userID})
+	keys := []string{
+		guestKey, userKey, guestKey + pricesKeySuffix, userKey + pricesKeySuffix, activityKey,
+		guestKey + versionKeySuffix, userKey + versionKeySuffix,
+	}
+	userTTL := int64(r.ttl(domain.CartOwner{UserID: userID}) / time.Second)
+	args := []any{string(strategy), userTTL, time.Now().UnixMilli()}
+	for key, limit := range limits {
+		args = append(args, itemField(key.ProductID, key.VariantID), limit)
+	}
+	return mergeCartScript.Run(ctx, r.client, keys, args...).Err()
+}
+
+func (r *CartRepository) ListIdleCarts(ctx context.Context, idleSince time.Time, limit int) ([]domain.IdleCart, error) {
+	if !r.client.IsEnabled() {
+		return nil, fmt.Errorf("redis disabled")
+	}
+
+	entries, err := r.client.ZRangeByScoreWithScores(ctx, activityKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(idleSince.UnixMilli(), 10),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	carts := make([]domain.IdleCart, 0, len(entries))
+	for _, entry := range entries {
+		key, ok := entry.Member.(string)
+		if !ok {
+			continue
+		}
+		owner, err := parseCartKey(key)
+		if err != nil {
+			// Not a cart key; drop it so it is not listed again.
+			r.client.ZRem(ctx, activityKey, key)
+			continue
+		}
+		carts = append(carts, domain.IdleCart{
+			Owner:     owner,
+			UpdatedAt: time.UnixMilli(int64(entry.Score)).UTC(),
+		})
+	}
+	return carts, nil
+}
+
+func (r *CartRepository) ReleaseIdleCart(ctx context.Context, cart domain.IdleCart) (bool, error) {
+	if !r.client.IsEnabled() {
+		return false, fmt.Errorf("redis disabled")
+	}
+
+	removed, err := releaseIdleScript.Run(ctx, r.client, []string{activityKey},
+		cartKey(cart.Owner), cart.UpdatedAt.UnixMilli(),
+	).Int()
+	if err != nil {
+		return false, err
+	}
+	return removed == 1, nil
+}
+
+func (r *CartRepository) TrackIdleCart(ctx context.Context, cart domain.IdleCart) error {
+	if !r.client.IsEnabled() {
+		return fmt.Errorf("redis disabled")
+	}
+
+	// NX keeps the newer score of a cart changed in the meantime.
+	return r.client.ZAddNX(ctx, activityKey, redis.Z{
+		Score:  float64(cart.UpdatedAt.UnixMilli()),
+		Member: cartKey(cart.Owner),
+	}).Err()
+}
+
+func (r *CartRepository) RestoreCart(ctx context.Context, cart domain.Cart) error {
+	if !r.client.IsEnabled() {
+		return fmt.Errorf("redis disabled")
+	}
+
+	owner := domain.CartOwner{UserID: cart.UserID, GuestToken: cart.GuestToken}
+	var updatedAt int64
+	if !cart.UpdatedAt.IsZero() {
+		updatedAt = cart.UpdatedAt.UnixMilli()
+	}
+	args := []any{cart.Version, int64(r.ttl(owner) / time.Second), updatedAt}
+	for _, item := range cart.Items {
+		args = append(args, itemField(item.ProductID, item.VariantID), item.Quantity, formatPrice(item.AddedPrice))
+	}
+	return restoreCartScript.Run(ctx, r.client, cartKeys(owner), args...).Err()
+}
+
+// mutate runs a cart mutation script with the cart's keys, args and the
+// trailing TTL, time and expected version arguments, and fails with
+// ErrVersionConflict when the script reports a version mismatch.
+func (r *CartRepository) mutate(ctx context.Context, script *redis.Script, owner domain.CartOwner, expectedVersion *int64, args ...any) ([]int64, error) {
+	expected := int64(-1)
+	if expectedVersion != nil {
+		expected = *expectedVersion
+	}
+	args = append(args, int64(r.ttl(owner)/time.Second), time.Now().UnixMilli(), expected)
+
+	result, err := script.Run(ctx, r.client, cartKeys(owner), args...).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+	if len(result) != 2 {
+		return nil, fmt.Errorf("unexpected cart script result: %v", result)
+	}
+	if result[0] == -1 {
+		return nil, fmt.Errorf("%w: cart is at version %d, expected %d", domain.ErrVersionConflict, result[1], expected)
+	}
+	return result, nil
+}
+
+func (r *CartRepository) ttl(owner domain.CartOwner) time.Duration {
+	if owner.IsGuest() {
+		return r.guestTTL
+	}
+	return r.cartTTL
+}
+
+func cartKey(owner domain.CartOwner) string {
+	if owner.IsGuest() {
+		return guestCartKeyPrefix + owner.GuestToken
+	}
+	return fmt.Sprintf("%s%d", cartKeyPrefix, owner.UserID)
+}
+
+// cartKeys are the keys of a cart in the order mutation scripts take them.
+func cartKeys(owner domain.CartOwner) []string {
+	key := cartKey(owner)
+	return []string{key, key + pricesKeySuffix, activityKey, key + versionKeySuffix}
+}
+
+func parseCartKey(key string) (domain.CartOwner, error) {
+	if token, ok := strings.CutPrefix(key, guestCartKeyPrefix); ok && token != "" {
+		return domain.CartOwner{GuestToken: token}, nil
+	}
+	userID, err := strconv.ParseUint(strings.TrimPrefix(key, cartKeyPrefix), 10, 32)
+	if err != nil || userID == 0 {
+		return domain.CartOwner{}, fmt.Errorf("invalid cart key %q", key)
+	}
+	return domain.CartOwner{UserID: uint(userID)}, nil
+}
+
+// itemField is the hash field of a cart line: "<productID>" for plain products
+// and "<productID>:<variantID>" for variants, so carts written before variants
+// existed are still readable.
+func itemField(productID, variantID uint) string {
+	if variantID == 0 {
+		return fmt.Sprintf("%d", productID)
+	}
+	return fmt.Sprintf("%d:%d", productID, variantID)
+}
+
+func parseItemField(field string) (uint, uint, error) {
+	productStr, variantStr, hasVariant := strings.Cut(field, ":")
+	productID, err := strconv.ParseUint(productStr, 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	if !hasVariant {
+		return uint(productID), 0, nil
+	}
+	variantID, err := strconv.ParseUint(variantStr, 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	return uint(productID), uint(variantID), nil
 }
 
-func cartKey(userID uint) string {
-	return fmt.Sprintf("%s%d", cartKeyPrefix, userID)
+func formatPrice(price float32) string {
+	return strconv.FormatFloat(float64(price), 'f', -1, 32)
 }
@@ -0,0 +1,482 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	redisClient "github.com/kareemhamed001/e-commerce/pkg/redis"
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// userListsKeyPrefix names the sorted set of a user's list IDs, scored by
+	// creation time.
+	userListsKeyPrefix = "lists:"
+	// listKeyPrefix names a list's metadata hash, "list:<user_id>:<list_id>";
+	// its items live in the companion hash with listItemsKeySuffix.
+	listKeyPrefix      = "list:"
+	listItemsKeySuffix = ":items"
+	// sharedListKeyPrefix maps a share token to "<user_id>:<list_id>".
+	sharedListKeyPrefix = "list:shared:"
+)
+
+// addListItemScript increments an item of a list (meta KEYS[1], items
+// KEYS[2]) if the list exists. ARGV: field, quantity, unix time in
+// milliseconds. Returns 0 when the list does not exist.
+var addListItemScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HINCRBY', KEYS[2], ARGV[1], ARGV[2])
+redis.call('HSET', KEYS[1], 'updated_at', ARGV[3])
+return 1
+`)
+
+// removeListItemScript removes an item of a list (meta KEYS[1], items
+// KEYS[2]) if the list exists. ARGV: field, unix time in milliseconds.
+// Returns 0 when the list does not exist.
+var removeListItemScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HDEL', KEYS[2], ARGV[1])
+redis.call('HSET', KEYS[1], 'updated_at', ARGV[2])
+return 1
+`)
+
+// moveFromCartScript moves a whole cart line (KEYS[1], prices KEYS[2]) into a
+// list (meta KEYS[4], items KEYS[5]), touches the cart in the activity set
+// (KEYS[3]) and bumps its version (KEYS[6]). ARGV: field, unix time in
+// milliseconds, cart TTL in seconds (0 for none). Returns -1 when the list
+// does not exist, 0 when the cart has no such line, else the quantity moved.
+var moveFromCartScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[4]) == 0 then
+	return -1
+end
+local qty = tonumber(redis.call('HGET', KEYS[1], ARGV[1]) or '0') or 0
+if qty <= 0 then
+	return 0
+end
+redis.call('HINCRBY', KEYS[5], ARGV[1], qty)
+redis.call('HSET', KEYS[4], 'updated_at', ARGV[2])
+redis.call('HDEL', KEYS[1], ARGV[1])
+redis.call('HDEL', KEYS[2], ARGV[1])
+redis.call('ZADD', KEYS[3], ARGV[2], KEYS[1])
+redis.call('INCR', KEYS[6])
+local ttl = tonumber(ARGV[3])
+if ttl > 0 then
+	redis.call('EXPIRE', KEYS[1], ttl)
+	redis.call('EXPIRE', KEYS[2], ttl)
+	redis.call('EXPIRE', KEYS[6], ttl)
+end
+return qty
+`)
+
+// moveToCartScript moves a whole list item (meta KEYS[4], items KEYS[5]) into
+// the cart (KEYS[1], prices KEYS[2]) unless the cart line would exceed the
+// limit, touches the cart in the activity set (KEYS[3]) and bumps its version
+// (KEYS[6]). ARGV: field, unit price, limit, cart TTL in seconds (0 for
+// none), unix time in milliseconds. Returns {-1, 0} when the list does not
+// exist, {-2, 0} when the list has no such item, {0, current cart quantity}
+// when the limit would be exceeded, else {1, new cart quantity}.
+var moveToCartScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[4]) == 0 then
+	return {-1, 0}
+end
+local qty = tonumber(redis.call('HGET', KEYS[5], ARGV[1]) or '0') or 0
+if qty <= 0 then
+	return {-2, 0}
+end
+local current = tonumber(redis.call('HGET', KEYS[1], ARGV[1]) or '0') or 0
+local updated = current + qty
+if updated > tonumber(ARGV[3]) then
+	return {0, current}
+end
+redis.call('HSET', KEYS[1], ARGV[1], updated)
+redis.call('HSET', KEYS[2], ARGV[1], ARGV[2])
+redis.call('ZADD', KEYS[3], ARGV[5], KEYS[1])
+redis.call('INCR', KEYS[6])
+local ttl = tonumber(ARGV[4])
+if ttl > 0 then
+	redis.call('EXPIRE', KEYS[1], ttl)
+	redis.call('EXPIRE', KEYS[2], ttl)
+	redis.call('EXPIRE', KEYS[6], ttl)
+end
+redis.call('HDEL', KEYS[5], ARGV[1])
+redis.call('HSET', KEYS[4], 'updated_at', ARGV[5])
+return {1, updated}
+`)
+
+// restoreListScript writes a list (meta KEYS[1], items KEYS[2]) and adds it
+// to the user's lists (KEYS[3]) unless its metadata exists; a shared list
+// also gets its share token key (KEYS[4]). ARGV: list ID, name, kind, share
+// token, creation and last change time in unix milliseconds, share target,
+// then a field and quantity per item. Returns 1 when restored.
+var restoreListScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'name', ARGV[2], 'kind', ARGV[3], 'created_at', ARGV[5], 'updated_at', ARGV[6])
+if #KEYS > 3 then
+	redis.call('HSET', KEYS[1], 'share_token', ARGV[4])
+	redis.call('SET', KEYS[4], ARGV[7])
+end
+for i = 8, #ARGV, 2 do
+	redis.call('HSET', KEYS[2], ARGV[i], ARGV[i + 1])
+end
+redis.call('ZADD', KEYS[3], ARGV[5], ARGV[1])
+return 1
+`)
+
+type ListRepository struct {
+	client  *redisClient.Client
+	cartTTL time.Duration
+}
+
+var _ domain.ListCache = (*ListRepository)(nil)
+
+// NewListRepository stores lists without expiry. cartTTL is restarted on the
+// user's cart when items move between it and a list.
+func NewListRepository(client *redisClient.Client, cartTTL time.Duration) *ListRepository {
+	return &ListRepository{client: client, cartTTL: cartTTL}
+}
+
+func (r *ListRepository) CreateList(ctx context.Context, list domain.ItemList) error {
+	if !r.client.IsEnabled() {
+		return fmt.Errorf("redis disabled")
+	}
+
+	key := listKey(list.UserID, list.ID)
+	createdAt := strconv.FormatInt(list.CreatedAt.UnixMilli(), 10)
+	_, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.HSetNX(ctx, key, "name", list.Name)
+		pipe.HSetNX(ctx, key, "kind", string(list.Kind))
+		pipe.HSetNX(ctx, key, "created_at", createdAt)
+		pipe.HSetNX(ctx, key, "updated_at", createdAt)
+		pipe.ZAddNX(ctx, userListsKey(list.UserID), redis.Z{
+			Score:  float64(list.CreatedAt.UnixMilli()),
+			Member: list.ID,
+		})
+		return nil
+	})
+	return err
+}
+
+func (r *ListRepository) GetList(ctx context.Context, userID uint, listID string) (domain.ItemList, error) {
+	if !r.client.IsEnabled() {
+		return domain.ItemList{}, fmt.Errorf("redis disabled")
+	}
+
+	lists, err := r.loadLists(ctx, userID, []string{listID})
+	if err != nil {
+		return domain.ItemList{}, err
+	}
+	if len(lists) == 0 {
+		return domain.ItemList{}, domain.ErrListNotFound
+	}
+	return lists[0], nil
+}
+
+func (r *ListRepository) ListLists(ctx context.Context, userID uint) ([]domain.ItemList, error) {
+	if !r.client.IsEnabled() {
+		return nil, fmt.Errorf("redis disabled")
+	}
+
+	listIDs, err := r.client.ZRange(ctx, userListsKey(userID), 0, -1).Result()
+	if err != nil {
+		return nil, err
+	}
+	return r.loadLists(ctx, userID, listIDs)
+}
+
+func (r *ListRepository) DeleteList(ctx context.Context, userID uint, listID string) error {
+	if !r.client.IsEnabled() {
+		return fmt.Errorf("redis disabled")
+	}
+
+	key := listKey(userID, listID)
+	shareToken, err := r.client.HGet(ctx, key, "share_token").Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return err
+	}
+
+	var deleted *redis.IntCmd
+	if _, err := r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		deleted = pipe.Del(ctx, key)
+		pipe.Del(ctx, key+listItemsKeySuffix)
+		pipe.ZRem(ctx, userListsKey(userID), listID)
+		if shareToken != "" {
+			pipe.Del(ctx, sharedListKeyPrefix+shareToken)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if deleted.Val() == 0 {
+		return domain.ErrListNotFound
+	}
+	return nil
+}
+
+func (r *ListRepository) AddItem(ctx context.Context, userID uint, listID string, productID, variantID uint, quantity int) error {
+	if !r.client.IsEnabled() {
+		return fmt.Errorf("redis disabled")
+	}
+
+	key := listKey(userID, listID)
+	ok, err := addListItemScript.Run(ctx, r.client, []string{key, key + listItemsKeySuffix},
+		itemField(productID, variantID), quantity, time.Now().UnixMilli(),
+	).Int()
+	if err != nil {
+		return err
+	}
+	if ok == 0 {
+		return domain.ErrListNotFound
+	}
+	return nil
+}
+
+func (r *ListRepository) RemoveItem(ctx context.Context, userID uint, listID string, productID, variantID uint) error {
+	if !r.client.IsEnabled() {
+		return fmt.Errorf("redis disabled")
+	}
+
+	key := listKey(userID, listID)
+	ok, err := removeListItemScript.Run(ctx, r.client, []string{key, key + listItemsKeySuffix},
+		itemField(productID, variantID), time.Now().UnixMilli(),
+	).Int()
+	if err != nil {
+		return err
+	}
+	if ok == 0 {
+		return domain.ErrListNotFound
+	}
+	return nil
+}
+
+func (r *ListRepository) MoveFromCart(ctx context.Context, userID uint, listID string, productID, variantID uint) error {
+	if !r.client.IsEnabled() {
+		return fmt.Errorf("redis disabled")
+	}
+
+	cart := cartKey(domain.CartOwner{UserID: userID})
+	list := listKey(userID, listID)
+	moved, err := moveFromCartScript.Run(ctx, r.client,
+		[]string{cart, cart + pricesKeySuffix, activityKey, list, list + listItemsKeySuffix, cart + versionKeySuffix},
+		itemField(productID, variantID), time.Now().UnixMilli(), int64(r.cartTTL/time.Second),
+	).Int()
+	if err != nil {
+		return err
+	}
+	switch {
+	case moved < 0:
+		return domain.ErrListNotFound
+	case moved == 0:
+		return domain.ErrItemNotInCart
+	}
+	return nil
+}
+
+func (r *ListRepository) MoveToCart(ctx context.Context, userID uint, listID string, productID, variantID uint, unitPrice float32, limit int) error {
+	if !r.client.IsEnabled() {
+		return fmt.Errorf("redis disabled")
+	}
+
+	cart := cartKey(domain.CartOwner{UserID: userID})
+	list := listKey(userID, listID)
+	result, err := moveToCartScript.Run(ctx, r.client,
+		[]string{cart, cart + pricesKeySuffix, activityKey, list, list + listItemsKeySuffix, cart + versionKeySuffix},
+		itemField(productID, variantID), formatPrice(unitPrice), limit,
+		int64(r.cartTTL/time.Second), time.Now().UnixMilli(),
+	).Int64Slice()
+	if err != nil {
+		return err
+	}
+	if len(result) != 2 {
+		return fmt.Errorf("unexpected move to cart result: %v", result)
+	}
+	switch result[0] {
+	case -1:
+		return domain.ErrListNotFound
+	case -2:
+		return domain.ErrItemNotInList
+	case 0:
+		return fmt.Errorf("%w: %d already in cart, at most %d allowed", domain.ErrQuantityExceedsLimit, result[1], limit)
+	}
+	return nil
+}
+
+func (r *ListRepository) SetShareToken(ctx context.Context, userID uint, listID, token string) error {
+	if !r.client.IsEnabled() {
+		return fmt.Errorf("redis disabled")
+	}
+
+	key := listKey(userID, listID)
+	current, err := r.client.HMGet(ctx, key, "kind", "share_token").Result()
+	if err != nil {
+		return err
+	}
+	if current[0] == nil {
+		return domain.ErrListNotFound
+	}
+	previous, _ := current[1].(string)
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		if previous != "" {
+			pipe.Del(ctx, sharedListKeyPrefix+previous)
+		}
+		if token == "" {
+			pipe.HDel(ctx, key, "share_token")
+			return nil
+		}
+		pipe.Set(ctx, sharedListKeyPrefix+token, fmt.Sprintf("%d:%s", userID, listID), 0)
+		pipe.HSet(ctx, key, "share_token", token)
+		return nil
+	})
+	return err
+}
+
+func (r *ListRepository) GetSharedList(ctx context.Context, shareToken string) (domain.ItemList, error) {
+	if !r.client.IsEnabled() {
+		return domain.ItemList{}, fmt.Errorf("redis disabled")
+	}
+
+	target, err := r.client.Get(ctx, sharedListKeyPrefix+shareToken).Result()
+	if errors.Is(err, redis.Nil) {
+		return domain.ItemList{}, domain.ErrListNotFound
+	}
+	if err != nil {
+		return domain.ItemList{}, err
+	}
+
+	userStr, listID, ok := strings.Cut(target, ":")
+	userID, err := strconv.ParseUint(userStr, 10, 32)
+	if !ok || err != nil {
+		return domain.ItemList{}, domain.ErrListNotFound
+	}
+
+	list, err := r.GetList(ctx, uint(userID), listID)
+	if err != nil {
+		return domain.ItemList{}, err
+	}
+	// The token may have been replaced since it was looked up.
+	if list.ShareToken != shareToken {
+		return domain.ItemList{}, domain.ErrListNotFound
+	}
+	return list, nil
+}
+
+func (r *ListRepository) RestoreLists(ctx context.Context, lists []domain.ItemList) error {
+	if !r.client.IsEnabled() {
+		return fmt.Errorf("redis disabled")
+	}
+
+	for _, list := range lists {
+		key := listKey(list.UserID, list.ID)
+		keys := []string{key, key + listItemsKeySuffix, userListsKey(list.UserID)}
+		if list.ShareToken != "" {
+			keys = append(keys, sharedListKeyPrefix+list.ShareToken)
+		}
+		args := []any{
+			list.ID, list.Name, string(list.Kind), list.ShareToken,
+			list.CreatedAt.UnixMilli(), list.UpdatedAt.UnixMilli(),
+			fmt.Sprintf("%d:%s", list.UserID, list.ID),
+		}
+		for _, item := range list.Items {
+			args = append(args, itemField(item.ProductID, item.VariantID), item.Quantity)
+		}
+		if err := restoreListScript.Run(ctx, r.client, keys, args...).Err(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loadLists reads the metadata and items of the given lists in one pipeline,
+// skipping lists that no longer exist.
+func (r *ListRepository) loadLists(ctx context.Context, userID uint, listIDs []string) ([]domain.ItemList, error) {
+	if len(listIDs) == 0 {
+		return []domain.ItemList{}, nil
+	}
+
+	metas := make([]*redis.MapStringStringCmd, len(listIDs))
+	items := make([]*redis.MapStringStringCmd, len(listIDs))
+	if _, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, listID := range listIDs {
+			key := listKey(userID, listID)
+			metas[i] = pipe.HGetAll(ctx, key)
+			items[i] = pipe.HGetAll(ctx, key+listItemsKeySuffix)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	lists := make([]domain.ItemList, 0, len(listIDs))
+	for i, listID := range listIDs {
+		meta := metas[i].Val()
+		if len(meta) == 0 {
+			continue
+		}
+		lists = append(lists, domain.ItemList{
+			ID:         listID,
+			UserID:     userID,
+			Name:       meta["name"],
+			Kind:       domain.ListKind(meta["kind"]),
+			ShareToken: meta["share_token"],
+			Items:      parseListItems(items[i].Val()),
+			CreatedAt:  parseMillis(meta["created_at"]),
+			UpdatedAt:  parseMillis(meta["updated_at"]),
+		})
+	}
+	return lists, nil
+}
+
+func parseListItems(values map[string]string) []domain.ListItem {
+	items := make([]domain.ListItem, 0, len(values))
+	for field, qtyStr := range values {
+		productID, variantID, err := parseItemField(field)
+		if err != nil {
+			continue
+		}
+		qty, err := strconv.Atoi(qtyStr)
+		if err != nil {
+			continue
+		}
+		items = append(items, domain.ListItem{
+			ProductID: productID,
+			VariantID: variantID,
+			Quantity:  qty,
+		})
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].ProductID != items[j].ProductID {
+			return items[i].ProductID < items[j].ProductID
+		}
+		return items[i].VariantID < items[j].VariantID
+	})
+	return items
+}
+
+func parseMillis(value string) time.Time {
+	millis, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(millis).UTC()
+}
+
+func userListsKey(userID uint) string {
+	return fmt.Sprintf("%s%d", userListsKeyPrefix, userID)
+}
+
+func listKey(userID uint, listID string) string {
+	return fmt.Sprintf("%s%d:%s", listKeyPrefix, userID, listID)
+}
@@ -0,0 +1,142 @@
+package writethrough
+
+import (
+	"context"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/domain"
+)
+
+// CartRepository serves carts from a cache and writes every change through
+// to a durable store. A cart missing from the cache is restored from the
+// store before it is read or changed, so carts survive the cache losing its
+// data.
+//
+// The cache stays authoritative: a change that reached the cache succeeds
+// even when the store cannot be written, and the store catches up on the
+// next change to the cart.
+type CartRepository struct {
+	cache domain.CartCache
+	store domain.CartStore
+}
+
+var _ domain.CartRepository = (*CartRepository)(nil)
+
+func NewCartRepository(cache domain.CartCache, store domain.CartStore) *CartRepository {
+	return &CartRepository{cache: cache, store: store}
+}
+
+func (r *CartRepository) GetCart(ctx context.Context, owner domain.CartOwner) (domain.Cart, error) {
+	return r.restore(ctx, owner)
+}
+
+func (r *CartRepository) AddItem(ctx context.Context, owner domain.CartOwner, productID, variantID uint, quantity int, unitPrice float32, limit int, expectedVersion *int64) error {
+	return r.write(ctx, owner, func() error {
+		return r.cache.AddItem(ctx, owner, productID, variantID, quantity, unitPrice, limit, expectedVersion)
+	})
+}
+
+func (r *CartRepository) UpdateItem(ctx context.Context, owner domain.CartOwner, productID, variantID uint, quantity int, unitPrice float32, expectedVersion *int64) error {
+	return r.write(ctx, owner, func() error {
+		return r.cache.UpdateItem(ctx, owner, productID, variantID, quantity, unitPrice, expectedVersion)
+	})
+}
+
+func (r *CartRepository) RemoveItem(ctx context.Context, owner domain.CartOwner, productID, variantID uint, expectedVersion *int64) error {
+	return r.write(ctx, owner, func() error {
+		return r.cache.RemoveItem(ctx, owner, productID, variantID, expectedVersion)
+	})
+}
+
+func (r *CartRepository) ClearCart(ctx context.Context, owner domain.CartOwner, expectedVersion *int64) error {
+	return r.write(ctx, owner, func() error {
+		return r.cache.ClearCart(ctx, owner, expectedVersion)
+	})
+}
+
+func (r *CartRepository) MergeCart(ctx context.Context, guestToken string, userID uint, strategy domain.MergeStrategy, limits map[domain.LineKey]int) error {
+	guest := domain.CartOwner{GuestToken: guestToken}
+	if _, err := r.restore(ctx, guest); err != nil {
+		return err
+	}
+
+	err := r.write(ctx, domain.CartOwner{UserID: userID}, func() error {
+		return r.cache.MergeCart(ctx, guestToken, userID, strategy, limits)
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := r.store.DeleteCart(ctx, guest); err != nil {
+		logger.Warnf("Failed to delete stored guest cart after merge into user %d: %v", userID, err)
+	}
+	return nil
+}
+
+// ListIdleCarts and the other idle-cart methods go to the cache, which alone
+// tracks idle carts. The scan doubles as the cleanup of expired stored
+// copies.
+func (r *CartRepository) ListIdleCarts(ctx context.Context, idleSince time.Time, limit int) ([]domain.IdleCart, error) {
+	if err := r.store.DeleteExpiredCarts(ctx); err != nil {
+		logger.Warnf("Failed to delete expired stored carts: %v", err)
+	}
+	return r.cache.ListIdleCarts(ctx, idleSince, limit)
+}
+
+func (r *CartRepository) ReleaseIdleCart(ctx context.Context, cart domain.IdleCart) (bool, error) {
+	return r.cache.ReleaseIdleCart(ctx, cart)
+}
+
+func (r *CartRepository) TrackIdleCart(ctx context.Context, cart domain.IdleCart) error {
+	return r.cache.TrackIdleCart(ctx, cart)
+}
+
+// write applies change to the cache once the cart is in it, then saves the
+// changed cart to the store.
+func (r *CartRepository) write(ctx context.Context, owner domain.CartOwner, change func() error) error {
+	if _, err := r.restore(ctx, owner); err != nil {
+		return err
+	}
+	if err := change(); err != nil {
+		return err
+	}
+	r.persist(ctx, owner)
+	return nil
+}
+
+// restore reads the cart from the cache, first refilling the cache from the
+// store when it has never seen the cart. The cart is served from the cache
+// alone when the store cannot be read.
+func (r *CartRepository) restore(ctx context.Context, owner domain.CartOwner) (domain.Cart, error) {
+	cart, err := r.cache.GetCart(ctx, owner)
+	if err != nil || cart.Version != 0 {
+		return cart, err
+	}
+
+	stored, err := r.store.GetCart(ctx, owner)
+	if err != nil {
+		logger.Warnf("Failed to read stored cart %+v: %v", owner, err)
+		return cart, nil
+	}
+	if stored.Version == 0 {
+		return cart, nil
+	}
+
+	if err := r.cache.RestoreCart(ctx, stored); err != nil {
+		return domain.Cart{}, err
+	}
+	return r.cache.GetCart(ctx, owner)
+}
+
+// persist saves the cart as the cache now holds it.
+func (r *CartRepository) persist(ctx context.Context, owner domain.CartOwner) {
+	cart, err := r.cache.GetCart(ctx, owner)
+	if err != nil {
+		logger.Warnf("Failed to read cart %+v to store it: %v", owner, err)
+		return
+	}
+	if err := r.store.SaveCart(ctx, cart); err != nil {
+		logger.Warnf("Failed to store cart %+v: %v", owner, err)
+	}
+}
@@ -0,0 +1,161 @@
+package writethrough
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/domain"
+)
+
+// ListRepository serves lists from a cache and writes every change through
+// to a durable store, like CartRepository does for carts. When the cache
+// holds no list of a user, the user's lists are restored from the store
+// before they are read or changed. Moves between a cart and a list write
+// both through.
+type ListRepository struct {
+	cache domain.ListCache
+	store domain.ListStore
+	carts *CartRepository
+}
+
+var _ domain.ListRepository = (*ListRepository)(nil)
+
+func NewListRepository(cache domain.ListCache, store domain.ListStore, carts *CartRepository) *ListRepository {
+	return &ListRepository{cache: cache, store: store, carts: carts}
+}
+
+func (r *ListRepository) CreateList(ctx context.Context, list domain.ItemList) error {
+	return r.write(ctx, list.UserID, list.ID, func() error {
+		return r.cache.CreateList(ctx, list)
+	})
+}
+
+func (r *ListRepository) GetList(ctx context.Context, userID uint, listID string) (domain.ItemList, error) {
+	if _, err := r.restore(ctx, userID); err != nil {
+		return domain.ItemList{}, err
+	}
+	return r.cache.GetList(ctx, userID, listID)
+}
+
+func (r *ListRepository) ListLists(ctx context.Context, userID uint) ([]domain.ItemList, error) {
+	return r.restore(ctx, userID)
+}
+
+func (r *ListRepository) DeleteList(ctx context.Context, userID uint, listID string) error {
+	if _, err := r.restore(ctx, userID); err != nil {
+		return err
+	}
+	if err := r.cache.DeleteList(ctx, userID, listID); err != nil {
+		return err
+	}
+
+	if err := r.store.DeleteList(ctx, userID, listID); err != nil && !errors.Is(err, domain.ErrListNotFound) {
+		logger.Warnf("Failed to delete stored list %s of user %d: %v", listID, userID, err)
+	}
+	return nil
+}
+
+func (r *ListRepository) AddItem(ctx context.Context, userID uint, listID string, productID, variantID uint, quantity int) error {
+	return r.write(ctx, userID, listID, func() error {
+		return r.cache.AddItem(ctx, userID, listID, productID, variantID, quantity)
+	})
+}
+
+func (r *ListRepository) RemoveItem(ctx context.Context, userID uint, listID string, productID, variantID uint) error {
+	return r.write(ctx, userID, listID, func() error {
+		return r.cache.RemoveItem(ctx, userID, listID, productID, variantID)
+	})
+}
+
+func (r *ListRepository) MoveFromCart(ctx context.Context, userID uint, listID string, productID, variantID uint) error {
+	return r.carts.write(ctx, domain.CartOwner{UserID: userID}, func() error {
+		return r.write(ctx, userID, listID, func() error {
+			return r.cache.MoveFromCart(ctx, userID, listID, productID, variantID)
+		})
+	})
+}
+
+func (r *ListRepository) MoveToCart(ctx context.Context, userID uint, listID string, productID, variantID uint, unitPrice float32, limit int) error {
+	return r.carts.write(ctx, domain.CartOwner{UserID: userID}, func() error {
+		return r.write(ctx, userID, listID, func() error {
+			return r.cache.MoveToCart(ctx, userID, listID, productID, variantID, unitPrice, limit)
+		})
+	})
+}
+
+func (r *ListRepository) SetShareToken(ctx context.Context, userID uint, listID, token string) error {
+	return r.write(ctx, userID, listID, func() error {
+		return r.cache.SetShareToken(ctx, userID, listID, token)
+	})
+}
+
+// GetSharedList looks the token up in the store when the cache does not know
+// it, and serves the list from the cache once its owner's lists are restored.
+func (r *ListRepository) GetSharedList(ctx context.Context, shareToken string) (domain.ItemList, error) {
+	list, err := r.cache.GetSharedList(ctx, shareToken)
+	if !errors.Is(err, domain.ErrListNotFound) {
+		return list, err
+	}
+
+	stored, storeErr := r.store.GetSharedList(ctx, shareToken)
+	if storeErr != nil {
+		if !errors.Is(storeErr, domain.ErrListNotFound) {
+			logger.Warnf("Failed to read stored shared list: %v", storeErr)
+		}
+		return list, err
+	}
+	if _, err := r.restore(ctx, stored.UserID); err != nil {
+		return domain.ItemList{}, err
+	}
+	return r.cache.GetSharedList(ctx, shareToken)
+}
+
+// write applies change to the cache once the user's lists are in it, then
+// saves the changed list to the store.
+func (r *ListRepository) write(ctx context.Context, userID uint, listID string, change func() error) error {
+	if _, err := r.restore(ctx, userID); err != nil {
+		return err
+	}
+	if err := change(); err != nil {
+		return err
+	}
+	r.persist(ctx, userID, listID)
+	return nil
+}
+
+// restore reads the user's lists from the cache, first refilling the cache
+// from the store when it holds none. The lists are served from the cache
+// alone when the store cannot be read.
+func (r *ListRepository) restore(ctx context.Context, userID uint) ([]domain.ItemList, error) {
+	lists, err := r.cache.ListLists(ctx, userID)
+	if err != nil || len(lists) > 0 {
+		return lists, err
+	}
+
+	stored, err := r.store.ListLists(ctx, userID)
+	if err != nil {
+		logger.Warnf("Failed to read stored lists of user %d: %v", userID, err)
+		return lists, nil
+	}
+	if len(stored) == 0 {
+		return lists, nil
+	}
+
+	if err := r.cache.RestoreLists(ctx, stored); err != nil {
+		return nil, err
+	}
+	return r.cache.ListLists(ctx, userID)
+}
+
+// persist saves the list as the cache now holds it.
+func (r *ListRepository) persist(ctx context.Context, userID uint, listID string) {
+	list, err := r.cache.GetList(ctx, userID, listID)
+	if err != nil {
+		logger.Warnf("Failed to read list %s of user %d to store it: %v", listID, userID, err)
+		return
+	}
+	if err := r.store.SaveList(ctx, list); err != nil {
+		logger.Warnf("Failed to store list %s of user %d: %v", listID, userID, err)
+	}
+}
@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// abandonedScanBatch bounds the carts listed at once; a scan lists batches
+// until one comes back short.
+const abandonedScanBatch = 100
+
+// AbandonedCartUsecase publishes cart.abandoned events for user carts that
+// have been idle for longer than idleAfter.
+type AbandonedCartUsecase struct {
+	repo      domain.CartRepository
+	carts     *CartUsecase
+	publisher domain.EventPublisher
+	idleAfter time.Duration
+	tracer    trace.Tracer
+}
+
+func NewAbandonedCartUsecase(repo domain.CartRepository, carts *CartUsecase, publisher domain.EventPublisher, idleAfter time.Duration) *AbandonedCartUsecase {
+	return &AbandonedCartUsecase{
+		repo:      repo,
+		carts:     carts,
+		publisher: publisher,
+		idleAfter: idleAfter,
+		tracer:    otel.Tracer("abandoned-cart-usecase"),
+	}
+}
+
+// NotifyAbandoned publishes an event for every user cart idle since before
+// now minus idleAfter, in batches of abandonedScanBatch until a batch comes
+// back short or ctx is done. Each cart is released before its event is
+// published, so concurrent scanners cannot notify twice; it is tracked again
+// when the event cannot be published. Guest carts, and carts with nothing
+// left in the catalog, are released silently: there is nobody or nothing to
+// remind of. A batch in which no cart stays released ends the scan early, as
+// the next one would list the same carts; they wait for the next tick.
+func (u *AbandonedCartUsecase) NotifyAbandoned(ctx context.Context) (int, error) {
+	ctx, span := u.tracer.Start(ctx, "AbandonedCartUsecase.NotifyAbandoned")
+	defer span.End()
+
+	idleSince := time.Now().Add(-u.idleAfter)
+	scanned, published := 0, 0
+	for ctx.Err() == nil {
+		idle, err := u.repo.ListIdleCarts(ctx, idleSince, abandonedScanBatch)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return published, err
+		}
+
+		batchPublished, released := u.notifyBatch(ctx, span, idle)
+		scanned += len(idle)
+		published += batchPublished
+		if len(idle) < abandonedScanBatch || released == 0 {
+			break
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("carts.idle", scanned),
+		attribute.Int("events.published", published),
+	)
+	span.SetStatus(codes.Ok, "abandoned carts notified")
+	return published, nil
+}
+
+// notifyBatch handles one batch of idle carts. It returns the events
+// published and the carts left released, which are no longer listed as idle.
+func (u *AbandonedCartUsecase) notifyBatch(ctx context.Context, span trace.Span, idle []domain.IdleCart) (int, int) {
+	published, releasedCount := 0, 0
+	for _, idleCart := range idle {
+		released, err := u.repo.ReleaseIdleCart(ctx, idleCart)
+		if err != nil {
+			span.RecordError(err)
+			logger.Warnf("Failed to release idle cart %+v: %v", idleCart.Owner, err)
+			continue
+		}
+		if !released {
+			continue
+		}
+		if idleCart.Owner.IsGuest() {
+			releasedCount++
+			continue
+		}
+
+		cart, err := u.repo.GetCart(ctx, idleCart.Owner)
+		if err != nil {
+			span.RecordError(err)
+			logger.Warnf("Failed to load abandoned cart of user %d: %v", idleCart.Owner.UserID, err)
+			u.track(ctx, idleCart)
+			continue
+		}
+		if len(cart.Items) == 0 {
+			releasedCount++
+			continue
+		}
+
+		event := u.abandonedEvent(ctx, cart, idleCart.UpdatedAt)
+		if len(event.Items) == 0 {
+			releasedCount++
+			continue
+		}
+		if err := u.publisher.Publish(ctx, domain.EventCartAbandoned, event); err != nil {
+			span.RecordError(err)
+			logger.Warnf("Failed to publish cart abandoned event for user %d: %v", cart.UserID, err)
+			u.track(ctx, idleCart)
+			continue
+		}
+		releasedCount++
+		published++
+	}
+	return published, releasedCount
+}
+
+// Run scans for abandoned carts every interval until done is closed.
+func (u *AbandonedCartUsecase) Run(done <-chan any, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			published, err := u.NotifyAbandoned(ctx)
+			cancel()
+			if err != nil {
+				logger.Errorf("Scanning abandoned carts failed: %v", err)
+				continue
+			}
+			if published > 0 {
+				logger.Infof("Published %d cart abandoned events", published)
+			}
+		}
+	}
+}
+
+// abandonedEvent attaches the cart contents, priced against the current
+// catalog when it can be reached.
+func (u *AbandonedCartUsecase) abandonedEvent(ctx context.Context, cart domain.Cart, lastModified time.Time) domain.CartAbandonedEvent {
+	priced := u.carts.priceCart(ctx, cart)
+
+	event := domain.CartAbandonedEvent{
+		UserID:         cart.UserID,
+		Items:          make([]domain.AbandonedCartItemEvent, 0, len(priced.Items)),
+		TotalQuantity:  priced.TotalQuantity,
+		Subtotal:       priced.Subtotal,
+		Priced:         true,
+		LastModifiedAt: lastModified,
+		OccurredAt:     time.Now().UTC(),
+	}
+	for _, warning := range priced.Warnings {
+		if warning.Code == string(domain.CartWarningPricingUnavailable) {
+			event.Priced = false
+		}
+	}
+	for _, item := range priced.Items {
+		event.Items = append(event.Items, domain.AbandonedCartItemEvent{
+			ProductID: item.ProductID,
+			VariantID: item.VariantID,
+			Quantity:  item.Quantity,
+			Name:      item.Name,
+			ImageURL:  item.ImageURL,
+			UnitPrice: item.UnitPrice,
+		})
+	}
+	return event
+}
+
+func (u *AbandonedCartUsecase) track(ctx context.Context, idleCart domain.IdleCart) {
+	if err := u.repo.TrackIdleCart(ctx, idleCart); err != nil {
+		logger.Warnf("Failed to track idle cart %+v again: %v", idleCart.Owner, err)
+	}
+}
@@ -0,0 +1,191 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/domain"
+	productpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/product"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// repriceTolerance ignores float noise when comparing a line's price snapshot
+// with the current price.
+const repriceTolerance = 0.005
+
+// catalogItem is what the catalog currently says about a cart line.
+type catalogItem struct {
+	name      string
+	imageURL  string
+	unitPrice float32
+	stock     int32
+	// maxPerOrder is the product's per-order limit, 0 for none.
+	maxPerOrder int
+}
+
+// priceCart prices the cart lines against the current catalog in batched
+// ProductService calls. Lines no longer in the catalog are left out with a
+// warning. When the catalog cannot be reached the cart is returned unpriced
+// with a warning rather than failing, so it stays readable.
+func (u *CartUsecase) priceCart(ctx context.Context, cart domain.Cart) *dto.CartResponse {
+	ctx, span := u.tracer.Start(ctx, "CartUsecase.PriceCart")
+	defer span.End()
+
+	response := mapCartToResponse(cart)
+	if len(cart.Items) == 0 {
+		return response
+	}
+
+	productIDs := make([]uint, 0, len(cart.Items))
+	seen := make(map[uint]struct{}, len(cart.Items))
+	for _, item := range cart.Items {
+		if _, ok := seen[item.ProductID]; !ok {
+			seen[item.ProductID] = struct{}{}
+			productIDs = append(productIDs, item.ProductID)
+		}
+	}
+
+	products, _, err := u.loadProducts(ctx, productIDs)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		response.Warnings = append(response.Warnings, dto.CartWarningResponse{
+			Code:    string(domain.CartWarningPricingUnavailable),
+			Message: "prices and availability are temporarily unavailable",
+		})
+		return response
+	}
+
+	response.Items = make([]dto.CartItemResponse, 0, len(cart.Items))
+	response.TotalQuantity = 0
+	for _, item := range cart.Items {
+		product, ok := products[item.ProductID]
+		if !ok {
+			response.Warnings = append(response.Warnings, removedWarning(item, fmt.Sprintf("product %d is no longer available", item.ProductID)))
+			continue
+		}
+		catalog, err := resolveCatalogItem(product, item.VariantID)
+		if err != nil {
+			response.Warnings = append(response.Warnings, removedWarning(item, err.Error()))
+			continue
+		}
+
+		discount := unitDiscount(product, catalog.unitPrice)
+		unitPrice := catalog.unitPrice - discount
+		lineTotal := unitPrice * float32(item.Quantity)
+		response.Items = append(response.Items, dto.CartItemResponse{
+			ProductID:   item.ProductID,
+			VariantID:   item.VariantID,
+			Quantity:    item.Quantity,
+			Name:        catalog.name,
+			ImageURL:    catalog.imageURL,
+			BasePrice:   catalog.unitPrice,
+			UnitPrice:   unitPrice,
+			LineTotal:   lineTotal,
+			StockStatus: string(stockStatus(catalog.stock, int32(item.Quantity), product.GetReorderThreshold())),
+		})
+		response.TotalQuantity += item.Quantity
+		response.Subtotal += catalog.unitPrice * float32(item.Quantity)
+		response.EstimatedDiscount += discount * float32(item.Quantity)
+		response.EstimatedTotal += lineTotal
+
+		if item.AddedPrice > 0 && math.Abs(float64(item.AddedPrice-catalog.unitPrice)) > repriceTolerance {
+			oldPrice, newPrice := item.AddedPrice, catalog.unitPrice
+			response.Warnings = append(response.Warnings, dto.CartWarningResponse{
+				Code:      string(domain.CartWarningRepriced),
+				ProductID: item.ProductID,
+				VariantID: item.VariantID,
+				Message:   fmt.Sprintf("price changed from %.2f to %.2f since it was added", oldPrice, newPrice),
+				OldPrice:  &oldPrice,
+				NewPrice:  &newPrice,
+			})
+		}
+	}
+
+	span.SetAttributes(
+		attribute.Int("cart.items", len(response.Items)),
+		attribute.Int("cart.warnings", len(response.Warnings)),
+	)
+	return response
+}
+
+// resolveCatalogItem finds the cart line in the product: the product itself
+// for plain products, else the chosen variant. It fails when a variant is
+// required but missing, or no longer belongs to the product.
+func resolveCatalogItem(product *productpb.Product, variantID uint) (catalogItem, error) {
+	item := catalogItem{
+		name:        product.GetName(),
+		imageURL:    product.GetImageUrl(),
+		unitPrice:   product.GetPrice(),
+		stock:       product.GetQuantity(),
+		maxPerOrder: int(product.GetMaxPerOrder()),
+	}
+
+	if variantID == 0 {
+		if len(product.GetVariants()) > 0 {
+			return catalogItem{}, fmt.Errorf("product %d is sold in variants: variant_id is required", product.GetId())
+		}
+		return item, nil
+	}
+
+	for _, variant := range product.GetVariants() {
+		if uint(variant.GetId()) != variantID {
+			continue
+		}
+		item.unitPrice = variant.GetPrice()
+		item.stock = variant.GetQuantity()
+		if variant.GetImageUrl() != "" {
+			item.imageURL = variant.GetImageUrl()
+		}
+		return item, nil
+	}
+	return catalogItem{}, fmt.Errorf("variant %d does not belong to product %d", variantID, product.GetId())
+}
+
+// stockStatus is out when nothing is left, low when the stock is below the
+// cart quantity or at or below the reorder threshold, else in stock.
+func stockStatus(available, wanted, reorderThreshold int32) domain.StockStatus {
+	if available <= 0 {
+		return domain.StockOut
+	}
+	if available < wanted || (reorderThreshold > 0 && available <= reorderThreshold) {
+		return domain.StockLow
+	}
+	return domain.StockInStock
+}
+
+// unitDiscount is the product discount on one unit at unitPrice, never more
+// than the price itself. Discounts outside their start and end dates give
+// nothing, as ProductService reports.
+func unitDiscount(product *productpb.Product, unitPrice float32) float32 {
+	if !product.GetDiscountActive() {
+		return 0
+	}
+
+	var discount float32
+	switch product.GetDiscountType() {
+	case "percent":
+		discount = unitPrice * product.GetDiscountValue() / 100
+	case "fixed":
+		discount = product.GetDiscountValue()
+	}
+	if discount < 0 {
+		return 0
+	}
+	if discount > unitPrice {
+		return unitPrice
+	}
+	return discount
+}
+
+func removedWarning(item domain.CartItem, message string) dto.CartWarningResponse {
+	return dto.CartWarningResponse{
+		Code:      string(domain.CartWarningRemoved),
+		ProductID: item.ProductID,
+		VariantID: item.VariantID,
+		Message:   message,
+	}
+}
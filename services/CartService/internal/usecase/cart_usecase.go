@@ -2,7 +2,11 @@ package usecase
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/kareemhamed001/e-commerce/services/CartService/internal/delivery/grpc/dto"
@@ -15,48 +19,62 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+// productBatchSize is the most products ProductService returns per
+// GetProductsByIDs call.
+const productBatchSize = 100
+
 type CartUsecase struct {
-	repo              domain.CartRepository
-	productClient     productpb.ProductServiceClient
-	userClient        userpb.UserServiceClient
-	downstreamTimeout time.Duration
-	tracer            trace.Tracer
+	repo                 domain.CartRepository
+	productClient        productpb.ProductServiceClient
+	userClient           userpb.UserServiceClient
+	downstreamTimeout    time.Duration
+	defaultMergeStrategy domain.MergeStrategy
+	maxLineQuantity      int
+	tracer               trace.Tracer
 }
 
 var _ domain.CartUsecase = (*CartUsecase)(nil)
 
-func NewCartUsecase(repo domain.CartRepository, productClient productpb.ProductServiceClient, userClient userpb.UserServiceClient, downstreamTimeout time.Duration) *CartUsecase {
+func NewCartUsecase(repo domain.CartRepository, productClient productpb.ProductServiceClient, userClient userpb.UserServiceClient, downstreamTimeout time.Duration, defaultMergeStrategy domain.MergeStrategy, maxLineQuantity int) *CartUsecase {
 	if downstreamTimeout <= 0 {
 		downstreamTimeout = 3 * time.Second
 	}
+	if !defaultMergeStrategy.IsValid() {
+		defaultMergeStrategy = domain.MergeStrategySum
+	}
+	if maxLineQuantity <= 0 {
+		maxLineQuantity = 99
+	}
 
 	return &CartUsecase{
-		repo:              repo,
-		productClient:     productClient,
-		userClient:        userClient,
-		downstreamTimeout: downstreamTimeout,
-		tracer:            otel.Tracer("cart-usecase"),
+		repo:                 repo,
+		productClient:        productClient,
+		userClient:           userClient,
+		downstreamTimeout:    downstreamTimeout,
+		defaultMergeStrategy: defaultMergeStrategy,
+		maxLineQuantity:      maxLineQuantity,
+		tracer:               otel.Tracer("cart-usecase"),
 	}
 }
 
-func (u *CartUsecase) GetCart(ctx context.Context, userID uint) (*dto.CartResponse, error) {
+func (u *CartUsecase) GetCart(ctx context.Context, owner domain.CartOwner) (*dto.CartResponse, error) {
 	ctx, span := u.tracer.Start(ctx, "CartUsecase.GetCart")
 	defer span.End()
 
-	if err := u.ensureUserExists(ctx, userID); err != nil {
+	if err := u.ensureOwnerExists(ctx, owner); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	cart, err := u.repo.GetCart(ctx, userID)
+	cart, err := u.repo.GetCart(ctx, owner)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	return mapCartToResponse(cart), nil
+	return u.priceCart(ctx, cart), nil
 }
 
 func (u *CartUsecase) AddItem(ctx context.Context, req *dto.AddItemRequest) (*dto.CartResponse, error) {
@@ -66,105 +84,148 @@ func (u *CartUsecase) AddItem(ctx context.Context, req *dto.AddItemRequest) (*dt
 	span.SetAttributes(
 		attribute.Int("cart.user_id", int(req.UserID)),
 		attribute.Int("cart.product_id", int(req.ProductID)),
+		attribute.Int("cart.variant_id", int(req.VariantID)),
 	)
 
-	if err := u.ensureUserExists(ctx, req.UserID); err != nil {
+	owner := domain.CartOwner{UserID: req.UserID, GuestToken: req.GuestToken}
+	if owner.IsGuest() && owner.GuestToken == "" {
+		token, err := newGuestToken()
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		owner.GuestToken = token
+	}
+	span.SetAttributes(attribute.Bool("cart.guest", owner.IsGuest()))
+
+	if err := u.ensureOwnerExists(ctx, owner); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	if _, err := u.ensureProductExists(ctx, req.ProductID); err != nil {
+	item, err := u.ensureItemExists(ctx, req.ProductID, req.VariantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	limit, err := u.lineLimit(item)
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	if err := u.repo.AddItem(ctx, req.UserID, req.ProductID, req.Quantity); err != nil {
+	if err := u.repo.AddItem(ctx, owner, req.ProductID, req.VariantID, req.Quantity, item.unitPrice, limit, req.ExpectedVersion); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	cart, err := u.repo.GetCart(ctx, req.UserID)
+	cart, err := u.repo.GetCart(ctx, owner)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	return mapCartToResponse(cart), nil
+	return u.priceCart(ctx, cart), nil
 }
 
 func (u *CartUsecase) UpdateItem(ctx context.Context, req *dto.UpdateItemRequest) (*dto.CartResponse, error) {
 	ctx, span := u.tracer.Start(ctx, "CartUsecase.UpdateItem")
 	defer span.End()
 
-	if err := u.ensureUserExists(ctx, req.UserID); err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		return nil, err
-	}
-
-	if _, err := u.ensureProductExists(ctx, req.ProductID); err != nil {
+	owner := domain.CartOwner{UserID: req.UserID, GuestToken: req.GuestToken}
+	if err := u.ensureOwnerExists(ctx, owner); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	if err := u.repo.UpdateItem(ctx, req.UserID, req.ProductID, req.Quantity); err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		return nil, err
+	if req.Quantity == 0 {
+		if err := u.repo.RemoveItem(ctx, owner, req.ProductID, req.VariantID, req.ExpectedVersion); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	} else {
+		item, err := u.ensureItemExists(ctx, req.ProductID, req.VariantID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+
+		limit, err := u.lineLimit(item)
+		if err == nil && req.Quantity > limit {
+			err = fmt.Errorf("%w: at most %d allowed", domain.ErrQuantityExceedsLimit, limit)
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+
+		if err := u.repo.UpdateItem(ctx, owner, req.ProductID, req.VariantID, req.Quantity, item.unitPrice, req.ExpectedVersion); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
 	}
 
-	cart, err := u.repo.GetCart(ctx, req.UserID)
+	cart, err := u.repo.GetCart(ctx, owner)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	return mapCartToResponse(cart), nil
+	return u.priceCart(ctx, cart), nil
 }
 
 func (u *CartUsecase) RemoveItem(ctx context.Context, req *dto.RemoveItemRequest) (*dto.CartResponse, error) {
 	ctx, span := u.tracer.Start(ctx, "CartUsecase.RemoveItem")
 	defer span.End()
 
-	if err := u.ensureUserExists(ctx, req.UserID); err != nil {
+	owner := domain.CartOwner{UserID: req.UserID, GuestToken: req.GuestToken}
+	if err := u.ensureOwnerExists(ctx, owner); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	if err := u.repo.RemoveItem(ctx, req.UserID, req.ProductID); err != nil {
+	if err := u.repo.RemoveItem(ctx, owner, req.ProductID, req.VariantID, req.ExpectedVersion); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	cart, err := u.repo.GetCart(ctx, req.UserID)
+	cart, err := u.repo.GetCart(ctx, owner)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	return mapCartToResponse(cart), nil
+	return u.priceCart(ctx, cart), nil
 }
 
-func (u *CartUsecase) ClearCart(ctx context.Context, userID uint) error {
+func (u *CartUsecase) ClearCart(ctx context.Context, req *dto.ClearCartRequest) error {
 	ctx, span := u.tracer.Start(ctx, "CartUsecase.ClearCart")
 	defer span.End()
 
-	if err := u.ensureUserExists(ctx, userID); err != nil {
+	owner := domain.CartOwner{UserID: req.UserID, GuestToken: req.GuestToken}
+	if err := u.ensureOwnerExists(ctx, owner); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return err
 	}
 
-	if err := u.repo.ClearCart(ctx, userID); err != nil {
+	if err := u.repo.ClearCart(ctx, owner, req.ExpectedVersion); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return err
@@ -173,6 +234,113 @@ func (u *CartUsecase) ClearCart(ctx context.Context, userID uint) error {
 	return nil
 }
 
+// MergeCart moves the guest cart into the user's cart, typically right after
+// the guest logs in or registers, and returns the merged cart. A guest cart
+// that is empty or has expired leaves the user's cart unchanged.
+func (u *CartUsecase) MergeCart(ctx context.Context, req *dto.MergeCartRequest) (*dto.CartResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "CartUsecase.MergeCart")
+	defer span.End()
+
+	strategy := domain.MergeStrategy(req.Strategy)
+	if strategy == "" {
+		strategy = u.defaultMergeStrategy
+	}
+	if !strategy.IsValid() {
+		err := fmt.Errorf("unknown merge strategy %q", req.Strategy)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Int("cart.user_id", int(req.UserID)),
+		attribute.String("cart.merge_strategy", string(strategy)),
+	)
+
+	if err := u.ensureUserExists(ctx, req.UserID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	limits, err := u.mergeLimits(ctx, req.GuestToken)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if err := u.repo.MergeCart(ctx, req.GuestToken, req.UserID, strategy, limits); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	cart, err := u.repo.GetCart(ctx, domain.CartOwner{UserID: req.UserID})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return u.priceCart(ctx, cart), nil
+}
+
+// mergeLimits returns the line limit of every line of the guest cart, so that
+// merging cannot push a line past what AddItem would allow. Lines whose
+// product or variant is gone or out of stock get no limit and are dropped
+// by the merge.
+func (u *CartUsecase) mergeLimits(ctx context.Context, guestToken string) (map[domain.LineKey]int, error) {
+	guest, err := u.repo.GetCart(ctx, domain.CartOwner{GuestToken: guestToken})
+	if err != nil {
+		return nil, err
+	}
+	if len(guest.Items) == 0 {
+		return nil, nil
+	}
+
+	productIDs := make([]uint, 0, len(guest.Items))
+	seen := make(map[uint]struct{}, len(guest.Items))
+	for _, item := range guest.Items {
+		if _, ok := seen[item.ProductID]; !ok {
+			seen[item.ProductID] = struct{}{}
+			productIDs = append(productIDs, item.ProductID)
+		}
+	}
+
+	products, _, err := u.loadProducts(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	limits := make(map[domain.LineKey]int, len(guest.Items))
+	for _, line := range guest.Items {
+		product, ok := products[line.ProductID]
+		if !ok {
+			continue
+		}
+		item, err := resolveCatalogItem(product, line.VariantID)
+		if err != nil {
+			continue
+		}
+		limit, err := u.lineLimit(item)
+		if err != nil {
+			continue
+		}
+		limits[domain.LineKey{ProductID: line.ProductID, VariantID: line.VariantID}] = limit
+	}
+	return limits, nil
+}
+
+// ensureOwnerExists checks the user of a user cart; guest carts need no
+// lookup.
+func (u *CartUsecase) ensureOwnerExists(ctx context.Context, owner domain.CartOwner) error {
+	if owner.IsGuest() {
+		return nil
+	}
+	return u.ensureUserExists(ctx, owner.UserID)
+}
+
 func (u *CartUsecase) ensureUserExists(ctx context.Context, userID uint) error {
 	ctx, cancel := context.WithTimeout(ctx, u.downstreamTimeout)
 	defer cancel()
@@ -184,18 +352,90 @@ func (u *CartUsecase) ensureUserExists(ctx context.Context, userID uint) error {
 	return nil
 }
 
-func (u *CartUsecase) ensureProductExists(ctx context.Context, productID uint) (*productpb.Product, error) {
+// loadProducts loads the given products, with their variants, in as few
+// ProductService calls as possible and returns the IDs it does not know.
+func (u *CartUsecase) loadProducts(ctx context.Context, productIDs []uint) (map[uint]*productpb.Product, []int64, error) {
 	ctx, cancel := context.WithTimeout(ctx, u.downstreamTimeout)
 	defer cancel()
 
-	response, err := u.productClient.GetProductByID(ctx, &productpb.GetProductByIDRequest{Id: int64(productID)})
+	ids := make([]int64, 0, len(productIDs))
+	for _, id := range productIDs {
+		ids = append(ids, int64(id))
+	}
+
+	products := make(map[uint]*productpb.Product, len(ids))
+	var missing []int64
+	for start := 0; start < len(ids); start += productBatchSize {
+		end := min(start+productBatchSize, len(ids))
+		response, err := u.productClient.GetProductsByIDs(ctx, &productpb.GetProductsByIDsRequest{Ids: ids[start:end]})
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load products: %w", err)
+		}
+		for _, product := range response.GetProducts() {
+			products[uint(product.GetId())] = product
+		}
+		missing = append(missing, response.GetMissingIds()...)
+	}
+	return products, missing, nil
+}
+
+// fetchProducts is loadProducts that reports every unknown ID in one error.
+func (u *CartUsecase) fetchProducts(ctx context.Context, productIDs []uint) (map[uint]*productpb.Product, error) {
+	products, missing, err := u.loadProducts(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(missing) > 0 {
+		ids := make([]string, 0, len(missing))
+		for _, id := range missing {
+			ids = append(ids, strconv.FormatInt(id, 10))
+		}
+		return nil, fmt.Errorf("products not found: %s", strings.Join(ids, ", "))
+	}
+	return products, nil
+}
+
+// ensureItemExists checks that the product exists and, for products sold in
+// variants, that a variant of that product was chosen. It returns what the
+// catalog currently says about the item.
+func (u *CartUsecase) ensureItemExists(ctx context.Context, productID, variantID uint) (catalogItem, error) {
+	products, err := u.fetchProducts(ctx, []uint{productID})
 	if err != nil {
-		return nil, fmt.Errorf("product not found: %w", err)
+		return catalogItem{}, err
+	}
+	product, ok := products[productID]
+	if !ok {
+		return catalogItem{}, fmt.Errorf("products not found: %d", productID)
+	}
+
+	return resolveCatalogItem(product, variantID)
+}
+
+// lineLimit is the largest quantity a cart line of the item may hold: the
+// available stock, the product's max-per-order limit and the service-wide
+// line cap, whichever is lowest.
+func (u *CartUsecase) lineLimit(item catalogItem) (int, error) {
+	if item.stock <= 0 {
+		return 0, domain.ErrOutOfStock
 	}
-	if response.GetProduct() == nil {
-		return nil, fmt.Errorf("product not found: empty response")
+
+	limit := u.maxLineQuantity
+	if item.maxPerOrder > 0 && item.maxPerOrder < limit {
+		limit = item.maxPerOrder
+	}
+	if int(item.stock) < limit {
+		limit = int(item.stock)
+	}
+	return limit, nil
+}
+
+// newGuestToken returns an opaque, unguessable guest cart token.
+func newGuestToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate guest cart token: %w", err)
 	}
-	return response.GetProduct(), nil
+	return hex.EncodeToString(b), nil
 }
 
 func mapCartToResponse(cart domain.Cart) *dto.CartResponse {
@@ -203,13 +443,21 @@ func mapCartToResponse(cart domain.Cart) *dto.CartResponse {
 	for _, item := range cart.Items {
 		items = append(items, dto.CartItemResponse{
 			ProductID: item.ProductID,
+			VariantID: item.VariantID,
 			Quantity:  item.Quantity,
 		})
 	}
 
-	return &dto.CartResponse{
+	response := &dto.CartResponse{
 		UserID:        cart.UserID,
+		GuestToken:    cart.GuestToken,
 		Items:         items,
 		TotalQuantity: cart.TotalQuantity,
+		Version:       cart.Version,
+	}
+	if !cart.UpdatedAt.IsZero() {
+		updatedAt := cart.UpdatedAt
+		response.UpdatedAt = &updatedAt
 	}
+	return response
 }
@@ -0,0 +1,549 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/CartService/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const savedForLaterListName = "Saved for later"
+
+// ListUsecase manages the lists users park items in outside the cart. The
+// saved-for-later list is created on first write; until then it reads as
+// empty.
+type ListUsecase struct {
+	repo   domain.ListRepository
+	carts  *CartUsecase
+	tracer trace.Tracer
+}
+
+var _ domain.ListUsecase = (*ListUsecase)(nil)
+
+func NewListUsecase(repo domain.ListRepository, carts *CartUsecase) *ListUsecase {
+	return &ListUsecase{
+		repo:   repo,
+		carts:  carts,
+		tracer: otel.Tracer("list-usecase"),
+	}
+}
+
+// ListLists returns the saved-for-later list first, then the wishlists from
+// oldest to newest.
+func (u *ListUsecase) ListLists(ctx context.Context, userID uint) (*dto.ListsResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ListUsecase.ListLists")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("list.user_id", int(userID)))
+
+	if err := u.carts.ensureUserExists(ctx, userID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	lists, err := u.repo.ListLists(ctx, userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	saved := emptySavedList(userID)
+	ordered := make([]domain.ItemList, 0, len(lists)+1)
+	for _, list := range lists {
+		if list.ID == domain.SavedForLaterListID {
+			saved = list
+			continue
+		}
+		ordered = append(ordered, list)
+	}
+	ordered = append([]domain.ItemList{saved}, ordered...)
+
+	span.SetAttributes(attribute.Int("lists.count", len(ordered)))
+	return &dto.ListsResponse{Lists: u.priceLists(ctx, ordered...)}, nil
+}
+
+func (u *ListUsecase) CreateWishlist(ctx context.Context, req *dto.CreateWishlistRequest) (*dto.ListResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ListUsecase.CreateWishlist")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("list.user_id", int(req.UserID)))
+
+	if err := u.carts.ensureUserExists(ctx, req.UserID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	listID, err := randomHex(8)
+	if err != nil {
+		err = fmt.Errorf("failed to generate list id: %w", err)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	list := domain.ItemList{
+		ID:        listID,
+		UserID:    req.UserID,
+		Name:      req.Name,
+		Kind:      domain.ListKindWishlist,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := u.repo.CreateList(ctx, list); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.String("list.id", listID))
+	return mapListToResponse(list), nil
+}
+
+func (u *ListUsecase) GetList(ctx context.Context, req *dto.ListRequest) (*dto.ListResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ListUsecase.GetList")
+	defer span.End()
+
+	if err := u.carts.ensureUserExists(ctx, req.UserID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response, err := u.loadList(ctx, req.UserID, req.ListID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return response, nil
+}
+
+func (u *ListUsecase) DeleteList(ctx context.Context, req *dto.ListRequest) error {
+	ctx, span := u.tracer.Start(ctx, "ListUsecase.DeleteList")
+	defer span.End()
+
+	listID := resolveListID(req.ListID)
+	span.SetAttributes(
+		attribute.Int("list.user_id", int(req.UserID)),
+		attribute.String("list.id", listID),
+	)
+
+	if listID == domain.SavedForLaterListID {
+		err := domain.ErrSavedListNotDeletable
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	if err := u.carts.ensureUserExists(ctx, req.UserID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	if err := u.repo.DeleteList(ctx, req.UserID, listID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	return nil
+}
+
+// AddListItem adds to a list any item in the catalog, in stock or not.
+func (u *ListUsecase) AddListItem(ctx context.Context, req *dto.AddListItemRequest) (*dto.ListResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ListUsecase.AddListItem")
+	defer span.End()
+
+	listID := resolveListID(req.ListID)
+	span.SetAttributes(
+		attribute.Int("list.user_id", int(req.UserID)),
+		attribute.String("list.id", listID),
+		attribute.Int("list.product_id", int(req.ProductID)),
+		attribute.Int("list.variant_id", int(req.VariantID)),
+	)
+
+	if err := u.carts.ensureUserExists(ctx, req.UserID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if _, err := u.carts.ensureItemExists(ctx, req.ProductID, req.VariantID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if err := u.ensureSavedList(ctx, req.UserID, listID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if err := u.repo.AddItem(ctx, req.UserID, listID, req.ProductID, req.VariantID, req.Quantity); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response, err := u.loadList(ctx, req.UserID, listID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return response, nil
+}
+
+func (u *ListUsecase) RemoveListItem(ctx context.Context, req *dto.ListItemRequest) (*dto.ListResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ListUsecase.RemoveListItem")
+	defer span.End()
+
+	listID := resolveListID(req.ListID)
+	span.SetAttributes(
+		attribute.Int("list.user_id", int(req.UserID)),
+		attribute.String("list.id", listID),
+	)
+
+	if err := u.carts.ensureUserExists(ctx, req.UserID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	err := u.repo.RemoveItem(ctx, req.UserID, listID, req.ProductID, req.VariantID)
+	// Nothing to remove from a saved-for-later list not created yet.
+	if err != nil && !(errors.Is(err, domain.ErrListNotFound) && listID == domain.SavedForLaterListID) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response, err := u.loadList(ctx, req.UserID, listID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return response, nil
+}
+
+// MoveToList moves a whole cart line into a list, the saved-for-later list
+// unless another is given.
+func (u *ListUsecase) MoveToList(ctx context.Context, req *dto.ListItemRequest) (*dto.MoveItemResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ListUsecase.MoveToList")
+	defer span.End()
+
+	listID := resolveListID(req.ListID)
+	span.SetAttributes(
+		attribute.Int("list.user_id", int(req.UserID)),
+		attribute.String("list.id", listID),
+		attribute.Int("list.product_id", int(req.ProductID)),
+		attribute.Int("list.variant_id", int(req.VariantID)),
+	)
+
+	if err := u.carts.ensureUserExists(ctx, req.UserID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if err := u.ensureSavedList(ctx, req.UserID, listID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if err := u.repo.MoveFromCart(ctx, req.UserID, listID, req.ProductID, req.VariantID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response, err := u.moveResponse(ctx, req.UserID, listID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return response, nil
+}
+
+// MoveToCart moves a whole list item into the cart, under the same stock and
+// quantity limits as adding it to the cart. A rejected move leaves both the
+// list and the cart unchanged.
+func (u *ListUsecase) MoveToCart(ctx context.Context, req *dto.ListItemRequest) (*dto.MoveItemResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ListUsecase.MoveToCart")
+	defer span.End()
+
+	listID := resolveListID(req.ListID)
+	span.SetAttributes(
+		attribute.Int("list.user_id", int(req.UserID)),
+		attribute.String("list.id", listID),
+		attribute.Int("list.product_id", int(req.ProductID)),
+		attribute.Int("list.variant_id", int(req.VariantID)),
+	)
+
+	if err := u.carts.ensureUserExists(ctx, req.UserID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	item, err := u.carts.ensureItemExists(ctx, req.ProductID, req.VariantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	limit, err := u.carts.lineLimit(item)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if err := u.repo.MoveToCart(ctx, req.UserID, listID, req.ProductID, req.VariantID, item.unitPrice, limit); err != nil {
+		if errors.Is(err, domain.ErrListNotFound) && listID == domain.SavedForLaterListID {
+			err = domain.ErrItemNotInList
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response, err := u.moveResponse(ctx, req.UserID, listID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return response, nil
+}
+
+// ShareList gives a wishlist a new public share token, revoking any previous
+// one, or stops sharing it.
+func (u *ListUsecase) ShareList(ctx context.Context, req *dto.ShareListRequest) (*dto.ListResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ListUsecase.ShareList")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("list.user_id", int(req.UserID)),
+		attribute.String("list.id", req.ListID),
+		attribute.Bool("list.shared", req.Shared),
+	)
+
+	if err := u.carts.ensureUserExists(ctx, req.UserID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	list, err := u.repo.GetList(ctx, req.UserID, req.ListID)
+	if err == nil && list.Kind != domain.ListKindWishlist {
+		err = domain.ErrListNotShareable
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	var token string
+	if req.Shared {
+		token, err = randomHex(16)
+		if err != nil {
+			err = fmt.Errorf("failed to generate share token: %w", err)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+
+	if err := u.repo.SetShareToken(ctx, req.UserID, req.ListID, token); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	list.ShareToken = token
+	return &u.priceLists(ctx, list)[0], nil
+}
+
+// GetSharedList returns a shared wishlist to anyone holding its token,
+// without its owner.
+func (u *ListUsecase) GetSharedList(ctx context.Context, shareToken string) (*dto.ListResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ListUsecase.GetSharedList")
+	defer span.End()
+
+	list, err := u.repo.GetSharedList(ctx, shareToken)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := u.priceLists(ctx, list)[0]
+	response.UserID = 0
+	return &response, nil
+}
+
+// loadList reads and prices a list. A saved-for-later list not created yet
+// reads as empty.
+func (u *ListUsecase) loadList(ctx context.Context, userID uint, listID string) (*dto.ListResponse, error) {
+	listID = resolveListID(listID)
+	list, err := u.repo.GetList(ctx, userID, listID)
+	if errors.Is(err, domain.ErrListNotFound) && listID == domain.SavedForLaterListID {
+		list, err = emptySavedList(userID), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &u.priceLists(ctx, list)[0], nil
+}
+
+func (u *ListUsecase) moveResponse(ctx context.Context, userID uint, listID string) (*dto.MoveItemResponse, error) {
+	list, err := u.loadList(ctx, userID, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	cart, err := u.carts.repo.GetCart(ctx, domain.CartOwner{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.MoveItemResponse{Cart: u.carts.priceCart(ctx, cart), List: list}, nil
+}
+
+// ensureSavedList creates the user's saved-for-later list when it is the
+// target of a write; other lists must already exist.
+func (u *ListUsecase) ensureSavedList(ctx context.Context, userID uint, listID string) error {
+	if listID != domain.SavedForLaterListID {
+		return nil
+	}
+	list := emptySavedList(userID)
+	list.CreatedAt = time.Now().UTC()
+	return u.repo.CreateList(ctx, list)
+}
+
+// priceLists describes the items of the lists with the current catalog in
+// batched ProductService calls. When the catalog cannot be reached the lists are
+// returned unpriced, flagged as such.
+func (u *ListUsecase) priceLists(ctx context.Context, lists ...domain.ItemList) []dto.ListResponse {
+	ctx, span := u.tracer.Start(ctx, "ListUsecase.PriceLists")
+	defer span.End()
+
+	responses := make([]dto.ListResponse, 0, len(lists))
+	productIDs := make([]uint, 0)
+	seen := make(map[uint]struct{})
+	for _, list := range lists {
+		responses = append(responses, *mapListToResponse(list))
+		for _, item := range list.Items {
+			if _, ok := seen[item.ProductID]; !ok {
+				seen[item.ProductID] = struct{}{}
+				productIDs = append(productIDs, item.ProductID)
+			}
+		}
+	}
+	if len(productIDs) == 0 {
+		return responses
+	}
+
+	products, _, err := u.carts.loadProducts(ctx, productIDs)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		for i := range responses {
+			responses[i].PricingUnavailable = len(responses[i].Items) > 0
+		}
+		return responses
+	}
+
+	for i := range responses {
+		for j := range responses[i].Items {
+			item := &responses[i].Items[j]
+			product, ok := products[item.ProductID]
+			if !ok {
+				item.Available = false
+				continue
+			}
+			catalog, err := resolveCatalogItem(product, item.VariantID)
+			if err != nil {
+				item.Available = false
+				continue
+			}
+			item.Name = catalog.name
+			item.ImageURL = catalog.imageURL
+			item.UnitPrice = catalog.unitPrice
+			item.StockStatus = string(stockStatus(catalog.stock, int32(item.Quantity), product.GetReorderThreshold()))
+		}
+	}
+	return responses
+}
+
+// resolveListID maps an empty list ID to the saved-for-later list.
+func resolveListID(listID string) string {
+	if listID == "" {
+		return domain.SavedForLaterListID
+	}
+	return listID
+}
+
+func emptySavedList(userID uint) domain.ItemList {
+	return domain.ItemList{
+		ID:     domain.SavedForLaterListID,
+		UserID: userID,
+		Name:   savedForLaterListName,
+		Kind:   domain.ListKindSavedForLater,
+	}
+}
+
+// randomHex returns n random bytes, hex encoded.
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func mapListToResponse(list domain.ItemList) *dto.ListResponse {
+	items := make([]dto.ListItemResponse, 0, len(list.Items))
+	var totalQty int
+	for _, item := range list.Items {
+		items = append(items, dto.ListItemResponse{
+			ProductID: item.ProductID,
+			VariantID: item.VariantID,
+			Quantity:  item.Quantity,
+			Available: true,
+		})
+		totalQty += item.Quantity
+	}
+
+	return &dto.ListResponse{
+		ID:            list.ID,
+		UserID:        list.UserID,
+		Name:          list.Name,
+		Kind:          string(list.Kind),
+		ShareToken:    list.ShareToken,
+		Items:         items,
+		TotalQuantity: totalQty,
+		CreatedAt:     list.CreatedAt,
+		UpdatedAt:     list.UpdatedAt,
+	}
+}
@@ -15,7 +15,10 @@ import (
 	"github.com/kareemhamed001/e-commerce/services/OrderService/config"
 	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/delivery/grpc/handler"
 	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/payment/fake"
 	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/repository/postgresql"
+	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/shipping"
+	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/tax"
 	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/usecase"
 	productpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/product"
 	userpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/user"
@@ -55,7 +58,7 @@ func main() {
 		panic("failed to connect database")
 	}
 
-	orderDB.AutoMigrate(&domain.Order{}, &domain.OrderItem{})
+	orderDB.AutoMigrate(&domain.Order{}, &domain.OrderItem{}, &domain.PaymentIntent{}, &domain.PaymentTransaction{})
 
 	productConn, err := grpc.NewClient(
 		config.ProductServiceGRPCAddr,
@@ -109,13 +112,27 @@ func main() {
 		_ = userConn.Close()
 	}()
 
+	shippingRates, err := newShippingRates(config.ShippingRatesFile)
+	if err != nil {
+		close(done)
+		panic(err)
+	}
+
+	taxRates, err := newTaxRates(config.TaxRatesFile)
+	if err != nil {
+		close(done)
+		panic(err)
+	}
+
 	orderRepo := postgresql.NewOrderRepository(orderDB)
+	paymentRepo := postgresql.NewPaymentRepository(orderDB)
 	productClient := productpb.NewProductServiceClient(productConn)
 	userClient := userpb.NewUserServiceClient(userConn)
-	orderUsecase := usecase.NewOrderUsecase(orderRepo, productClient, userClient)
+	orderUsecase := usecase.NewOrderUsecase(orderRepo, paymentRepo, productClient, userClient, shippingRates, taxRates)
+	paymentUsecase := usecase.NewPaymentUsecase(paymentRepo, orderRepo, newPaymentProvider(config.PaymentProvider, config.PaymentWebhookSecret), config.PaymentCurrency)
 
 	validate := validator.New()
-	grpcHandler := handler.NewOrderGRPCHandler(orderUsecase, validate, config.InternalAuthToken)
+	grpcHandler := handler.NewOrderGRPCHandler(orderUsecase, paymentUsecase, validate, config.InternalAuthToken)
 
 	if err := grpcHandler.Run(done, config.GRPCPort); err != nil {
 		logger.Errorf("failed to start gRPC server: %v", err)
@@ -132,6 +149,33 @@ func main() {
 	time.Sleep(200 * time.Millisecond)
 }
 
+// newShippingRates loads the shipping rate table from path, falling back to
+// the built-in table when no file is configured.
+func newShippingRates(path string) (*shipping.RateTable, error) {
+	if path == "" {
+		logger.Info("SHIPPING_RATES_FILE not set, using the default shipping rates")
+		return shipping.DefaultRateTable(), nil
+	}
+	return shipping.LoadRateTable(path)
+}
+
+// newTaxRates loads the tax rate table from path. Without one, orders are not
+// taxed.
+func newTaxRates(path string) (*tax.RateTable, error) {
+	if path == "" {
+		logger.Info("TAX_RATES_FILE not set, orders are not taxed")
+		return tax.NewRateTable(nil)
+	}
+	return tax.LoadRateTable(path)
+}
+
+// newPaymentProvider returns the configured payment provider. Only the fake
+// provider exists so far; config validation rejects any other name.
+func newPaymentProvider(name, webhookSecret string) domain.PaymentProvider {
+	logger.Infof("using the %s payment provider", name)
+	return fake.NewProvider(webhookSecret)
+}
+
 func initTracing(ctx context.Context) func() {
 	jaegerEndpoint := config.GetEnv("JAEGER_ENDPOINT", "ecommece_jaeger:4317")
 	tp, err := tracer.InitTracer(ctx, "order-service-grpc", jaegerEndpoint)
@@ -30,6 +30,15 @@ type Config struct {
 	ProductServiceGRPCAddr string
 	UserServiceGRPCAddr    string
 
+	// Shipping and tax
+	ShippingRatesFile string
+	TaxRatesFile      string
+
+	// Payments
+	PaymentProvider      string
+	PaymentWebhookSecret string
+	PaymentCurrency      string
+
 	// Service name
 	ServiceName string
 
@@ -86,6 +95,15 @@ func Load() (*Config, error) {
 		ProductServiceGRPCAddr: GetEnv("PRODUCT_SERVICE_GRPC_ADDR", "localhost:50053"),
 		UserServiceGRPCAddr:    GetEnv("USER_SERVICE_GRPC_ADDR", "localhost:50051"),
 
+		// Shipping and tax
+		ShippingRatesFile: GetEnv("SHIPPING_RATES_FILE", ""),
+		TaxRatesFile:      GetEnv("TAX_RATES_FILE", ""),
+
+		// Payments
+		PaymentProvider:      GetEnv("PAYMENT_PROVIDER", "fake"),
+		PaymentWebhookSecret: GetEnv("PAYMENT_WEBHOOK_SECRET", ""),
+		PaymentCurrency:      GetEnv("PAYMENT_CURRENCY", "USD"),
+
 		// Service
 		ServiceName: GetEnv("SERVICE_NAME", "order-service"),
 
@@ -137,6 +155,18 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("INTERNAL_AUTH_TOKEN is required")
 	}
 
+	if c.PaymentProvider != "fake" {
+		return fmt.Errorf("PAYMENT_PROVIDER %q is not supported; use fake", c.PaymentProvider)
+	}
+
+	if c.PaymentWebhookSecret == "" {
+		return fmt.Errorf("PAYMENT_WEBHOOK_SECRET is required")
+	}
+
+	if len(c.PaymentCurrency) != 3 {
+		return fmt.Errorf("PAYMENT_CURRENCY must be a 3-letter currency code")
+	}
+
 	return nil
 }
 
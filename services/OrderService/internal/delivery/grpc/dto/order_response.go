@@ -3,12 +3,17 @@ package dto
 import "time"
 
 type OrderItemResponse struct {
-	ID         uint    `json:"id"`
-	OrderID    uint    `json:"order_id"`
-	ProductID  uint    `json:"product_id"`
-	Quantity   int     `json:"quantity"`
-	UnitPrice  float32 `json:"unit_price"`
-	TotalPrice float32 `json:"total_price"`
+	ID          uint    `json:"id"`
+	OrderID     uint    `json:"order_id"`
+	ProductID   uint    `json:"product_id"`
+	VariantID   uint    `json:"variant_id,omitempty"`
+	SKU         string  `json:"sku,omitempty"`
+	Quantity    int     `json:"quantity"`
+	UnitPrice   float32 `json:"unit_price"`
+	TotalPrice  float32 `json:"total_price"`
+	TaxCategory string  `json:"tax_category,omitempty"`
+	TaxRate     float32 `json:"tax_rate"`
+	TaxAmount   float32 `json:"tax_amount"`
 }
 
 type OrderResponse struct {
@@ -16,10 +21,28 @@ type OrderResponse struct {
 	UserID           uint                `json:"user_id"`
 	ShippingCost     float32             `json:"shipping_cost"`
 	ShippingDuration int                 `json:"shipping_duration_days"`
+	ShippingOption   string              `json:"shipping_option,omitempty"`
 	Discount         float32             `json:"discount"`
+	Tax              float32             `json:"tax"`
+	TaxInclusive     bool                `json:"tax_inclusive"`
+	AddressID        uint                `json:"address_id,omitempty"`
+	WarehouseID      uint                `json:"warehouse_id,omitempty"`
 	Total            float32             `json:"total"`
 	Status           string              `json:"status"`
 	Items            []OrderItemResponse `json:"items"`
 	CreatedAt        time.Time           `json:"created_at"`
 	UpdatedAt        time.Time           `json:"updated_at"`
 }
+
+type ShippingOptionResponse struct {
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	Cost         float32 `json:"cost"`
+	DeliveryDays int     `json:"delivery_days"`
+	Free         bool    `json:"free"`
+}
+
+type ShippingOptionsResponse struct {
+	Zone    string                   `json:"zone"`
+	Options []ShippingOptionResponse `json:"options"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+type AuthorizePaymentRequest struct {
+	OrderID       uint   `json:"order_id" validate:"required,gt=0"`
+	UserID        uint   `json:"user_id" validate:"required,gt=0"`
+	PaymentMethod string `json:"payment_method" validate:"required,max=64"`
+}
+
+// RefundPaymentRequest refunds Amount of a captured payment, or whatever is
+// left of it when Amount is zero.
+type RefundPaymentRequest struct {
+	PaymentID uint    `json:"payment_id" validate:"required,gt=0"`
+	Amount    float32 `json:"amount" validate:"gte=0"`
+}
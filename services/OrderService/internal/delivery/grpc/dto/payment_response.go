@@ -0,0 +1,28 @@
+package dto
+
+import "time"
+
+type PaymentTransactionResponse struct {
+	ID            uint      `json:"id"`
+	Operation     string    `json:"operation"`
+	Amount        float32   `json:"amount"`
+	Succeeded     bool      `json:"succeeded"`
+	ProviderRef   string    `json:"provider_ref,omitempty"`
+	FailureReason string    `json:"failure_reason,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type PaymentResponse struct {
+	ID             uint                         `json:"id"`
+	OrderID        uint                         `json:"order_id"`
+	Provider       string                       `json:"provider"`
+	ProviderRef    string                       `json:"provider_ref"`
+	Amount         float32                      `json:"amount"`
+	Currency       string                       `json:"currency"`
+	Status         string                       `json:"status"`
+	AmountCaptured float32                      `json:"amount_captured"`
+	AmountRefunded float32                      `json:"amount_refunded"`
+	Transactions   []PaymentTransactionResponse `json:"transactions"`
+	CreatedAt      time.Time                    `json:"created_at"`
+	UpdatedAt      time.Time                    `json:"updated_at"`
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"net"
 	"time"
 
@@ -10,29 +11,34 @@ import (
 	"github.com/kareemhamed001/e-commerce/pkg/logger"
 	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/delivery/grpc/dto"
 	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/repository"
 	orderpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/order"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	grpcCodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type OrderGRPCHandler struct {
 	orderpb.UnimplementedOrderServiceServer
-	orderUsecase domain.OrderUsecase
-	validate     *validator.Validate
-	tracer       trace.Tracer
+	orderUsecase      domain.OrderUsecase
+	paymentUsecase    domain.PaymentUsecase
+	validate          *validator.Validate
+	tracer            trace.Tracer
 	internalAuthToken string
 }
 
 var _ orderpb.OrderServiceServer = (*OrderGRPCHandler)(nil)
 
-func NewOrderGRPCHandler(orderUsecase domain.OrderUsecase, validate *validator.Validate, internalAuthToken string) *OrderGRPCHandler {
+func NewOrderGRPCHandler(orderUsecase domain.OrderUsecase, paymentUsecase domain.PaymentUsecase, validate *validator.Validate, internalAuthToken string) *OrderGRPCHandler {
 	return &OrderGRPCHandler{
-		orderUsecase: orderUsecase,
-		validate:     validate,
-		tracer:       otel.Tracer("order_GRPC_handler"),
+		orderUsecase:      orderUsecase,
+		paymentUsecase:    paymentUsecase,
+		validate:          validate,
+		tracer:            otel.Tracer("order_GRPC_handler"),
 		internalAuthToken: internalAuthToken,
 	}
 }
@@ -41,20 +47,14 @@ func (h *OrderGRPCHandler) CreateOrder(ctx context.Context, req *orderpb.CreateO
 	reqCtx, span := h.tracer.Start(ctx, "OrderHandler.CreateOrder")
 	defer span.End()
 
-	items := make([]dto.OrderItemInput, 0, len(req.GetItems()))
-	for _, item := range req.GetItems() {
-		items = append(items, dto.OrderItemInput{
-			ProductID: uint(item.GetProductId()),
-			Quantity:  int(item.GetQuantity()),
-		})
-	}
+	items := mapOrderItemInputs(req.GetItems())
 
 	createReq := dto.CreateOrderRequest{
-		UserID:               uint(req.GetUserId()),
-		ShippingCost:         req.GetShippingCost(),
-		ShippingDurationDays: int(req.GetShippingDurationDays()),
-		Discount:             req.GetDiscount(),
-		Items:                items,
+		UserID:         uint(req.GetUserId()),
+		ShippingOption: req.GetShippingOption(),
+		Discount:       req.GetDiscount(),
+		AddressID:      uint(req.GetAddressId()),
+		Items:          items,
 	}
 
 	if err := h.validate.Struct(&createReq); err != nil {
@@ -67,13 +67,50 @@ func (h *OrderGRPCHandler) CreateOrder(ctx context.Context, req *orderpb.CreateO
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, err
+		return nil, grpcError(err)
 	}
 
 	span.SetAttributes(attribute.Int("order.id", int(order.ID)))
 	return &orderpb.CreateOrderResponse{Order: mapOrderToPB(order)}, nil
 }
 
+func (h *OrderGRPCHandler) GetShippingOptions(ctx context.Context, req *orderpb.GetShippingOptionsRequest) (*orderpb.GetShippingOptionsResponse, error) {
+	reqCtx, span := h.tracer.Start(ctx, "OrderHandler.GetShippingOptions")
+	defer span.End()
+
+	optionsReq := dto.ShippingOptionsRequest{
+		UserID:    uint(req.GetUserId()),
+		AddressID: uint(req.GetAddressId()),
+		Items:     mapOrderItemInputs(req.GetItems()),
+	}
+
+	if err := h.validate.Struct(&optionsReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	quote, err := h.orderUsecase.GetShippingOptions(reqCtx, &optionsReq)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, grpcError(err)
+	}
+
+	options := make([]*orderpb.ShippingOption, 0, len(quote.Options))
+	for _, option := range quote.Options {
+		options = append(options, &orderpb.ShippingOption{
+			Id:           option.ID,
+			Name:         option.Name,
+			Cost:         option.Cost,
+			DeliveryDays: int32(option.DeliveryDays),
+			Free:         option.Free,
+		})
+	}
+
+	return &orderpb.GetShippingOptionsResponse{Options: options, Zone: quote.Zone}, nil
+}
+
 func (h *OrderGRPCHandler) GetOrderByID(ctx context.Context, req *orderpb.GetOrderByIDRequest) (*orderpb.GetOrderByIDResponse, error) {
 	reqCtx, span := h.tracer.Start(ctx, "OrderHandler.GetOrderByID")
 	defer span.End()
@@ -132,6 +169,7 @@ func (h *OrderGRPCHandler) AddOrderItem(ctx context.Context, req *orderpb.AddOrd
 	addReq := dto.AddOrderItemRequest{
 		OrderID:   uint(req.GetOrderId()),
 		ProductID: uint(req.GetProductId()),
+		VariantID: uint(req.GetVariantId()),
 		Quantity:  int(req.GetQuantity()),
 	}
 
@@ -145,7 +183,7 @@ func (h *OrderGRPCHandler) AddOrderItem(ctx context.Context, req *orderpb.AddOrd
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, err
+		return nil, grpcError(err)
 	}
 
 	return &orderpb.AddOrderItemResponse{Order: mapOrderToPB(order)}, nil
@@ -159,7 +197,7 @@ func (h *OrderGRPCHandler) RemoveOrderItem(ctx context.Context, req *orderpb.Rem
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, err
+		return nil, grpcError(err)
 	}
 
 	return &orderpb.RemoveOrderItemResponse{Order: mapOrderToPB(order)}, nil
@@ -184,12 +222,26 @@ func (h *OrderGRPCHandler) UpdateOrderStatus(ctx context.Context, req *orderpb.U
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, err
+		return nil, grpcError(err)
 	}
 
 	return &orderpb.UpdateOrderStatusResponse{Order: mapOrderToPB(order)}, nil
 }
 
+func (h *OrderGRPCHandler) HasPurchasedProduct(ctx context.Context, req *orderpb.HasPurchasedProductRequest) (*orderpb.HasPurchasedProductResponse, error) {
+	reqCtx, span := h.tracer.Start(ctx, "OrderHandler.HasPurchasedProduct")
+	defer span.End()
+
+	purchased, err := h.orderUsecase.HasPurchasedProduct(reqCtx, uint(req.GetUserId()), uint(req.GetProductId()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	return &orderpb.HasPurchasedProductResponse{Purchased: purchased}, nil
+}
+
 func (h *OrderGRPCHandler) Run(done <-chan any, port string) error {
 	lis, err := net.Listen("tcp", ":"+port)
 	if err != nil {
@@ -216,6 +268,47 @@ func (h *OrderGRPCHandler) Run(done <-chan any, port string) error {
 	return nil
 }
 
+// grpcError gives errors clients are expected to act on their gRPC status
+// code; other errors are returned as they are.
+func grpcError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUnknownShippingOption),
+		errors.Is(err, domain.ErrNoShippingZone),
+		errors.Is(err, domain.ErrInvalidPaymentAmount),
+		errors.Is(err, domain.ErrPaidByCaptureOnly),
+		errors.Is(err, domain.ErrUnsupportedWebhookEvent):
+		return status.Error(grpcCodes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrPaymentDeclined),
+		errors.Is(err, domain.ErrPaymentInProgress),
+		errors.Is(err, domain.ErrPaymentStateChanged),
+		errors.Is(err, domain.ErrPaymentOperationPending),
+		errors.Is(err, domain.ErrInvalidPaymentState),
+		errors.Is(err, domain.ErrOrderNotPayable),
+		errors.Is(err, domain.ErrPaymentAmountMismatch),
+		errors.Is(err, domain.ErrCapturedOrderNotPaid),
+		errors.Is(err, domain.ErrOrderItemsLocked):
+		return status.Error(grpcCodes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrInvalidWebhookSignature):
+		return status.Error(grpcCodes.Unauthenticated, err.Error())
+	case errors.Is(err, repository.ErrOrderNotFound),
+		errors.Is(err, repository.ErrPaymentNotFound):
+		return status.Error(grpcCodes.NotFound, err.Error())
+	}
+	return err
+}
+
+func mapOrderItemInputs(inputs []*orderpb.OrderItemInput) []dto.OrderItemInput {
+	items := make([]dto.OrderItemInput, 0, len(inputs))
+	for _, item := range inputs {
+		items = append(items, dto.OrderItemInput{
+			ProductID: uint(item.GetProductId()),
+			VariantID: uint(item.GetVariantId()),
+			Quantity:  int(item.GetQuantity()),
+		})
+	}
+	return items
+}
+
 func mapOrderToPB(order *dto.OrderResponse) *orderpb.Order {
 	if order == nil {
 		return nil
@@ -224,12 +317,17 @@ func mapOrderToPB(order *dto.OrderResponse) *orderpb.Order {
 	items := make([]*orderpb.OrderItem, 0, len(order.Items))
 	for _, item := range order.Items {
 		items = append(items, &orderpb.OrderItem{
-			Id:         int64(item.ID),
-			OrderId:    int64(item.OrderID),
-			ProductId:  int64(item.ProductID),
-			Quantity:   int32(item.Quantity),
-			UnitPrice:  item.UnitPrice,
-			TotalPrice: item.TotalPrice,
+			Id:          int64(item.ID),
+			OrderId:     int64(item.OrderID),
+			ProductId:   int64(item.ProductID),
+			VariantId:   int64(item.VariantID),
+			Sku:         item.SKU,
+			Quantity:    int32(item.Quantity),
+			UnitPrice:   item.UnitPrice,
+			TotalPrice:  item.TotalPrice,
+			TaxCategory: item.TaxCategory,
+			TaxRate:     item.TaxRate,
+			TaxAmount:   item.TaxAmount,
 		})
 	}
 
@@ -238,12 +336,17 @@ func mapOrderToPB(order *dto.OrderResponse) *orderpb.Order {
 		UserId:               int64(order.UserID),
 		ShippingCost:         order.ShippingCost,
 		ShippingDurationDays: int32(order.ShippingDuration),
+		ShippingOption:       order.ShippingOption,
 		Discount:             order.Discount,
+		Tax:                  order.Tax,
+		TaxInclusive:         order.TaxInclusive,
 		Total:                order.Total,
 		Status:               order.Status,
 		Items:                items,
 		CreatedAt:            formatTime(order.CreatedAt),
 		UpdatedAt:            formatTime(order.UpdatedAt),
+		AddressId:            int64(order.AddressID),
+		WarehouseId:          int64(order.WarehouseID),
 	}
 }
 
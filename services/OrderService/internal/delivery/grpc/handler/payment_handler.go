@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/delivery/grpc/dto"
+	orderpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/order"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (h *OrderGRPCHandler) AuthorizePayment(ctx context.Context, req *orderpb.AuthorizePaymentRequest) (*orderpb.PaymentResponse, error) {
+	reqCtx, span := h.tracer.Start(ctx, "OrderHandler.AuthorizePayment")
+	defer span.End()
+
+	authorizeReq := dto.AuthorizePaymentRequest{
+		OrderID:       uint(req.GetOrderId()),
+		UserID:        uint(req.GetUserId()),
+		PaymentMethod: req.GetPaymentMethod(),
+	}
+
+	if err := h.validate.Struct(&authorizeReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	payment, err := h.paymentUsecase.AuthorizePayment(reqCtx, &authorizeReq)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, grpcError(err)
+	}
+
+	span.SetAttributes(attribute.Int("payment.id", int(payment.ID)))
+	return &orderpb.PaymentResponse{Payment: mapPaymentToPB(payment)}, nil
+}
+
+func (h *OrderGRPCHandler) CapturePayment(ctx context.Context, req *orderpb.CapturePaymentRequest) (*orderpb.PaymentResponse, error) {
+	reqCtx, span := h.tracer.Start(ctx, "OrderHandler.CapturePayment")
+	defer span.End()
+
+	payment, err := h.paymentUsecase.CapturePayment(reqCtx, uint(req.GetPaymentId()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, grpcError(err)
+	}
+
+	return &orderpb.PaymentResponse{Payment: mapPaymentToPB(payment)}, nil
+}
+
+func (h *OrderGRPCHandler) VoidPayment(ctx context.Context, req *orderpb.VoidPaymentRequest) (*orderpb.PaymentResponse, error) {
+	reqCtx, span := h.tracer.Start(ctx, "OrderHandler.VoidPayment")
+	defer span.End()
+
+	payment, err := h.paymentUsecase.VoidPayment(reqCtx, uint(req.GetPaymentId()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, grpcError(err)
+	}
+
+	return &orderpb.PaymentResponse{Payment: mapPaymentToPB(payment)}, nil
+}
+
+func (h *OrderGRPCHandler) RefundPayment(ctx context.Context, req *orderpb.RefundPaymentRequest) (*orderpb.PaymentResponse, error) {
+	reqCtx, span := h.tracer.Start(ctx, "OrderHandler.RefundPayment")
+	defer span.End()
+
+	refundReq := dto.RefundPaymentRequest{
+		PaymentID: uint(req.GetPaymentId()),
+		Amount:    req.GetAmount(),
+	}
+
+	if err := h.validate.Struct(&refundReq); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, err
+	}
+
+	payment, err := h.paymentUsecase.RefundPayment(reqCtx, &refundReq)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, grpcError(err)
+	}
+
+	return &orderpb.PaymentResponse{Payment: mapPaymentToPB(payment)}, nil
+}
+
+func (h *OrderGRPCHandler) ListOrderPayments(ctx context.Context, req *orderpb.ListOrderPaymentsRequest) (*orderpb.ListOrderPaymentsResponse, error) {
+	reqCtx, span := h.tracer.Start(ctx, "OrderHandler.ListOrderPayments")
+	defer span.End()
+
+	var userID *uint
+	if req.GetUserId() > 0 {
+		id := uint(req.GetUserId())
+		userID = &id
+	}
+
+	payments, err := h.paymentUsecase.ListOrderPayments(reqCtx, uint(req.GetOrderId()), userID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, grpcError(err)
+	}
+
+	response := make([]*orderpb.Payment, 0, len(payments))
+	for i := range payments {
+		response = append(response, mapPaymentToPB(&payments[i]))
+	}
+
+	return &orderpb.ListOrderPaymentsResponse{Payments: response}, nil
+}
+
+func (h *OrderGRPCHandler) HandlePaymentWebhook(ctx context.Context, req *orderpb.HandlePaymentWebhookRequest) (*orderpb.HandlePaymentWebhookResponse, error) {
+	reqCtx, span := h.tracer.Start(ctx, "OrderHandler.HandlePaymentWebhook")
+	defer span.End()
+
+	if err := h.paymentUsecase.HandleWebhook(reqCtx, req.GetPayload(), req.GetSignature()); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, grpcError(err)
+	}
+
+	return &orderpb.HandlePaymentWebhookResponse{Success: true}, nil
+}
+
+func mapPaymentToPB(payment *dto.PaymentResponse) *orderpb.Payment {
+	if payment == nil {
+		return nil
+	}
+
+	transactions := make([]*orderpb.PaymentTransaction, 0, len(payment.Transactions))
+	for _, transaction := range payment.Transactions {
+		transactions = append(transactions, &orderpb.PaymentTransaction{
+			Id:            int64(transaction.ID),
+			Operation:     transaction.Operation,
+			Amount:        transaction.Amount,
+			Succeeded:     transaction.Succeeded,
+			ProviderRef:   transaction.ProviderRef,
+			FailureReason: transaction.FailureReason,
+			CreatedAt:     formatTime(transaction.CreatedAt),
+		})
+	}
+
+	return &orderpb.Payment{
+		Id:             int64(payment.ID),
+		OrderId:        int64(payment.OrderID),
+		Provider:       payment.Provider,
+		ProviderRef:    payment.ProviderRef,
+		Amount:         payment.Amount,
+		Currency:       payment.Currency,
+		Status:         payment.Status,
+		AmountCaptured: payment.AmountCaptured,
+		AmountRefunded: payment.AmountRefunded,
+		Transactions:   transactions,
+		CreatedAt:      formatTime(payment.CreatedAt),
+		UpdatedAt:      formatTime(payment.UpdatedAt),
+	}
+}
@@ -17,17 +17,32 @@ type Order struct {
 	UserID               uint        `json:"user_id"`
 	ShippingCost         float32     `json:"shipping_cost"`
 	ShippingDurationDays int         `json:"shipping_duration_days"`
+	ShippingOption       string      `gorm:"type:varchar(64);not null;default:''" json:"shipping_option"`
 	Discount             float32     `json:"discount"`
+	Tax                  float32     `json:"tax"`
+	TaxInclusive         bool        `json:"tax_inclusive"`
+	AddressID            uint        `json:"address_id"`
+	WarehouseID          uint        `json:"warehouse_id"`
 	Total                float32     `json:"total"`
 	Status               OrderStatus `gorm:"type:varchar(20);not null;default:'pending'" json:"status"`
 	Items                []OrderItem `gorm:"foreignKey:OrderID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	// StockReserved is set while the order's items are taken out of stock,
+	// in its warehouse or in unassigned stock when WarehouseID is 0.
+	StockReserved bool `gorm:"not null;default:false" json:"stock_reserved"`
 }
 
 type OrderItem struct {
 	gorm.Model
 	OrderID    uint    `json:"order_id"`
 	ProductID  uint    `json:"product_id"`
+	VariantID  uint    `json:"variant_id"`
+	SKU        string  `json:"sku"`
 	Quantity   int     `json:"quantity"`
 	UnitPrice  float32 `json:"unit_price"`
 	TotalPrice float32 `json:"total_price"`
-}
\ No newline at end of file
+	// TaxCategory is the product's tax category when the item was priced;
+	// TaxRate and TaxAmount are the tax of the item's total price.
+	TaxCategory string  `gorm:"type:varchar(32);not null;default:''" json:"tax_category"`
+	TaxRate     float32 `json:"tax_rate"`
+	TaxAmount   float32 `json:"tax_amount"`
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+var (
+	ErrPaymentDeclined         = errors.New("payment declined")
+	ErrPaymentInProgress       = errors.New("order already has a pending or authorized payment")
+	ErrPaymentStateChanged     = errors.New("payment was changed concurrently")
+	ErrPaymentOperationPending = errors.New("another operation on the payment is in progress")
+	ErrInvalidPaymentState     = errors.New("payment cannot do this in its current state")
+	ErrInvalidPaymentAmount    = errors.New("invalid payment amount")
+	ErrOrderNotPayable         = errors.New("order is not awaiting payment")
+	ErrPaymentAmountMismatch   = errors.New("payment does not cover the order total")
+	ErrOrderItemsLocked        = errors.New("order items can only change while the order is pending without an authorized payment")
+	ErrPaidByCaptureOnly       = errors.New("orders become paid only when a payment is captured")
+	ErrCapturedOrderNotPaid    = errors.New("payment captured but the order was not marked paid")
+	ErrInvalidWebhookSignature = errors.New("invalid webhook signature")
+	ErrDuplicateWebhookEvent   = errors.New("webhook event already processed")
+	ErrUnsupportedWebhookEvent = errors.New("unsupported webhook event")
+)
+
+// PaymentAmountTolerance absorbs float rounding when comparing payment
+// amounts.
+const PaymentAmountTolerance = 0.005
+
+type PaymentStatus string
+
+const (
+	// PaymentStatusPending is an authorization the provider has not answered
+	// yet.
+	PaymentStatusPending PaymentStatus = "pending"
+	// PaymentStatusAuthorized holds the amount on the customer's payment
+	// method until it is captured or voided.
+	PaymentStatusAuthorized        PaymentStatus = "authorized"
+	PaymentStatusCaptured          PaymentStatus = "captured"
+	PaymentStatusVoided            PaymentStatus = "voided"
+	PaymentStatusPartiallyRefunded PaymentStatus = "partially_refunded"
+	PaymentStatusRefunded          PaymentStatus = "refunded"
+	// PaymentStatusFailed is a declined authorization.
+	PaymentStatusFailed PaymentStatus = "failed"
+)
+
+// HoldsOrder reports whether a payment in this status stands for the order's
+// current total, so the order may have no other such payment and its items
+// may not change.
+func (s PaymentStatus) HoldsOrder() bool {
+	return s == PaymentStatusPending || s == PaymentStatusAuthorized
+}
+
+type PaymentOperation string
+
+const (
+	PaymentOperationAuthorize PaymentOperation = "authorize"
+	PaymentOperationCapture   PaymentOperation = "capture"
+	PaymentOperationVoid      PaymentOperation = "void"
+	PaymentOperationRefund    PaymentOperation = "refund"
+)
+
+// PaymentIntent is one attempt to pay for an order through a provider.
+// Captured and refunded amounts follow the transactions applied to it.
+// InFlight is the operation the provider is being asked for, since
+// InFlightAt; the outcome is applied once the provider answers.
+type PaymentIntent struct {
+	gorm.Model
+	OrderID        uint                 `gorm:"not null;index" json:"order_id"`
+	Provider       string               `gorm:"type:varchar(32);not null;index:idx_payment_intents_provider_ref" json:"provider"`
+	ProviderRef    string               `gorm:"type:varchar(128);not null;index:idx_payment_intents_provider_ref" json:"provider_ref"`
+	Amount         float32              `gorm:"not null" json:"amount"`
+	Currency       string               `gorm:"type:varchar(3);not null" json:"currency"`
+	Status         PaymentStatus        `gorm:"type:varchar(20);not null" json:"status"`
+	AmountCaptured float32              `gorm:"not null;default:0" json:"amount_captured"`
+	AmountRefunded float32              `gorm:"not null;default:0" json:"amount_refunded"`
+	InFlight       PaymentOperation     `gorm:"type:varchar(20);not null;default:''" json:"in_flight,omitempty"`
+	InFlightAt     *time.Time           `json:"in_flight_at,omitempty"`
+	Transactions   []PaymentTransaction `gorm:"foreignKey:PaymentIntentID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;" json:"transactions"`
+}
+
+// PaymentTransaction records one provider operation on an intent, whether it
+// was requested through the API or reported by a provider webhook. EventID is
+// the webhook event that reported it, so each event is applied once.
+type PaymentTransaction struct {
+	ID              uint             `gorm:"primarykey" json:"id"`
+	PaymentIntentID uint             `gorm:"not null;index" json:"payment_intent_id"`
+	Operation       PaymentOperation `gorm:"type:varchar(20);not null" json:"operation"`
+	Amount          float32          `gorm:"not null" json:"amount"`
+	Succeeded       bool             `gorm:"not null" json:"succeeded"`
+	ProviderRef     string           `gorm:"type:varchar(128);not null;default:''" json:"provider_ref"`
+	FailureReason   string           `gorm:"type:text;not null;default:''" json:"failure_reason"`
+	EventID         *string          `gorm:"type:varchar(128);uniqueIndex" json:"event_id,omitempty"`
+	CreatedAt       time.Time        `json:"created_at"`
+}
+
+// ProviderResult is a provider's answer to an operation. A declined operation
+// is a result with Succeeded false, not an error; errors mean the provider
+// could not be asked.
+type ProviderResult struct {
+	Succeeded     bool
+	Reference     string
+	FailureReason string
+}
+
+// AuthorizeRequest asks for an authorization. IdempotencyKey, like the key
+// passed to the other provider calls, makes the provider answer a repeated
+// request with the outcome of the first instead of running it again.
+type AuthorizeRequest struct {
+	IdempotencyKey string
+	OrderID        uint
+	Amount         float32
+	Currency       string
+	PaymentMethod  string
+}
+
+// WebhookEvent is a provider notification about an operation on a payment,
+// such as a capture or refund made on the provider's side. ProviderRef
+// identifies the payment and OperationRef the operation itself, which is how
+// an event about an operation already recorded is recognized.
+type WebhookEvent struct {
+	ID            string
+	Operation     PaymentOperation
+	ProviderRef   string
+	OperationRef  string
+	Amount        float32
+	Succeeded     bool
+	FailureReason string
+}
+
+// PaymentProvider moves money through a payment processor. References
+// returned by Authorize identify the payment in the later calls.
+type PaymentProvider interface {
+	Name() string
+	Authorize(ctx context.Context, req AuthorizeRequest) (ProviderResult, error)
+	Capture(ctx context.Context, idempotencyKey, reference string, amount float32) (ProviderResult, error)
+	Void(ctx context.Context, idempotencyKey, reference string) (ProviderResult, error)
+	Refund(ctx context.Context, idempotencyKey, reference string, amount float32) (ProviderResult, error)
+	// ParseWebhook verifies the signature of a webhook payload and decodes
+	// it, returning ErrInvalidWebhookSignature when it does not match.
+	ParseWebhook(payload []byte, signature string) (WebhookEvent, error)
+}
@@ -0,0 +1,39 @@
+package domain
+
+import "errors"
+
+var (
+	ErrNoShippingZone        = errors.New("shipping is not available to this destination")
+	ErrUnknownShippingOption = errors.New("unknown shipping option")
+)
+
+// Shipment is what shipping is priced on: where the items go, what they cost
+// before discounts and how many units there are.
+type Shipment struct {
+	Country  string
+	Subtotal float32
+	Quantity int
+}
+
+type ShippingOption struct {
+	ID           string
+	Name         string
+	Cost         float32
+	DeliveryDays int
+	// Free reports that the shipment reached the free-shipping threshold.
+	Free bool
+}
+
+// ShippingQuote lists the options of the zone covering a shipment.
+type ShippingQuote struct {
+	Zone    string
+	Options []ShippingOption
+}
+
+// ShippingCalculator prices shipments. Options are identified by an ID that
+// is stable across calls, so a client can pick one from a quote and the order
+// is priced again with it.
+type ShippingCalculator interface {
+	Quote(shipment Shipment) (ShippingQuote, error)
+	Option(shipment Shipment, optionID string) (ShippingOption, error)
+}
@@ -0,0 +1,36 @@
+package domain
+
+// DefaultTaxCategory is the tax category of products that do not name one.
+const DefaultTaxCategory = "standard"
+
+// TaxDestination is where an order ships to, which decides the tax rates.
+type TaxDestination struct {
+	Country string
+	State   string
+}
+
+// TaxableItem is an order line as tax sees it: its tax category and what the
+// line costs.
+type TaxableItem struct {
+	TaxCategory string
+	Amount      float32
+}
+
+type ItemTax struct {
+	Rate   float32
+	Amount float32
+}
+
+// OrderTax is the tax of each line, in the order of the items, and their
+// total. Inclusive reports that the prices already contain the tax, so it is
+// not added to the order total.
+type OrderTax struct {
+	Items     []ItemTax
+	Total     float32
+	Inclusive bool
+}
+
+// TaxCalculator computes the tax of order lines shipped to a destination.
+type TaxCalculator interface {
+	Tax(destination TaxDestination, items []TaxableItem) OrderTax
+}
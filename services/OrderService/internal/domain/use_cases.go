@@ -8,11 +8,13 @@ import (
 
 type OrderUsecase interface {
 	CreateOrder(ctx context.Context, req *dto.CreateOrderRequest) (*dto.OrderResponse, error)
+	GetShippingOptions(ctx context.Context, req *dto.ShippingOptionsRequest) (*dto.ShippingOptionsResponse, error)
 	GetOrderByID(ctx context.Context, id uint) (*dto.OrderResponse, error)
 	ListOrders(ctx context.Context, userID *uint, page, perPage int) ([]dto.OrderResponse, int, error)
 	AddOrderItem(ctx context.Context, req *dto.AddOrderItemRequest) (*dto.OrderResponse, error)
 	RemoveOrderItem(ctx context.Context, orderID, itemID uint) (*dto.OrderResponse, error)
 	UpdateOrderStatus(ctx context.Context, orderID uint, status string) (*dto.OrderResponse, error)
+	HasPurchasedProduct(ctx context.Context, userID, productID uint) (bool, error)
 }
 
 type OrderRepository interface {
@@ -22,5 +24,43 @@ type OrderRepository interface {
 	AddOrderItem(ctx context.Context, item *OrderItem) error
 	RemoveOrderItem(ctx context.Context, orderID, itemID uint) error
 	UpdateOrderStatus(ctx context.Context, orderID uint, status OrderStatus) error
-	UpdateOrderTotal(ctx context.Context, orderID uint, total float32) error
-}
\ No newline at end of file
+	UpdateOrderTotals(ctx context.Context, orderID uint, shippingCost, tax, total float32) error
+	// SetStockReserved flips the order's StockReserved flag and reports
+	// whether this call changed it, so only one caller returns the stock.
+	SetStockReserved(ctx context.Context, orderID uint, reserved bool) (bool, error)
+	HasDeliveredOrderWithProduct(ctx context.Context, userID, productID uint) (bool, error)
+}
+
+type PaymentUsecase interface {
+	AuthorizePayment(ctx context.Context, req *dto.AuthorizePaymentRequest) (*dto.PaymentResponse, error)
+	CapturePayment(ctx context.Context, paymentID uint) (*dto.PaymentResponse, error)
+	VoidPayment(ctx context.Context, paymentID uint) (*dto.PaymentResponse, error)
+	RefundPayment(ctx context.Context, req *dto.RefundPaymentRequest) (*dto.PaymentResponse, error)
+	ListOrderPayments(ctx context.Context, orderID uint, userID *uint) ([]dto.PaymentResponse, error)
+	HandleWebhook(ctx context.Context, payload []byte, signature string) error
+}
+
+type PaymentRepository interface {
+	// CreateIntent returns ErrPaymentInProgress when the order already has a
+	// payment that holds it.
+	CreateIntent(ctx context.Context, intent *PaymentIntent) error
+	GetIntent(ctx context.Context, id uint) (*PaymentIntent, error)
+	GetIntentByProviderRef(ctx context.Context, provider, reference string) (*PaymentIntent, error)
+	ListIntentsByOrder(ctx context.Context, orderID uint) ([]PaymentIntent, error)
+	// RecordTransaction saves the intent's new state, provided it is still in
+	// status from, together with the transaction that led to it. With
+	// markOrderPaid the pending order becomes paid in the same database
+	// transaction, provided the captured amount covers its total; orderPaid
+	// reports whether it did. An order left unpaid does not roll the
+	// transaction back, since the money has already moved.
+	RecordTransaction(ctx context.Context, intent *PaymentIntent, from PaymentStatus, transaction *PaymentTransaction, markOrderPaid bool) (orderPaid bool, err error)
+	WebhookEventSeen(ctx context.Context, eventID string) (bool, error)
+	// SetInFlight marks operation as in flight on the intent, from now, or
+	// clears the mark when operation is empty.
+	SetInFlight(ctx context.Context, intent *PaymentIntent, operation PaymentOperation) error
+	// LockIntent runs fn in one database transaction holding a row lock on
+	// the intent, so changes to a payment are made one at a time. fn gets the
+	// locked intent and a repository working inside that transaction; an
+	// error from fn rolls the transaction back.
+	LockIntent(ctx context.Context, id uint, fn func(ctx context.Context, repo PaymentRepository, intent *PaymentIntent) error) error
+}
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table order_items
+    add column variant_id int not null default 0,
+    add column sku varchar(64) not null default '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table order_items
+    drop column sku,
+    drop column variant_id;
+-- +goose StatementEnd
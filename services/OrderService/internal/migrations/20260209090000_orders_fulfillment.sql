@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table orders
+    add column address_id int not null default 0,
+    add column warehouse_id int not null default 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table orders
+    drop column warehouse_id,
+    drop column address_id;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table orders
+    add column shipping_option varchar(64) not null default '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table orders
+    drop column shipping_option;
+-- +goose StatementEnd
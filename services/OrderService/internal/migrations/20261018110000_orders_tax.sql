@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table orders
+    add column tax float not null default 0,
+    add column tax_inclusive boolean not null default false;
+
+alter table order_items
+    add column tax_category varchar(32) not null default '',
+    add column tax_rate float not null default 0,
+    add column tax_amount float not null default 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table order_items
+    drop column tax_amount,
+    drop column tax_rate,
+    drop column tax_category;
+
+alter table orders
+    drop column tax_inclusive,
+    drop column tax;
+-- +goose StatementEnd
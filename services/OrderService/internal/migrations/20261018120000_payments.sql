@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+create table payment_intents (
+    id serial primary key,
+    order_id int not null references orders(id) on delete cascade,
+    provider varchar(32) not null,
+    provider_ref varchar(128) not null,
+    amount float not null,
+    currency varchar(3) not null,
+    status varchar(20) not null,
+    amount_captured float not null default 0,
+    amount_refunded float not null default 0,
+    created_at timestamp with time zone default current_timestamp,
+    updated_at timestamp with time zone default current_timestamp,
+    deleted_at timestamp with time zone
+);
+
+create index idx_payment_intents_order_id on payment_intents (order_id);
+create index idx_payment_intents_provider_ref on payment_intents (provider, provider_ref);
+
+create table payment_transactions (
+    id serial primary key,
+    payment_intent_id int not null references payment_intents(id) on delete cascade,
+    operation varchar(20) not null,
+    amount float not null,
+    succeeded boolean not null,
+    provider_ref varchar(128) not null default '',
+    failure_reason text not null default '',
+    event_id varchar(128),
+    created_at timestamp with time zone default current_timestamp
+);
+
+create index idx_payment_transactions_payment_intent_id on payment_transactions (payment_intent_id);
+create unique index idx_payment_transactions_event_id on payment_transactions (event_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table payment_transactions;
+drop table payment_intents;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table orders
+    add column stock_reserved boolean not null default false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table orders
+    drop column stock_reserved;
+-- +goose StatementEnd
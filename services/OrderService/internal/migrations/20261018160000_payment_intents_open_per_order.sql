@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- An order has at most one payment being authorized or holding an
+-- authorization, so concurrent AuthorizePayment calls cannot both go through.
+create unique index idx_payment_intents_open_order on payment_intents (order_id)
+    where status in ('pending', 'authorized') and deleted_at is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index idx_payment_intents_open_order;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table payment_intents
+    add column in_flight varchar(20) not null default '',
+    add column in_flight_at timestamp with time zone;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table payment_intents
+    drop column in_flight_at,
+    drop column in_flight;
+-- +goose StatementEnd
@@ -0,0 +1,207 @@
+package fake
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/payment"
+)
+
+const (
+	ProviderName = "fake"
+
+	// DeclinedPaymentMethod is the payment method the provider declines,
+	// to try the failure paths; every other payment method is approved.
+	DeclinedPaymentMethod = "fake_declined"
+)
+
+// Provider is a payment provider that moves no money. It keeps payments in
+// memory, so it suits tests and local development only: payments authorized
+// before a restart can no longer be captured or voided. Results are kept by
+// idempotency key, and a repeated key gets the first result back.
+type Provider struct {
+	mu            sync.Mutex
+	webhookSecret []byte
+	payments      map[string]*fakePayment
+	results       map[string]domain.ProviderResult
+}
+
+type fakePayment struct {
+	amount   float32
+	captured float32
+	refunded float32
+	voided   bool
+}
+
+var _ domain.PaymentProvider = (*Provider)(nil)
+
+func NewProvider(webhookSecret string) *Provider {
+	return &Provider{
+		webhookSecret: []byte(webhookSecret),
+		payments:      make(map[string]*fakePayment),
+		results:       make(map[string]domain.ProviderResult),
+	}
+}
+
+func (p *Provider) Name() string {
+	return ProviderName
+}
+
+func (p *Provider) Authorize(ctx context.Context, req domain.AuthorizeRequest) (domain.ProviderResult, error) {
+	return p.once(req.IdempotencyKey, func() domain.ProviderResult {
+		return p.authorize(req)
+	}), nil
+}
+
+func (p *Provider) authorize(req domain.AuthorizeRequest) domain.ProviderResult {
+	reference := newReference("pay")
+	if req.PaymentMethod == DeclinedPaymentMethod {
+		return declined(reference, "card declined")
+	}
+	if req.Amount <= 0 {
+		return declined(reference, "amount must be positive")
+	}
+
+	p.payments[reference] = &fakePayment{amount: req.Amount}
+	return domain.ProviderResult{Succeeded: true, Reference: reference}
+}
+
+func (p *Provider) Capture(ctx context.Context, idempotencyKey, reference string, amount float32) (domain.ProviderResult, error) {
+	return p.once(idempotencyKey, func() domain.ProviderResult {
+		return p.capture(reference, amount)
+	}), nil
+}
+
+func (p *Provider) capture(reference string, amount float32) domain.ProviderResult {
+	operation := newReference("cap")
+	payment, ok := p.payments[reference]
+	switch {
+	case !ok:
+		return declined(operation, "unknown payment")
+	case payment.voided || payment.captured > 0:
+		return declined(operation, "payment is not authorized")
+	case amount <= 0 || amount > payment.amount:
+		return declined(operation, "amount exceeds the authorization")
+	}
+
+	payment.captured = amount
+	return domain.ProviderResult{Succeeded: true, Reference: operation}
+}
+
+func (p *Provider) Void(ctx context.Context, idempotencyKey, reference string) (domain.ProviderResult, error) {
+	return p.once(idempotencyKey, func() domain.ProviderResult {
+		return p.void(reference)
+	}), nil
+}
+
+func (p *Provider) void(reference string) domain.ProviderResult {
+	operation := newReference("void")
+	payment, ok := p.payments[reference]
+	switch {
+	case !ok:
+		return declined(operation, "unknown payment")
+	case payment.voided || payment.captured > 0:
+		return declined(operation, "payment is not authorized")
+	}
+
+	payment.voided = true
+	return domain.ProviderResult{Succeeded: true, Reference: operation}
+}
+
+func (p *Provider) Refund(ctx context.Context, idempotencyKey, reference string, amount float32) (domain.ProviderResult, error) {
+	return p.once(idempotencyKey, func() domain.ProviderResult {
+		return p.refund(reference, amount)
+	}), nil
+}
+
+func (p *Provider) refund(reference string, amount float32) domain.ProviderResult {
+	operation := newReference("ref")
+	payment, ok := p.payments[reference]
+	switch {
+	case !ok:
+		return declined(operation, "unknown payment")
+	case amount <= 0 || payment.refunded+amount > payment.captured:
+		return declined(operation, "amount exceeds the captured amount")
+	}
+
+	payment.refunded += amount
+	return domain.ProviderResult{Succeeded: true, Reference: operation}
+}
+
+// once runs an operation under the provider's lock, unless its idempotency
+// key was seen before, in which case the earlier result is returned. An
+// empty key is never remembered.
+func (p *Provider) once(idempotencyKey string, operation func() domain.ProviderResult) domain.ProviderResult {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if result, ok := p.results[idempotencyKey]; ok && idempotencyKey != "" {
+		return result
+	}
+	result := operation()
+	if idempotencyKey != "" {
+		p.results[idempotencyKey] = result
+	}
+	return result
+}
+
+// webhookPayload is the body of the provider's webhooks, signed with the
+// webhook secret.
+type webhookPayload struct {
+	ID            string  `json:"id"`
+	Type          string  `json:"type"`
+	PaymentRef    string  `json:"payment_ref"`
+	Reference     string  `json:"reference"`
+	Amount        float32 `json:"amount"`
+	Succeeded     bool    `json:"succeeded"`
+	FailureReason string  `json:"failure_reason"`
+}
+
+func (p *Provider) ParseWebhook(payload []byte, signature string) (domain.WebhookEvent, error) {
+	if !payment.VerifySignature(p.webhookSecret, payload, signature) {
+		return domain.WebhookEvent{}, domain.ErrInvalidWebhookSignature
+	}
+
+	var body webhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return domain.WebhookEvent{}, fmt.Errorf("%w: %v", domain.ErrUnsupportedWebhookEvent, err)
+	}
+	if body.ID == "" || body.PaymentRef == "" {
+		return domain.WebhookEvent{}, fmt.Errorf("%w: id and payment_ref are required", domain.ErrUnsupportedWebhookEvent)
+	}
+
+	event := domain.WebhookEvent{
+		ID:            body.ID,
+		ProviderRef:   body.PaymentRef,
+		OperationRef:  body.Reference,
+		Amount:        body.Amount,
+		Succeeded:     body.Succeeded,
+		FailureReason: body.FailureReason,
+	}
+	switch body.Type {
+	case "payment.captured":
+		event.Operation = domain.PaymentOperationCapture
+	case "payment.voided":
+		event.Operation = domain.PaymentOperationVoid
+	case "payment.refunded":
+		event.Operation = domain.PaymentOperationRefund
+	default:
+		return domain.WebhookEvent{}, fmt.Errorf("%w: %q", domain.ErrUnsupportedWebhookEvent, body.Type)
+	}
+	return event, nil
+}
+
+func declined(reference, reason string) domain.ProviderResult {
+	return domain.ProviderResult{Reference: reference, FailureReason: reason}
+}
+
+func newReference(prefix string) string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return prefix + "_" + hex.EncodeToString(b)
+}
@@ -0,0 +1,27 @@
+package payment
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Sign returns the hex-encoded HMAC-SHA256 of a webhook payload, the
+// signature providers send along with it.
+func Sign(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether signature is the signature of payload, in
+// constant time.
+func VerifySignature(secret, payload []byte, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || len(secret) == 0 {
+		return false
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -5,6 +5,7 @@ import "errors"
 var (
 	ErrOrderNotFound       = errors.New("order not found")
 	ErrOrderItemNotFound   = errors.New("order item not found")
+	ErrPaymentNotFound     = errors.New("payment not found")
 	ErrDatabaseConnection  = errors.New("database connection error")
 	ErrDatabaseQuery       = errors.New("database query failed")
 	ErrForeignKeyViolation = errors.New("related record not found")
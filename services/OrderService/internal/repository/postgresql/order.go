@@ -155,11 +155,11 @@ func (r *OrderRepository) UpdateOrderStatus(ctx context.Context, orderID uint, s
 	return nil
 }
 
-func (r *OrderRepository) UpdateOrderTotal(ctx context.Context, orderID uint, total float32) error {
-	ctx, span := r.tracer.Start(ctx, "OrderRepository.UpdateOrderTotal")
+func (r *OrderRepository) UpdateOrderTotals(ctx context.Context, orderID uint, shippingCost, tax, total float32) error {
+	ctx, span := r.tracer.Start(ctx, "OrderRepository.UpdateOrderTotals")
 	defer span.End()
 
-	result := r.db.WithContext(ctx).Model(&domain.Order{}).Where("id = ?", orderID).Update("total", total)
+	result := r.db.WithContext(ctx).Model(&domain.Order{}).Where("id = ?", orderID).Updates(map[string]any{"shipping_cost": shippingCost, "tax": tax, "total": total})
 	if result.Error != nil {
 		span.RecordError(result.Error)
 		span.SetStatus(codes.Error, result.Error.Error())
@@ -170,6 +170,58 @@ func (r *OrderRepository) UpdateOrderTotal(ctx context.Context, orderID uint, to
 		return repository.ErrOrderNotFound
 	}
 
-	span.SetStatus(codes.Ok, "order total updated")
+	span.SetStatus(codes.Ok, "order totals updated")
 	return nil
 }
+
+func (r *OrderRepository) SetStockReserved(ctx context.Context, orderID uint, reserved bool) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "OrderRepository.SetStockReserved")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("order.id", int(orderID)),
+		attribute.Bool("order.stock_reserved", reserved),
+	)
+
+	result := r.db.WithContext(ctx).Model(&domain.Order{}).
+		Where("id = ? AND stock_reserved = ?", orderID, !reserved).
+		Update("stock_reserved", reserved)
+	if result.Error != nil {
+		span.RecordError(result.Error)
+		span.SetStatus(codes.Error, result.Error.Error())
+		return false, mapPostgresError(result.Error)
+	}
+
+	span.SetStatus(codes.Ok, "order stock flag updated")
+	return result.RowsAffected > 0, nil
+}
+
+// HasDeliveredOrderWithProduct reports whether any delivered order of the user
+// contains the product, in any variant.
+func (r *OrderRepository) HasDeliveredOrderWithProduct(ctx context.Context, userID, productID uint) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "OrderRepository.HasDeliveredOrderWithProduct")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("order.user_id", int(userID)),
+		attribute.Int("product.id", int(productID)),
+	)
+
+	var purchased bool
+	err := r.db.WithContext(ctx).Raw(`
+		SELECT EXISTS (
+			SELECT 1
+			FROM orders o
+			JOIN order_items oi ON oi.order_id = o.id AND oi.deleted_at IS NULL
+			WHERE o.user_id = ? AND oi.product_id = ? AND o.status = ? AND o.deleted_at IS NULL
+		)`, userID, productID, domain.OrderStatusDelivered).Scan(&purchased).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return false, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Bool("order.purchased", purchased))
+	span.SetStatus(codes.Ok, "purchase checked")
+	return purchased, nil
+}
@@ -0,0 +1,251 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PaymentRepository struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+var _ domain.PaymentRepository = (*PaymentRepository)(nil)
+
+func NewPaymentRepository(db *gorm.DB) *PaymentRepository {
+	return &PaymentRepository{db: db, tracer: otel.Tracer("payment-repo")}
+}
+
+func (r *PaymentRepository) CreateIntent(ctx context.Context, intent *domain.PaymentIntent) error {
+	ctx, span := r.tracer.Start(ctx, "PaymentRepository.CreateIntent")
+	defer span.End()
+
+	if err := r.db.WithContext(ctx).Create(intent).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "idx_payment_intents_open_order" {
+			return domain.ErrPaymentInProgress
+		}
+		return mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("payment.id", int(intent.ID)))
+	span.SetStatus(codes.Ok, "payment intent created")
+	return nil
+}
+
+func (r *PaymentRepository) GetIntent(ctx context.Context, id uint) (*domain.PaymentIntent, error) {
+	ctx, span := r.tracer.Start(ctx, "PaymentRepository.GetIntent")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("payment.id", int(id)))
+
+	var intent domain.PaymentIntent
+	if err := r.db.WithContext(ctx).Preload("Transactions", orderTransactions).First(&intent, id).Error; err != nil {
+		return nil, r.notFound(span, err)
+	}
+
+	span.SetStatus(codes.Ok, "payment intent retrieved")
+	return &intent, nil
+}
+
+func (r *PaymentRepository) GetIntentByProviderRef(ctx context.Context, provider, reference string) (*domain.PaymentIntent, error) {
+	ctx, span := r.tracer.Start(ctx, "PaymentRepository.GetIntentByProviderRef")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("payment.provider", provider))
+
+	var intent domain.PaymentIntent
+	err := r.db.WithContext(ctx).
+		Preload("Transactions", orderTransactions).
+		Where("provider = ? AND provider_ref = ?", provider, reference).
+		First(&intent).Error
+	if err != nil {
+		return nil, r.notFound(span, err)
+	}
+
+	span.SetStatus(codes.Ok, "payment intent retrieved")
+	return &intent, nil
+}
+
+func (r *PaymentRepository) ListIntentsByOrder(ctx context.Context, orderID uint) ([]domain.PaymentIntent, error) {
+	ctx, span := r.tracer.Start(ctx, "PaymentRepository.ListIntentsByOrder")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("order.id", int(orderID)))
+
+	var intents []domain.PaymentIntent
+	err := r.db.WithContext(ctx).
+		Preload("Transactions", orderTransactions).
+		Where("order_id = ?", orderID).
+		Order("id").
+		Find(&intents).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("payments.count", len(intents)))
+	span.SetStatus(codes.Ok, "payment intents listed")
+	return intents, nil
+}
+
+func (r *PaymentRepository) RecordTransaction(ctx context.Context, intent *domain.PaymentIntent, from domain.PaymentStatus, transaction *domain.PaymentTransaction, markOrderPaid bool) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "PaymentRepository.RecordTransaction")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("payment.id", int(intent.ID)),
+		attribute.String("payment.operation", string(transaction.Operation)),
+	)
+
+	orderPaid := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&domain.PaymentIntent{}).
+			Where("id = ? AND status = ?", intent.ID, from).
+			Updates(map[string]any{
+				"provider_ref":    intent.ProviderRef,
+				"status":          intent.Status,
+				"amount_captured": intent.AmountCaptured,
+				"amount_refunded": intent.AmountRefunded,
+				"in_flight":       intent.InFlight,
+				"in_flight_at":    intent.InFlightAt,
+			})
+		if result.Error != nil {
+			return mapPostgresError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrPaymentStateChanged
+		}
+
+		transaction.ID = 0
+		transaction.PaymentIntentID = intent.ID
+		if err := tx.Omit("id").Create(transaction).Error; err != nil {
+			var pgErr *pgconn.PgError
+			if transaction.EventID != nil && errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return domain.ErrDuplicateWebhookEvent
+			}
+			return mapPostgresError(err)
+		}
+
+		if markOrderPaid {
+			result := tx.Model(&domain.Order{}).
+				Where("id = ? AND status = ? AND total <= ?", intent.OrderID, domain.OrderStatusPending, intent.AmountCaptured+domain.PaymentAmountTolerance).
+				Update("status", domain.OrderStatusPaid)
+			if result.Error != nil {
+				return mapPostgresError(result.Error)
+			}
+			orderPaid = result.RowsAffected > 0
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return false, err
+	}
+
+	intent.Transactions = append(intent.Transactions, *transaction)
+	span.SetAttributes(attribute.Bool("order.paid", orderPaid))
+	span.SetStatus(codes.Ok, "payment transaction recorded")
+	return orderPaid, nil
+}
+
+func (r *PaymentRepository) WebhookEventSeen(ctx context.Context, eventID string) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "PaymentRepository.WebhookEventSeen")
+	defer span.End()
+
+	var seen bool
+	err := r.db.WithContext(ctx).Raw(
+		`SELECT EXISTS (SELECT 1 FROM payment_transactions WHERE event_id = ?)`, eventID,
+	).Scan(&seen).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return false, mapPostgresError(err)
+	}
+
+	span.SetStatus(codes.Ok, "webhook event checked")
+	return seen, nil
+}
+
+func (r *PaymentRepository) SetInFlight(ctx context.Context, intent *domain.PaymentIntent, operation domain.PaymentOperation) error {
+	ctx, span := r.tracer.Start(ctx, "PaymentRepository.SetInFlight")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("payment.id", int(intent.ID)),
+		attribute.String("payment.operation", string(operation)),
+	)
+
+	var at *time.Time
+	if operation != "" {
+		now := time.Now()
+		at = &now
+	}
+	err := r.db.WithContext(ctx).Model(&domain.PaymentIntent{}).
+		Where("id = ?", intent.ID).
+		Updates(map[string]any{"in_flight": operation, "in_flight_at": at}).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return mapPostgresError(err)
+	}
+
+	intent.InFlight, intent.InFlightAt = operation, at
+	span.SetStatus(codes.Ok, "payment in-flight operation set")
+	return nil
+}
+
+func (r *PaymentRepository) LockIntent(ctx context.Context, id uint, fn func(ctx context.Context, repo domain.PaymentRepository, intent *domain.PaymentIntent) error) error {
+	ctx, span := r.tracer.Start(ctx, "PaymentRepository.LockIntent")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("payment.id", int(id)))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var intent domain.PaymentIntent
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Preload("Transactions", orderTransactions).
+			First(&intent, id).Error
+		if err != nil {
+			return r.notFound(span, err)
+		}
+		return fn(ctx, &PaymentRepository{db: tx, tracer: r.tracer}, &intent)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "payment intent updated under lock")
+	return nil
+}
+
+func (r *PaymentRepository) notFound(span trace.Span, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		span.SetStatus(codes.Error, repository.ErrPaymentNotFound.Error())
+		return repository.ErrPaymentNotFound
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+	return mapPostgresError(err)
+}
+
+func orderTransactions(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
+}
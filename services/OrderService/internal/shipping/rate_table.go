@@ -0,0 +1,255 @@
+package shipping
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/domain"
+)
+
+// anyCountry in a zone's countries makes it the zone for destinations no
+// other zone lists.
+const anyCountry = "*"
+
+// Zone groups destination countries that share shipping methods. Countries
+// are matched case-insensitively against the address country, so a zone may
+// list both codes and names ("EG", "Egypt").
+type Zone struct {
+	Name      string   `json:"name"`
+	Countries []string `json:"countries"`
+	Methods   []Method `json:"methods"`
+}
+
+// Method is a shipping option within a zone. Its cost comes from the tier
+// with the highest min_quantity the shipment reaches, and drops to zero once
+// the subtotal reaches free_over (0 disables free shipping).
+type Method struct {
+	ID           string  `json:"id"`
+	Name         string  `json:"name"`
+	DeliveryDays int     `json:"delivery_days"`
+	FreeOver     float32 `json:"free_over"`
+	Tiers        []Tier  `json:"tiers"`
+}
+
+type Tier struct {
+	MinQuantity int     `json:"min_quantity"`
+	Cost        float32 `json:"cost"`
+}
+
+// RateTable prices shipments from zones of rate tiers.
+type RateTable struct {
+	zones     []Zone
+	countries map[string]int
+	fallback  int
+}
+
+var _ domain.ShippingCalculator = (*RateTable)(nil)
+
+// NewRateTable checks the zones and indexes them by country. Every method
+// needs a tier starting at one unit, so any shipment has a price.
+func NewRateTable(zones []Zone) (*RateTable, error) {
+	if len(zones) == 0 {
+		return nil, fmt.Errorf("shipping rates: at least one zone is required")
+	}
+
+	table := &RateTable{
+		zones:     make([]Zone, 0, len(zones)),
+		countries: make(map[string]int),
+		fallback:  -1,
+	}
+	names := make(map[string]struct{}, len(zones))
+
+	for i, zone := range zones {
+		if zone.Name == "" {
+			return nil, fmt.Errorf("shipping rates: zone %d has no name", i)
+		}
+		if _, ok := names[zone.Name]; ok {
+			return nil, fmt.Errorf("shipping rates: zone %q is defined twice", zone.Name)
+		}
+		names[zone.Name] = struct{}{}
+
+		if len(zone.Countries) == 0 {
+			return nil, fmt.Errorf("shipping rates: zone %q lists no countries", zone.Name)
+		}
+		for _, country := range zone.Countries {
+			key := normalizeCountry(country)
+			if key == "" {
+				return nil, fmt.Errorf("shipping rates: zone %q lists an empty country", zone.Name)
+			}
+			if key == anyCountry {
+				if table.fallback >= 0 {
+					return nil, fmt.Errorf("shipping rates: zone %q is the second zone for any country", zone.Name)
+				}
+				table.fallback = i
+				continue
+			}
+			if other, ok := table.countries[key]; ok {
+				return nil, fmt.Errorf("shipping rates: country %q is in zones %q and %q", country, zones[other].Name, zone.Name)
+			}
+			table.countries[key] = i
+		}
+
+		methods, err := checkMethods(zone)
+		if err != nil {
+			return nil, err
+		}
+		zone.Methods = methods
+		table.zones = append(table.zones, zone)
+	}
+
+	return table, nil
+}
+
+// LoadRateTable reads zones from a JSON file of the form {"zones": [...]}.
+func LoadRateTable(path string) (*RateTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("shipping rates: %w", err)
+	}
+
+	var file struct {
+		Zones []Zone `json:"zones"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("shipping rates: %s: %w", path, err)
+	}
+	return NewRateTable(file.Zones)
+}
+
+// DefaultRateTable ships anywhere with a standard and an express method. It
+// is used when no rates file is configured.
+func DefaultRateTable() *RateTable {
+	table, err := NewRateTable([]Zone{{
+		Name:      "worldwide",
+		Countries: []string{anyCountry},
+		Methods: []Method{
+			{
+				ID:           "standard",
+				Name:         "Standard",
+				DeliveryDays: 5,
+				FreeOver:     100,
+				Tiers:        []Tier{{MinQuantity: 1, Cost: 5}, {MinQuantity: 5, Cost: 8}, {MinQuantity: 10, Cost: 12}},
+			},
+			{
+				ID:           "express",
+				Name:         "Express",
+				DeliveryDays: 2,
+				Tiers:        []Tier{{MinQuantity: 1, Cost: 15}, {MinQuantity: 5, Cost: 20}, {MinQuantity: 10, Cost: 25}},
+			},
+		},
+	}})
+	if err != nil {
+		panic(err)
+	}
+	return table
+}
+
+func (t *RateTable) Quote(shipment domain.Shipment) (domain.ShippingQuote, error) {
+	zone, err := t.zone(shipment.Country)
+	if err != nil {
+		return domain.ShippingQuote{}, err
+	}
+
+	quote := domain.ShippingQuote{
+		Zone:    zone.Name,
+		Options: make([]domain.ShippingOption, 0, len(zone.Methods)),
+	}
+	for _, method := range zone.Methods {
+		quote.Options = append(quote.Options, method.price(shipment))
+	}
+	return quote, nil
+}
+
+func (t *RateTable) Option(shipment domain.Shipment, optionID string) (domain.ShippingOption, error) {
+	zone, err := t.zone(shipment.Country)
+	if err != nil {
+		return domain.ShippingOption{}, err
+	}
+
+	for _, method := range zone.Methods {
+		if method.ID == optionID {
+			return method.price(shipment), nil
+		}
+	}
+	return domain.ShippingOption{}, fmt.Errorf("%w %q for zone %s", domain.ErrUnknownShippingOption, optionID, zone.Name)
+}
+
+func (t *RateTable) zone(country string) (*Zone, error) {
+	if i, ok := t.countries[normalizeCountry(country)]; ok {
+		return &t.zones[i], nil
+	}
+	if t.fallback >= 0 {
+		return &t.zones[t.fallback], nil
+	}
+	return nil, fmt.Errorf("%w: %q", domain.ErrNoShippingZone, country)
+}
+
+func (m Method) price(shipment domain.Shipment) domain.ShippingOption {
+	option := domain.ShippingOption{
+		ID:           m.ID,
+		Name:         m.Name,
+		DeliveryDays: m.DeliveryDays,
+	}
+
+	if m.FreeOver > 0 && shipment.Subtotal >= m.FreeOver {
+		option.Free = true
+		return option
+	}
+
+	// Tiers are sorted by min_quantity and the first starts at one unit.
+	for _, tier := range m.Tiers {
+		if shipment.Quantity < tier.MinQuantity {
+			break
+		}
+		option.Cost = tier.Cost
+	}
+	return option
+}
+
+// checkMethods validates the methods of a zone and returns them with their
+// tiers sorted.
+func checkMethods(zone Zone) ([]Method, error) {
+	if len(zone.Methods) == 0 {
+		return nil, fmt.Errorf("shipping rates: zone %q has no methods", zone.Name)
+	}
+
+	methods := make([]Method, 0, len(zone.Methods))
+	ids := make(map[string]struct{}, len(zone.Methods))
+	for _, method := range zone.Methods {
+		if method.ID == "" {
+			return nil, fmt.Errorf("shipping rates: zone %q has a method without an id", zone.Name)
+		}
+		if _, ok := ids[method.ID]; ok {
+			return nil, fmt.Errorf("shipping rates: method %q is defined twice in zone %q", method.ID, zone.Name)
+		}
+		ids[method.ID] = struct{}{}
+
+		if method.Name == "" {
+			method.Name = method.ID
+		}
+		if method.DeliveryDays < 0 || method.FreeOver < 0 {
+			return nil, fmt.Errorf("shipping rates: method %q in zone %q has a negative value", method.ID, zone.Name)
+		}
+
+		tiers := append([]Tier(nil), method.Tiers...)
+		sort.Slice(tiers, func(i, j int) bool { return tiers[i].MinQuantity < tiers[j].MinQuantity })
+		if len(tiers) == 0 || tiers[0].MinQuantity > 1 {
+			return nil, fmt.Errorf("shipping rates: method %q in zone %q needs a tier with min_quantity 1", method.ID, zone.Name)
+		}
+		for _, tier := range tiers {
+			if tier.Cost < 0 {
+				return nil, fmt.Errorf("shipping rates: method %q in zone %q has a negative cost", method.ID, zone.Name)
+			}
+		}
+		method.Tiers = tiers
+		methods = append(methods, method)
+	}
+	return methods, nil
+}
+
+func normalizeCountry(country string) string {
+	return strings.ToUpper(strings.TrimSpace(country))
+}
@@ -0,0 +1,144 @@
+package tax
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+
+	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/domain"
+)
+
+// anyCountry as a jurisdiction's country makes it the jurisdiction of
+// destinations no other jurisdiction covers.
+const anyCountry = "*"
+
+// Jurisdiction holds the tax rates of a country, or of one state of it when
+// State is set. Rates map tax categories to fractions (0.14 for 14%); a
+// category without a rate is taxed at the standard rate. Inclusive
+// jurisdictions price products with tax included.
+type Jurisdiction struct {
+	Country   string             `json:"country"`
+	State     string             `json:"state"`
+	Inclusive bool               `json:"inclusive"`
+	Rates     map[string]float32 `json:"rates"`
+}
+
+// RateTable taxes order lines by the jurisdiction of the destination: the
+// state's when one is configured, else the country's, else the one for any
+// country. Destinations without a jurisdiction are not taxed.
+type RateTable struct {
+	jurisdictions map[jurisdictionKey]Jurisdiction
+}
+
+type jurisdictionKey struct {
+	country string
+	state   string
+}
+
+var _ domain.TaxCalculator = (*RateTable)(nil)
+
+func NewRateTable(jurisdictions []Jurisdiction) (*RateTable, error) {
+	table := &RateTable{jurisdictions: make(map[jurisdictionKey]Jurisdiction, len(jurisdictions))}
+
+	for i, jurisdiction := range jurisdictions {
+		key := jurisdictionKey{country: normalize(jurisdiction.Country), state: normalize(jurisdiction.State)}
+		if key.country == "" {
+			return nil, fmt.Errorf("tax rates: jurisdiction %d has no country", i)
+		}
+		if key.country == anyCountry && key.state != "" {
+			return nil, fmt.Errorf("tax rates: jurisdiction %d sets a state for any country", i)
+		}
+		if _, ok := table.jurisdictions[key]; ok {
+			return nil, fmt.Errorf("tax rates: %s is defined twice", jurisdiction.name())
+		}
+		for category, rate := range jurisdiction.Rates {
+			if rate < 0 || rate >= 1 {
+				return nil, fmt.Errorf("tax rates: %s rate %q must be at least 0 and below 1", jurisdiction.name(), category)
+			}
+		}
+		table.jurisdictions[key] = jurisdiction
+	}
+
+	return table, nil
+}
+
+// LoadRateTable reads jurisdictions from a JSON file of the form
+// {"jurisdictions": [...]}.
+func LoadRateTable(path string) (*RateTable, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("tax rates: %w", err)
+	}
+
+	var file struct {
+		Jurisdictions []Jurisdiction `json:"jurisdictions"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("tax rates: %s: %w", path, err)
+	}
+	return NewRateTable(file.Jurisdictions)
+}
+
+func (t *RateTable) Tax(destination domain.TaxDestination, items []domain.TaxableItem) domain.OrderTax {
+	jurisdiction, ok := t.jurisdiction(destination)
+	tax := domain.OrderTax{
+		Items:     make([]domain.ItemTax, len(items)),
+		Inclusive: ok && jurisdiction.Inclusive,
+	}
+	if !ok {
+		return tax
+	}
+
+	for i, item := range items {
+		rate := jurisdiction.rate(item.TaxCategory)
+		amount := item.Amount * rate
+		if jurisdiction.Inclusive {
+			amount = item.Amount - item.Amount/(1+rate)
+		}
+		tax.Items[i] = domain.ItemTax{Rate: rate, Amount: roundCents(amount)}
+		tax.Total += tax.Items[i].Amount
+	}
+	tax.Total = roundCents(tax.Total)
+	return tax
+}
+
+func (t *RateTable) jurisdiction(destination domain.TaxDestination) (Jurisdiction, bool) {
+	country := normalize(destination.Country)
+	if state := normalize(destination.State); state != "" {
+		if jurisdiction, ok := t.jurisdictions[jurisdictionKey{country: country, state: state}]; ok {
+			return jurisdiction, true
+		}
+	}
+	if jurisdiction, ok := t.jurisdictions[jurisdictionKey{country: country}]; ok {
+		return jurisdiction, true
+	}
+	jurisdiction, ok := t.jurisdictions[jurisdictionKey{country: anyCountry}]
+	return jurisdiction, ok
+}
+
+func (j Jurisdiction) rate(category string) float32 {
+	if category == "" {
+		category = domain.DefaultTaxCategory
+	}
+	if rate, ok := j.Rates[category]; ok {
+		return rate
+	}
+	return j.Rates[domain.DefaultTaxCategory]
+}
+
+func (j Jurisdiction) name() string {
+	if j.State != "" {
+		return j.Country + "/" + j.State
+	}
+	return j.Country
+}
+
+func roundCents(amount float32) float32 {
+	return float32(math.Round(float64(amount)*100) / 100)
+}
+
+func normalize(value string) string {
+	return strings.ToUpper(strings.TrimSpace(value))
+}
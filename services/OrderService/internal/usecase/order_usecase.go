@@ -2,7 +2,10 @@ package usecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/delivery/grpc/dto"
@@ -17,22 +20,31 @@ import (
 
 const (
 	downstreamTimeout = 3 * time.Second
+	// productBatchSize is the most products ProductService returns per
+	// GetProductsByIDs call.
+	productBatchSize = 100
 )
 
 type OrderUsecase struct {
 	orderRepo     domain.OrderRepository
+	paymentRepo   domain.PaymentRepository
 	productClient productpb.ProductServiceClient
 	userClient    userpb.UserServiceClient
+	shipping      domain.ShippingCalculator
+	tax           domain.TaxCalculator
 	tracer        trace.Tracer
 }
 
 var _ domain.OrderUsecase = (*OrderUsecase)(nil)
 
-func NewOrderUsecase(orderRepo domain.OrderRepository, productClient productpb.ProductServiceClient, userClient userpb.UserServiceClient) *OrderUsecase {
+func NewOrderUsecase(orderRepo domain.OrderRepository, paymentRepo domain.PaymentRepository, productClient productpb.ProductServiceClient, userClient userpb.UserServiceClient, shipping domain.ShippingCalculator, tax domain.TaxCalculator) *OrderUsecase {
 	return &OrderUsecase{
 		orderRepo:     orderRepo,
+		paymentRepo:   paymentRepo,
 		productClient: productClient,
 		userClient:    userClient,
+		shipping:      shipping,
+		tax:           tax,
 		tracer:        otel.Tracer("order-usecase"),
 	}
 }
@@ -49,42 +61,59 @@ func (u *OrderUsecase) CreateOrder(ctx context.Context, req *dto.CreateOrderRequ
 		return nil, err
 	}
 
-	items := make([]domain.OrderItem, 0, len(req.Items))
-	var itemsTotal float32
+	items, itemsTotal, err := u.priceItems(ctx, req.Items)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
 
-	for _, item := range req.Items {
-		product, err := u.ensureProductExists(ctx, item.ProductID)
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-			return nil, err
-		}
+	address, err := u.ensureAddressExists(ctx, req.UserID, req.AddressID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
 
-		unitPrice := product.GetPrice()
-		totalPrice := unitPrice * float32(item.Quantity)
-		itemsTotal += totalPrice
+	shipping, err := u.shipping.Option(newShipment(address, items, itemsTotal), req.ShippingOption)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	span.SetAttributes(attribute.String("order.shipping_option", shipping.ID))
 
-		items = append(items, domain.OrderItem{
-			ProductID:  item.ProductID,
-			Quantity:   item.Quantity,
-			UnitPrice:  unitPrice,
-			TotalPrice: totalPrice,
-		})
+	warehouseID, err := u.allocateWarehouse(ctx, req.UserID, address, items)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
 	}
+	span.SetAttributes(attribute.Int("order.warehouse_id", int(warehouseID)))
 
-	total := calculateOrderTotal(itemsTotal, req.ShippingCost, req.Discount)
+	tax := u.applyTax(address, items)
+	total := calculateOrderTotal(itemsTotal, shipping.Cost, req.Discount, tax.Total, tax.Inclusive)
 
 	order := &domain.Order{
 		UserID:               req.UserID,
-		ShippingCost:         req.ShippingCost,
-		ShippingDurationDays: req.ShippingDurationDays,
+		ShippingCost:         shipping.Cost,
+		ShippingDurationDays: shipping.DeliveryDays,
+		ShippingOption:       shipping.ID,
 		Discount:             req.Discount,
+		Tax:                  tax.Total,
+		TaxInclusive:         tax.Inclusive,
+		AddressID:            req.AddressID,
+		WarehouseID:          warehouseID,
 		Total:                total,
 		Status:               domain.OrderStatusPending,
 		Items:                items,
+		StockReserved:        true,
 	}
 
 	if err := u.orderRepo.CreateOrder(ctx, order); err != nil {
+		if returnErr := u.returnStock(ctx, warehouseID, items, "order not created"); returnErr != nil {
+			span.RecordError(returnErr)
+		}
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
@@ -95,6 +124,57 @@ func (u *OrderUsecase) CreateOrder(ctx context.Context, req *dto.CreateOrderRequ
 	return mapOrderToResponse(order), nil
 }
 
+// GetShippingOptions prices every shipping option of the zone the address is
+// in, for the items at their current catalog prices.
+func (u *OrderUsecase) GetShippingOptions(ctx context.Context, req *dto.ShippingOptionsRequest) (*dto.ShippingOptionsResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "OrderUsecase.GetShippingOptions")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("order.user_id", int(req.UserID)),
+		attribute.Int("order.address_id", int(req.AddressID)),
+	)
+
+	items, itemsTotal, err := u.priceItems(ctx, req.Items)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	address, err := u.ensureAddressExists(ctx, req.UserID, req.AddressID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	quote, err := u.shipping.Quote(newShipment(address, items, itemsTotal))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := &dto.ShippingOptionsResponse{
+		Zone:    quote.Zone,
+		Options: make([]dto.ShippingOptionResponse, 0, len(quote.Options)),
+	}
+	for _, option := range quote.Options {
+		response.Options = append(response.Options, dto.ShippingOptionResponse{
+			ID:           option.ID,
+			Name:         option.Name,
+			Cost:         option.Cost,
+			DeliveryDays: option.DeliveryDays,
+			Free:         option.Free,
+		})
+	}
+
+	span.SetAttributes(attribute.String("shipping.zone", quote.Zone))
+	span.SetStatus(codes.Ok, "shipping options priced")
+	return response, nil
+}
+
 func (u *OrderUsecase) GetOrderByID(ctx context.Context, id uint) (*dto.OrderResponse, error) {
 	ctx, span := u.tracer.Start(ctx, "OrderUsecase.GetOrderByID")
 	defer span.End()
@@ -130,47 +210,112 @@ func (u *OrderUsecase) ListOrders(ctx context.Context, userID *uint, page, perPa
 	return response, total, nil
 }
 
+func (u *OrderUsecase) HasPurchasedProduct(ctx context.Context, userID, productID uint) (bool, error) {
+	ctx, span := u.tracer.Start(ctx, "OrderUsecase.HasPurchasedProduct")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("order.user_id", int(userID)),
+		attribute.Int("product.id", int(productID)),
+	)
+
+	purchased, err := u.orderRepo.HasDeliveredOrderWithProduct(ctx, userID, productID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return false, err
+	}
+
+	span.SetStatus(codes.Ok, "purchase checked")
+	return purchased, nil
+}
+
 func (u *OrderUsecase) AddOrderItem(ctx context.Context, req *dto.AddOrderItemRequest) (*dto.OrderResponse, error) {
 	ctx, span := u.tracer.Start(ctx, "OrderUsecase.AddOrderItem")
 	defer span.End()
 
-	product, err := u.ensureProductExists(ctx, req.ProductID)
+	products, err := u.fetchProducts(ctx, []uint{req.ProductID})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	unitPrice := product.GetPrice()
-	item := &domain.OrderItem{
-		OrderID:    req.OrderID,
-		ProductID:  req.ProductID,
-		Quantity:   req.Quantity,
-		UnitPrice:  unitPrice,
-		TotalPrice: unitPrice * float32(req.Quantity),
+	unitPrice, sku, err := resolveItemPrice(products, req.ProductID, req.VariantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
 	}
 
-	if err := u.orderRepo.AddOrderItem(ctx, item); err != nil {
+	order, err := u.orderRepo.GetOrderByID(ctx, req.OrderID)
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	order, err := u.orderRepo.GetOrderByID(ctx, req.OrderID)
+	if err := u.ensureItemsEditable(ctx, order); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	var address *userpb.Address
+	if order.AddressID != 0 {
+		address, err = u.ensureAddressExists(ctx, order.UserID, order.AddressID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+
+	item := domain.OrderItem{
+		OrderID:     req.OrderID,
+		ProductID:   req.ProductID,
+		VariantID:   req.VariantID,
+		SKU:         sku,
+		Quantity:    req.Quantity,
+		UnitPrice:   unitPrice,
+		TotalPrice:  unitPrice * float32(req.Quantity),
+		TaxCategory: taxCategory(products[req.ProductID]),
+	}
+	items := []domain.OrderItem{item}
+	u.applyTax(address, items)
+	item = items[0]
+
+	if order.StockReserved {
+		if err := u.takeStock(ctx, order.WarehouseID, items, orderStockReason(order.ID, "item added")); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+
+	if err := u.orderRepo.AddOrderItem(ctx, &item); err != nil {
+		if order.StockReserved {
+			if returnErr := u.returnStock(ctx, order.WarehouseID, items, orderStockReason(order.ID, "item not added")); returnErr != nil {
+				span.RecordError(returnErr)
+			}
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	order, err = u.orderRepo.GetOrderByID(ctx, req.OrderID)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	itemsTotal := sumItemsTotal(order.Items)
-	updatedTotal := calculateOrderTotal(itemsTotal, order.ShippingCost, order.Discount)
-	if err := u.orderRepo.UpdateOrderTotal(ctx, order.ID, updatedTotal); err != nil {
+	if err := u.updateOrderTotals(ctx, order, address); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
-	order.Total = updatedTotal
 
 	return mapOrderToResponse(order), nil
 }
@@ -179,27 +324,61 @@ func (u *OrderUsecase) RemoveOrderItem(ctx context.Context, orderID, itemID uint
 	ctx, span := u.tracer.Start(ctx, "OrderUsecase.RemoveOrderItem")
 	defer span.End()
 
+	order, err := u.orderRepo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if err := u.ensureItemsEditable(ctx, order); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	var address *userpb.Address
+	if order.AddressID != 0 {
+		address, err = u.ensureAddressExists(ctx, order.UserID, order.AddressID)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+
 	if err := u.orderRepo.RemoveOrderItem(ctx, orderID, itemID); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	order, err := u.orderRepo.GetOrderByID(ctx, orderID)
+	if order.StockReserved {
+		var removed []domain.OrderItem
+		for _, item := range order.Items {
+			if item.ID == itemID {
+				removed = append(removed, item)
+			}
+		}
+		if err := u.returnStock(ctx, order.WarehouseID, removed, orderStockReason(orderID, "item removed")); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+
+	order, err = u.orderRepo.GetOrderByID(ctx, orderID)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
 
-	itemsTotal := sumItemsTotal(order.Items)
-	updatedTotal := calculateOrderTotal(itemsTotal, order.ShippingCost, order.Discount)
-	if err := u.orderRepo.UpdateOrderTotal(ctx, order.ID, updatedTotal); err != nil {
+	if err := u.updateOrderTotals(ctx, order, address); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
-	order.Total = updatedTotal
 
 	return mapOrderToResponse(order), nil
 }
@@ -209,6 +388,13 @@ func (u *OrderUsecase) UpdateOrderStatus(ctx context.Context, orderID uint, stat
 	defer span.End()
 
 	orderStatus := domain.OrderStatus(status)
+	if orderStatus == domain.OrderStatusPaid {
+		err := domain.ErrPaidByCaptureOnly
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
 	if err := u.orderRepo.UpdateOrderStatus(ctx, orderID, orderStatus); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -222,9 +408,56 @@ func (u *OrderUsecase) UpdateOrderStatus(ctx context.Context, orderID uint, stat
 		return nil, err
 	}
 
+	if orderStatus == domain.OrderStatusCanceled {
+		if err := u.releaseStock(ctx, order); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+
 	return mapOrderToResponse(order), nil
 }
 
+// releaseStock puts back the stock a canceled order holds. The flag is
+// cleared first so concurrent cancellations return it once; it is set again
+// when ProductService fails, so cancelling again retries.
+func (u *OrderUsecase) releaseStock(ctx context.Context, order *domain.Order) error {
+	released, err := u.orderRepo.SetStockReserved(ctx, order.ID, false)
+	if err != nil || !released {
+		return err
+	}
+
+	if err := u.returnStock(ctx, order.WarehouseID, order.Items, orderStockReason(order.ID, "canceled")); err != nil {
+		if _, restoreErr := u.orderRepo.SetStockReserved(ctx, order.ID, true); restoreErr != nil {
+			return errors.Join(err, restoreErr)
+		}
+		return err
+	}
+	order.StockReserved = false
+	return nil
+}
+
+// ensureItemsEditable rejects item changes once payment has started: an
+// authorization holds the order total as it was, and a paid order has been
+// charged for the items it had.
+func (u *OrderUsecase) ensureItemsEditable(ctx context.Context, order *domain.Order) error {
+	if order.Status != domain.OrderStatusPending {
+		return domain.ErrOrderItemsLocked
+	}
+
+	intents, err := u.paymentRepo.ListIntentsByOrder(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+	for _, intent := range intents {
+		if intent.Status.HoldsOrder() {
+			return domain.ErrOrderItemsLocked
+		}
+	}
+	return nil
+}
+
 func (u *OrderUsecase) ensureUserExists(ctx context.Context, userID uint) error {
 	ctx, cancel := context.WithTimeout(ctx, downstreamTimeout)
 	defer cancel()
@@ -236,30 +469,279 @@ func (u *OrderUsecase) ensureUserExists(ctx context.Context, userID uint) error
 	return nil
 }
 
-func (u *OrderUsecase) ensureProductExists(ctx context.Context, productID uint) (*productpb.Product, error) {
+// fetchProducts loads the given products, with their variants, in as few
+// ProductService calls as possible and reports every unknown ID in one error.
+func (u *OrderUsecase) fetchProducts(ctx context.Context, productIDs []uint) (map[uint]*productpb.Product, error) {
+	ctx, cancel := context.WithTimeout(ctx, downstreamTimeout)
+	defer cancel()
+
+	ids := make([]int64, 0, len(productIDs))
+	seen := make(map[uint]struct{}, len(productIDs))
+	for _, id := range productIDs {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, int64(id))
+	}
+
+	products := make(map[uint]*productpb.Product, len(ids))
+	var missing []string
+	for start := 0; start < len(ids); start += productBatchSize {
+		end := min(start+productBatchSize, len(ids))
+		response, err := u.productClient.GetProductsByIDs(ctx, &productpb.GetProductsByIDsRequest{Ids: ids[start:end]})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load products: %w", err)
+		}
+		for _, product := range response.GetProducts() {
+			products[uint(product.GetId())] = product
+		}
+		for _, id := range response.GetMissingIds() {
+			missing = append(missing, strconv.FormatInt(id, 10))
+		}
+	}
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("products not found: %s", strings.Join(missing, ", "))
+	}
+	return products, nil
+}
+
+// priceItems resolves the catalog price of every line and returns the lines
+// with the items total.
+func (u *OrderUsecase) priceItems(ctx context.Context, inputs []dto.OrderItemInput) ([]domain.OrderItem, float32, error) {
+	productIDs := make([]uint, 0, len(inputs))
+	for _, item := range inputs {
+		productIDs = append(productIDs, item.ProductID)
+	}
+	products, err := u.fetchProducts(ctx, productIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	items := make([]domain.OrderItem, 0, len(inputs))
+	var itemsTotal float32
+
+	for _, item := range inputs {
+		unitPrice, sku, err := resolveItemPrice(products, item.ProductID, item.VariantID)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		totalPrice := unitPrice * float32(item.Quantity)
+		itemsTotal += totalPrice
+
+		items = append(items, domain.OrderItem{
+			ProductID:   item.ProductID,
+			VariantID:   item.VariantID,
+			SKU:         sku,
+			Quantity:    item.Quantity,
+			UnitPrice:   unitPrice,
+			TotalPrice:  totalPrice,
+			TaxCategory: taxCategory(products[item.ProductID]),
+		})
+	}
+	return items, itemsTotal, nil
+}
+
+// resolveItemPrice returns the unit price and SKU of an order line. Products
+// sold in variants must be ordered by variant, which carries its own price.
+func resolveItemPrice(products map[uint]*productpb.Product, productID, variantID uint) (float32, string, error) {
+	product, ok := products[productID]
+	if !ok {
+		return 0, "", fmt.Errorf("products not found: %d", productID)
+	}
+
+	if variantID == 0 {
+		if len(product.GetVariants()) > 0 {
+			return 0, "", fmt.Errorf("product %d is sold in variants: variant_id is required", productID)
+		}
+		return product.GetPrice(), "", nil
+	}
+
+	for _, variant := range product.GetVariants() {
+		if uint(variant.GetId()) == variantID {
+			return variant.GetPrice(), variant.GetSku(), nil
+		}
+	}
+	return 0, "", fmt.Errorf("variant %d does not belong to product %d", variantID, productID)
+}
+
+// allocateWarehouse asks ProductService which warehouse ships the order,
+// ranking by proximity to the shipping address, and takes the items out of
+// its stock so concurrent orders cannot be given the same units. It returns 0
+// when the items ship from stock not placed in any warehouse.
+func (u *OrderUsecase) allocateWarehouse(ctx context.Context, userID uint, address *userpb.Address, items []domain.OrderItem) (uint, error) {
+	request := &productpb.AllocateWarehouseRequest{
+		Items:   allocationItems(items),
+		Country: address.GetCountry(),
+		State:   address.GetState(),
+		City:    address.GetCity(),
+		ZipCode: address.GetZipCode(),
+		Reserve: true,
+		Reason:  "order checkout",
+		Actor:   fmt.Sprintf("user:%d", userID),
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, downstreamTimeout)
 	defer cancel()
 
-	response, err := u.productClient.GetProductByID(ctx, &productpb.GetProductByIDRequest{Id: int64(productID)})
+	response, err := u.productClient.AllocateWarehouse(ctx, request)
 	if err != nil {
-		return nil, fmt.Errorf("product not found: %w", err)
+		return 0, fmt.Errorf("warehouse allocation failed: %w", err)
 	}
-	if response.GetProduct() == nil {
-		return nil, fmt.Errorf("product not found: empty response")
+	if !response.GetAllocated() {
+		return 0, nil
 	}
-	return response.GetProduct(), nil
+	return uint(response.GetWarehouse().GetId()), nil
+}
+
+// takeStock takes items added to an order out of the stock it ships from.
+func (u *OrderUsecase) takeStock(ctx context.Context, warehouseID uint, items []domain.OrderItem, reason string) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, downstreamTimeout)
+	defer cancel()
+
+	_, err := u.productClient.TakeStock(ctx, &productpb.StockItemsRequest{
+		WarehouseId: int64(warehouseID),
+		Items:       allocationItems(items),
+		Reason:      reason,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to take stock: %w", err)
+	}
+	return nil
+}
+
+// returnStock puts items back into the stock the order took them from.
+func (u *OrderUsecase) returnStock(ctx context.Context, warehouseID uint, items []domain.OrderItem, reason string) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, downstreamTimeout)
+	defer cancel()
+
+	_, err := u.productClient.ReturnStock(ctx, &productpb.StockItemsRequest{
+		WarehouseId: int64(warehouseID),
+		Items:       allocationItems(items),
+		Reason:      reason,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to return stock: %w", err)
+	}
+	return nil
+}
+
+func allocationItems(items []domain.OrderItem) []*productpb.AllocationItem {
+	allocation := make([]*productpb.AllocationItem, 0, len(items))
+	for _, item := range items {
+		allocation = append(allocation, &productpb.AllocationItem{
+			ProductId: int64(item.ProductID),
+			VariantId: int64(item.VariantID),
+			Quantity:  int32(item.Quantity),
+		})
+	}
+	return allocation
+}
+
+func orderStockReason(orderID uint, event string) string {
+	return fmt.Sprintf("order %d %s", orderID, event)
+}
+
+func (u *OrderUsecase) ensureAddressExists(ctx context.Context, userID, addressID uint) (*userpb.Address, error) {
+	ctx, cancel := context.WithTimeout(ctx, downstreamTimeout)
+	defer cancel()
+
+	response, err := u.userClient.GetAddressByID(ctx, &userpb.GetAddressByIDRequest{Id: int32(addressID)})
+	if err != nil {
+		return nil, fmt.Errorf("address not found: %w", err)
+	}
+	if response.GetAddress() == nil || uint(response.GetAddress().GetUserId()) != userID {
+		return nil, fmt.Errorf("address %d not found for user %d", addressID, userID)
+	}
+	return response.GetAddress(), nil
+}
+
+func taxCategory(product *productpb.Product) string {
+	if category := product.GetTaxCategory(); category != "" {
+		return category
+	}
+	return domain.DefaultTaxCategory
+}
+
+// applyTax taxes the items for the address, which may be nil for orders
+// placed without one, and records each item's rate and amount on it.
+func (u *OrderUsecase) applyTax(address *userpb.Address, items []domain.OrderItem) domain.OrderTax {
+	taxable := make([]domain.TaxableItem, 0, len(items))
+	for _, item := range items {
+		taxable = append(taxable, domain.TaxableItem{TaxCategory: item.TaxCategory, Amount: item.TotalPrice})
+	}
+
+	tax := u.tax.Tax(domain.TaxDestination{Country: address.GetCountry(), State: address.GetState()}, taxable)
+	for i := range items {
+		items[i].TaxRate = tax.Items[i].Rate
+		items[i].TaxAmount = tax.Items[i].Amount
+	}
+	return tax
+}
+
+// updateOrderTotals recalculates the shipping, tax and total of an order from
+// its items after they changed, and saves them. Shipping is priced again for
+// the order's option, since the quantity tier and the free-shipping threshold
+// follow the items; orders without an address or option keep their cost.
+func (u *OrderUsecase) updateOrderTotals(ctx context.Context, order *domain.Order, address *userpb.Address) error {
+	itemsTotal := sumItemsTotal(order.Items)
+
+	shippingCost := order.ShippingCost
+	if address != nil && order.ShippingOption != "" {
+		option, err := u.shipping.Option(newShipment(address, order.Items, itemsTotal), order.ShippingOption)
+		if err != nil {
+			return err
+		}
+		shippingCost = option.Cost
+	}
+
+	tax := sumItemsTax(order.Items)
+	total := calculateOrderTotal(itemsTotal, shippingCost, order.Discount, tax, order.TaxInclusive)
+	if err := u.orderRepo.UpdateOrderTotals(ctx, order.ID, shippingCost, tax, total); err != nil {
+		return err
+	}
+	order.ShippingCost = shippingCost
+	order.Tax = tax
+	order.Total = total
+	return nil
+}
+
+func newShipment(address *userpb.Address, items []domain.OrderItem, itemsTotal float32) domain.Shipment {
+	shipment := domain.Shipment{
+		Country:  address.GetCountry(),
+		Subtotal: itemsTotal,
+	}
+	for _, item := range items {
+		shipment.Quantity += item.Quantity
+	}
+	return shipment
 }
 
 func mapOrderToResponse(order *domain.Order) *dto.OrderResponse {
 	items := make([]dto.OrderItemResponse, 0, len(order.Items))
 	for _, item := range order.Items {
 		items = append(items, dto.OrderItemResponse{
-			ID:         item.ID,
-			OrderID:    item.OrderID,
-			ProductID:  item.ProductID,
-			Quantity:   item.Quantity,
-			UnitPrice:  item.UnitPrice,
-			TotalPrice: item.TotalPrice,
+			ID:          item.ID,
+			OrderID:     item.OrderID,
+			ProductID:   item.ProductID,
+			VariantID:   item.VariantID,
+			SKU:         item.SKU,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			TotalPrice:  item.TotalPrice,
+			TaxCategory: item.TaxCategory,
+			TaxRate:     item.TaxRate,
+			TaxAmount:   item.TaxAmount,
 		})
 	}
 
@@ -268,7 +750,12 @@ func mapOrderToResponse(order *domain.Order) *dto.OrderResponse {
 		UserID:           order.UserID,
 		ShippingCost:     order.ShippingCost,
 		ShippingDuration: order.ShippingDurationDays,
+		ShippingOption:   order.ShippingOption,
 		Discount:         order.Discount,
+		Tax:              order.Tax,
+		TaxInclusive:     order.TaxInclusive,
+		AddressID:        order.AddressID,
+		WarehouseID:      order.WarehouseID,
 		Total:            order.Total,
 		Status:           string(order.Status),
 		Items:            items,
@@ -289,14 +776,27 @@ func sumItemsTotal(items []domain.OrderItem) float32 {
 	return total
 }
 
-func calculateOrderTotal(itemsTotal, shippingCost, discount float32) float32 {
+func sumItemsTax(items []domain.OrderItem) float32 {
+	var total float32
+	for _, item := range items {
+		total += item.TaxAmount
+	}
+	return total
+}
+
+// calculateOrderTotal adds shipping and tax to the items total and takes the
+// discount off. Tax that is included in the item prices is not added again.
+func calculateOrderTotal(itemsTotal, shippingCost, discount, tax float32, taxInclusive bool) float32 {
 	if discount < 0 {
 		discount = 0
 	}
 	if shippingCost < 0 {
 		shippingCost = 0
 	}
-	total := itemsTotal + shippingCost - discount
+	if tax < 0 || taxInclusive {
+		tax = 0
+	}
+	total := itemsTotal + shippingCost + tax - discount
 	if total < 0 {
 		return 0
 	}
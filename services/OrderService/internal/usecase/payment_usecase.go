@@ -0,0 +1,582 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/OrderService/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// providerTimeout bounds each call to the payment provider.
+	providerTimeout = 30 * time.Second
+	// inFlightTimeout is how long an operation marked in flight keeps other
+	// operations on its payment away. It outlasts providerTimeout, so a mark
+	// goes stale only when its caller died before clearing it.
+	inFlightTimeout = 2 * providerTimeout
+)
+
+type PaymentUsecase struct {
+	paymentRepo domain.PaymentRepository
+	orderRepo   domain.OrderRepository
+	provider    domain.PaymentProvider
+	currency    string
+	tracer      trace.Tracer
+}
+
+var _ domain.PaymentUsecase = (*PaymentUsecase)(nil)
+
+func NewPaymentUsecase(paymentRepo domain.PaymentRepository, orderRepo domain.OrderRepository, provider domain.PaymentProvider, currency string) *PaymentUsecase {
+	return &PaymentUsecase{
+		paymentRepo: paymentRepo,
+		orderRepo:   orderRepo,
+		provider:    provider,
+		currency:    currency,
+		tracer:      otel.Tracer("payment-usecase"),
+	}
+}
+
+// AuthorizePayment holds the order total on the customer's payment method.
+// A declined authorization is still recorded, as a failed payment.
+func (u *PaymentUsecase) AuthorizePayment(ctx context.Context, req *dto.AuthorizePaymentRequest) (*dto.PaymentResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "PaymentUsecase.AuthorizePayment")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("order.id", int(req.OrderID)),
+		attribute.Int("order.user_id", int(req.UserID)),
+	)
+
+	order, err := u.userOrder(ctx, req.OrderID, &req.UserID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	if order.Status != domain.OrderStatusPending {
+		err := domain.ErrOrderNotPayable
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	if order.Total <= 0 {
+		err := fmt.Errorf("%w: order total must be positive", domain.ErrInvalidPaymentAmount)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// The intent is created before the provider is asked, so that a
+	// concurrent authorization of the order is turned away by the database
+	// instead of holding the total twice.
+	intent := &domain.PaymentIntent{
+		OrderID:  order.ID,
+		Provider: u.provider.Name(),
+		Amount:   order.Total,
+		Currency: u.currency,
+		Status:   domain.PaymentStatusPending,
+	}
+	if err := u.paymentRepo.CreateIntent(ctx, intent); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	providerCtx, cancel := context.WithTimeout(ctx, providerTimeout)
+	result, err := u.provider.Authorize(providerCtx, domain.AuthorizeRequest{
+		IdempotencyKey: idempotencyKey(intent, domain.PaymentOperationAuthorize),
+		OrderID:        order.ID,
+		Amount:         order.Total,
+		Currency:       u.currency,
+		PaymentMethod:  req.PaymentMethod,
+	})
+	cancel()
+	if err != nil {
+		// The intent must not keep the order held; the failure is recorded
+		// as a declined authorization.
+		result = domain.ProviderResult{FailureReason: err.Error()}
+	}
+
+	next := *intent
+	next.ProviderRef = result.Reference
+	next.Status = domain.PaymentStatusAuthorized
+	if !result.Succeeded {
+		next.Status = domain.PaymentStatusFailed
+	}
+	transaction := &domain.PaymentTransaction{
+		Operation:     domain.PaymentOperationAuthorize,
+		Amount:        order.Total,
+		Succeeded:     result.Succeeded,
+		ProviderRef:   result.Reference,
+		FailureReason: result.FailureReason,
+	}
+	if _, recordErr := u.paymentRepo.RecordTransaction(ctx, &next, domain.PaymentStatusPending, transaction, false); recordErr != nil {
+		err = errors.Join(err, recordErr)
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	intent = &next
+
+	span.SetAttributes(attribute.Int("payment.id", int(intent.ID)))
+	if !result.Succeeded {
+		err := fmt.Errorf("%w: %s", domain.ErrPaymentDeclined, result.FailureReason)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "payment authorized")
+	return mapPaymentToResponse(intent), nil
+}
+
+// CapturePayment collects an authorized payment in full. The order becomes
+// paid in the same database transaction that records the capture; an
+// authorization that no longer covers the order total is not captured.
+func (u *PaymentUsecase) CapturePayment(ctx context.Context, paymentID uint) (*dto.PaymentResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "PaymentUsecase.CapturePayment")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("payment.id", int(paymentID)))
+
+	intent, err := u.operate(ctx, paymentID, domain.PaymentOperationCapture, 0, u.ensureCapturable)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "payment captured")
+	return mapPaymentToResponse(intent), nil
+}
+
+// VoidPayment releases an authorized payment without collecting it.
+func (u *PaymentUsecase) VoidPayment(ctx context.Context, paymentID uint) (*dto.PaymentResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "PaymentUsecase.VoidPayment")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("payment.id", int(paymentID)))
+
+	intent, err := u.operate(ctx, paymentID, domain.PaymentOperationVoid, 0, nil)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "payment voided")
+	return mapPaymentToResponse(intent), nil
+}
+
+// RefundPayment returns part or all of a captured payment. The order status is
+// left alone; canceling the order is a separate decision.
+func (u *PaymentUsecase) RefundPayment(ctx context.Context, req *dto.RefundPaymentRequest) (*dto.PaymentResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "PaymentUsecase.RefundPayment")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("payment.id", int(req.PaymentID)))
+
+	intent, err := u.operate(ctx, req.PaymentID, domain.PaymentOperationRefund, req.Amount, nil)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "payment refunded")
+	return mapPaymentToResponse(intent), nil
+}
+
+// ListOrderPayments lists the payments of an order, oldest first. With a
+// userID the order must belong to that user.
+func (u *PaymentUsecase) ListOrderPayments(ctx context.Context, orderID uint, userID *uint) ([]dto.PaymentResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "PaymentUsecase.ListOrderPayments")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("order.id", int(orderID)))
+
+	if _, err := u.userOrder(ctx, orderID, userID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	intents, err := u.paymentRepo.ListIntentsByOrder(ctx, orderID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := make([]dto.PaymentResponse, 0, len(intents))
+	for i := range intents {
+		response = append(response, *mapPaymentToResponse(&intents[i]))
+	}
+
+	span.SetStatus(codes.Ok, "payments listed")
+	return response, nil
+}
+
+// HandleWebhook applies a provider notification about an operation made on
+// the provider's side. Events are applied once: repeated deliveries, and
+// events about operations already recorded through the API, are ignored.
+func (u *PaymentUsecase) HandleWebhook(ctx context.Context, payload []byte, signature string) error {
+	ctx, span := u.tracer.Start(ctx, "PaymentUsecase.HandleWebhook")
+	defer span.End()
+
+	event, err := u.provider.ParseWebhook(payload, signature)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetAttributes(
+		attribute.String("payment.webhook_event", event.ID),
+		attribute.String("payment.operation", string(event.Operation)),
+	)
+
+	seen, err := u.paymentRepo.WebhookEventSeen(ctx, event.ID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	if seen {
+		span.SetStatus(codes.Ok, "webhook event already processed")
+		return nil
+	}
+
+	intent, err := u.paymentRepo.GetIntentByProviderRef(ctx, u.provider.Name(), event.ProviderRef)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	applied, orderPaid := true, false
+	var captured *domain.PaymentIntent
+	err = u.paymentRepo.LockIntent(ctx, intent.ID, func(ctx context.Context, repo domain.PaymentRepository, intent *domain.PaymentIntent) error {
+		if event.OperationRef != "" && hasSucceededOperation(intent, event.Operation, event.OperationRef) {
+			applied = false
+			return nil
+		}
+
+		from := intent.Status
+		next := *intent
+		amount := operationAmount(intent, event.Operation, event.Amount)
+		if event.Succeeded {
+			if err := applyPaymentOperation(&next, event.Operation, amount); err != nil {
+				return err
+			}
+		}
+
+		eventID := event.ID
+		transaction := &domain.PaymentTransaction{
+			Operation:     event.Operation,
+			Amount:        amount,
+			Succeeded:     event.Succeeded,
+			ProviderRef:   event.OperationRef,
+			FailureReason: event.FailureReason,
+			EventID:       &eventID,
+		}
+		markOrderPaid := event.Succeeded && event.Operation == domain.PaymentOperationCapture
+		var err error
+		orderPaid, err = repo.RecordTransaction(ctx, &next, from, transaction, markOrderPaid)
+		if err != nil {
+			return err
+		}
+		if markOrderPaid {
+			captured = &next
+		}
+		return nil
+	})
+	if errors.Is(err, domain.ErrDuplicateWebhookEvent) {
+		span.SetStatus(codes.Ok, "webhook event already processed")
+		return nil
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	if !applied {
+		span.SetStatus(codes.Ok, "webhook operation already recorded")
+		return nil
+	}
+	if captured != nil && !orderPaid {
+		err := capturedOrderNotPaid(captured)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	span.SetStatus(codes.Ok, "webhook event applied")
+	return nil
+}
+
+// operate runs an operation on a payment without holding a database lock
+// while the provider is asked. Under a short lock the intent is vetted, with
+// check when set, and the operation marked in flight, which turns other
+// operations on the payment away; the provider is called; and under a second
+// short lock the outcome is recorded and the mark cleared. A provider error
+// records nothing, and the retried call reuses the idempotency key, so the
+// provider does not run the operation twice. A declined operation is recorded
+// and returned as ErrPaymentDeclined, leaving the intent as it was.
+func (u *PaymentUsecase) operate(ctx context.Context, paymentID uint, operation domain.PaymentOperation, requested float32, check func(ctx context.Context, intent *domain.PaymentIntent) error) (*domain.PaymentIntent, error) {
+	var (
+		intent *domain.PaymentIntent
+		amount float32
+	)
+	err := u.paymentRepo.LockIntent(ctx, paymentID, func(ctx context.Context, repo domain.PaymentRepository, locked *domain.PaymentIntent) error {
+		if locked.InFlight != "" && locked.InFlightAt != nil && time.Since(*locked.InFlightAt) < inFlightTimeout {
+			return domain.ErrPaymentOperationPending
+		}
+		if check != nil {
+			if err := check(ctx, locked); err != nil {
+				return err
+			}
+		}
+
+		next := *locked
+		amount = operationAmount(locked, operation, requested)
+		if err := applyPaymentOperation(&next, operation, amount); err != nil {
+			return err
+		}
+		if err := repo.SetInFlight(ctx, locked, operation); err != nil {
+			return err
+		}
+		intent = locked
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, callErr := u.callProvider(ctx, intent, operation, amount)
+
+	var (
+		updated   *domain.PaymentIntent
+		recorded  bool
+		orderPaid bool
+		applyErr  error
+	)
+	err = u.paymentRepo.LockIntent(ctx, paymentID, func(ctx context.Context, repo domain.PaymentRepository, locked *domain.PaymentIntent) error {
+		if locked.InFlight != operation {
+			// The mark went stale and another call took the payment over.
+			return domain.ErrPaymentStateChanged
+		}
+		if callErr != nil || (result.Succeeded && hasSucceededOperation(locked, operation, result.Reference)) {
+			// Nothing to record, or a webhook recorded the outcome first.
+			updated = locked
+			return repo.SetInFlight(ctx, locked, "")
+		}
+
+		from := locked.Status
+		next := *locked
+		next.InFlight, next.InFlightAt = "", nil
+		if result.Succeeded {
+			if applyErr = applyPaymentOperation(&next, operation, amount); applyErr != nil {
+				// A webhook changed the payment meanwhile. The provider's
+				// answer is still recorded, on the intent as it now is.
+				next = *locked
+				next.InFlight, next.InFlightAt = "", nil
+			}
+		}
+
+		transaction := &domain.PaymentTransaction{
+			Operation:     operation,
+			Amount:        amount,
+			Succeeded:     result.Succeeded,
+			ProviderRef:   result.Reference,
+			FailureReason: result.FailureReason,
+		}
+		markOrderPaid := result.Succeeded && applyErr == nil && operation == domain.PaymentOperationCapture
+		var err error
+		orderPaid, err = repo.RecordTransaction(ctx, &next, from, transaction, markOrderPaid)
+		if err != nil {
+			return err
+		}
+		updated, recorded = &next, true
+		return nil
+	})
+	switch {
+	case err != nil:
+		return nil, err
+	case callErr != nil:
+		return nil, callErr
+	case applyErr != nil:
+		return nil, applyErr
+	case !result.Succeeded:
+		return nil, fmt.Errorf("%w: %s", domain.ErrPaymentDeclined, result.FailureReason)
+	case recorded && operation == domain.PaymentOperationCapture && !orderPaid:
+		return nil, capturedOrderNotPaid(updated)
+	}
+	return updated, nil
+}
+
+// callProvider asks the provider for an operation on the intent. The
+// idempotency key names the intent, the operation and how many transactions
+// the intent had, so it changes once an outcome is recorded and stays the
+// same for retries until then.
+func (u *PaymentUsecase) callProvider(ctx context.Context, intent *domain.PaymentIntent, operation domain.PaymentOperation, amount float32) (domain.ProviderResult, error) {
+	ctx, cancel := context.WithTimeout(ctx, providerTimeout)
+	defer cancel()
+
+	key := idempotencyKey(intent, operation)
+	switch operation {
+	case domain.PaymentOperationCapture:
+		return u.provider.Capture(ctx, key, intent.ProviderRef, amount)
+	case domain.PaymentOperationVoid:
+		return u.provider.Void(ctx, key, intent.ProviderRef)
+	case domain.PaymentOperationRefund:
+		return u.provider.Refund(ctx, key, intent.ProviderRef, amount)
+	}
+	return domain.ProviderResult{}, fmt.Errorf("%w: %s", domain.ErrInvalidPaymentState, operation)
+}
+
+func idempotencyKey(intent *domain.PaymentIntent, operation domain.PaymentOperation) string {
+	return fmt.Sprintf("payment-%d-%s-%d", intent.ID, operation, len(intent.Transactions))
+}
+
+// capturedOrderNotPaid reports a capture that was recorded while its order
+// stayed unpaid, because the order had left pending or the capture did not
+// cover its total. The money has moved, so the payment needs to be refunded
+// or the order settled by hand.
+func capturedOrderNotPaid(intent *domain.PaymentIntent) error {
+	return fmt.Errorf("%w: payment %d captured %.2f but order %d is not pending or not covered; refund the payment or settle the order", domain.ErrCapturedOrderNotPaid, intent.ID, intent.AmountCaptured, intent.OrderID)
+}
+
+// ensureCapturable checks that the order of the payment still awaits payment
+// and that the authorization covers its current total.
+func (u *PaymentUsecase) ensureCapturable(ctx context.Context, intent *domain.PaymentIntent) error {
+	order, err := u.orderRepo.GetOrderByID(ctx, intent.OrderID)
+	if err != nil {
+		return err
+	}
+	if order.Status != domain.OrderStatusPending {
+		return domain.ErrOrderNotPayable
+	}
+	if intent.Amount < order.Total-domain.PaymentAmountTolerance {
+		return fmt.Errorf("%w: authorized %.2f of %.2f; void it and authorize again", domain.ErrPaymentAmountMismatch, intent.Amount, order.Total)
+	}
+	return nil
+}
+
+// userOrder loads an order, treating orders of other users as missing when
+// userID is set.
+func (u *PaymentUsecase) userOrder(ctx context.Context, orderID uint, userID *uint) (*domain.Order, error) {
+	order, err := u.orderRepo.GetOrderByID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if userID != nil && order.UserID != *userID {
+		return nil, repository.ErrOrderNotFound
+	}
+	return order, nil
+}
+
+// applyPaymentOperation moves the intent to the state a successful operation
+// leaves it in, or returns why the operation is not allowed.
+func applyPaymentOperation(intent *domain.PaymentIntent, operation domain.PaymentOperation, amount float32) error {
+	switch operation {
+	case domain.PaymentOperationCapture:
+		if intent.Status != domain.PaymentStatusAuthorized {
+			return fmt.Errorf("%w: cannot capture a %s payment", domain.ErrInvalidPaymentState, intent.Status)
+		}
+		if amount <= 0 || amount > intent.Amount+domain.PaymentAmountTolerance {
+			return fmt.Errorf("%w: capture must be positive and at most %.2f", domain.ErrInvalidPaymentAmount, intent.Amount)
+		}
+		intent.Status = domain.PaymentStatusCaptured
+		intent.AmountCaptured = amount
+	case domain.PaymentOperationVoid:
+		if intent.Status != domain.PaymentStatusAuthorized {
+			return fmt.Errorf("%w: cannot void a %s payment", domain.ErrInvalidPaymentState, intent.Status)
+		}
+		intent.Status = domain.PaymentStatusVoided
+	case domain.PaymentOperationRefund:
+		if intent.Status != domain.PaymentStatusCaptured && intent.Status != domain.PaymentStatusPartiallyRefunded {
+			return fmt.Errorf("%w: cannot refund a %s payment", domain.ErrInvalidPaymentState, intent.Status)
+		}
+		remaining := intent.AmountCaptured - intent.AmountRefunded
+		if amount <= 0 || amount > remaining+domain.PaymentAmountTolerance {
+			return fmt.Errorf("%w: refund must be positive and at most %.2f", domain.ErrInvalidPaymentAmount, remaining)
+		}
+		intent.AmountRefunded += amount
+		intent.Status = domain.PaymentStatusPartiallyRefunded
+		if intent.AmountCaptured-intent.AmountRefunded < domain.PaymentAmountTolerance {
+			intent.Status = domain.PaymentStatusRefunded
+		}
+	default:
+		return fmt.Errorf("%w: %s", domain.ErrInvalidPaymentState, operation)
+	}
+	return nil
+}
+
+// operationAmount is the amount an operation moves when none is requested:
+// the whole authorization for captures and voids, and whatever has not been
+// refunded yet for refunds.
+func operationAmount(intent *domain.PaymentIntent, operation domain.PaymentOperation, requested float32) float32 {
+	switch operation {
+	case domain.PaymentOperationVoid:
+		return intent.Amount
+	case domain.PaymentOperationCapture:
+		if requested == 0 {
+			return intent.Amount
+		}
+	case domain.PaymentOperationRefund:
+		if requested == 0 {
+			return intent.AmountCaptured - intent.AmountRefunded
+		}
+	}
+	return requested
+}
+
+func hasSucceededOperation(intent *domain.PaymentIntent, operation domain.PaymentOperation, reference string) bool {
+	for _, transaction := range intent.Transactions {
+		if transaction.Succeeded && transaction.Operation == operation && transaction.ProviderRef == reference {
+			return true
+		}
+	}
+	return false
+}
+
+func mapPaymentToResponse(intent *domain.PaymentIntent) *dto.PaymentResponse {
+	transactions := make([]dto.PaymentTransactionResponse, 0, len(intent.Transactions))
+	for _, transaction := range intent.Transactions {
+		transactions = append(transactions, dto.PaymentTransactionResponse{
+			ID:            transaction.ID,
+			Operation:     string(transaction.Operation),
+			Amount:        transaction.Amount,
+			Succeeded:     transaction.Succeeded,
+			ProviderRef:   transaction.ProviderRef,
+			FailureReason: transaction.FailureReason,
+			CreatedAt:     transaction.CreatedAt,
+		})
+	}
+
+	return &dto.PaymentResponse{
+		ID:             intent.ID,
+		OrderID:        intent.OrderID,
+		Provider:       intent.Provider,
+		ProviderRef:    intent.ProviderRef,
+		Amount:         intent.Amount,
+		Currency:       intent.Currency,
+		Status:         string(intent.Status),
+		AmountCaptured: intent.AmountCaptured,
+		AmountRefunded: intent.AmountRefunded,
+		Transactions:   transactions,
+		CreatedAt:      intent.CreatedAt,
+		UpdatedAt:      intent.UpdatedAt,
+	}
+}
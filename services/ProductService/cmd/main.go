@@ -2,21 +2,32 @@ package main
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/kareemhamed001/e-commerce/pkg/db"
+	"github.com/kareemhamed001/e-commerce/pkg/grpcmiddleware"
 	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/pkg/rabbitmq"
 	"github.com/kareemhamed001/e-commerce/pkg/redis"
 	"github.com/kareemhamed001/e-commerce/pkg/tracer"
 	"github.com/kareemhamed001/e-commerce/services/ProductService/config"
 	redisCache "github.com/kareemhamed001/e-commerce/services/ProductService/internal/cache/redis"
 	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/handler"
 	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	rabbitmqEvents "github.com/kareemhamed001/e-commerce/services/ProductService/internal/events/rabbitmq"
 	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/repository/postgresql"
+	localStorage "github.com/kareemhamed001/e-commerce/services/ProductService/internal/storage/local"
+	s3Storage "github.com/kareemhamed001/e-commerce/services/ProductService/internal/storage/s3"
 	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/usecase"
+	orderpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/order"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
@@ -63,14 +74,78 @@ func main() {
 	}
 
 	productCache := redisCache.NewProductCache(redisClient)
-	productUseCase := usecase.NewProductUsecase(productRepo, productCache)
+	variantRepo := postgresql.NewVariantRepository(db)
+	mediaRepo := postgresql.NewMediaRepository(db)
+	attributeRepo := postgresql.NewAttributeRepository(db)
+	productUseCase := usecase.NewProductUsecase(productRepo, variantRepo, mediaRepo, attributeRepo, productCache, usecase.ProductCachePolicy{
+		StaleWhileRevalidate: config.ProductCacheStaleWhileRevalidate,
+		StaleTTL:             config.ProductCacheStaleTTL,
+		NegativeTTL:          config.ProductCacheNegativeTTL,
+	})
+	variantUseCase := usecase.NewVariantUsecase(productRepo, variantRepo, productCache)
+	inventoryRepo := postgresql.NewInventoryRepository(db)
+	inventoryUseCase := usecase.NewInventoryUsecase(inventoryRepo, productCache)
+	warehouseUseCase := usecase.NewWarehouseUsecase(postgresql.NewWarehouseRepository(db), inventoryRepo, productRepo, variantRepo, productCache, domain.AllocationStrategy(config.WarehouseAllocationStrategy))
 
 	categoryRepo := postgresql.NewCategoryRepository(db)
-	categoryUseCase := usecase.NewCategoryUsecase(categoryRepo)
+	categoryUseCase := usecase.NewCategoryUsecase(categoryRepo, productRepo, variantRepo, attributeRepo, productCache)
+	attributeUseCase := usecase.NewAttributeUsecase(attributeRepo, categoryRepo, productRepo, productCache)
+
+	var mq *rabbitmq.RabbitMQ
+	if config.RabbitMQEnabled {
+		mq, err = rabbitmq.NewRabbitMQ(config.RabbitMQURL)
+		if err != nil {
+			close(done)
+			panic("failed to connect to rabbitmq")
+		}
+		defer mq.Close()
+
+		if err := mq.DeclareTopicExchange(config.EventsExchange); err != nil {
+			close(done)
+			panic("failed to declare events exchange")
+		}
+	}
+
+	eventPublisher := rabbitmqEvents.NewPublisher(mq, config.EventsExchange)
+	lowStockUseCase := usecase.NewLowStockUsecase(inventoryRepo, eventPublisher, productCache, config.LowStockSalesWindow, config.ReorderCoverageDays)
+	go lowStockUseCase.Run(done, config.LowStockEvalInterval)
+
+	blobStore, err := initBlobStore(done, config)
+	if err != nil {
+		close(done)
+		logger.Errorf("failed to initialize media storage: %v", err)
+		panic(err)
+	}
+	mediaUseCase := usecase.NewMediaUsecase(mediaRepo, blobStore, productCache, config.MediaMaxUploadBytes, config.MediaThumbnailSize)
+
+	// The connection is established lazily, so OrderService, which also
+	// calls this service, may start later.
+	orderConn, err := grpc.NewClient(
+		config.OrderServiceGRPCAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(
+			grpcmiddleware.InternalAuthUnaryClientInterceptor(config.InternalAuthToken),
+		),
+	)
+	if err != nil {
+		close(done)
+		panic("failed to connect to order service")
+	}
+	defer func() {
+		_ = orderConn.Close()
+	}()
+
+	reviewUseCase := usecase.NewReviewUsecase(postgresql.NewReviewRepository(db), productCache, orderpb.NewOrderServiceClient(orderConn))
+
+	pricingUseCase := usecase.NewPricingUsecase(postgresql.NewPricingRepository(db), productRepo, productCache)
+	go pricingUseCase.Run(done, config.PriceScheduleInterval)
+
+	trashUseCase := usecase.NewTrashUsecase(postgresql.NewTrashRepository(db), productUseCase, productCache, blobStore, eventPublisher, config.TrashRetention)
+	go trashUseCase.Run(done, config.TrashPurgeInterval)
 
 	validate := validator.New()
 
-	grpcHandler := handler.NewProductGRPCHandler(productUseCase, categoryUseCase, validate, config.InternalAuthToken)
+	grpcHandler := handler.NewProductGRPCHandler(productUseCase, categoryUseCase, variantUseCase, inventoryUseCase, warehouseUseCase, lowStockUseCase, mediaUseCase, reviewUseCase, pricingUseCase, trashUseCase, attributeUseCase, validate, config.InternalAuthToken)
 
 	err = grpcHandler.Run(done, config.GRPCPort)
 	if err != nil {
@@ -88,6 +163,52 @@ func main() {
 
 }
 
+// initBlobStore picks the media storage backend. Local storage also starts an
+// HTTP server for the stored files, since nothing else serves them.
+func initBlobStore(done <-chan any, cfg *config.Config) (domain.BlobStore, error) {
+	if cfg.MediaStorage == "s3" {
+		return s3Storage.NewBlobStore(s3Storage.Config{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.S3Bucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			UsePathStyle:    cfg.S3UsePathStyle,
+			PublicBaseURL:   cfg.MediaPublicBaseURL,
+		})
+	}
+
+	store, err := localStorage.NewBlobStore(cfg.MediaLocalDir, cfg.MediaPublicBaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/media/", http.StripPrefix("/media/", store.Handler()))
+	server := &http.Server{
+		Addr:              ":" + cfg.MediaHTTPPort,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		logger.Infof("Product media server is running on port %s", cfg.MediaHTTPPort)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Errorf("Error while serving product media: %v", err)
+		}
+	}()
+
+	go func() {
+		<-done
+		logger.Info("Shutting down product media server...")
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	return store, nil
+}
+
 func initTracing(ctx context.Context) func() {
 	// For OTLP gRPC, endpoint should be just host:port without http:// scheme or path
 	jaegerEndpoint := config.GetEnv("JAEGER_ENDPOINT", "ecommece_jaeger:4317")
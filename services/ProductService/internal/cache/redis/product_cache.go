@@ -3,31 +3,42 @@ package redisCache
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	redisClient "github.com/kareemhamed001/e-commerce/pkg/redis"
-	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
 	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"github.com/redis/go-redis/v9"
 )
 
 const (
-	productKeyPrefix     = "product:"
-	productListKeyPrefix = "products:list"
+	productKeyPrefix      = "product:"
+	productListKeyPrefix  = "products:list"
+	productListVersionKey = "products:list:version"
 )
 
 var _ domain.ProductCache = (*ProductCache)(nil)
 
 type ProductCache struct {
 	client *redisClient.Client
+
+	productHits     atomic.Uint64
+	productMisses   atomic.Uint64
+	productStale    atomic.Uint64
+	productNegative atomic.Uint64
+	listHits        atomic.Uint64
+	listMisses      atomic.Uint64
 }
 
 func NewProductCache(client *redisClient.Client) *ProductCache {
 	return &ProductCache{client: client}
 }
 
-// GetProduct retrieves a product from cache by ID
-func (c *ProductCache) GetProduct(ctx context.Context, id uint) (*dto.ProductResponse, error) {
+// GetProduct retrieves a product entry from cache by ID. Hits on stale and
+// missing-product entries are counted separately as well.
+func (c *ProductCache) GetProduct(ctx context.Context, id uint) (*domain.CachedProduct, error) {
 	if !c.client.IsEnabled() {
 		return nil, fmt.Errorf("cache disabled")
 	}
@@ -35,35 +46,35 @@ func (c *ProductCache) GetProduct(ctx context.Context, id uint) (*dto.ProductRes
 	key := fmt.Sprintf("%s%d", productKeyPrefix, id)
 	data, err := c.client.Get(ctx, key).Bytes()
 	if err != nil {
+		c.productMisses.Add(1)
 		return nil, err
 	}
 
-	var product domain.Product
-	if err := json.Unmarshal(data, &product); err != nil {
-		return nil, err
+	var entry domain.CachedProduct
+	if err := json.Unmarshal(data, &entry); err != nil || (entry.Product == nil && !entry.Missing) {
+		c.productMisses.Add(1)
+		return nil, fmt.Errorf("invalid product cache entry %s", key)
 	}
 
-	return &dto.ProductResponse{
-		Id:               product.ID,
-		Name:             product.Name,
-		ShortDescription: product.ShortDescription,
-		Description:      product.Description,
-		Price:            product.Price,
-		Quantity:         product.Quantity,
-		ImageUrl:         product.ImageUrl,
-		DiscountType:     string(product.DiscountType),
-		DiscountValue:    product.DiscountValue,
-	}, nil
+	c.productHits.Add(1)
+	switch {
+	case entry.Missing:
+		c.productNegative.Add(1)
+	case time.Now().After(entry.FreshUntil):
+		c.productStale.Add(1)
+	}
+	return &entry, nil
 }
 
-// SetProduct stores a product in cache
-func (c *ProductCache) SetProduct(ctx context.Context, product *dto.ProductResponse, ttl time.Duration) error {
+// SetProduct stores a product entry in cache; ttl bounds how long it may be
+// served, stale or not.
+func (c *ProductCache) SetProduct(ctx context.Context, id uint, entry *domain.CachedProduct, ttl time.Duration) error {
 	if !c.client.IsEnabled() {
 		return nil // Graceful degradation
 	}
 
-	key := fmt.Sprintf("%s%d", productKeyPrefix, product.Id)
-	data, err := json.Marshal(product)
+	key := fmt.Sprintf("%s%d", productKeyPrefix, id)
+	data, err := json.Marshal(entry)
 	if err != nil {
 		return err
 	}
@@ -71,63 +82,164 @@ func (c *ProductCache) SetProduct(ctx context.Context, product *dto.ProductRespo
 	return c.client.Set(ctx, key, data, ttl).Err()
 }
 
-// DeleteProduct removes a product from cache
+// GetProducts reads the entries of several products with a single MGET. IDs
+// without a usable entry are left out of the result and counted as misses.
+func (c *ProductCache) GetProducts(ctx context.Context, ids []uint) (map[uint]*domain.CachedProduct, error) {
+	if !c.client.IsEnabled() {
+		return nil, fmt.Errorf("cache disabled")
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = fmt.Sprintf("%s%d", productKeyPrefix, id)
+	}
+	values, err := c.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		c.productMisses.Add(uint64(len(ids)))
+		return nil, err
+	}
+
+	now := time.Now()
+	entries := make(map[uint]*domain.CachedProduct, len(ids))
+	for i, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			c.productMisses.Add(1)
+			continue
+		}
+
+		var entry domain.CachedProduct
+		if err := json.Unmarshal([]byte(data), &entry); err != nil || (entry.Product == nil && !entry.Missing) {
+			c.productMisses.Add(1)
+			continue
+		}
+
+		c.productHits.Add(1)
+		switch {
+		case entry.Missing:
+			c.productNegative.Add(1)
+		case now.After(entry.FreshUntil):
+			c.productStale.Add(1)
+		}
+		entries[ids[i]] = &entry
+	}
+	return entries, nil
+}
+
+// SetProducts stores several product entries in one pipeline, all with the
+// same ttl.
+func (c *ProductCache) SetProducts(ctx context.Context, entries map[uint]*domain.CachedProduct, ttl time.Duration) error {
+	if !c.client.IsEnabled() || len(entries) == 0 {
+		return nil
+	}
+
+	pipe := c.client.Pipeline()
+	for id, entry := range entries {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		pipe.Set(ctx, fmt.Sprintf("%s%d", productKeyPrefix, id), data, ttl)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// DeleteProduct removes a product from cache. Any list page may contain the
+// product, so the catalog version is bumped as well.
 func (c *ProductCache) DeleteProduct(ctx context.Context, id uint) error {
 	if !c.client.IsEnabled() {
 		return nil
 	}
 
 	key := fmt.Sprintf("%s%d", productKeyPrefix, id)
-	return c.client.Del(ctx, key).Err()
+	pipe := c.client.TxPipeline()
+	pipe.Del(ctx, key)
+	pipe.Incr(ctx, productListVersionKey)
+	_, err := pipe.Exec(ctx)
+	return err
 }
 
-// GetProductList retrieves product list from cache
-func (c *ProductCache) GetProductList(ctx context.Context, key string) ([]domain.Product, error) {
+// EvictProduct removes a product from cache without bumping the catalog
+// version. Stock changes go through here: they are frequent, and list pages
+// may show a stock level up to their TTL old.
+func (c *ProductCache) EvictProduct(ctx context.Context, id uint) error {
 	if !c.client.IsEnabled() {
-		return nil, fmt.Errorf("cache disabled")
+		return nil
+	}
+
+	return c.client.Del(ctx, fmt.Sprintf("%s%d", productKeyPrefix, id)).Err()
+}
+
+// ProductListKey returns the cache key of a list page under the current
+// catalog version. Resolve it before loading the page from the database, so
+// a write that lands during the load moves the version past the stored entry.
+func (c *ProductCache) ProductListKey(ctx context.Context, name string) (string, error) {
+	if !c.client.IsEnabled() {
+		return "", fmt.Errorf("cache disabled")
+	}
+
+	version, err := c.client.Get(ctx, productListVersionKey).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s:v%d:%s", productListKeyPrefix, version, name), nil
+}
+
+// GetProductList decodes the cached list page stored under key into dest
+func (c *ProductCache) GetProductList(ctx context.Context, key string, dest any) error {
+	if !c.client.IsEnabled() {
+		return fmt.Errorf("cache disabled")
 	}
 
-	cacheKey := fmt.Sprintf("%s:%s", productListKeyPrefix, key)
-	data, err := c.client.Get(ctx, cacheKey).Bytes()
+	data, err := c.client.Get(ctx, key).Bytes()
 	if err != nil {
-		return nil, err
+		c.listMisses.Add(1)
+		return err
 	}
 
-	var products []domain.Product
-	if err := json.Unmarshal(data, &products); err != nil {
-		return nil, err
+	if err := json.Unmarshal(data, dest); err != nil {
+		c.listMisses.Add(1)
+		return err
 	}
 
-	return products, nil
+	c.listHits.Add(1)
+	return nil
 }
 
-// SetProductList stores product list in cache
-func (c *ProductCache) SetProductList(ctx context.Context, key string, products []domain.Product, ttl time.Duration) error {
+// SetProductList stores a list page under a key from ProductListKey
+func (c *ProductCache) SetProductList(ctx context.Context, key string, list any, ttl time.Duration) error {
 	if !c.client.IsEnabled() {
 		return nil
 	}
 
-	cacheKey := fmt.Sprintf("%s:%s", productListKeyPrefix, key)
-	data, err := json.Marshal(products)
+	data, err := json.Marshal(list)
 	if err != nil {
 		return err
 	}
 
-	return c.client.Set(ctx, cacheKey, data, ttl).Err()
+	return c.client.Set(ctx, key, data, ttl).Err()
 }
 
-// InvalidateProductList clears all product list caches
+// InvalidateProductList bumps the catalog version. Pages cached under older
+// versions are never read again and expire with their TTL.
 func (c *ProductCache) InvalidateProductList(ctx context.Context) error {
 	if !c.client.IsEnabled() {
 		return nil
 	}
 
-	pattern := fmt.Sprintf("%s:*", productListKeyPrefix)
-	iter := c.client.Scan(ctx, 0, pattern, 100).Iterator()
-	for iter.Next(ctx) {
-		if err := c.client.Del(ctx, iter.Val()).Err(); err != nil {
-			return err
-		}
+	return c.client.Incr(ctx, productListVersionKey).Err()
+}
+
+// Stats returns the hit and miss counters of this process since it started
+func (c *ProductCache) Stats() domain.CacheStats {
+	return domain.CacheStats{
+		ProductHits:     c.productHits.Load(),
+		ProductMisses:   c.productMisses.Load(),
+		ProductStale:    c.productStale.Load(),
+		ProductNegative: c.productNegative.Load(),
+		ListHits:        c.listHits.Load(),
+		ListMisses:      c.listMisses.Load(),
 	}
-	return iter.Err()
 }
@@ -0,0 +1,37 @@
+package dto
+
+type CreateAttributeRequest struct {
+	Code string  `json:"code" validate:"required,min=1,max=64"`
+	Name string  `json:"name" validate:"required,min=1,max=100"`
+	Type string  `json:"type" validate:"required,oneof=string number boolean enum"`
+	Unit *string `json:"unit" validate:"omitempty,max=20"`
+	// Options lists the accepted values of enum attributes.
+	Options []string `json:"options" validate:"omitempty,max=100,unique,dive,required,max=100"`
+}
+
+type AttachCategoryAttributeRequest struct {
+	CategoryID  uint `json:"category_id" validate:"required,gt=0"`
+	AttributeID uint `json:"attribute_id" validate:"required,gt=0"`
+	Required    bool `json:"required"`
+	Position    int  `json:"position" validate:"gte=0"`
+}
+
+type ProductAttributeValueRequest struct {
+	Code  string `json:"code" validate:"required,max=64"`
+	Value string `json:"value" validate:"required,max=500"`
+}
+
+// SetProductAttributesRequest replaces every attribute value of the product.
+type SetProductAttributesRequest struct {
+	ProductID uint                           `json:"product_id" validate:"required,gt=0"`
+	Values    []ProductAttributeValueRequest `json:"values" validate:"omitempty,max=100,unique=Code,dive"`
+}
+
+// AttributeFilterRequest matches products whose value of the attribute is one
+// of Values and, for number attributes, lies within [Min, Max].
+type AttributeFilterRequest struct {
+	Code   string   `json:"code" validate:"required,max=64"`
+	Values []string `json:"values" validate:"omitempty,max=50,dive,required,max=100"`
+	Min    *float64 `json:"min"`
+	Max    *float64 `json:"max"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+type AttributeResponse struct {
+	ID      uint     `json:"id"`
+	Code    string   `json:"code"`
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Unit    *string  `json:"unit,omitempty"`
+	Options []string `json:"options,omitempty"`
+	// CategoryID, Required and Position are set when listing the attributes
+	// of a category; CategoryID names the category the attribute is
+	// attached to, which may be an ancestor.
+	CategoryID *uint `json:"category_id,omitempty"`
+	Required   bool  `json:"required"`
+	Position   int   `json:"position"`
+}
+
+type ProductAttributeResponse struct {
+	Code  string  `json:"code"`
+	Name  string  `json:"name"`
+	Type  string  `json:"type"`
+	Unit  *string `json:"unit,omitempty"`
+	Value string  `json:"value"`
+}
@@ -3,9 +3,24 @@ package dto
 type CreateCategoryRequest struct {
 	Name        string  `json:"name" validate:"required"`
 	Description *string `json:"description" validate:"omitempty"`
+	ParentID    *uint   `json:"parent_id" validate:"omitempty,gt=0"`
+	Slug        *string `json:"slug" validate:"omitempty,min=2,max=120"`
 }
 
 type UpdateCategoryRequest struct {
 	Name        *string `json:"name" validate:"omitempty"`
 	Description *string `json:"description" validate:"omitempty"`
+	// ParentID moves the category when set; a value of 0 moves it to the root.
+	ParentID *uint   `json:"parent_id" validate:"omitempty"`
+	Slug     *string `json:"slug" validate:"omitempty,min=2,max=120"`
+}
+
+type AssignProductCategoriesRequest struct {
+	ProductID   uint   `json:"product_id" validate:"required,gt=0"`
+	CategoryIDs []uint `json:"category_ids" validate:"required,min=1,dive,gt=0"`
+}
+
+type UnassignProductCategoriesRequest struct {
+	ProductID   uint   `json:"product_id" validate:"required,gt=0"`
+	CategoryIDs []uint `json:"category_ids" validate:"required,min=1,dive,gt=0"`
 }
@@ -2,6 +2,19 @@ package dto
 
 type CategoryResponse struct {
 	Id          uint    `json:"id"`
+	ParentID    *uint   `json:"parent_id,omitempty"`
 	Name        string  `json:"name"`
+	Slug        string  `json:"slug"`
+	Path        string  `json:"path"`
 	Description *string `json:"description"`
 }
+
+type CategoryTreeNode struct {
+	CategoryResponse
+	Children []CategoryTreeNode `json:"children"`
+}
+
+type ProductCategoriesResponse struct {
+	ProductID  uint               `json:"product_id"`
+	Categories []CategoryResponse `json:"categories"`
+}
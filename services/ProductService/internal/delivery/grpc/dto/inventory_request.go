@@ -0,0 +1,39 @@
+package dto
+
+type RestockProductRequest struct {
+	ProductID   uint   `json:"product_id" validate:"required,gt=0"`
+	VariantID   *uint  `json:"variant_id" validate:"omitempty,gt=0"`
+	WarehouseID *uint  `json:"warehouse_id" validate:"omitempty,gt=0"`
+	Quantity    int    `json:"quantity" validate:"required,gt=0"`
+	Reason      string `json:"reason" validate:"omitempty,max=255"`
+	Actor       string `json:"actor" validate:"omitempty,max=100"`
+}
+
+// RecordStockMovementRequest carries an unsigned Quantity for every type except
+// adjustment, whose Quantity is the signed change to apply.
+// Transfers are not accepted here; use TransferStockRequest.
+type RecordStockMovementRequest struct {
+	ProductID   uint   `json:"product_id" validate:"required,gt=0"`
+	VariantID   *uint  `json:"variant_id" validate:"omitempty,gt=0"`
+	WarehouseID *uint  `json:"warehouse_id" validate:"omitempty,gt=0"`
+	Type        string `json:"type" validate:"required,oneof=restock sale reservation release return adjustment"`
+	Quantity    int    `json:"quantity" validate:"required"`
+	Reason      string `json:"reason" validate:"omitempty,max=255"`
+	Actor       string `json:"actor" validate:"omitempty,max=100"`
+}
+
+type ListStockMovementsRequest struct {
+	ProductID   uint   `json:"product_id" validate:"required,gt=0"`
+	VariantID   *uint  `json:"variant_id" validate:"omitempty,gt=0"`
+	WarehouseID *uint  `json:"warehouse_id" validate:"omitempty,gt=0"`
+	Type        string `json:"type" validate:"omitempty,oneof=restock sale reservation release return adjustment transfer"`
+	Page        int    `json:"page" validate:"gte=1"`
+	PerPage     int    `json:"per_page" validate:"gte=1,lte=100"`
+}
+
+// SetReorderThresholdRequest sets the stock level below which a product, or
+// each of its variants, is reported as low; 0 disables the check.
+type SetReorderThresholdRequest struct {
+	ProductID uint `json:"product_id" validate:"required,gt=0"`
+	Threshold int  `json:"threshold" validate:"gte=0"`
+}
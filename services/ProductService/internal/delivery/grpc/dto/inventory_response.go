@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+type StockMovementResponse struct {
+	ID                    uint      `json:"id"`
+	ProductID             uint      `json:"product_id"`
+	VariantID             *uint     `json:"variant_id,omitempty"`
+	WarehouseID           *uint     `json:"warehouse_id,omitempty"`
+	Type                  string    `json:"type"`
+	Quantity              int       `json:"quantity"`
+	BalanceAfter          int       `json:"balance_after"`
+	WarehouseBalanceAfter *int      `json:"warehouse_balance_after,omitempty"`
+	Reason                string    `json:"reason"`
+	Actor                 string    `json:"actor"`
+	CreatedAt             time.Time `json:"created_at"`
+}
+
+// LowStockItemResponse is a product or variant below its reorder threshold.
+// DailySalesRate is derived from sale movements in the sales window and
+// SuggestedReorderQuantity tops stock up to the threshold plus the expected
+// sales over the coverage period.
+type LowStockItemResponse struct {
+	ProductID                uint    `json:"product_id"`
+	VariantID                *uint   `json:"variant_id,omitempty"`
+	Name                     string  `json:"name"`
+	SKU                      string  `json:"sku,omitempty"`
+	Quantity                 int     `json:"quantity"`
+	ReorderThreshold         int     `json:"reorder_threshold"`
+	UnitsSold                int     `json:"units_sold"`
+	DailySalesRate           float64 `json:"daily_sales_rate"`
+	SuggestedReorderQuantity int     `json:"suggested_reorder_quantity"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+type UploadProductImageRequest struct {
+	ProductID uint   `json:"product_id" validate:"required,gt=0"`
+	AltText   string `json:"alt_text" validate:"max=255"`
+}
+
+type UpdateProductImageRequest struct {
+	AltText string `json:"alt_text" validate:"max=255"`
+}
+
+type ReorderProductImagesRequest struct {
+	ProductID uint   `json:"product_id" validate:"required,gt=0"`
+	ImageIDs  []uint `json:"image_ids" validate:"required,min=1,dive,gt=0"`
+}
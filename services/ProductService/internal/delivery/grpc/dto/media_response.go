@@ -0,0 +1,17 @@
+package dto
+
+import "time"
+
+type ProductImageResponse struct {
+	ID           uint      `json:"id"`
+	ProductID    uint      `json:"product_id"`
+	Position     int       `json:"position"`
+	AltText      string    `json:"alt_text"`
+	URL          string    `json:"url"`
+	ThumbnailURL string    `json:"thumbnail_url"`
+	ContentType  string    `json:"content_type"`
+	SizeBytes    int64     `json:"size_bytes"`
+	Width        int       `json:"width"`
+	Height       int       `json:"height"`
+	CreatedAt    time.Time `json:"created_at"`
+}
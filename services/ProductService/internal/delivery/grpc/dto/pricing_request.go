@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+// SchedulePriceChangeRequest changes the price, the discount or both at
+// EffectiveAt. RemoveDiscount ends the current discount instead.
+type SchedulePriceChangeRequest struct {
+	ProductID      uint      `json:"product_id" validate:"required,gt=0"`
+	Price          *float32  `json:"price" validate:"omitempty,gt=0"`
+	DiscountType   *string   `json:"discount_type" validate:"omitempty,oneof=fixed percent"`
+	DiscountValue  *float32  `json:"discount_value" validate:"omitempty,gt=0"`
+	RemoveDiscount bool      `json:"remove_discount"`
+	EffectiveAt    time.Time `json:"effective_at" validate:"required"`
+	CreatedBy      string    `json:"created_by" validate:"omitempty,max=100"`
+}
+
+// ListScheduledPriceChangesRequest filters scheduled changes; zero fields
+// match all.
+type ListScheduledPriceChangesRequest struct {
+	ProductID uint   `json:"product_id"`
+	Status    string `json:"status" validate:"omitempty,oneof=pending applied canceled"`
+	Page      int    `json:"page" validate:"gte=1"`
+	PerPage   int    `json:"per_page" validate:"gte=1,lte=100"`
+}
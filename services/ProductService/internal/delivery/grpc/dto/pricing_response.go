@@ -0,0 +1,34 @@
+package dto
+
+import "time"
+
+type ScheduledPriceChangeResponse struct {
+	ID             uint       `json:"id"`
+	ProductID      uint       `json:"product_id"`
+	Price          *float32   `json:"price,omitempty"`
+	DiscountType   *string    `json:"discount_type,omitempty"`
+	DiscountValue  *float32   `json:"discount_value,omitempty"`
+	RemoveDiscount bool       `json:"remove_discount"`
+	EffectiveAt    time.Time  `json:"effective_at"`
+	Status         string     `json:"status"`
+	AppliedAt      *time.Time `json:"applied_at,omitempty"`
+	CreatedBy      string     `json:"created_by"`
+	CreatedAt      time.Time  `json:"created_at"`
+}
+
+type PriceHistoryEntryResponse struct {
+	Price             float32   `json:"price"`
+	DiscountType      string    `json:"discount_type"`
+	DiscountValue     float32   `json:"discount_value"`
+	Source            string    `json:"source"`
+	ScheduledChangeID *uint     `json:"scheduled_change_id,omitempty"`
+	ChangedAt         time.Time `json:"changed_at"`
+}
+
+// PriceTimelineResponse lists past prices oldest first, followed by the
+// pending changes in the order they take effect.
+type PriceTimelineResponse struct {
+	ProductID uint                           `json:"product_id"`
+	History   []PriceHistoryEntryResponse    `json:"history"`
+	Upcoming  []ScheduledPriceChangeResponse `json:"upcoming"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+// ImportProductRow is one parsed row of an import file. Line is the line of
+// the row in the file, used to report errors back against it.
+type ImportProductRow struct {
+	Line    int
+	Product CreateProductRequest
+}
+
+type ImportRowError struct {
+	Line  int    `json:"line"`
+	SKU   string `json:"sku,omitempty"`
+	Name  string `json:"name,omitempty"`
+	Error string `json:"error"`
+}
+
+type ImportProductsResponse struct {
+	DryRun    bool             `json:"dry_run"`
+	TotalRows int              `json:"total_rows"`
+	Created   int              `json:"created"`
+	Updated   int              `json:"updated"`
+	Failed    int              `json:"failed"`
+	Errors    []ImportRowError `json:"errors"`
+}
@@ -1,6 +1,7 @@
 package dto
 
 type CreateProductRequest struct {
+	SKU               *string `json:"sku" validate:"omitempty,min=1,max=64"`
 	Name              string  `json:"name" validate:"required,min=2,max=100"`
 	ShortDescription  *string `json:"short_description" validate:"omitempty,min=2,max=150"`
 	Description       string  `json:"description" validate:"required,min=2"`
@@ -11,6 +12,8 @@ type CreateProductRequest struct {
 	DiscountEndDate   *string `json:"discount_end_date" validate:"omitempty,datetime=2006-01-02"`
 	ImageUrl          *string `json:"image_url" validate:"omitempty,url"`
 	Quantity          int     `json:"quantity" validate:"required,gte=0"`
+	MaxPerOrder       int     `json:"max_per_order" validate:"gte=0"`
+	TaxCategory       string  `json:"tax_category" validate:"omitempty,max=32"`
 }
 
 type UpdateProductRequest struct {
@@ -23,5 +26,31 @@ type UpdateProductRequest struct {
 	DiscountStartDate *string  `json:"discount_start_date" validate:"omitempty,datetime=2006-01-02"`
 	DiscountEndDate   *string  `json:"discount_end_date" validate:"omitempty,datetime=2006-01-02"`
 	ImageUrl          *string  `json:"image_url" validate:"omitempty,url"`
-	Quantity          *int     `json:"quantity" validate:"omitempty,gte=0"`
+	TaxCategory       *string  `json:"tax_category" validate:"omitempty,max=32"`
+	// Deprecated: ignored on update; stock changes go through the inventory ledger.
+	Quantity *int `json:"quantity" validate:"omitempty,gte=0"`
+}
+
+// SetMaxPerOrderRequest caps the quantity of a product, or of each of its
+// variants, in one cart or order; 0 removes the limit.
+type SetMaxPerOrderRequest struct {
+	ProductID   uint `json:"product_id" validate:"required,gt=0"`
+	MaxPerOrder int  `json:"max_per_order" validate:"gte=0"`
+}
+
+type GetProductsByIDsRequest struct {
+	IDs []uint `json:"ids" validate:"required,min=1,max=100,dive,gt=0"`
+}
+
+type SearchProductsRequest struct {
+	Query          string                   `json:"query" validate:"omitempty,max=200"`
+	MinPrice       *float32                 `json:"min_price" validate:"omitempty,gte=0"`
+	MaxPrice       *float32                 `json:"max_price" validate:"omitempty,gte=0"`
+	CategoryID     *uint                    `json:"category_id" validate:"omitempty,gt=0"`
+	InStockOnly    bool                     `json:"in_stock_only"`
+	DiscountActive bool                     `json:"discount_active"`
+	Attributes     []AttributeFilterRequest `json:"attributes" validate:"omitempty,max=10,dive"`
+	SortBy         string                   `json:"sort_by" validate:"omitempty,oneof=relevance price_asc price_desc newest popularity"`
+	Page           int                      `json:"page" validate:"gte=1"`
+	PerPage        int                      `json:"per_page" validate:"gte=1,lte=100"`
 }
@@ -1,13 +1,87 @@
 package dto
 
+import "time"
+
 type ProductResponse struct {
-	Id               uint    `json:"id"`
-	Name             string  `json:"name"`
-	ShortDescription *string `json:"short_description,omitempty"`
-	Description      string  `json:"description"`
-	Price            float32 `json:"price"`
-	DiscountType     string  `json:"discount_type"`
-	DiscountValue    float32 `json:"discount_value"`
-	ImageUrl         *string `json:"image_url,omitempty"`
-	Quantity         int     `json:"quantity"`
+	Id                uint       `json:"id"`
+	SKU               *string    `json:"sku,omitempty"`
+	Name              string     `json:"name"`
+	ShortDescription  *string    `json:"short_description,omitempty"`
+	Description       string     `json:"description"`
+	Price             float32    `json:"price"`
+	DiscountType      string     `json:"discount_type"`
+	DiscountValue     float32    `json:"discount_value"`
+	DiscountStartDate *time.Time `json:"discount_start_date,omitempty"`
+	DiscountEndDate   *time.Time `json:"discount_end_date,omitempty"`
+	ImageUrl          *string    `json:"image_url,omitempty"`
+	Quantity          int        `json:"quantity"`
+	ReorderThreshold  int        `json:"reorder_threshold"`
+	MaxPerOrder       int        `json:"max_per_order"`
+	TaxCategory       string     `json:"tax_category"`
+	RatingAverage     float64    `json:"rating_average"`
+	RatingCount       int        `json:"rating_count"`
+
+	Options    []ProductOptionResponse    `json:"options,omitempty"`
+	Variants   []ProductVariantResponse   `json:"variants,omitempty"`
+	Images     []ProductImageResponse     `json:"images,omitempty"`
+	Attributes []ProductAttributeResponse `json:"attributes,omitempty"`
+}
+
+// DiscountActive reports whether the discount applies at now, matching the
+// effective price the catalog search filters and sorts by.
+func (p *ProductResponse) DiscountActive(now time.Time) bool {
+	if p.DiscountValue <= 0 || (p.DiscountType != "fixed" && p.DiscountType != "percent") {
+		return false
+	}
+	if p.DiscountStartDate != nil && p.DiscountStartDate.After(now) {
+		return false
+	}
+	return p.DiscountEndDate == nil || !p.DiscountEndDate.Before(now)
+}
+
+type CategoryFacetResponse struct {
+	CategoryID uint   `json:"category_id"`
+	Name       string `json:"name"`
+	Count      int    `json:"count"`
+}
+
+type PriceRangeFacetResponse struct {
+	Min   float32 `json:"min"`
+	Max   float32 `json:"max"`
+	Count int     `json:"count"`
+}
+
+type SearchFacetsResponse struct {
+	Categories  []CategoryFacetResponse   `json:"categories"`
+	PriceRanges []PriceRangeFacetResponse `json:"price_ranges"`
+	InStock     int                       `json:"in_stock"`
+	OnDiscount  int                       `json:"on_discount"`
+}
+
+type ProductsByIDsResponse struct {
+	Products   []ProductResponse `json:"products"`
+	MissingIDs []uint            `json:"missing_ids"`
+}
+
+type SearchProductsResponse struct {
+	Products   []ProductResponse    `json:"products"`
+	TotalCount int                  `json:"total_count"`
+	Facets     SearchFacetsResponse `json:"facets"`
+}
+
+// ProductListPage is one page of a product listing as stored in the cache.
+type ProductListPage struct {
+	Products   []ProductResponse `json:"products"`
+	TotalCount int               `json:"total_count"`
+}
+
+type CacheStatsResponse struct {
+	ProductHits     uint64  `json:"product_hits"`
+	ProductMisses   uint64  `json:"product_misses"`
+	ProductStale    uint64  `json:"product_stale"`
+	ProductNegative uint64  `json:"product_negative"`
+	ProductHitRatio float64 `json:"product_hit_ratio"`
+	ListHits        uint64  `json:"list_hits"`
+	ListMisses      uint64  `json:"list_misses"`
+	ListHitRatio    float64 `json:"list_hit_ratio"`
 }
@@ -0,0 +1,30 @@
+package dto
+
+type CreateReviewRequest struct {
+	ProductID uint   `json:"product_id" validate:"required,gt=0"`
+	UserID    uint   `json:"user_id" validate:"required,gt=0"`
+	Rating    int    `json:"rating" validate:"required,min=1,max=5"`
+	Title     string `json:"title" validate:"omitempty,max=150"`
+	Body      string `json:"body" validate:"omitempty,max=5000"`
+}
+
+type UpdateReviewRequest struct {
+	UserID uint   `json:"user_id" validate:"required,gt=0"`
+	Rating int    `json:"rating" validate:"required,min=1,max=5"`
+	Title  string `json:"title" validate:"omitempty,max=150"`
+	Body   string `json:"body" validate:"omitempty,max=5000"`
+}
+
+// ListReviewsRequest filters reviews in any status; zero fields match all.
+type ListReviewsRequest struct {
+	ProductID uint   `json:"product_id"`
+	UserID    uint   `json:"user_id"`
+	Status    string `json:"status" validate:"omitempty,oneof=pending approved rejected"`
+	Page      int    `json:"page" validate:"gte=1"`
+	PerPage   int    `json:"per_page" validate:"gte=1,lte=100"`
+}
+
+type ModerateReviewRequest struct {
+	Status string `json:"status" validate:"required,oneof=approved rejected"`
+	Note   string `json:"note" validate:"omitempty,max=500"`
+}
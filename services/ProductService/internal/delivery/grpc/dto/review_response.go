@@ -0,0 +1,16 @@
+package dto
+
+import "time"
+
+type ReviewResponse struct {
+	ID             uint      `json:"id"`
+	ProductID      uint      `json:"product_id"`
+	UserID         uint      `json:"user_id"`
+	Rating         int       `json:"rating"`
+	Title          string    `json:"title"`
+	Body           string    `json:"body"`
+	Status         string    `json:"status"`
+	ModerationNote string    `json:"moderation_note,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
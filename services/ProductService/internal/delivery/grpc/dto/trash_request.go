@@ -0,0 +1,6 @@
+package dto
+
+type ListTrashRequest struct {
+	Page    int `json:"page" validate:"gte=1"`
+	PerPage int `json:"per_page" validate:"gte=1,lte=100"`
+}
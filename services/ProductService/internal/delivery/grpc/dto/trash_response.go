@@ -0,0 +1,24 @@
+package dto
+
+import "time"
+
+// TrashedProductResponse describes a product in the trash. PurgeAt is unset
+// when trashed items are kept until purged by hand.
+type TrashedProductResponse struct {
+	ID        uint       `json:"id"`
+	SKU       *string    `json:"sku,omitempty"`
+	Name      string     `json:"name"`
+	Price     float32    `json:"price"`
+	DeletedAt time.Time  `json:"deleted_at"`
+	PurgeAt   *time.Time `json:"purge_at,omitempty"`
+}
+
+type TrashedCategoryResponse struct {
+	ID        uint       `json:"id"`
+	ParentID  *uint      `json:"parent_id,omitempty"`
+	Name      string     `json:"name"`
+	Slug      string     `json:"slug"`
+	Path      string     `json:"path"`
+	DeletedAt time.Time  `json:"deleted_at"`
+	PurgeAt   *time.Time `json:"purge_at,omitempty"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+type CreateProductOptionRequest struct {
+	ProductID uint     `json:"product_id" validate:"required,gt=0"`
+	Name      string   `json:"name" validate:"required,min=1,max=50"`
+	Values    []string `json:"values" validate:"required,min=1,unique,dive,required,max=100"`
+}
+
+type CreateProductVariantRequest struct {
+	ProductID      uint     `json:"product_id" validate:"required,gt=0"`
+	SKU            string   `json:"sku" validate:"required,min=1,max=64"`
+	Price          *float32 `json:"price" validate:"omitempty,gt=0"`
+	Quantity       int      `json:"quantity" validate:"gte=0"`
+	ImageUrl       *string  `json:"image_url" validate:"omitempty,url"`
+	OptionValueIDs []uint   `json:"option_value_ids" validate:"omitempty,unique,dive,gt=0"`
+}
+
+type UpdateProductVariantRequest struct {
+	SKU *string `json:"sku" validate:"omitempty,min=1,max=64"`
+	// Price replaces the price override when set; a value of 0 clears it so
+	// the variant falls back to the product price.
+	Price    *float32 `json:"price" validate:"omitempty,gte=0"`
+	Quantity *int     `json:"quantity" validate:"omitempty,gte=0"`
+	ImageUrl *string  `json:"image_url" validate:"omitempty,url"`
+}
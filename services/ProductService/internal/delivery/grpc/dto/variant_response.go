@@ -0,0 +1,26 @@
+package dto
+
+type ProductOptionValueResponse struct {
+	ID    uint   `json:"id"`
+	Value string `json:"value"`
+}
+
+type ProductOptionResponse struct {
+	ID       uint                         `json:"id"`
+	Name     string                       `json:"name"`
+	Position int                          `json:"position"`
+	Values   []ProductOptionValueResponse `json:"values"`
+}
+
+type ProductVariantResponse struct {
+	ID        uint   `json:"id"`
+	ProductID uint   `json:"product_id"`
+	SKU       string `json:"sku"`
+	// Price is the effective unit price: the override if set, else the product price.
+	Price            float32           `json:"price"`
+	HasPriceOverride bool              `json:"has_price_override"`
+	Quantity         int               `json:"quantity"`
+	ImageUrl         *string           `json:"image_url,omitempty"`
+	OptionValueIDs   []uint            `json:"option_value_ids"`
+	Options          map[string]string `json:"options"`
+}
@@ -0,0 +1,65 @@
+package dto
+
+type CreateWarehouseRequest struct {
+	Code     string `json:"code" validate:"required,min=1,max=32"`
+	Name     string `json:"name" validate:"required,min=1,max=255"`
+	Country  string `json:"country" validate:"omitempty,max=100"`
+	State    string `json:"state" validate:"omitempty,max=100"`
+	City     string `json:"city" validate:"omitempty,max=100"`
+	ZipCode  string `json:"zip_code" validate:"omitempty,max=20"`
+	Priority int    `json:"priority" validate:"gte=0"`
+	// IsActive defaults to true when omitted.
+	IsActive *bool `json:"is_active"`
+}
+
+type UpdateWarehouseRequest struct {
+	Code     *string `json:"code" validate:"omitempty,min=1,max=32"`
+	Name     *string `json:"name" validate:"omitempty,min=1,max=255"`
+	Country  *string `json:"country" validate:"omitempty,max=100"`
+	State    *string `json:"state" validate:"omitempty,max=100"`
+	City     *string `json:"city" validate:"omitempty,max=100"`
+	ZipCode  *string `json:"zip_code" validate:"omitempty,max=20"`
+	Priority *int    `json:"priority" validate:"omitempty,gte=0"`
+	IsActive *bool   `json:"is_active"`
+}
+
+type TransferStockRequest struct {
+	ProductID       uint   `json:"product_id" validate:"required,gt=0"`
+	VariantID       *uint  `json:"variant_id" validate:"omitempty,gt=0"`
+	FromWarehouseID uint   `json:"from_warehouse_id" validate:"required,gt=0"`
+	ToWarehouseID   uint   `json:"to_warehouse_id" validate:"required,gt=0,nefield=FromWarehouseID"`
+	Quantity        int    `json:"quantity" validate:"required,gt=0"`
+	Reason          string `json:"reason" validate:"omitempty,max=255"`
+	Actor           string `json:"actor" validate:"omitempty,max=100"`
+}
+
+type AllocationItemRequest struct {
+	ProductID uint  `json:"product_id" validate:"required,gt=0"`
+	VariantID *uint `json:"variant_id" validate:"omitempty,gt=0"`
+	Quantity  int   `json:"quantity" validate:"required,gt=0"`
+}
+
+// AllocateWarehouseRequest picks one warehouse for all Items. Strategy
+// overrides the configured default when set. Reserve also takes the items out
+// of stock, recording Reason and Actor on the sale movements.
+type AllocateWarehouseRequest struct {
+	Items    []AllocationItemRequest `json:"items" validate:"required,min=1,dive"`
+	Country  string                  `json:"country" validate:"omitempty,max=100"`
+	State    string                  `json:"state" validate:"omitempty,max=100"`
+	City     string                  `json:"city" validate:"omitempty,max=100"`
+	ZipCode  string                  `json:"zip_code" validate:"omitempty,max=20"`
+	Strategy string                  `json:"strategy" validate:"omitempty,oneof=nearest most_stock"`
+	Reserve  bool                    `json:"reserve"`
+	Reason   string                  `json:"reason" validate:"omitempty,max=255"`
+	Actor    string                  `json:"actor" validate:"omitempty,max=100"`
+}
+
+// StockItemsRequest takes stock for, or returns stock of, an allocated order:
+// in the warehouse it was allocated, or in unassigned stock when WarehouseID
+// is nil.
+type StockItemsRequest struct {
+	WarehouseID *uint                   `json:"warehouse_id" validate:"omitempty,gt=0"`
+	Items       []AllocationItemRequest `json:"items" validate:"required,min=1,dive"`
+	Reason      string                  `json:"reason" validate:"omitempty,max=255"`
+	Actor       string                  `json:"actor" validate:"omitempty,max=100"`
+}
@@ -0,0 +1,47 @@
+package dto
+
+import "time"
+
+type WarehouseResponse struct {
+	ID        uint      `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Country   string    `json:"country"`
+	State     string    `json:"state"`
+	City      string    `json:"city"`
+	ZipCode   string    `json:"zip_code"`
+	Priority  int       `json:"priority"`
+	IsActive  bool      `json:"is_active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type WarehouseStockLevelResponse struct {
+	WarehouseID   uint   `json:"warehouse_id"`
+	WarehouseCode string `json:"warehouse_code"`
+	WarehouseName string `json:"warehouse_name"`
+	IsActive      bool   `json:"is_active"`
+	Quantity      int    `json:"quantity"`
+}
+
+// StockAvailabilityResponse reports stock of a product or variant by location.
+// TotalQuantity sums every warehouse level and UnassignedQuantity is on-hand
+// stock not yet placed in any warehouse.
+type StockAvailabilityResponse struct {
+	ProductID          uint                          `json:"product_id"`
+	VariantID          *uint                         `json:"variant_id,omitempty"`
+	OnHand             int                           `json:"on_hand"`
+	TotalQuantity      int                           `json:"total_quantity"`
+	UnassignedQuantity int                           `json:"unassigned_quantity"`
+	Levels             []WarehouseStockLevelResponse `json:"levels"`
+}
+
+// AllocationResponse carries the chosen warehouse. Allocated is false when
+// no active warehouse holds every item and unassigned stock covers them
+// instead. Movements are the sale movements of a reserving allocation.
+type AllocationResponse struct {
+	Allocated bool                    `json:"allocated"`
+	Strategy  string                  `json:"strategy"`
+	Warehouse *WarehouseResponse      `json:"warehouse,omitempty"`
+	Movements []StockMovementResponse `json:"movements,omitempty"`
+}
@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	pb "github.com/kareemhamed001/e-commerce/shared/proto/v1/product"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (h *ProductGRPCHandler) CreateAttribute(ctx context.Context, req *pb.CreateAttributeRequest) (*pb.AttributeResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.CreateAttribute")
+	defer span.End()
+
+	createDto := dto.CreateAttributeRequest{
+		Code:    req.GetCode(),
+		Name:    req.GetName(),
+		Type:    req.GetType(),
+		Options: req.GetOptions(),
+	}
+	if unit := req.GetUnit(); unit != "" {
+		createDto.Unit = &unit
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateCreateAttribute")
+	if err := h.validate.Struct(&createDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(attribute.String("attribute.code", createDto.Code))
+
+	attr, err := h.attributeUsecase.CreateAttribute(ctx, &createDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Attribute created successfully")
+	return &pb.AttributeResponse{Attribute: mapAttributeToPB(attr)}, nil
+}
+
+func (h *ProductGRPCHandler) ListAttributes(ctx context.Context, req *pb.ListAttributesRequest) (*pb.ListAttributesResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.ListAttributes")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("category.id", int(req.GetCategoryId())))
+
+	attrs, err := h.attributeUsecase.ListAttributes(ctx, uint(req.GetCategoryId()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("attributes.count", len(attrs)))
+	span.SetStatus(codes.Ok, "Attributes retrieved successfully")
+	return mapAttributesToPB(attrs), nil
+}
+
+func (h *ProductGRPCHandler) DeleteAttribute(ctx context.Context, req *pb.DeleteAttributeRequest) (*pb.DeleteAttributeResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.DeleteAttribute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("attribute.id", int(req.GetId())))
+
+	if err := h.attributeUsecase.DeleteAttribute(ctx, uint(req.GetId())); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Attribute deleted successfully")
+	return &pb.DeleteAttributeResponse{Success: true}, nil
+}
+
+func (h *ProductGRPCHandler) AttachCategoryAttribute(ctx context.Context, req *pb.AttachCategoryAttributeRequest) (*pb.ListAttributesResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.AttachCategoryAttribute")
+	defer span.End()
+
+	attachDto := dto.AttachCategoryAttributeRequest{
+		CategoryID:  uint(req.GetCategoryId()),
+		AttributeID: uint(req.GetAttributeId()),
+		Required:    req.GetRequired(),
+		Position:    int(req.GetPosition()),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateAttachCategoryAttribute")
+	if err := h.validate.Struct(&attachDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("category.id", int(attachDto.CategoryID)),
+		attribute.Int("attribute.id", int(attachDto.AttributeID)),
+	)
+
+	attrs, err := h.attributeUsecase.AttachCategoryAttribute(ctx, &attachDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Attribute attached successfully")
+	return mapAttributesToPB(attrs), nil
+}
+
+func (h *ProductGRPCHandler) DetachCategoryAttribute(ctx context.Context, req *pb.DetachCategoryAttributeRequest) (*pb.DetachCategoryAttributeResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.DetachCategoryAttribute")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("category.id", int(req.GetCategoryId())),
+		attribute.Int("attribute.id", int(req.GetAttributeId())),
+	)
+
+	if err := h.attributeUsecase.DetachCategoryAttribute(ctx, uint(req.GetCategoryId()), uint(req.GetAttributeId())); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Attribute detached successfully")
+	return &pb.DetachCategoryAttributeResponse{Success: true}, nil
+}
+
+func (h *ProductGRPCHandler) SetProductAttributes(ctx context.Context, req *pb.SetProductAttributesRequest) (*pb.SetProductAttributesResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.SetProductAttributes")
+	defer span.End()
+
+	setDto := dto.SetProductAttributesRequest{
+		ProductID: uint(req.GetProductId()),
+		Values:    make([]dto.ProductAttributeValueRequest, 0, len(req.GetValues())),
+	}
+	for _, v := range req.GetValues() {
+		setDto.Values = append(setDto.Values, dto.ProductAttributeValueRequest{
+			Code:  v.GetCode(),
+			Value: v.GetValue(),
+		})
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateSetProductAttributes")
+	if err := h.validate.Struct(&setDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(setDto.ProductID)))
+
+	values, err := h.attributeUsecase.SetProductAttributes(ctx, &setDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := &pb.SetProductAttributesResponse{
+		Attributes: make([]*pb.ProductAttribute, 0, len(values)),
+	}
+	for i := range values {
+		response.Attributes = append(response.Attributes, mapProductAttributeToPB(&values[i]))
+	}
+
+	span.SetStatus(codes.Ok, "Product attributes set successfully")
+	return response, nil
+}
+
+// validateAttributeFilters checks the attribute filters of a listing request.
+func (h *ProductGRPCHandler) validateAttributeFilters(filters []dto.AttributeFilterRequest) error {
+	return h.validate.Var(filters, "omitempty,max=10,dive")
+}
+
+func mapAttributeFiltersFromPB(filters []*pb.AttributeFilter) []dto.AttributeFilterRequest {
+	if len(filters) == 0 {
+		return nil
+	}
+	mapped := make([]dto.AttributeFilterRequest, 0, len(filters))
+	for _, f := range filters {
+		mapped = append(mapped, dto.AttributeFilterRequest{
+			Code:   f.GetCode(),
+			Values: f.GetValues(),
+			Min:    f.Min,
+			Max:    f.Max,
+		})
+	}
+	return mapped
+}
+
+func mapAttributesToPB(attrs []dto.AttributeResponse) *pb.ListAttributesResponse {
+	response := &pb.ListAttributesResponse{
+		Attributes: make([]*pb.Attribute, 0, len(attrs)),
+	}
+	for i := range attrs {
+		response.Attributes = append(response.Attributes, mapAttributeToPB(&attrs[i]))
+	}
+	return response
+}
+
+func mapAttributeToPB(attr *dto.AttributeResponse) *pb.Attribute {
+	response := &pb.Attribute{
+		Id:       int64(attr.ID),
+		Code:     attr.Code,
+		Name:     attr.Name,
+		Type:     attr.Type,
+		Options:  attr.Options,
+		Required: attr.Required,
+		Position: int32(attr.Position),
+	}
+	if attr.Unit != nil {
+		response.Unit = *attr.Unit
+	}
+	if attr.CategoryID != nil {
+		response.CategoryId = int64(*attr.CategoryID)
+	}
+	return response
+}
+
+func mapProductAttributeToPB(attr *dto.ProductAttributeResponse) *pb.ProductAttribute {
+	response := &pb.ProductAttribute{
+		Code:  attr.Code,
+		Name:  attr.Name,
+		Type:  attr.Type,
+		Value: attr.Value,
+	}
+	if attr.Unit != nil {
+		response.Unit = *attr.Unit
+	}
+	return response
+}
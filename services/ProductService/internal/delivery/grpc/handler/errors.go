@@ -3,5 +3,6 @@ package handler
 import "errors"
 
 var (
-	ErrEmailRequired = errors.New("email is required")
+	ErrEmailRequired      = errors.New("email is required")
+	ErrInvalidEffectiveAt = errors.New("effective_at must be an RFC 3339 timestamp")
 )
@@ -3,6 +3,7 @@ package handler
 import (
 	"context"
 	"net"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/kareemhamed001/e-commerce/pkg/grpcmiddleware"
@@ -19,21 +20,39 @@ import (
 
 type ProductGRPCHandler struct {
 	pb.UnimplementedProductServiceServer
-	productUsecase  domain.ProductUsecase
-	categoryUsecase domain.CategoryUsecase
-	validate        *validator.Validate
-	tracer          trace.Tracer
+	productUsecase    domain.ProductUsecase
+	categoryUsecase   domain.CategoryUsecase
+	variantUsecase    domain.VariantUsecase
+	inventoryUsecase  domain.InventoryUsecase
+	warehouseUsecase  domain.WarehouseUsecase
+	lowStockUsecase   domain.LowStockUsecase
+	mediaUsecase      domain.MediaUsecase
+	reviewUsecase     domain.ReviewUsecase
+	pricingUsecase    domain.PricingUsecase
+	trashUsecase      domain.TrashUsecase
+	attributeUsecase  domain.AttributeUsecase
+	validate          *validator.Validate
+	tracer            trace.Tracer
 	internalAuthToken string
 }
 
 var _ pb.ProductServiceServer = (*ProductGRPCHandler)(nil)
 
-func NewProductGRPCHandler(productUsecase domain.ProductUsecase, categoryUsecase domain.CategoryUsecase, validate *validator.Validate, internalAuthToken string) *ProductGRPCHandler {
+func NewProductGRPCHandler(productUsecase domain.ProductUsecase, categoryUsecase domain.CategoryUsecase, variantUsecase domain.VariantUsecase, inventoryUsecase domain.InventoryUsecase, warehouseUsecase domain.WarehouseUsecase, lowStockUsecase domain.LowStockUsecase, mediaUsecase domain.MediaUsecase, reviewUsecase domain.ReviewUsecase, pricingUsecase domain.PricingUsecase, trashUsecase domain.TrashUsecase, attributeUsecase domain.AttributeUsecase, validate *validator.Validate, internalAuthToken string) *ProductGRPCHandler {
 	return &ProductGRPCHandler{
-		productUsecase:  productUsecase,
-		categoryUsecase: categoryUsecase,
-		validate:        validate,
-		tracer:          otel.Tracer("product_GRPC_handler"),
+		productUsecase:    productUsecase,
+		categoryUsecase:   categoryUsecase,
+		variantUsecase:    variantUsecase,
+		inventoryUsecase:  inventoryUsecase,
+		warehouseUsecase:  warehouseUsecase,
+		lowStockUsecase:   lowStockUsecase,
+		mediaUsecase:      mediaUsecase,
+		reviewUsecase:     reviewUsecase,
+		pricingUsecase:    pricingUsecase,
+		trashUsecase:      trashUsecase,
+		attributeUsecase:  attributeUsecase,
+		validate:          validate,
+		tracer:            otel.Tracer("product_GRPC_handler"),
 		internalAuthToken: internalAuthToken,
 	}
 }
@@ -64,6 +83,11 @@ func (h *ProductGRPCHandler) CreateProduct(ctx context.Context, req *pb.CreatePr
 		DiscountValue:    req.GetDiscountValue(),
 		ImageUrl:         &imageUrl,
 		Quantity:         int(req.GetQuantity()),
+		MaxPerOrder:      int(req.GetMaxPerOrder()),
+		TaxCategory:      req.GetTaxCategory(),
+	}
+	if sku := req.GetSku(); sku != "" {
+		productRequestDto.SKU = &sku
 	}
 
 	_, validationSpan := h.tracer.Start(reqCtx, "ProductHandler.ValidateProduct")
@@ -128,17 +152,7 @@ func (h *ProductGRPCHandler) GetProductByID(ctx context.Context, req *pb.GetProd
 		attribute.Float64("product.price", float64(product.Price)),
 	)
 
-	productResponse := &pb.Product{
-		Id:               int32(product.Id),
-		Name:             product.Name,
-		ShortDescription: *product.ShortDescription,
-		Description:      product.Description,
-		Price:            product.Price,
-		DiscountType:     string(product.DiscountType),
-		DiscountValue:    product.DiscountValue,
-		ImageUrl:         *product.ImageUrl,
-		Quantity:         int32(product.Quantity),
-	}
+	productResponse := mapProductToPB(product)
 
 	span.SetAttributes(attribute.String("product.response", productResponse.String()))
 
@@ -149,6 +163,56 @@ func (h *ProductGRPCHandler) GetProductByID(ctx context.Context, req *pb.GetProd
 	}, nil
 }
 
+func (h *ProductGRPCHandler) GetProductsByIDs(ctx context.Context, req *pb.GetProductsByIDsRequest) (*pb.GetProductsByIDsResponse, error) {
+	reqCtx, span := h.tracer.Start(ctx, "ProductHandler.GetProductsByIDs")
+	defer span.End()
+
+	request := dto.GetProductsByIDsRequest{IDs: make([]uint, 0, len(req.GetIds()))}
+	for _, id := range req.GetIds() {
+		request.IDs = append(request.IDs, uint(id))
+	}
+	span.SetAttributes(attribute.Int("product.ids.count", len(request.IDs)))
+
+	_, validationSpan := h.tracer.Start(reqCtx, "ProductHandler.ValidateGetProductsByIDs")
+	if err := h.validate.Struct(&request); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	result, err := h.productUsecase.GetProductsByIDs(reqCtx, request.IDs)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	products := make([]*pb.Product, 0, len(result.Products))
+	for i := range result.Products {
+		products = append(products, mapProductToPB(&result.Products[i]))
+	}
+	missingIDs := make([]int64, 0, len(result.MissingIDs))
+	for _, id := range result.MissingIDs {
+		missingIDs = append(missingIDs, int64(id))
+	}
+
+	span.SetAttributes(
+		attribute.Int("products.count", len(products)),
+		attribute.Int("products.missing", len(missingIDs)),
+	)
+	span.SetStatus(codes.Ok, "Products retrieved successfully")
+
+	return &pb.GetProductsByIDsResponse{
+		Products:   products,
+		MissingIds: missingIDs,
+	}, nil
+}
+
 func (h *ProductGRPCHandler) ListProducts(ctx context.Context, req *pb.ListProductsRequest) (*pb.ListProductsResponse, error) {
 	// Implementation here
 
@@ -164,12 +228,27 @@ func (h *ProductGRPCHandler) ListProducts(ctx context.Context, req *pb.ListProdu
 		limit = 10
 	}
 
+	attributes := mapAttributeFiltersFromPB(req.GetAttributes())
+
+	_, validationSpan := h.tracer.Start(reqCtx, "ProductHandler.ValidateListProducts")
+	if err := h.validateAttributeFilters(attributes); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
 	span.SetAttributes(
 		attribute.Int("pagination.page", page),
 		attribute.Int("pagination.limit", limit),
+		attribute.Int("filter.attributes", len(attributes)),
 	)
 
-	products, total, err := h.productUsecase.ListProducts(reqCtx, page, limit)
+	products, total, err := h.productUsecase.ListProducts(reqCtx, page, limit, attributes)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -182,18 +261,8 @@ func (h *ProductGRPCHandler) ListProducts(ctx context.Context, req *pb.ListProdu
 
 	productResponse := make([]*pb.Product, 0, len(products))
 
-	for _, p := range products {
-		productResponse = append(productResponse, &pb.Product{
-			Id:               int32(p.Id),
-			Name:             p.Name,
-			ShortDescription: *p.ShortDescription,
-			Description:      p.Description,
-			Price:            p.Price,
-			DiscountType:     string(p.DiscountType),
-			DiscountValue:    p.DiscountValue,
-			ImageUrl:         *p.ImageUrl,
-			Quantity:         int32(p.Quantity),
-		})
+	for i := range products {
+		productResponse = append(productResponse, mapProductToPB(&products[i]))
 	}
 
 	span.SetStatus(codes.Ok, "Products retrieved successfully")
@@ -204,6 +273,25 @@ func (h *ProductGRPCHandler) ListProducts(ctx context.Context, req *pb.ListProdu
 	}, nil
 }
 
+func (h *ProductGRPCHandler) GetCacheStats(ctx context.Context, req *pb.GetCacheStatsRequest) (*pb.GetCacheStatsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.GetCacheStats")
+	defer span.End()
+
+	stats := h.productUsecase.GetCacheStats(ctx)
+
+	span.SetStatus(codes.Ok, "Cache stats retrieved successfully")
+	return &pb.GetCacheStatsResponse{
+		ProductHits:     stats.ProductHits,
+		ProductMisses:   stats.ProductMisses,
+		ProductStale:    stats.ProductStale,
+		ProductNegative: stats.ProductNegative,
+		ProductHitRatio: stats.ProductHitRatio,
+		ListHits:        stats.ListHits,
+		ListMisses:      stats.ListMisses,
+		ListHitRatio:    stats.ListHitRatio,
+	}, nil
+}
+
 func (h *ProductGRPCHandler) UpdateProduct(ctx context.Context, req *pb.UpdateProductRequest) (*pb.UpdateProductResponse, error) {
 	id := int(req.GetId())
 	reqCtx, span := h.tracer.Start(ctx, "ProductHandler.UpdateProduct")
@@ -218,6 +306,7 @@ func (h *ProductGRPCHandler) UpdateProduct(ctx context.Context, req *pb.UpdatePr
 	discountValue := req.GetDiscountValue()
 	imageUrl := req.GetImageUrl()
 	quantity := int(req.GetQuantity())
+	taxCategory := req.GetTaxCategory()
 
 	var discountType string
 	switch req.GetDiscountType() {
@@ -238,6 +327,7 @@ func (h *ProductGRPCHandler) UpdateProduct(ctx context.Context, req *pb.UpdatePr
 		DiscountValue:    &discountValue,
 		ImageUrl:         &imageUrl,
 		Quantity:         &quantity,
+		TaxCategory:      &taxCategory,
 	}
 
 	_, validationSpan := h.tracer.Start(reqCtx, "ProductHandler.ValidateUpdateProduct")
@@ -287,6 +377,44 @@ func (h *ProductGRPCHandler) UpdateProduct(ctx context.Context, req *pb.UpdatePr
 	}, nil
 }
 
+func (h *ProductGRPCHandler) SetMaxPerOrder(ctx context.Context, req *pb.SetMaxPerOrderRequest) (*pb.SetMaxPerOrderResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.SetMaxPerOrder")
+	defer span.End()
+
+	limitDto := dto.SetMaxPerOrderRequest{
+		ProductID:   uint(req.GetProductId()),
+		MaxPerOrder: int(req.GetMaxPerOrder()),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateSetMaxPerOrder")
+	if err := h.validate.Struct(&limitDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(limitDto.ProductID)),
+		attribute.Int("product.max_per_order", limitDto.MaxPerOrder),
+	)
+
+	if err := h.productUsecase.SetMaxPerOrder(ctx, &limitDto); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Max per order set successfully")
+	return &pb.SetMaxPerOrderResponse{
+		Success: true,
+	}, nil
+}
+
 func (h *ProductGRPCHandler) DeleteProduct(ctx context.Context, req *pb.DeleteProductRequest) (*pb.DeleteProductResponse, error) {
 	id := req.GetId()
 	reqCtx, span := h.tracer.Start(ctx, "ProductHandler.DeleteProduct")
@@ -306,6 +434,104 @@ func (h *ProductGRPCHandler) DeleteProduct(ctx context.Context, req *pb.DeletePr
 	}, nil
 }
 
+func (h *ProductGRPCHandler) SearchProducts(ctx context.Context, req *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	reqCtx, span := h.tracer.Start(ctx, "ProductHandler.SearchProducts")
+	defer span.End()
+
+	page := int(req.GetPage())
+	if page == 0 {
+		page = 1
+	}
+	perPage := int(req.GetPerPage())
+	if perPage == 0 {
+		perPage = 10
+	}
+
+	searchRequest := dto.SearchProductsRequest{
+		Query:          req.GetQuery(),
+		InStockOnly:    req.GetInStockOnly(),
+		DiscountActive: req.GetDiscountActive(),
+		Attributes:     mapAttributeFiltersFromPB(req.GetAttributes()),
+		SortBy:         mapSortByFromPB(req.GetSortBy()),
+		Page:           page,
+		PerPage:        perPage,
+	}
+	if req.GetMinPrice() > 0 {
+		minPrice := req.GetMinPrice()
+		searchRequest.MinPrice = &minPrice
+	}
+	if req.GetMaxPrice() > 0 {
+		maxPrice := req.GetMaxPrice()
+		searchRequest.MaxPrice = &maxPrice
+	}
+	if req.GetCategoryId() > 0 {
+		categoryID := uint(req.GetCategoryId())
+		searchRequest.CategoryID = &categoryID
+	}
+
+	_, validationSpan := h.tracer.Start(reqCtx, "ProductHandler.ValidateSearchProducts")
+	if err := h.validate.Struct(&searchRequest); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.String("search.query", searchRequest.Query),
+		attribute.String("search.sort_by", searchRequest.SortBy),
+		attribute.Int("pagination.page", page),
+		attribute.Int("pagination.limit", perPage),
+	)
+
+	result, err := h.productUsecase.SearchProducts(reqCtx, &searchRequest)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	products := make([]*pb.Product, 0, len(result.Products))
+	for i := range result.Products {
+		products = append(products, mapProductToPB(&result.Products[i]))
+	}
+
+	facets := &pb.SearchFacets{
+		Categories:  make([]*pb.CategoryFacet, 0, len(result.Facets.Categories)),
+		PriceRanges: make([]*pb.PriceRangeFacet, 0, len(result.Facets.PriceRanges)),
+		InStock:     int32(result.Facets.InStock),
+		OnDiscount:  int32(result.Facets.OnDiscount),
+	}
+	for _, c := range result.Facets.Categories {
+		facets.Categories = append(facets.Categories, &pb.CategoryFacet{
+			CategoryId: int64(c.CategoryID),
+			Name:       c.Name,
+			Count:      int32(c.Count),
+		})
+	}
+	for _, pr := range result.Facets.PriceRanges {
+		facets.PriceRanges = append(facets.PriceRanges, &pb.PriceRangeFacet{
+			Min:   pr.Min,
+			Max:   pr.Max,
+			Count: int32(pr.Count),
+		})
+	}
+
+	span.SetAttributes(attribute.Int("products.count", len(products)))
+	span.SetAttributes(attribute.Int("products.total", result.TotalCount))
+	span.SetStatus(codes.Ok, "Products searched successfully")
+
+	return &pb.SearchProductsResponse{
+		Products:   products,
+		TotalCount: int32(result.TotalCount),
+		Facets:     facets,
+	}, nil
+}
+
 // CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
 func (h *ProductGRPCHandler) CreateCategory(ctx context.Context, req *pb.CreateCategoryRequest) (*pb.CreateCategoryResponse, error) {
 	ctx, span := h.tracer.Start(ctx, "ProductHandler.CreateCategory")
@@ -317,6 +543,14 @@ func (h *ProductGRPCHandler) CreateCategory(ctx context.Context, req *pb.CreateC
 		Name:        req.GetName(),
 		Description: &description,
 	}
+	if req.GetParentId() > 0 {
+		parentID := uint(req.GetParentId())
+		categoryDto.ParentID = &parentID
+	}
+	if req.GetSlug() != "" {
+		slug := req.GetSlug()
+		categoryDto.Slug = &slug
+	}
 
 	// Validation and creation logic here
 	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateCategory")
@@ -372,10 +606,7 @@ func (h *ProductGRPCHandler) GetCategoryByID(ctx context.Context, req *pb.GetCat
 	span.SetStatus(codes.Ok, "Category retrieved successfully")
 
 	return &pb.GetCategoryByIDResponse{
-		Category: &pb.Category{
-			Name:        category.Name,
-			Description: *category.Description,
-		},
+		Category: mapCategoryToPB(category),
 	}, nil
 }
 
@@ -402,11 +633,8 @@ func (h *ProductGRPCHandler) ListCategories(ctx context.Context, req *pb.ListCat
 	span.SetAttributes(attribute.Int("categories.total", total))
 
 	var categoryResponses []*pb.Category
-	for _, c := range categories {
-		categoryResponses = append(categoryResponses, &pb.Category{
-			Name:        c.Name,
-			Description: *c.Description,
-		})
+	for i := range categories {
+		categoryResponses = append(categoryResponses, mapCategoryToPB(&categories[i]))
 	}
 
 	span.SetStatus(codes.Ok, "Categories listed successfully")
@@ -426,6 +654,14 @@ func (h *ProductGRPCHandler) UpdateCategory(ctx context.Context, req *pb.UpdateC
 		Name:        &req.Name,
 		Description: &req.Description,
 	}
+	if req.ParentId != nil {
+		parentID := uint(req.GetParentId())
+		updateDto.ParentID = &parentID
+	}
+	if req.GetSlug() != "" {
+		slug := req.GetSlug()
+		updateDto.Slug = &slug
+	}
 
 	// Validation and update logic here
 	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateUpdateCategory")
@@ -485,6 +721,152 @@ func (h *ProductGRPCHandler) DeleteCategory(ctx context.Context, req *pb.DeleteC
 	}, nil
 }
 
+func (h *ProductGRPCHandler) GetCategoryTree(ctx context.Context, req *pb.GetCategoryTreeRequest) (*pb.GetCategoryTreeResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.GetCategoryTree")
+	defer span.End()
+
+	tree, err := h.categoryUsecase.GetCategoryTree(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	roots := make([]*pb.CategoryNode, 0, len(tree))
+	for i := range tree {
+		roots = append(roots, mapCategoryNodeToPB(&tree[i]))
+	}
+
+	span.SetAttributes(attribute.Int("categories.roots", len(roots)))
+	span.SetStatus(codes.Ok, "Category tree retrieved successfully")
+	return &pb.GetCategoryTreeResponse{
+		Roots: roots,
+	}, nil
+}
+
+func (h *ProductGRPCHandler) AssignProductCategories(ctx context.Context, req *pb.AssignProductCategoriesRequest) (*pb.ProductCategoriesResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.AssignProductCategories")
+	defer span.End()
+
+	assignDto := dto.AssignProductCategoriesRequest{
+		ProductID:   uint(req.GetProductId()),
+		CategoryIDs: toUintIDs(req.GetCategoryIds()),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateAssignProductCategories")
+	if err := h.validate.Struct(&assignDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(assignDto.ProductID)))
+
+	result, err := h.categoryUsecase.AssignProductCategories(ctx, &assignDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Product categories assigned successfully")
+	return mapProductCategoriesToPB(result), nil
+}
+
+func (h *ProductGRPCHandler) UnassignProductCategories(ctx context.Context, req *pb.UnassignProductCategoriesRequest) (*pb.ProductCategoriesResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.UnassignProductCategories")
+	defer span.End()
+
+	unassignDto := dto.UnassignProductCategoriesRequest{
+		ProductID:   uint(req.GetProductId()),
+		CategoryIDs: toUintIDs(req.GetCategoryIds()),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateUnassignProductCategories")
+	if err := h.validate.Struct(&unassignDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(unassignDto.ProductID)))
+
+	result, err := h.categoryUsecase.UnassignProductCategories(ctx, &unassignDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Product categories unassigned successfully")
+	return mapProductCategoriesToPB(result), nil
+}
+
+func (h *ProductGRPCHandler) ListProductsByCategory(ctx context.Context, req *pb.ListProductsByCategoryRequest) (*pb.ListProductsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.ListProductsByCategory")
+	defer span.End()
+
+	page := int(req.GetPage())
+	if page == 0 {
+		page = 1
+	}
+	limit := int(req.GetPerPage())
+	if limit == 0 {
+		limit = 10
+	}
+
+	attributes := mapAttributeFiltersFromPB(req.GetAttributes())
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateListProductsByCategory")
+	if err := h.validateAttributeFilters(attributes); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("category.id", int(req.GetCategoryId())),
+		attribute.Bool("category.include_descendants", req.GetIncludeDescendants()),
+		attribute.Int("pagination.page", page),
+		attribute.Int("pagination.limit", limit),
+		attribute.Int("filter.attributes", len(attributes)),
+	)
+
+	products, total, err := h.categoryUsecase.ListProductsByCategory(ctx, uint(req.GetCategoryId()), req.GetIncludeDescendants(), page, limit, attributes)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	productResponse := make([]*pb.Product, 0, len(products))
+	for i := range products {
+		productResponse = append(productResponse, mapProductToPB(&products[i]))
+	}
+
+	span.SetAttributes(attribute.Int("products.count", len(products)))
+	span.SetStatus(codes.Ok, "Products retrieved successfully")
+	return &pb.ListProductsResponse{
+		Products:   productResponse,
+		TotalCount: int32(total),
+	}, nil
+}
+
 func (h *ProductGRPCHandler) Run(done <-chan any, port string) error {
 	// Implementation here
 	lis, err := net.Listen("tcp", ":"+port)
@@ -492,7 +874,10 @@ func (h *ProductGRPCHandler) Run(done <-chan any, port string) error {
 		logger.Errorf("Error while starting product grpc server: %v", err)
 		return err
 	}
-	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(grpcmiddleware.InternalAuthUnaryServerInterceptor(h.internalAuthToken)))
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(grpcmiddleware.InternalAuthUnaryServerInterceptor(h.internalAuthToken)),
+		grpc.StreamInterceptor(grpcmiddleware.InternalAuthStreamServerInterceptor(h.internalAuthToken)),
+	)
 	pb.RegisterProductServiceServer(grpcServer, h)
 
 	go func() {
@@ -510,3 +895,141 @@ func (h *ProductGRPCHandler) Run(done <-chan any, port string) error {
 
 	return nil
 }
+
+func mapProductToPB(p *dto.ProductResponse) *pb.Product {
+	product := &pb.Product{
+		Id:               int32(p.Id),
+		Name:             p.Name,
+		Description:      p.Description,
+		Price:            p.Price,
+		DiscountType:     p.DiscountType,
+		DiscountValue:    p.DiscountValue,
+		Quantity:         int32(p.Quantity),
+		ReorderThreshold: int32(p.ReorderThreshold),
+		MaxPerOrder:      int32(p.MaxPerOrder),
+		TaxCategory:      p.TaxCategory,
+		RatingAverage:    p.RatingAverage,
+		RatingCount:      int32(p.RatingCount),
+		DiscountActive:   p.DiscountActive(time.Now()),
+	}
+	if p.SKU != nil {
+		product.Sku = *p.SKU
+	}
+	if p.ShortDescription != nil {
+		product.ShortDescription = *p.ShortDescription
+	}
+	if p.ImageUrl != nil {
+		product.ImageUrl = *p.ImageUrl
+	}
+	for i := range p.Options {
+		product.Options = append(product.Options, mapOptionToPB(&p.Options[i]))
+	}
+	for i := range p.Variants {
+		product.Variants = append(product.Variants, mapVariantToPB(&p.Variants[i]))
+	}
+	for i := range p.Images {
+		product.Images = append(product.Images, mapImageToPB(&p.Images[i]))
+	}
+	for i := range p.Attributes {
+		product.Attributes = append(product.Attributes, mapProductAttributeToPB(&p.Attributes[i]))
+	}
+	return product
+}
+
+func mapOptionToPB(o *dto.ProductOptionResponse) *pb.ProductOption {
+	values := make([]*pb.ProductOptionValue, 0, len(o.Values))
+	for _, v := range o.Values {
+		values = append(values, &pb.ProductOptionValue{
+			Id:    int64(v.ID),
+			Value: v.Value,
+		})
+	}
+	return &pb.ProductOption{
+		Id:       int64(o.ID),
+		Name:     o.Name,
+		Position: int32(o.Position),
+		Values:   values,
+	}
+}
+
+func mapVariantToPB(v *dto.ProductVariantResponse) *pb.ProductVariant {
+	valueIDs := make([]int64, 0, len(v.OptionValueIDs))
+	for _, id := range v.OptionValueIDs {
+		valueIDs = append(valueIDs, int64(id))
+	}
+	variant := &pb.ProductVariant{
+		Id:               int64(v.ID),
+		ProductId:        int64(v.ProductID),
+		Sku:              v.SKU,
+		Price:            v.Price,
+		HasPriceOverride: v.HasPriceOverride,
+		Quantity:         int32(v.Quantity),
+		OptionValueIds:   valueIDs,
+		Options:          v.Options,
+	}
+	if v.ImageUrl != nil {
+		variant.ImageUrl = *v.ImageUrl
+	}
+	return variant
+}
+
+func mapSortByFromPB(sortBy pb.ProductSortBy) string {
+	switch sortBy {
+	case pb.ProductSortBy_SORT_PRICE_ASC:
+		return string(domain.SortByPriceAsc)
+	case pb.ProductSortBy_SORT_PRICE_DESC:
+		return string(domain.SortByPriceDesc)
+	case pb.ProductSortBy_SORT_NEWEST:
+		return string(domain.SortByNewest)
+	case pb.ProductSortBy_SORT_POPULARITY:
+		return string(domain.SortByPopularity)
+	default:
+		return string(domain.SortByRelevance)
+	}
+}
+
+func mapCategoryToPB(c *dto.CategoryResponse) *pb.Category {
+	category := &pb.Category{
+		Id:   int32(c.Id),
+		Name: c.Name,
+		Slug: c.Slug,
+		Path: c.Path,
+	}
+	if c.ParentID != nil {
+		category.ParentId = int64(*c.ParentID)
+	}
+	if c.Description != nil {
+		category.Description = *c.Description
+	}
+	return category
+}
+
+func mapCategoryNodeToPB(node *dto.CategoryTreeNode) *pb.CategoryNode {
+	children := make([]*pb.CategoryNode, 0, len(node.Children))
+	for i := range node.Children {
+		children = append(children, mapCategoryNodeToPB(&node.Children[i]))
+	}
+	return &pb.CategoryNode{
+		Category: mapCategoryToPB(&node.CategoryResponse),
+		Children: children,
+	}
+}
+
+func mapProductCategoriesToPB(result *dto.ProductCategoriesResponse) *pb.ProductCategoriesResponse {
+	categories := make([]*pb.Category, 0, len(result.Categories))
+	for i := range result.Categories {
+		categories = append(categories, mapCategoryToPB(&result.Categories[i]))
+	}
+	return &pb.ProductCategoriesResponse{
+		ProductId:  int64(result.ProductID),
+		Categories: categories,
+	}
+}
+
+func toUintIDs(ids []int64) []uint {
+	result := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, uint(id))
+	}
+	return result
+}
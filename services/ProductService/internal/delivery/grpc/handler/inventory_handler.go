@@ -0,0 +1,408 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	pb "github.com/kareemhamed001/e-commerce/shared/proto/v1/product"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (h *ProductGRPCHandler) RestockProduct(ctx context.Context, req *pb.RestockProductRequest) (*pb.StockMovementResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.RestockProduct")
+	defer span.End()
+
+	restockDto := dto.RestockProductRequest{
+		ProductID:   uint(req.GetProductId()),
+		VariantID:   optionalID(req.GetVariantId()),
+		WarehouseID: optionalID(req.GetWarehouseId()),
+		Quantity:    int(req.GetQuantity()),
+		Reason:      req.GetReason(),
+		Actor:       req.GetActor(),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateRestockProduct")
+	if err := h.validate.Struct(&restockDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(restockDto.ProductID)),
+		attribute.Int("product.restock_quantity", restockDto.Quantity),
+	)
+
+	movement, err := h.inventoryUsecase.RestockProduct(ctx, &restockDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Product restocked successfully")
+	return &pb.StockMovementResponse{
+		Movement: mapStockMovementToPB(movement),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) RecordStockMovement(ctx context.Context, req *pb.RecordStockMovementRequest) (*pb.StockMovementResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.RecordStockMovement")
+	defer span.End()
+
+	movementDto := dto.RecordStockMovementRequest{
+		ProductID:   uint(req.GetProductId()),
+		VariantID:   optionalID(req.GetVariantId()),
+		WarehouseID: optionalID(req.GetWarehouseId()),
+		Type:        mapStockMovementTypeFromPB(req.GetType()),
+		Quantity:    int(req.GetQuantity()),
+		Reason:      req.GetReason(),
+		Actor:       req.GetActor(),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateRecordStockMovement")
+	if err := h.validate.Struct(&movementDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(movementDto.ProductID)),
+		attribute.String("stock.type", movementDto.Type),
+	)
+
+	movement, err := h.inventoryUsecase.RecordStockMovement(ctx, &movementDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Stock movement recorded successfully")
+	return &pb.StockMovementResponse{
+		Movement: mapStockMovementToPB(movement),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) TransferStock(ctx context.Context, req *pb.TransferStockRequest) (*pb.TransferStockResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.TransferStock")
+	defer span.End()
+
+	transferDto := dto.TransferStockRequest{
+		ProductID:       uint(req.GetProductId()),
+		VariantID:       optionalID(req.GetVariantId()),
+		FromWarehouseID: uint(req.GetFromWarehouseId()),
+		ToWarehouseID:   uint(req.GetToWarehouseId()),
+		Quantity:        int(req.GetQuantity()),
+		Reason:          req.GetReason(),
+		Actor:           req.GetActor(),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateTransferStock")
+	if err := h.validate.Struct(&transferDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(transferDto.ProductID)),
+		attribute.Int("warehouse.from_id", int(transferDto.FromWarehouseID)),
+		attribute.Int("warehouse.to_id", int(transferDto.ToWarehouseID)),
+	)
+
+	movements, err := h.inventoryUsecase.TransferStock(ctx, &transferDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := make([]*pb.StockMovement, 0, len(movements))
+	for i := range movements {
+		response = append(response, mapStockMovementToPB(&movements[i]))
+	}
+
+	span.SetStatus(codes.Ok, "Stock transferred successfully")
+	return &pb.TransferStockResponse{
+		Movements: response,
+	}, nil
+}
+
+func (h *ProductGRPCHandler) TakeStock(ctx context.Context, req *pb.StockItemsRequest) (*pb.StockItemsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.TakeStock")
+	defer span.End()
+
+	return h.recordStockItems(ctx, span, req, h.inventoryUsecase.TakeStock)
+}
+
+func (h *ProductGRPCHandler) ReturnStock(ctx context.Context, req *pb.StockItemsRequest) (*pb.StockItemsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.ReturnStock")
+	defer span.End()
+
+	return h.recordStockItems(ctx, span, req, h.inventoryUsecase.ReturnStock)
+}
+
+func (h *ProductGRPCHandler) recordStockItems(ctx context.Context, span trace.Span, req *pb.StockItemsRequest, record func(context.Context, *dto.StockItemsRequest) ([]dto.StockMovementResponse, error)) (*pb.StockItemsResponse, error) {
+	items := make([]dto.AllocationItemRequest, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		items = append(items, dto.AllocationItemRequest{
+			ProductID: uint(item.GetProductId()),
+			VariantID: optionalID(item.GetVariantId()),
+			Quantity:  int(item.GetQuantity()),
+		})
+	}
+
+	itemsDto := dto.StockItemsRequest{
+		WarehouseID: optionalID(req.GetWarehouseId()),
+		Items:       items,
+		Reason:      req.GetReason(),
+		Actor:       req.GetActor(),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateStockItems")
+	if err := h.validate.Struct(&itemsDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("warehouse.id", int(req.GetWarehouseId())),
+		attribute.Int("stock.items.count", len(items)),
+	)
+
+	movements, err := record(ctx, &itemsDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := make([]*pb.StockMovement, 0, len(movements))
+	for i := range movements {
+		response = append(response, mapStockMovementToPB(&movements[i]))
+	}
+
+	span.SetStatus(codes.Ok, "Stock movements recorded successfully")
+	return &pb.StockItemsResponse{
+		Movements: response,
+	}, nil
+}
+
+func (h *ProductGRPCHandler) ListStockMovements(ctx context.Context, req *pb.ListStockMovementsRequest) (*pb.ListStockMovementsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.ListStockMovements")
+	defer span.End()
+
+	page := int(req.GetPage())
+	if page == 0 {
+		page = 1
+	}
+	limit := int(req.GetPerPage())
+	if limit == 0 {
+		limit = 10
+	}
+
+	listDto := dto.ListStockMovementsRequest{
+		ProductID:   uint(req.GetProductId()),
+		VariantID:   optionalID(req.GetVariantId()),
+		WarehouseID: optionalID(req.GetWarehouseId()),
+		Type:        mapStockMovementTypeFromPB(req.GetType()),
+		Page:        page,
+		PerPage:     limit,
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateListStockMovements")
+	if err := h.validate.Struct(&listDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(listDto.ProductID)),
+		attribute.Int("pagination.page", page),
+		attribute.Int("pagination.limit", limit),
+	)
+
+	movements, total, err := h.inventoryUsecase.ListStockMovements(ctx, &listDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := make([]*pb.StockMovement, 0, len(movements))
+	for i := range movements {
+		response = append(response, mapStockMovementToPB(&movements[i]))
+	}
+
+	span.SetAttributes(attribute.Int("movements.count", len(movements)))
+	span.SetStatus(codes.Ok, "Stock movements retrieved successfully")
+	return &pb.ListStockMovementsResponse{
+		Movements:  response,
+		TotalCount: int32(total),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) SetReorderThreshold(ctx context.Context, req *pb.SetReorderThresholdRequest) (*pb.SetReorderThresholdResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.SetReorderThreshold")
+	defer span.End()
+
+	thresholdDto := dto.SetReorderThresholdRequest{
+		ProductID: uint(req.GetProductId()),
+		Threshold: int(req.GetThreshold()),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateSetReorderThreshold")
+	if err := h.validate.Struct(&thresholdDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(thresholdDto.ProductID)),
+		attribute.Int("product.reorder_threshold", thresholdDto.Threshold),
+	)
+
+	if err := h.lowStockUsecase.SetReorderThreshold(ctx, &thresholdDto); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Reorder threshold set successfully")
+	return &pb.SetReorderThresholdResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *ProductGRPCHandler) ListLowStockProducts(ctx context.Context, req *pb.ListLowStockProductsRequest) (*pb.ListLowStockProductsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.ListLowStockProducts")
+	defer span.End()
+
+	items, err := h.lowStockUsecase.ListLowStockProducts(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := make([]*pb.LowStockItem, 0, len(items))
+	for _, item := range items {
+		lowStockItem := &pb.LowStockItem{
+			ProductId:                int64(item.ProductID),
+			Name:                     item.Name,
+			Sku:                      item.SKU,
+			Quantity:                 int32(item.Quantity),
+			ReorderThreshold:         int32(item.ReorderThreshold),
+			UnitsSold:                int32(item.UnitsSold),
+			DailySalesRate:           item.DailySalesRate,
+			SuggestedReorderQuantity: int32(item.SuggestedReorderQuantity),
+		}
+		if item.VariantID != nil {
+			lowStockItem.VariantId = int64(*item.VariantID)
+		}
+		response = append(response, lowStockItem)
+	}
+
+	span.SetAttributes(attribute.Int("low_stock.count", len(items)))
+	span.SetStatus(codes.Ok, "Low stock products retrieved successfully")
+	return &pb.ListLowStockProductsResponse{
+		Items: response,
+	}, nil
+}
+
+func mapStockMovementTypeFromPB(t pb.StockMovementType) string {
+	switch t {
+	case pb.StockMovementType_STOCK_MOVEMENT_RESTOCK:
+		return string(domain.StockMovementRestock)
+	case pb.StockMovementType_STOCK_MOVEMENT_SALE:
+		return string(domain.StockMovementSale)
+	case pb.StockMovementType_STOCK_MOVEMENT_RESERVATION:
+		return string(domain.StockMovementReservation)
+	case pb.StockMovementType_STOCK_MOVEMENT_RELEASE:
+		return string(domain.StockMovementRelease)
+	case pb.StockMovementType_STOCK_MOVEMENT_RETURN:
+		return string(domain.StockMovementReturn)
+	case pb.StockMovementType_STOCK_MOVEMENT_ADJUSTMENT:
+		return string(domain.StockMovementAdjustment)
+	case pb.StockMovementType_STOCK_MOVEMENT_TRANSFER:
+		return string(domain.StockMovementTransfer)
+	case pb.StockMovementType_STOCK_MOVEMENT_INITIAL:
+		return string(domain.StockMovementInitial)
+	case pb.StockMovementType_STOCK_MOVEMENT_IMPORT:
+		return string(domain.StockMovementImport)
+	default:
+		return ""
+	}
+}
+
+func mapStockMovementToPB(m *dto.StockMovementResponse) *pb.StockMovement {
+	movement := &pb.StockMovement{
+		Id:           int64(m.ID),
+		ProductId:    int64(m.ProductID),
+		Type:         m.Type,
+		Quantity:     int32(m.Quantity),
+		BalanceAfter: int32(m.BalanceAfter),
+		Reason:       m.Reason,
+		Actor:        m.Actor,
+		CreatedAt:    m.CreatedAt.Format(time.RFC3339),
+	}
+	if m.VariantID != nil {
+		movement.VariantId = int64(*m.VariantID)
+	}
+	if m.WarehouseID != nil {
+		movement.WarehouseId = int64(*m.WarehouseID)
+	}
+	if m.WarehouseBalanceAfter != nil {
+		balance := int32(*m.WarehouseBalanceAfter)
+		movement.WarehouseBalanceAfter = &balance
+	}
+	return movement
+}
+
+// optionalID maps a proto3 zero ID to nil.
+func optionalID(id int64) *uint {
+	if id == 0 {
+		return nil
+	}
+	value := uint(id)
+	return &value
+}
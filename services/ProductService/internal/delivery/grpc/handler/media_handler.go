@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	pb "github.com/kareemhamed001/e-commerce/shared/proto/v1/product"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (h *ProductGRPCHandler) UploadProductImage(stream pb.ProductService_UploadProductImageServer) error {
+	ctx, span := h.tracer.Start(stream.Context(), "ProductHandler.UploadProductImage")
+	defer span.End()
+
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	if first == nil {
+		first = &pb.UploadProductImageRequest{}
+	}
+
+	uploadDto := dto.UploadProductImageRequest{
+		ProductID: uint(first.GetProductId()),
+		AltText:   first.GetAltText(),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateUploadProductImage")
+	if err := h.validate.Struct(&uploadDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(uploadDto.ProductID)))
+
+	reader := &chunkReader{
+		buf: first.GetData(),
+		next: func() ([]byte, error) {
+			req, err := stream.Recv()
+			return req.GetData(), err
+		},
+	}
+	image, err := h.mediaUsecase.UploadProductImage(ctx, &uploadDto, reader)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Image uploaded successfully")
+	return stream.SendAndClose(&pb.ProductImageResponse{
+		Image: mapImageToPB(image),
+	})
+}
+
+func (h *ProductGRPCHandler) ListProductImages(ctx context.Context, req *pb.ListProductImagesRequest) (*pb.ListProductImagesResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.ListProductImages")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(req.GetProductId())))
+
+	images, err := h.mediaUsecase.ListProductImages(ctx, uint(req.GetProductId()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("images.count", len(images)))
+	span.SetStatus(codes.Ok, "Images retrieved successfully")
+	return &pb.ListProductImagesResponse{
+		Images: mapImagesToPB(images),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) UpdateProductImage(ctx context.Context, req *pb.UpdateProductImageRequest) (*pb.ProductImageResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.UpdateProductImage")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("image.id", int(req.GetId())))
+
+	imageDto := dto.UpdateProductImageRequest{
+		AltText: req.GetAltText(),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateUpdateProductImage")
+	if err := h.validate.Struct(&imageDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	image, err := h.mediaUsecase.UpdateProductImage(ctx, uint(req.GetId()), &imageDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Image updated successfully")
+	return &pb.ProductImageResponse{
+		Image: mapImageToPB(image),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) ReorderProductImages(ctx context.Context, req *pb.ReorderProductImagesRequest) (*pb.ListProductImagesResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.ReorderProductImages")
+	defer span.End()
+
+	imageIDs := make([]uint, 0, len(req.GetImageIds()))
+	for _, id := range req.GetImageIds() {
+		imageIDs = append(imageIDs, uint(id))
+	}
+
+	reorderDto := dto.ReorderProductImagesRequest{
+		ProductID: uint(req.GetProductId()),
+		ImageIDs:  imageIDs,
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateReorderProductImages")
+	if err := h.validate.Struct(&reorderDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(reorderDto.ProductID)),
+		attribute.Int("images.count", len(imageIDs)),
+	)
+
+	images, err := h.mediaUsecase.ReorderProductImages(ctx, &reorderDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Images reordered successfully")
+	return &pb.ListProductImagesResponse{
+		Images: mapImagesToPB(images),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) DeleteProductImage(ctx context.Context, req *pb.DeleteProductImageRequest) (*pb.DeleteProductImageResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.DeleteProductImage")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("image.id", int(req.GetId())))
+
+	if err := h.mediaUsecase.DeleteProductImage(ctx, uint(req.GetId())); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Image deleted successfully")
+	return &pb.DeleteProductImageResponse{
+		Success: true,
+	}, nil
+}
+
+func mapImagesToPB(images []dto.ProductImageResponse) []*pb.ProductImage {
+	response := make([]*pb.ProductImage, 0, len(images))
+	for i := range images {
+		response = append(response, mapImageToPB(&images[i]))
+	}
+	return response
+}
+
+func mapImageToPB(image *dto.ProductImageResponse) *pb.ProductImage {
+	return &pb.ProductImage{
+		Id:           int64(image.ID),
+		ProductId:    int64(image.ProductID),
+		Position:     int32(image.Position),
+		AltText:      image.AltText,
+		Url:          image.URL,
+		ThumbnailUrl: image.ThumbnailURL,
+		ContentType:  image.ContentType,
+		SizeBytes:    image.SizeBytes,
+		Width:        int32(image.Width),
+		Height:       int32(image.Height),
+		CreatedAt:    image.CreatedAt.Format(time.RFC3339),
+	}
+}
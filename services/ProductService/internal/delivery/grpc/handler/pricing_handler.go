@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	pb "github.com/kareemhamed001/e-commerce/shared/proto/v1/product"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (h *ProductGRPCHandler) SchedulePriceChange(ctx context.Context, req *pb.SchedulePriceChangeRequest) (*pb.ScheduledPriceChangeResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.SchedulePriceChange")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(req.GetProductId())))
+
+	effectiveAt, err := time.Parse(time.RFC3339, req.GetEffectiveAt())
+	if err != nil {
+		span.RecordError(ErrInvalidEffectiveAt)
+		span.SetStatus(codes.Error, "validation failed")
+		return nil, ErrInvalidEffectiveAt
+	}
+
+	changeDto := dto.SchedulePriceChangeRequest{
+		ProductID:      uint(req.GetProductId()),
+		Price:          req.Price,
+		DiscountValue:  req.DiscountValue,
+		RemoveDiscount: req.GetRemoveDiscount(),
+		EffectiveAt:    effectiveAt,
+		CreatedBy:      req.GetCreatedBy(),
+	}
+	if req.DiscountType != nil {
+		var discountType string
+		switch req.GetDiscountType() {
+		case pb.DiscountType_DISCOUNT_PERCENT:
+			discountType = "percent"
+		case pb.DiscountType_DISCOUNT_FIXED:
+			discountType = "fixed"
+		}
+		changeDto.DiscountType = &discountType
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateSchedulePriceChange")
+	if err := h.validate.Struct(&changeDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	change, err := h.pricingUsecase.SchedulePriceChange(ctx, &changeDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Price change scheduled successfully")
+	return &pb.ScheduledPriceChangeResponse{
+		Change: mapScheduledPriceChangeToPB(change),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) CancelScheduledPriceChange(ctx context.Context, req *pb.CancelScheduledPriceChangeRequest) (*pb.ScheduledPriceChangeResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.CancelScheduledPriceChange")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("price_change.id", int(req.GetId())))
+
+	change, err := h.pricingUsecase.CancelScheduledPriceChange(ctx, uint(req.GetId()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Price change canceled successfully")
+	return &pb.ScheduledPriceChangeResponse{
+		Change: mapScheduledPriceChangeToPB(change),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) ListScheduledPriceChanges(ctx context.Context, req *pb.ListScheduledPriceChangesRequest) (*pb.ListScheduledPriceChangesResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.ListScheduledPriceChanges")
+	defer span.End()
+
+	listDto := dto.ListScheduledPriceChangesRequest{
+		ProductID: uint(req.GetProductId()),
+		Status:    req.GetStatus(),
+		Page:      int(req.GetPage()),
+		PerPage:   int(req.GetPerPage()),
+	}
+	if listDto.Page == 0 {
+		listDto.Page = 1
+	}
+	if listDto.PerPage == 0 {
+		listDto.PerPage = 10
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateListScheduledPriceChanges")
+	if err := h.validate.Struct(&listDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(listDto.ProductID)),
+		attribute.String("price_change.status", listDto.Status),
+		attribute.Int("pagination.page", listDto.Page),
+		attribute.Int("pagination.limit", listDto.PerPage),
+	)
+
+	changes, total, err := h.pricingUsecase.ListScheduledPriceChanges(ctx, &listDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := make([]*pb.ScheduledPriceChange, 0, len(changes))
+	for i := range changes {
+		response = append(response, mapScheduledPriceChangeToPB(&changes[i]))
+	}
+
+	span.SetAttributes(attribute.Int("price_changes.count", len(changes)))
+	span.SetStatus(codes.Ok, "Price changes retrieved successfully")
+	return &pb.ListScheduledPriceChangesResponse{
+		Changes:    response,
+		TotalCount: int32(total),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) GetPriceTimeline(ctx context.Context, req *pb.GetPriceTimelineRequest) (*pb.GetPriceTimelineResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.GetPriceTimeline")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(req.GetProductId())))
+
+	timeline, err := h.pricingUsecase.GetPriceTimeline(ctx, uint(req.GetProductId()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	history := make([]*pb.PriceHistoryEntry, 0, len(timeline.History))
+	for i := range timeline.History {
+		history = append(history, mapPriceHistoryEntryToPB(&timeline.History[i]))
+	}
+	upcoming := make([]*pb.ScheduledPriceChange, 0, len(timeline.Upcoming))
+	for i := range timeline.Upcoming {
+		upcoming = append(upcoming, mapScheduledPriceChangeToPB(&timeline.Upcoming[i]))
+	}
+
+	span.SetStatus(codes.Ok, "Price timeline retrieved successfully")
+	return &pb.GetPriceTimelineResponse{
+		ProductId: int64(timeline.ProductID),
+		History:   history,
+		Upcoming:  upcoming,
+	}, nil
+}
+
+func mapScheduledPriceChangeToPB(change *dto.ScheduledPriceChangeResponse) *pb.ScheduledPriceChange {
+	response := &pb.ScheduledPriceChange{
+		Id:             int64(change.ID),
+		ProductId:      int64(change.ProductID),
+		Price:          change.Price,
+		DiscountType:   change.DiscountType,
+		DiscountValue:  change.DiscountValue,
+		RemoveDiscount: change.RemoveDiscount,
+		EffectiveAt:    change.EffectiveAt.Format(time.RFC3339),
+		Status:         change.Status,
+		CreatedBy:      change.CreatedBy,
+		CreatedAt:      change.CreatedAt.Format(time.RFC3339),
+	}
+	if change.AppliedAt != nil {
+		response.AppliedAt = change.AppliedAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func mapPriceHistoryEntryToPB(entry *dto.PriceHistoryEntryResponse) *pb.PriceHistoryEntry {
+	response := &pb.PriceHistoryEntry{
+		Price:         entry.Price,
+		DiscountType:  entry.DiscountType,
+		DiscountValue: entry.DiscountValue,
+		Source:        entry.Source,
+		ChangedAt:     entry.ChangedAt.Format(time.RFC3339),
+	}
+	if entry.ScheduledChangeID != nil {
+		response.ScheduledChangeId = int64(*entry.ScheduledChangeID)
+	}
+	return response
+}
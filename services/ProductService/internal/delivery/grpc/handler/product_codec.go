@@ -0,0 +1,257 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+)
+
+const (
+	importFormatCSV    = "csv"
+	importFormatNDJSON = "ndjson"
+
+	// maxImportLineSize bounds a single NDJSON line.
+	maxImportLineSize = 1 << 20
+)
+
+// exportColumns is the CSV layout of an export. Import reads the same columns
+// by name and ignores the ones it does not set, such as id.
+var exportColumns = []string{
+	"id", "sku", "name", "short_description", "description", "price",
+	"discount_type", "discount_value", "image_url", "quantity", "reorder_threshold",
+	"max_per_order", "tax_category",
+}
+
+// rowDecodeError is a malformed row; decoding continues with the next row.
+type rowDecodeError struct {
+	line int
+	err  error
+}
+
+func (e *rowDecodeError) Error() string {
+	return e.err.Error()
+}
+
+type productRowDecoder interface {
+	// Next returns the next row, a *rowDecodeError for a malformed row, or
+	// io.EOF once the input is exhausted.
+	Next() (dto.ImportProductRow, error)
+}
+
+func newProductRowDecoder(format string, r io.Reader) (productRowDecoder, error) {
+	switch strings.ToLower(format) {
+	case importFormatCSV:
+		return newCSVRowDecoder(r)
+	case importFormatNDJSON:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxImportLineSize)
+		return &ndjsonRowDecoder{scanner: scanner}, nil
+	default:
+		return nil, domain.ErrInvalidImportFormat
+	}
+}
+
+type csvRowDecoder struct {
+	reader *csv.Reader
+	header []string
+}
+
+func newCSVRowDecoder(r io.Reader) (*csvRowDecoder, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, domain.ErrInvalidImportHeader
+		}
+		return nil, err
+	}
+
+	hasName := false
+	for i, column := range header {
+		if i == 0 {
+			column = strings.TrimPrefix(column, "\ufeff")
+		}
+		header[i] = strings.ToLower(strings.TrimSpace(column))
+		if header[i] == "name" {
+			hasName = true
+		}
+	}
+	if !hasName {
+		return nil, domain.ErrInvalidImportHeader
+	}
+
+	return &csvRowDecoder{reader: reader, header: header}, nil
+}
+
+func (d *csvRowDecoder) Next() (dto.ImportProductRow, error) {
+	record, err := d.reader.Read()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return dto.ImportProductRow{}, &rowDecodeError{line: parseErr.StartLine, err: parseErr.Err}
+		}
+		return dto.ImportProductRow{}, err
+	}
+
+	line, _ := d.reader.FieldPos(0)
+	product, err := csvRecordToRequest(d.header, record)
+	if err != nil {
+		return dto.ImportProductRow{}, &rowDecodeError{line: line, err: err}
+	}
+	return dto.ImportProductRow{Line: line, Product: product}, nil
+}
+
+// csvRecordToRequest maps a record onto the create request by column name.
+// Empty cells are left unset so optional fields stay nil.
+func csvRecordToRequest(header, record []string) (dto.CreateProductRequest, error) {
+	var req dto.CreateProductRequest
+	for i, column := range header {
+		value := strings.TrimSpace(record[i])
+		if value == "" {
+			continue
+		}
+
+		switch column {
+		case "sku":
+			req.SKU = &value
+		case "name":
+			req.Name = value
+		case "short_description":
+			req.ShortDescription = &value
+		case "description":
+			req.Description = value
+		case "price":
+			price, err := strconv.ParseFloat(value, 32)
+			if err != nil {
+				return req, fmt.Errorf("invalid price %q", value)
+			}
+			req.Price = float32(price)
+		case "discount_type":
+			req.DiscountType = value
+		case "discount_value":
+			discount, err := strconv.ParseFloat(value, 32)
+			if err != nil {
+				return req, fmt.Errorf("invalid discount_value %q", value)
+			}
+			req.DiscountValue = float32(discount)
+		case "discount_start_date":
+			req.DiscountStartDate = &value
+		case "discount_end_date":
+			req.DiscountEndDate = &value
+		case "image_url":
+			req.ImageUrl = &value
+		case "quantity":
+			quantity, err := strconv.Atoi(value)
+			if err != nil {
+				return req, fmt.Errorf("invalid quantity %q", value)
+			}
+			req.Quantity = quantity
+		case "tax_category":
+			req.TaxCategory = value
+		}
+	}
+	return req, nil
+}
+
+type ndjsonRowDecoder struct {
+	scanner *bufio.Scanner
+	line    int
+}
+
+func (d *ndjsonRowDecoder) Next() (dto.ImportProductRow, error) {
+	for d.scanner.Scan() {
+		d.line++
+		data := bytes.TrimSpace(d.scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var product dto.CreateProductRequest
+		if err := json.Unmarshal(data, &product); err != nil {
+			return dto.ImportProductRow{}, &rowDecodeError{line: d.line, err: fmt.Errorf("invalid json: %w", err)}
+		}
+		return dto.ImportProductRow{Line: d.line, Product: product}, nil
+	}
+	if err := d.scanner.Err(); err != nil {
+		return dto.ImportProductRow{}, err
+	}
+	return dto.ImportProductRow{}, io.EOF
+}
+
+type productRowEncoder interface {
+	Encode(product *dto.ProductResponse) error
+	// Flush writes any buffered rows to the underlying writer.
+	Flush() error
+}
+
+func newProductRowEncoder(format string, w io.Writer) (productRowEncoder, error) {
+	switch strings.ToLower(format) {
+	case importFormatCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write(exportColumns); err != nil {
+			return nil, err
+		}
+		return &csvRowEncoder{writer: writer}, nil
+	case importFormatNDJSON:
+		return &ndjsonRowEncoder{encoder: json.NewEncoder(w)}, nil
+	default:
+		return nil, domain.ErrInvalidImportFormat
+	}
+}
+
+type csvRowEncoder struct {
+	writer *csv.Writer
+}
+
+func (e *csvRowEncoder) Encode(p *dto.ProductResponse) error {
+	return e.writer.Write([]string{
+		strconv.FormatUint(uint64(p.Id), 10),
+		stringValue(p.SKU),
+		p.Name,
+		stringValue(p.ShortDescription),
+		p.Description,
+		strconv.FormatFloat(float64(p.Price), 'f', -1, 32),
+		p.DiscountType,
+		strconv.FormatFloat(float64(p.DiscountValue), 'f', -1, 32),
+		stringValue(p.ImageUrl),
+		strconv.Itoa(p.Quantity),
+		strconv.Itoa(p.ReorderThreshold),
+		strconv.Itoa(p.MaxPerOrder),
+		p.TaxCategory,
+	})
+}
+
+func (e *csvRowEncoder) Flush() error {
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+type ndjsonRowEncoder struct {
+	encoder *json.Encoder
+}
+
+func (e *ndjsonRowEncoder) Encode(p *dto.ProductResponse) error {
+	return e.encoder.Encode(p)
+}
+
+func (e *ndjsonRowEncoder) Flush() error {
+	return nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,228 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	pb "github.com/kareemhamed001/e-commerce/shared/proto/v1/product"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+const (
+	importBatchSize = 100
+	exportBatchSize = 200
+	// maxImportErrors caps the row errors returned; failed still counts all.
+	maxImportErrors = 500
+)
+
+func (h *ProductGRPCHandler) ImportProducts(stream pb.ProductService_ImportProductsServer) error {
+	ctx, span := h.tracer.Start(stream.Context(), "ProductHandler.ImportProducts")
+	defer span.End()
+
+	first, err := stream.Recv()
+	if err != nil && !errors.Is(err, io.EOF) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	if first == nil {
+		first = &pb.ImportProductsRequest{}
+	}
+
+	span.SetAttributes(
+		attribute.String("import.format", first.GetFormat()),
+		attribute.Bool("import.dry_run", first.GetDryRun()),
+	)
+
+	reader := &chunkReader{
+		buf: first.GetData(),
+		next: func() ([]byte, error) {
+			req, err := stream.Recv()
+			return req.GetData(), err
+		},
+	}
+	decoder, err := newProductRowDecoder(first.GetFormat(), reader)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	summary := &dto.ImportProductsResponse{DryRun: first.GetDryRun()}
+	err = h.productUsecase.ImportProducts(ctx, summary.DryRun, func(importBatch domain.ImportBatchFunc) error {
+		return h.importRows(decoder, summary, importBatch)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetAttributes(
+		attribute.Int("import.rows.count", summary.TotalRows),
+		attribute.Int("import.created", summary.Created),
+		attribute.Int("import.updated", summary.Updated),
+		attribute.Int("import.failed", summary.Failed),
+	)
+	span.SetStatus(codes.Ok, "Products imported successfully")
+	return stream.SendAndClose(mapImportSummaryToPB(summary))
+}
+
+// importRows decodes and validates the rows, hands them to importBatch in
+// batches of importBatchSize and adds the outcome to summary.
+func (h *ProductGRPCHandler) importRows(decoder productRowDecoder, summary *dto.ImportProductsResponse, importBatch domain.ImportBatchFunc) error {
+	batch := make([]dto.ImportProductRow, 0, importBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		result, err := importBatch(batch)
+		if err != nil {
+			return err
+		}
+		summary.Created += result.Created
+		summary.Updated += result.Updated
+		for _, rowErr := range result.Errors {
+			addImportError(summary, rowErr)
+		}
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		row, err := decoder.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			var decodeErr *rowDecodeError
+			if !errors.As(err, &decodeErr) {
+				return err
+			}
+			summary.TotalRows++
+			addImportError(summary, dto.ImportRowError{Line: decodeErr.line, Error: decodeErr.Error()})
+			continue
+		}
+
+		summary.TotalRows++
+		if err := h.validate.Struct(&row.Product); err != nil {
+			rowErr := dto.ImportRowError{Line: row.Line, Name: row.Product.Name, Error: err.Error()}
+			if row.Product.SKU != nil {
+				rowErr.SKU = *row.Product.SKU
+			}
+			addImportError(summary, rowErr)
+			continue
+		}
+
+		batch = append(batch, row)
+		if len(batch) == importBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	return flush()
+}
+
+func (h *ProductGRPCHandler) ExportProducts(req *pb.ExportProductsRequest, stream pb.ProductService_ExportProductsServer) error {
+	ctx, span := h.tracer.Start(stream.Context(), "ProductHandler.ExportProducts")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("export.format", req.GetFormat()))
+
+	var buf bytes.Buffer
+	encoder, err := newProductRowEncoder(req.GetFormat(), &buf)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	sendBuffered := func() error {
+		if err := encoder.Flush(); err != nil {
+			return err
+		}
+		if buf.Len() == 0 {
+			return nil
+		}
+		// The message may be read after Send returns, so it gets its own copy.
+		if err := stream.Send(&pb.ExportProductsChunk{Data: bytes.Clone(buf.Bytes())}); err != nil {
+			return err
+		}
+		buf.Reset()
+		return nil
+	}
+
+	err = h.productUsecase.ExportProducts(ctx, exportBatchSize, func(products []dto.ProductResponse) error {
+		for i := range products {
+			if err := encoder.Encode(&products[i]); err != nil {
+				return err
+			}
+		}
+		return sendBuffered()
+	})
+	if err == nil {
+		// Sends the CSV header of an empty catalog.
+		err = sendBuffered()
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Products exported successfully")
+	return nil
+}
+
+// chunkReader exposes the data chunks of a client stream as one continuous
+// reader. next returns the data of the next message.
+type chunkReader struct {
+	next func() ([]byte, error)
+	buf  []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		data, err := r.next()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = data
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func addImportError(summary *dto.ImportProductsResponse, rowErr dto.ImportRowError) {
+	summary.Failed++
+	if len(summary.Errors) < maxImportErrors {
+		summary.Errors = append(summary.Errors, rowErr)
+	}
+}
+
+func mapImportSummaryToPB(summary *dto.ImportProductsResponse) *pb.ImportProductsResponse {
+	rowErrors := make([]*pb.ImportRowError, 0, len(summary.Errors))
+	for _, rowErr := range summary.Errors {
+		rowErrors = append(rowErrors, &pb.ImportRowError{
+			Line:  int32(rowErr.Line),
+			Sku:   rowErr.SKU,
+			Name:  rowErr.Name,
+			Error: rowErr.Error,
+		})
+	}
+	return &pb.ImportProductsResponse{
+		DryRun:    summary.DryRun,
+		TotalRows: int32(summary.TotalRows),
+		Created:   int32(summary.Created),
+		Updated:   int32(summary.Updated),
+		Failed:    int32(summary.Failed),
+		Errors:    rowErrors,
+	}
+}
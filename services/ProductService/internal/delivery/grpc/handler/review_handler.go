@@ -0,0 +1,231 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	pb "github.com/kareemhamed001/e-commerce/shared/proto/v1/product"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (h *ProductGRPCHandler) CreateReview(ctx context.Context, req *pb.CreateReviewRequest) (*pb.ReviewResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.CreateReview")
+	defer span.End()
+
+	reviewDto := dto.CreateReviewRequest{
+		ProductID: uint(req.GetProductId()),
+		UserID:    uint(req.GetUserId()),
+		Rating:    int(req.GetRating()),
+		Title:     req.GetTitle(),
+		Body:      req.GetBody(),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateCreateReview")
+	if err := h.validate.Struct(&reviewDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(reviewDto.ProductID)))
+
+	review, err := h.reviewUsecase.CreateReview(ctx, &reviewDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Review created successfully")
+	return &pb.ReviewResponse{
+		Review: mapReviewToPB(review),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) ListProductReviews(ctx context.Context, req *pb.ListProductReviewsRequest) (*pb.ListReviewsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.ListProductReviews")
+	defer span.End()
+
+	return h.listReviews(ctx, span, "ProductHandler.ValidateListProductReviews", dto.ListReviewsRequest{
+		ProductID: uint(req.GetProductId()),
+		Status:    string(domain.ReviewStatusApproved),
+		Page:      int(req.GetPage()),
+		PerPage:   int(req.GetPerPage()),
+	})
+}
+
+func (h *ProductGRPCHandler) ListReviews(ctx context.Context, req *pb.ListReviewsRequest) (*pb.ListReviewsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.ListReviews")
+	defer span.End()
+
+	return h.listReviews(ctx, span, "ProductHandler.ValidateListReviews", dto.ListReviewsRequest{
+		ProductID: uint(req.GetProductId()),
+		UserID:    uint(req.GetUserId()),
+		Status:    req.GetStatus(),
+		Page:      int(req.GetPage()),
+		PerPage:   int(req.GetPerPage()),
+	})
+}
+
+func (h *ProductGRPCHandler) listReviews(ctx context.Context, span trace.Span, validationName string, listDto dto.ListReviewsRequest) (*pb.ListReviewsResponse, error) {
+	if listDto.Page == 0 {
+		listDto.Page = 1
+	}
+	if listDto.PerPage == 0 {
+		listDto.PerPage = 10
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, validationName)
+	if err := h.validate.Struct(&listDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(listDto.ProductID)),
+		attribute.String("review.status", listDto.Status),
+		attribute.Int("pagination.page", listDto.Page),
+		attribute.Int("pagination.limit", listDto.PerPage),
+	)
+
+	reviews, total, err := h.reviewUsecase.ListReviews(ctx, &listDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := make([]*pb.Review, 0, len(reviews))
+	for i := range reviews {
+		response = append(response, mapReviewToPB(&reviews[i]))
+	}
+
+	span.SetAttributes(attribute.Int("reviews.count", len(reviews)))
+	span.SetStatus(codes.Ok, "Reviews retrieved successfully")
+	return &pb.ListReviewsResponse{
+		Reviews:    response,
+		TotalCount: int32(total),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) UpdateReview(ctx context.Context, req *pb.UpdateReviewRequest) (*pb.ReviewResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.UpdateReview")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("review.id", int(req.GetId())))
+
+	reviewDto := dto.UpdateReviewRequest{
+		UserID: uint(req.GetUserId()),
+		Rating: int(req.GetRating()),
+		Title:  req.GetTitle(),
+		Body:   req.GetBody(),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateUpdateReview")
+	if err := h.validate.Struct(&reviewDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	review, err := h.reviewUsecase.UpdateReview(ctx, uint(req.GetId()), &reviewDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Review updated successfully")
+	return &pb.ReviewResponse{
+		Review: mapReviewToPB(review),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) DeleteReview(ctx context.Context, req *pb.DeleteReviewRequest) (*pb.DeleteReviewResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.DeleteReview")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("review.id", int(req.GetId())))
+
+	if err := h.reviewUsecase.DeleteReview(ctx, uint(req.GetId()), uint(req.GetUserId())); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Review deleted successfully")
+	return &pb.DeleteReviewResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *ProductGRPCHandler) ModerateReview(ctx context.Context, req *pb.ModerateReviewRequest) (*pb.ReviewResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.ModerateReview")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("review.id", int(req.GetId())))
+
+	moderateDto := dto.ModerateReviewRequest{
+		Status: req.GetStatus(),
+		Note:   req.GetNote(),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateModerateReview")
+	if err := h.validate.Struct(&moderateDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	review, err := h.reviewUsecase.ModerateReview(ctx, uint(req.GetId()), &moderateDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Review moderated successfully")
+	return &pb.ReviewResponse{
+		Review: mapReviewToPB(review),
+	}, nil
+}
+
+func mapReviewToPB(review *dto.ReviewResponse) *pb.Review {
+	return &pb.Review{
+		Id:             int64(review.ID),
+		ProductId:      int64(review.ProductID),
+		UserId:         int64(review.UserID),
+		Rating:         int32(review.Rating),
+		Title:          review.Title,
+		Body:           review.Body,
+		Status:         review.Status,
+		ModerationNote: review.ModerationNote,
+		CreatedAt:      review.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      review.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	pb "github.com/kareemhamed001/e-commerce/shared/proto/v1/product"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (h *ProductGRPCHandler) ListTrashedProducts(ctx context.Context, req *pb.ListTrashedProductsRequest) (*pb.ListTrashedProductsResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.ListTrashedProducts")
+	defer span.End()
+
+	listDto := newListTrashRequest(req.GetPage(), req.GetPerPage())
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateListTrashedProducts")
+	if err := h.validate.Struct(&listDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("pagination.page", listDto.Page),
+		attribute.Int("pagination.limit", listDto.PerPage),
+	)
+
+	products, total, err := h.trashUsecase.ListTrashedProducts(ctx, &listDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := make([]*pb.TrashedProduct, 0, len(products))
+	for i := range products {
+		response = append(response, mapTrashedProductToPB(&products[i]))
+	}
+
+	span.SetAttributes(attribute.Int("products.count", len(products)))
+	span.SetStatus(codes.Ok, "Trashed products retrieved successfully")
+	return &pb.ListTrashedProductsResponse{
+		Products:   response,
+		TotalCount: int32(total),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) ListTrashedCategories(ctx context.Context, req *pb.ListTrashedCategoriesRequest) (*pb.ListTrashedCategoriesResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.ListTrashedCategories")
+	defer span.End()
+
+	listDto := newListTrashRequest(req.GetPage(), req.GetPerPage())
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateListTrashedCategories")
+	if err := h.validate.Struct(&listDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("pagination.page", listDto.Page),
+		attribute.Int("pagination.limit", listDto.PerPage),
+	)
+
+	categories, total, err := h.trashUsecase.ListTrashedCategories(ctx, &listDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := make([]*pb.TrashedCategory, 0, len(categories))
+	for i := range categories {
+		response = append(response, mapTrashedCategoryToPB(&categories[i]))
+	}
+
+	span.SetAttributes(attribute.Int("categories.count", len(categories)))
+	span.SetStatus(codes.Ok, "Trashed categories retrieved successfully")
+	return &pb.ListTrashedCategoriesResponse{
+		Categories: response,
+		TotalCount: int32(total),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) RestoreProduct(ctx context.Context, req *pb.RestoreProductRequest) (*pb.RestoreProductResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.RestoreProduct")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(req.GetId())))
+
+	product, err := h.trashUsecase.RestoreProduct(ctx, uint(req.GetId()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Product restored successfully")
+	return &pb.RestoreProductResponse{
+		Product: mapProductToPB(product),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) RestoreCategory(ctx context.Context, req *pb.RestoreCategoryRequest) (*pb.RestoreCategoryResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.RestoreCategory")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("category.id", int(req.GetId())))
+
+	category, err := h.trashUsecase.RestoreCategory(ctx, uint(req.GetId()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Category restored successfully")
+	return &pb.RestoreCategoryResponse{
+		Category: mapCategoryToPB(category),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) PurgeProduct(ctx context.Context, req *pb.PurgeProductRequest) (*pb.PurgeProductResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.PurgeProduct")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(req.GetId())))
+
+	if err := h.trashUsecase.PurgeProduct(ctx, uint(req.GetId())); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Product purged successfully")
+	return &pb.PurgeProductResponse{Success: true}, nil
+}
+
+func (h *ProductGRPCHandler) PurgeCategory(ctx context.Context, req *pb.PurgeCategoryRequest) (*pb.PurgeCategoryResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.PurgeCategory")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("category.id", int(req.GetId())))
+
+	if err := h.trashUsecase.PurgeCategory(ctx, uint(req.GetId())); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Category purged successfully")
+	return &pb.PurgeCategoryResponse{Success: true}, nil
+}
+
+func newListTrashRequest(page, perPage int32) dto.ListTrashRequest {
+	listDto := dto.ListTrashRequest{Page: int(page), PerPage: int(perPage)}
+	if listDto.Page == 0 {
+		listDto.Page = 1
+	}
+	if listDto.PerPage == 0 {
+		listDto.PerPage = 10
+	}
+	return listDto
+}
+
+func mapTrashedProductToPB(product *dto.TrashedProductResponse) *pb.TrashedProduct {
+	response := &pb.TrashedProduct{
+		Id:        int64(product.ID),
+		Name:      product.Name,
+		Price:     product.Price,
+		DeletedAt: product.DeletedAt.Format(time.RFC3339),
+	}
+	if product.SKU != nil {
+		response.Sku = *product.SKU
+	}
+	if product.PurgeAt != nil {
+		response.PurgeAt = product.PurgeAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func mapTrashedCategoryToPB(category *dto.TrashedCategoryResponse) *pb.TrashedCategory {
+	response := &pb.TrashedCategory{
+		Id:        int64(category.ID),
+		Name:      category.Name,
+		Slug:      category.Slug,
+		Path:      category.Path,
+		DeletedAt: category.DeletedAt.Format(time.RFC3339),
+	}
+	if category.ParentID != nil {
+		response.ParentId = int64(*category.ParentID)
+	}
+	if category.PurgeAt != nil {
+		response.PurgeAt = category.PurgeAt.Format(time.RFC3339)
+	}
+	return response
+}
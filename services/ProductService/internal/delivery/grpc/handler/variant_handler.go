@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	pb "github.com/kareemhamed001/e-commerce/shared/proto/v1/product"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (h *ProductGRPCHandler) CreateProductOption(ctx context.Context, req *pb.CreateProductOptionRequest) (*pb.ProductOptionResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.CreateProductOption")
+	defer span.End()
+
+	optionDto := dto.CreateProductOptionRequest{
+		ProductID: uint(req.GetProductId()),
+		Name:      req.GetName(),
+		Values:    req.GetValues(),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateCreateProductOption")
+	if err := h.validate.Struct(&optionDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(optionDto.ProductID)),
+		attribute.String("option.name", optionDto.Name),
+	)
+
+	option, err := h.variantUsecase.CreateOption(ctx, &optionDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Product option created successfully")
+	return &pb.ProductOptionResponse{
+		Option: mapOptionToPB(option),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) DeleteProductOption(ctx context.Context, req *pb.DeleteProductOptionRequest) (*pb.DeleteProductOptionResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.DeleteProductOption")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(req.GetProductId())),
+		attribute.Int("option.id", int(req.GetOptionId())),
+	)
+
+	if err := h.variantUsecase.DeleteOption(ctx, uint(req.GetProductId()), uint(req.GetOptionId())); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Product option deleted successfully")
+	return &pb.DeleteProductOptionResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *ProductGRPCHandler) CreateProductVariant(ctx context.Context, req *pb.CreateProductVariantRequest) (*pb.ProductVariantResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.CreateProductVariant")
+	defer span.End()
+
+	variantDto := dto.CreateProductVariantRequest{
+		ProductID:      uint(req.GetProductId()),
+		SKU:            req.GetSku(),
+		Quantity:       int(req.GetQuantity()),
+		OptionValueIDs: toUintIDs(req.GetOptionValueIds()),
+	}
+	if price := req.GetPrice(); price != 0 {
+		variantDto.Price = &price
+	}
+	if imageUrl := req.GetImageUrl(); imageUrl != "" {
+		variantDto.ImageUrl = &imageUrl
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateCreateProductVariant")
+	if err := h.validate.Struct(&variantDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(variantDto.ProductID)),
+		attribute.String("variant.sku", variantDto.SKU),
+	)
+
+	variant, err := h.variantUsecase.CreateVariant(ctx, &variantDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Product variant created successfully")
+	return &pb.ProductVariantResponse{
+		Variant: mapVariantToPB(variant),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) GetProductVariant(ctx context.Context, req *pb.GetProductVariantRequest) (*pb.ProductVariantResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.GetProductVariant")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("variant.id", int(req.GetId())))
+
+	variant, err := h.variantUsecase.GetVariantByID(ctx, uint(req.GetId()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Product variant retrieved successfully")
+	return &pb.ProductVariantResponse{
+		Variant: mapVariantToPB(variant),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) UpdateProductVariant(ctx context.Context, req *pb.UpdateProductVariantRequest) (*pb.ProductVariantResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.UpdateProductVariant")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("variant.id", int(req.GetId())))
+
+	variantDto := dto.UpdateProductVariantRequest{
+		SKU:      req.Sku,
+		Price:    req.Price,
+		ImageUrl: req.ImageUrl,
+	}
+	if req.Quantity != nil {
+		quantity := int(req.GetQuantity())
+		variantDto.Quantity = &quantity
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateUpdateProductVariant")
+	if err := h.validate.Struct(&variantDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	variant, err := h.variantUsecase.UpdateVariant(ctx, uint(req.GetId()), &variantDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Product variant updated successfully")
+	return &pb.ProductVariantResponse{
+		Variant: mapVariantToPB(variant),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) DeleteProductVariant(ctx context.Context, req *pb.DeleteProductVariantRequest) (*pb.DeleteProductVariantResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.DeleteProductVariant")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("variant.id", int(req.GetId())))
+
+	if err := h.variantUsecase.DeleteVariant(ctx, uint(req.GetId())); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Product variant deleted successfully")
+	return &pb.DeleteProductVariantResponse{
+		Success: true,
+	}, nil
+}
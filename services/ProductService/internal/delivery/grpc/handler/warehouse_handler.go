@@ -0,0 +1,275 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	pb "github.com/kareemhamed001/e-commerce/shared/proto/v1/product"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (h *ProductGRPCHandler) CreateWarehouse(ctx context.Context, req *pb.CreateWarehouseRequest) (*pb.WarehouseResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.CreateWarehouse")
+	defer span.End()
+
+	warehouseDto := dto.CreateWarehouseRequest{
+		Code:     req.GetCode(),
+		Name:     req.GetName(),
+		Country:  req.GetCountry(),
+		State:    req.GetState(),
+		City:     req.GetCity(),
+		ZipCode:  req.GetZipCode(),
+		Priority: int(req.GetPriority()),
+		IsActive: req.IsActive,
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateCreateWarehouse")
+	if err := h.validate.Struct(&warehouseDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(attribute.String("warehouse.code", warehouseDto.Code))
+
+	warehouse, err := h.warehouseUsecase.CreateWarehouse(ctx, &warehouseDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Warehouse created successfully")
+	return &pb.WarehouseResponse{
+		Warehouse: mapWarehouseToPB(warehouse),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) GetWarehouse(ctx context.Context, req *pb.GetWarehouseRequest) (*pb.WarehouseResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.GetWarehouse")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("warehouse.id", int(req.GetId())))
+
+	warehouse, err := h.warehouseUsecase.GetWarehouseByID(ctx, uint(req.GetId()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Warehouse retrieved successfully")
+	return &pb.WarehouseResponse{
+		Warehouse: mapWarehouseToPB(warehouse),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) ListWarehouses(ctx context.Context, req *pb.ListWarehousesRequest) (*pb.ListWarehousesResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.ListWarehouses")
+	defer span.End()
+
+	span.SetAttributes(attribute.Bool("query.active_only", req.GetActiveOnly()))
+
+	warehouses, err := h.warehouseUsecase.ListWarehouses(ctx, req.GetActiveOnly())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := make([]*pb.Warehouse, 0, len(warehouses))
+	for i := range warehouses {
+		response = append(response, mapWarehouseToPB(&warehouses[i]))
+	}
+
+	span.SetAttributes(attribute.Int("warehouses.count", len(warehouses)))
+	span.SetStatus(codes.Ok, "Warehouses retrieved successfully")
+	return &pb.ListWarehousesResponse{
+		Warehouses: response,
+	}, nil
+}
+
+func (h *ProductGRPCHandler) UpdateWarehouse(ctx context.Context, req *pb.UpdateWarehouseRequest) (*pb.WarehouseResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.UpdateWarehouse")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("warehouse.id", int(req.GetId())))
+
+	warehouseDto := dto.UpdateWarehouseRequest{
+		Code:     req.Code,
+		Name:     req.Name,
+		Country:  req.Country,
+		State:    req.State,
+		City:     req.City,
+		ZipCode:  req.ZipCode,
+		IsActive: req.IsActive,
+	}
+	if req.Priority != nil {
+		priority := int(req.GetPriority())
+		warehouseDto.Priority = &priority
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateUpdateWarehouse")
+	if err := h.validate.Struct(&warehouseDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	warehouse, err := h.warehouseUsecase.UpdateWarehouse(ctx, uint(req.GetId()), &warehouseDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Warehouse updated successfully")
+	return &pb.WarehouseResponse{
+		Warehouse: mapWarehouseToPB(warehouse),
+	}, nil
+}
+
+func (h *ProductGRPCHandler) DeleteWarehouse(ctx context.Context, req *pb.DeleteWarehouseRequest) (*pb.DeleteWarehouseResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.DeleteWarehouse")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("warehouse.id", int(req.GetId())))
+
+	if err := h.warehouseUsecase.DeleteWarehouse(ctx, uint(req.GetId())); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Warehouse deleted successfully")
+	return &pb.DeleteWarehouseResponse{
+		Success: true,
+	}, nil
+}
+
+func (h *ProductGRPCHandler) GetStockAvailability(ctx context.Context, req *pb.GetStockAvailabilityRequest) (*pb.StockAvailabilityResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.GetStockAvailability")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(req.GetProductId())),
+		attribute.Int("variant.id", int(req.GetVariantId())),
+	)
+
+	availability, err := h.warehouseUsecase.GetStockAvailability(ctx, uint(req.GetProductId()), optionalID(req.GetVariantId()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	levels := make([]*pb.WarehouseStockLevel, 0, len(availability.Levels))
+	for _, level := range availability.Levels {
+		levels = append(levels, &pb.WarehouseStockLevel{
+			WarehouseId:   int64(level.WarehouseID),
+			WarehouseCode: level.WarehouseCode,
+			WarehouseName: level.WarehouseName,
+			IsActive:      level.IsActive,
+			Quantity:      int32(level.Quantity),
+		})
+	}
+
+	span.SetStatus(codes.Ok, "Stock availability retrieved successfully")
+	return &pb.StockAvailabilityResponse{
+		ProductId:          int64(availability.ProductID),
+		VariantId:          req.GetVariantId(),
+		OnHand:             int32(availability.OnHand),
+		TotalQuantity:      int32(availability.TotalQuantity),
+		UnassignedQuantity: int32(availability.UnassignedQuantity),
+		Levels:             levels,
+	}, nil
+}
+
+func (h *ProductGRPCHandler) AllocateWarehouse(ctx context.Context, req *pb.AllocateWarehouseRequest) (*pb.AllocateWarehouseResponse, error) {
+	ctx, span := h.tracer.Start(ctx, "ProductHandler.AllocateWarehouse")
+	defer span.End()
+
+	items := make([]dto.AllocationItemRequest, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		items = append(items, dto.AllocationItemRequest{
+			ProductID: uint(item.GetProductId()),
+			VariantID: optionalID(item.GetVariantId()),
+			Quantity:  int(item.GetQuantity()),
+		})
+	}
+
+	allocationDto := dto.AllocateWarehouseRequest{
+		Items:    items,
+		Country:  req.GetCountry(),
+		State:    req.GetState(),
+		City:     req.GetCity(),
+		ZipCode:  req.GetZipCode(),
+		Strategy: req.GetStrategy(),
+		Reserve:  req.GetReserve(),
+		Reason:   req.GetReason(),
+		Actor:    req.GetActor(),
+	}
+
+	_, validationSpan := h.tracer.Start(ctx, "ProductHandler.ValidateAllocateWarehouse")
+	if err := h.validate.Struct(&allocationDto); err != nil {
+		validationSpan.RecordError(err)
+		validationSpan.SetStatus(codes.Error, "validation failed")
+		validationSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "validation failed")
+
+		return nil, err
+	}
+	validationSpan.End()
+
+	span.SetAttributes(attribute.Int("allocation.items.count", len(items)))
+
+	allocation, err := h.warehouseUsecase.AllocateWarehouse(ctx, &allocationDto)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := &pb.AllocateWarehouseResponse{
+		Allocated: allocation.Allocated,
+		Strategy:  allocation.Strategy,
+	}
+	if allocation.Warehouse != nil {
+		response.Warehouse = mapWarehouseToPB(allocation.Warehouse)
+	}
+	for i := range allocation.Movements {
+		response.Movements = append(response.Movements, mapStockMovementToPB(&allocation.Movements[i]))
+	}
+
+	span.SetStatus(codes.Ok, "Warehouse allocated successfully")
+	return response, nil
+}
+
+func mapWarehouseToPB(w *dto.WarehouseResponse) *pb.Warehouse {
+	return &pb.Warehouse{
+		Id:        int64(w.ID),
+		Code:      w.Code,
+		Name:      w.Name,
+		Country:   w.Country,
+		State:     w.State,
+		City:      w.City,
+		ZipCode:   w.ZipCode,
+		Priority:  int32(w.Priority),
+		IsActive:  w.IsActive,
+		CreatedAt: w.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: w.UpdatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,71 @@
+package domain
+
+import "time"
+
+type AttributeType string
+
+const (
+	AttributeTypeString  AttributeType = "string"
+	AttributeTypeNumber  AttributeType = "number"
+	AttributeTypeBoolean AttributeType = "boolean"
+	AttributeTypeEnum    AttributeType = "enum"
+)
+
+// AttributeDefinition is a typed specification such as "RAM" or "Screen
+// size". Enum attributes only accept one of Options.
+type AttributeDefinition struct {
+	ID        uint          `gorm:"primarykey"`
+	Code      string        `json:"code"`
+	Name      string        `json:"name"`
+	Type      AttributeType `json:"type"`
+	Unit      *string       `json:"unit"`
+	Options   []string      `json:"options" gorm:"serializer:json"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// CategoryAttribute attaches an attribute to a category and, through it, to
+// every category below it.
+type CategoryAttribute struct {
+	CategoryID  uint `gorm:"primaryKey"`
+	AttributeID uint `gorm:"primaryKey"`
+	Required    bool `json:"required"`
+	Position    int  `json:"position"`
+}
+
+func (CategoryAttribute) TableName() string {
+	return "category_attributes"
+}
+
+// CategoryAttributeDefinition is an attribute that applies to a category,
+// either directly or inherited from the ancestor CategoryID.
+type CategoryAttributeDefinition struct {
+	AttributeDefinition
+	CategoryID uint
+	Required   bool
+	Position   int
+}
+
+// ProductAttributeValue is the value of one attribute for a product; only the
+// column matching the attribute type is set.
+type ProductAttributeValue struct {
+	ProductID   uint                `gorm:"primaryKey"`
+	AttributeID uint                `gorm:"primaryKey"`
+	TextValue   *string             `gorm:"column:value_text"`
+	NumberValue *float64            `gorm:"column:value_number"`
+	BoolValue   *bool               `gorm:"column:value_bool"`
+	Attribute   AttributeDefinition `gorm:"foreignKey:AttributeID"`
+	UpdatedAt   time.Time
+}
+
+// AttributeFilter keeps the products whose value of the attribute is one of
+// the listed values and, for numbers, lies within [Min, Max].
+type AttributeFilter struct {
+	AttributeID uint
+	Type        AttributeType
+	Texts       []string
+	Numbers     []float64
+	Bool        *bool
+	Min         *float64
+	Max         *float64
+}
@@ -8,7 +8,39 @@ import (
 )
 
 type ProductCache interface {
-	GetProduct(ctx context.Context, id uint) (*dto.ProductResponse, error)
-	SetProduct(ctx context.Context, product *dto.ProductResponse, ttl time.Duration) error
+	GetProduct(ctx context.Context, id uint) (*CachedProduct, error)
+	SetProduct(ctx context.Context, id uint, entry *CachedProduct, ttl time.Duration) error
+	GetProducts(ctx context.Context, ids []uint) (map[uint]*CachedProduct, error)
+	SetProducts(ctx context.Context, entries map[uint]*CachedProduct, ttl time.Duration) error
 	DeleteProduct(ctx context.Context, id uint) error
+	// EvictProduct drops only the product's entry, leaving list pages
+	// cached, for changes that listings do not depend on, such as stock.
+	EvictProduct(ctx context.Context, id uint) error
+	ProductListKey(ctx context.Context, name string) (string, error)
+	GetProductList(ctx context.Context, key string, dest any) error
+	SetProductList(ctx context.Context, key string, list any, ttl time.Duration) error
+	InvalidateProductList(ctx context.Context) error
+	Stats() CacheStats
+}
+
+// CachedProduct is a product cache entry. Entries stay in the cache past
+// FreshUntil so they can be served stale while being refreshed; Missing
+// entries record that the product does not exist.
+type CachedProduct struct {
+	Product    *dto.ProductResponse `json:"product,omitempty"`
+	Missing    bool                 `json:"missing,omitempty"`
+	FreshUntil time.Time            `json:"fresh_until"`
+	// LoadTime is how long the entry took to build; slower entries are
+	// refreshed earlier ahead of FreshUntil.
+	LoadTime time.Duration `json:"load_time"`
+}
+
+// CacheStats counts product cache lookups of a single service instance.
+type CacheStats struct {
+	ProductHits     uint64
+	ProductMisses   uint64
+	ProductStale    uint64
+	ProductNegative uint64
+	ListHits        uint64
+	ListMisses      uint64
 }
@@ -1,11 +1,34 @@
 package domain
 
-import "time"
+import (
+	"time"
 
+	"gorm.io/gorm"
+)
+
+// Category is a node in the category tree. Path is the materialized chain of
+// ancestor IDs ending with the category's own ID, e.g. "1/4/9", so a subtree
+// is every category whose path equals or starts with "<path>/".
 type Category struct {
 	ID          uint    `gorm:"primarykey"`
+	ParentID    *uint   `json:"parent_id"`
 	Name        string  `json:"name"`
+	Slug        string  `json:"slug"`
+	Path        string  `json:"path"`
 	Description *string `json:"description"`
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	// DeletedAt moves the category to the trash; it is purged for good by
+	// the trash retention job or an explicit purge.
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
+// ProductCategory is a row of the product <-> category join table.
+type ProductCategory struct {
+	ProductID  uint `gorm:"primaryKey"`
+	CategoryID uint `gorm:"primaryKey"`
+}
+
+func (ProductCategory) TableName() string {
+	return "product_categories"
 }
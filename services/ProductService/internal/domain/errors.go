@@ -6,4 +6,56 @@ var (
 	ErrUserNotFound       = errors.New("user not found")
 	ErrInvalidCredentials = errors.New("invalid email or password")
 	ErrHashingPassword    = errors.New("error hashing password")
+	ErrInvalidPriceRange  = errors.New("min price must not exceed max price")
+
+	ErrInvalidImportFormat  = errors.New("format must be csv or ndjson")
+	ErrInvalidImportHeader  = errors.New("csv header must include a name column")
+	ErrAmbiguousProductName = errors.New("several products share this name, add a sku to the row")
+
+	ErrUnsupportedImageType = errors.New("image must be a jpeg, png or gif")
+	ErrImageTooLarge        = errors.New("image exceeds the maximum upload size")
+	ErrImageDimensions      = errors.New("image dimensions are invalid or too large")
+	ErrInvalidImageOrder    = errors.New("image order must list every image of the product exactly once")
+
+	ErrReviewNotPurchased   = errors.New("only customers with a delivered order of this product can review it")
+	ErrDuplicateReview      = errors.New("you have already reviewed this product")
+	ErrReviewForbidden      = errors.New("review belongs to another user")
+	ErrInvalidReviewStatus  = errors.New("review status must be approved or rejected")
+	ErrPurchaseVerification = errors.New("could not verify the purchase, try again later")
+
+	ErrPriceChangeNotPending = errors.New("only pending price changes can be canceled")
+	ErrPriceChangeInPast     = errors.New("price change must take effect in the future")
+	ErrEmptyPriceChange      = errors.New("price change must set a price or a discount")
+	ErrInvalidDiscount       = errors.New("discount must be fixed or percent with a positive value")
+
+	ErrCategoryHasChildren = errors.New("category has child categories")
+	ErrCategoryParentTrash = errors.New("restore the parent category first")
+	ErrRestoreConflict     = errors.New("a live item already uses the sku or slug of the trashed one")
+	ErrCategoryCycle       = errors.New("category cannot be moved under itself or its descendants")
+	ErrEmptyCategorySlug   = errors.New("category slug must contain a letter or digit")
+	ErrCategorySlugTaken   = errors.New("a sibling category already uses this slug")
+
+	ErrInvalidAttributeCode      = errors.New("attribute code may only hold lowercase letters, digits and underscores")
+	ErrDuplicateAttributeCode    = errors.New("an attribute with this code already exists")
+	ErrInvalidAttributeOptions   = errors.New("enum attributes need at least one option, other types take none")
+	ErrInvalidAttributeValue     = errors.New("value does not match the attribute type or options")
+	ErrAttributeNotApplicable    = errors.New("attribute does not apply to the categories of the product")
+	ErrMissingRequiredAttributes = errors.New("product is missing required attributes")
+	ErrInvalidAttributeFilter    = errors.New("attribute filter does not fit the attribute type")
+
+	ErrInvalidVariantOptions = errors.New("variant must pick exactly one value of every product option")
+	ErrDuplicateVariant      = errors.New("a variant with the same option values already exists")
+	ErrProductHasVariants    = errors.New("product options cannot change while variants exist")
+	ErrVariantMismatch       = errors.New("variant does not belong to product")
+
+	ErrInsufficientStock    = errors.New("insufficient stock")
+	ErrInvalidStockQuantity = errors.New("stock movement quantity must be positive, or non-zero for adjustments")
+	ErrInvalidMovementType  = errors.New("transfers must be recorded with TransferStock")
+	ErrOpeningMovementType  = errors.New("initial and import movements are only recorded when a product or variant is created")
+
+	ErrSameWarehouse             = errors.New("source and destination warehouse must differ")
+	ErrWarehouseInactive         = errors.New("warehouse is inactive")
+	ErrWarehouseHasStock         = errors.New("warehouse still holds stock")
+	ErrNoFulfillingWarehouse     = errors.New("no warehouse can fulfill all requested items")
+	ErrInvalidAllocationStrategy = errors.New("allocation strategy must be nearest or most_stock")
 )
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	EventInventoryLowStock = "inventory.low_stock"
+	EventProductRestored   = "product.restored"
+	EventCategoryRestored  = "category.restored"
+)
+
+// EventPublisher delivers domain events to other services. Implementations
+// must be safe for concurrent use.
+type EventPublisher interface {
+	Publish(ctx context.Context, routingKey string, event any) error
+}
+
+// LowStockEvent is published once when a product or variant falls below its
+// reorder threshold; it is raised again only after stock has recovered.
+type LowStockEvent struct {
+	ProductID                uint      `json:"product_id"`
+	VariantID                *uint     `json:"variant_id,omitempty"`
+	Name                     string    `json:"name"`
+	SKU                      string    `json:"sku,omitempty"`
+	Quantity                 int       `json:"quantity"`
+	ReorderThreshold         int       `json:"reorder_threshold"`
+	DailySalesRate           float64   `json:"daily_sales_rate"`
+	SuggestedReorderQuantity int       `json:"suggested_reorder_quantity"`
+	OccurredAt               time.Time `json:"occurred_at"`
+}
+
+// ProductRestoredEvent is published when a trashed product is restored.
+type ProductRestoredEvent struct {
+	ProductID  uint      `json:"product_id"`
+	Name       string    `json:"name"`
+	SKU        string    `json:"sku,omitempty"`
+	Price      float32   `json:"price"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// CategoryRestoredEvent is published when a trashed category is restored.
+type CategoryRestoredEvent struct {
+	CategoryID uint      `json:"category_id"`
+	ParentID   *uint     `json:"parent_id,omitempty"`
+	Name       string    `json:"name"`
+	Slug       string    `json:"slug"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
@@ -0,0 +1,83 @@
+package domain
+
+import "time"
+
+type StockMovementType string
+
+const (
+	StockMovementRestock     StockMovementType = "restock"
+	StockMovementSale        StockMovementType = "sale"
+	StockMovementReservation StockMovementType = "reservation"
+	StockMovementRelease     StockMovementType = "release"
+	StockMovementReturn      StockMovementType = "return"
+	StockMovementAdjustment  StockMovementType = "adjustment"
+	StockMovementTransfer    StockMovementType = "transfer"
+	// StockMovementInitial and StockMovementImport record the stock a product
+	// or variant starts with when it is created, directly or by an import.
+	StockMovementInitial StockMovementType = "initial"
+	StockMovementImport  StockMovementType = "import"
+)
+
+// SystemActor is the actor of movements the service records on its own.
+const SystemActor = "system"
+
+// Sign returns the direction in which a movement of this type changes on-hand
+// stock. Adjustments and transfers return 0 because they carry their own signed
+// quantity.
+func (t StockMovementType) Sign() int {
+	switch t {
+	case StockMovementRestock, StockMovementRelease, StockMovementReturn, StockMovementInitial, StockMovementImport:
+		return 1
+	case StockMovementSale, StockMovementReservation:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// IsOpening reports whether movements of this type only record the stock an
+// item is created with, so callers cannot record them.
+func (t StockMovementType) IsOpening() bool {
+	return t == StockMovementInitial || t == StockMovementImport
+}
+
+// StockMovement is an append-only ledger entry. Quantity is the signed change
+// applied to on-hand stock of the product, or of the variant when VariantID is
+// set, and BalanceAfter is the on-hand quantity right after it was applied.
+// Movements scoped to a warehouse also change that warehouse's stock level and
+// record it in WarehouseBalanceAfter; transfers only change warehouse levels.
+type StockMovement struct {
+	ID                    uint              `gorm:"primarykey"`
+	ProductID             uint              `json:"product_id"`
+	VariantID             *uint             `json:"variant_id"`
+	WarehouseID           *uint             `json:"warehouse_id"`
+	Type                  StockMovementType `gorm:"type:varchar(20)" json:"type"`
+	Quantity              int               `json:"quantity"`
+	BalanceAfter          int               `json:"balance_after"`
+	WarehouseBalanceAfter *int              `json:"warehouse_balance_after"`
+	Reason                string            `json:"reason"`
+	Actor                 string            `json:"actor"`
+	CreatedAt             time.Time
+}
+
+type StockMovementFilter struct {
+	ProductID   uint
+	VariantID   *uint
+	WarehouseID *uint
+	Type        StockMovementType
+	Page        int
+	PerPage     int
+}
+
+// LowStockItem is a product, or one of its variants, whose on-hand quantity is
+// below the product's reorder threshold. UnitsSold counts sale movements
+// recorded since the start of the sales window.
+type LowStockItem struct {
+	ProductID        uint
+	VariantID        *uint
+	Name             string
+	SKU              string
+	Quantity         int
+	ReorderThreshold int
+	UnitsSold        int
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// ProductImage is an uploaded product image. Images are shown by ascending
+// Position; the first one is mirrored into Product.ImageUrl.
+type ProductImage struct {
+	ID           uint   `gorm:"primarykey"`
+	ProductID    uint   `json:"product_id"`
+	Position     int    `json:"position"`
+	AltText      string `json:"alt_text"`
+	ObjectKey    string `json:"object_key"`
+	ThumbnailKey string `json:"thumbnail_key"`
+	URL          string `json:"url" gorm:"column:url"`
+	ThumbnailURL string `json:"thumbnail_url" gorm:"column:thumbnail_url"`
+	ContentType  string `json:"content_type"`
+	SizeBytes    int64  `json:"size_bytes"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// BlobStore keeps uploaded media files. Put returns the public URL the file
+// is served from.
+type BlobStore interface {
+	Put(ctx context.Context, key string, data []byte, contentType string) (string, error)
+	Delete(ctx context.Context, key string) error
+}
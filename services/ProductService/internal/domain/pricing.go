@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+type PriceChangeSource string
+
+const (
+	PriceSourceInitial  PriceChangeSource = "initial"
+	PriceSourceCreate   PriceChangeSource = "create"
+	PriceSourceUpdate   PriceChangeSource = "update"
+	PriceSourceImport   PriceChangeSource = "import"
+	PriceSourceSchedule PriceChangeSource = "schedule"
+)
+
+// PriceHistoryEntry records the price and discount of a product from
+// ChangedAt until the next entry.
+type PriceHistoryEntry struct {
+	ID                uint              `gorm:"primarykey"`
+	ProductID         uint              `json:"product_id"`
+	Price             float32           `json:"price"`
+	DiscountType      DiscountType      `json:"discount_type"`
+	DiscountValue     float32           `json:"discount_value"`
+	Source            PriceChangeSource `json:"source"`
+	ScheduledChangeID *uint             `json:"scheduled_change_id"`
+	ChangedAt         time.Time         `json:"changed_at" gorm:"autoCreateTime"`
+}
+
+func (PriceHistoryEntry) TableName() string {
+	return "product_price_history"
+}
+
+type ScheduledPriceChangeStatus string
+
+const (
+	ScheduledPriceChangePending  ScheduledPriceChangeStatus = "pending"
+	ScheduledPriceChangeApplied  ScheduledPriceChangeStatus = "applied"
+	ScheduledPriceChangeCanceled ScheduledPriceChangeStatus = "canceled"
+)
+
+// ScheduledPriceChange is applied by the price scheduler once EffectiveAt has
+// passed. Nil fields keep their current value; RemoveDiscount clears the
+// discount, e.g. to end a sale.
+type ScheduledPriceChange struct {
+	ID             uint                       `gorm:"primarykey"`
+	ProductID      uint                       `json:"product_id"`
+	Price          *float32                   `json:"price"`
+	DiscountType   *DiscountType              `json:"discount_type"`
+	DiscountValue  *float32                   `json:"discount_value"`
+	RemoveDiscount bool                       `json:"remove_discount"`
+	EffectiveAt    time.Time                  `json:"effective_at"`
+	Status         ScheduledPriceChangeStatus `json:"status"`
+	AppliedAt      *time.Time                 `json:"applied_at"`
+	CreatedBy      string                     `json:"created_by"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+type ScheduledPriceChangeFilter struct {
+	ProductID uint
+	Status    ScheduledPriceChangeStatus
+	Page      int
+	PerPage   int
+}
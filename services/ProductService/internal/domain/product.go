@@ -9,6 +9,7 @@ import (
 type Product struct {
 	gorm.Model
 	Name              string       `json:"name"`
+	SKU               *string      `json:"sku" gorm:"column:sku"`
 	ShortDescription  *string      `json:"short_description"`
 	Description       string       `json:"description"`
 	Price             float32      `json:"price"`
@@ -18,4 +19,18 @@ type Product struct {
 	DiscountEndDate   *time.Time   `json:"discount_end_date"`
 	ImageUrl          *string      `json:"image_url"`
 	Quantity          int          `json:"quantity"`
+	SoldCount         int          `json:"sold_count"`
+	// ReorderThreshold flags the product, or each of its variants, as low on
+	// stock once quantity drops below it; 0 disables the check.
+	ReorderThreshold int `json:"reorder_threshold"`
+	// MaxPerOrder caps the quantity of the product, or of each of its
+	// variants, in one cart or order; 0 means no limit.
+	MaxPerOrder int `json:"max_per_order"`
+	// TaxCategory is the tax class OrderService looks tax rates up by, such
+	// as "standard", "reduced" or "exempt".
+	TaxCategory string `json:"tax_category" gorm:"type:varchar(32);not null;default:'standard'"`
+	// RatingAverage and RatingCount summarize the approved reviews and are
+	// only written by the review repository.
+	RatingAverage float64 `json:"rating_average" gorm:"type:numeric(3,2)"`
+	RatingCount   int     `json:"rating_count"`
 }
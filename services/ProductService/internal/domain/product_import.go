@@ -0,0 +1,17 @@
+package domain
+
+// ProductImportRow is one row of an import file, keyed by its line so errors
+// can be reported back against the file.
+type ProductImportRow struct {
+	Line    int
+	Product Product
+}
+
+// ProductImportResult reports what happened to one imported row. Err holds a
+// row-level failure; the rest of the batch is still imported.
+type ProductImportResult struct {
+	Line      int
+	ProductID uint
+	Created   bool
+	Err       error
+}
@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 )
 
 type ProductRepository interface {
@@ -9,8 +10,17 @@ type ProductRepository interface {
 	GetProductByID(ctx context.Context, id uint) (*Product, error)
 	GetProductsByIDs(ctx context.Context, ids []uint) ([]Product, error)
 	UpdateProduct(ctx context.Context, id uint, product *Product) error
-	ListProducts(ctx context.Context, page, perPage int) ([]Product, int, error)
+	SetMaxPerOrder(ctx context.Context, id uint, maxPerOrder int) error
+	ListProducts(ctx context.Context, page, perPage int, attributes []AttributeFilter) ([]Product, int, error)
 	DeleteProduct(ctx context.Context, id uint) error
+	SearchProducts(ctx context.Context, filter ProductSearchFilter) ([]Product, int, error)
+	SearchFacets(ctx context.Context, filter ProductSearchFilter) (*SearchFacets, error)
+	ListProductsByCategory(ctx context.Context, categoryID uint, includeDescendants bool, page, perPage int, attributes []AttributeFilter) ([]Product, int, error)
+	ImportProducts(ctx context.Context, rows []ProductImportRow) ([]ProductImportResult, error)
+	// DryRun runs fn with a repository whose writes are all rolled back
+	// together once fn returns.
+	DryRun(ctx context.Context, fn func(repo ProductRepository) error) error
+	ListProductsAfter(ctx context.Context, afterID uint, limit int) ([]Product, error)
 }
 
 type CategoryRepository interface {
@@ -19,4 +29,110 @@ type CategoryRepository interface {
 	UpdateCategory(ctx context.Context, id uint, category *Category) error
 	ListCategories(ctx context.Context, page, perPage int) ([]Category, int, error)
 	DeleteCategory(ctx context.Context, id uint) error
+	ListAllCategories(ctx context.Context) ([]Category, error)
+	MoveCategory(ctx context.Context, id uint, parentID *uint) error
+	CountChildren(ctx context.Context, id uint) (int, error)
+	AssignProductCategories(ctx context.Context, productID uint, categoryIDs []uint) error
+	UnassignProductCategories(ctx context.Context, productID uint, categoryIDs []uint) error
+	ListProductCategories(ctx context.Context, productID uint) ([]Category, error)
+}
+
+type VariantRepository interface {
+	CreateOption(ctx context.Context, option *ProductOption) error
+	ListOptions(ctx context.Context, productID uint) ([]ProductOption, error)
+	ListOptionsByProductIDs(ctx context.Context, productIDs []uint) ([]ProductOption, error)
+	DeleteOption(ctx context.Context, productID, optionID uint) error
+	CreateVariant(ctx context.Context, variant *ProductVariant, optionValueIDs []uint) error
+	GetVariantByID(ctx context.Context, id uint) (*ProductVariant, error)
+	UpdateVariant(ctx context.Context, id uint, variant *ProductVariant) error
+	DeleteVariant(ctx context.Context, id uint) error
+	ListVariants(ctx context.Context, productID uint) ([]ProductVariant, error)
+	ListVariantsByProductIDs(ctx context.Context, productIDs []uint) ([]ProductVariant, error)
+	CountVariants(ctx context.Context, productID uint) (int, error)
+}
+
+// AttributeRepository stores attribute definitions, their attachment to
+// categories and the attribute values of products.
+type AttributeRepository interface {
+	CreateAttribute(ctx context.Context, attr *AttributeDefinition) error
+	ListAttributes(ctx context.Context) ([]AttributeDefinition, error)
+	GetAttributesByCodes(ctx context.Context, codes []string) ([]AttributeDefinition, error)
+	// DeleteAttribute returns the IDs of the products that had a value for
+	// the attribute.
+	DeleteAttribute(ctx context.Context, id uint) ([]uint, error)
+	AttachCategoryAttribute(ctx context.Context, link *CategoryAttribute) error
+	DetachCategoryAttribute(ctx context.Context, categoryID, attributeID uint) error
+	// ListCategoryAttributes includes the attributes inherited from the
+	// ancestors of the category.
+	ListCategoryAttributes(ctx context.Context, categoryID uint) ([]CategoryAttributeDefinition, error)
+	// ListApplicableAttributes lists the attributes of every live category
+	// of the product and their ancestors.
+	ListApplicableAttributes(ctx context.Context, productID uint) ([]CategoryAttributeDefinition, error)
+	// SetProductAttributes replaces all attribute values of the product.
+	SetProductAttributes(ctx context.Context, productID uint, values []ProductAttributeValue) error
+	ListValuesByProductIDs(ctx context.Context, productIDs []uint) ([]ProductAttributeValue, error)
+}
+
+type InventoryRepository interface {
+	RecordMovement(ctx context.Context, movement *StockMovement) error
+	RecordMovements(ctx context.Context, movements []StockMovement) error
+	TransferStock(ctx context.Context, transfer StockTransfer) ([]StockMovement, error)
+	ListMovements(ctx context.Context, filter StockMovementFilter) ([]StockMovement, int, error)
+	SetReorderThreshold(ctx context.Context, productID uint, threshold int) error
+	ListLowStock(ctx context.Context, salesSince time.Time) ([]LowStockItem, error)
+	RaiseLowStockAlert(ctx context.Context, item LowStockItem) (bool, error)
+	ClearLowStockAlert(ctx context.Context, key StockKey) error
+	ResolveLowStockAlerts(ctx context.Context, stillLow []StockKey) error
+}
+
+type WarehouseRepository interface {
+	CreateWarehouse(ctx context.Context, warehouse *Warehouse) error
+	GetWarehouseByID(ctx context.Context, id uint) (*Warehouse, error)
+	ListWarehouses(ctx context.Context, activeOnly bool) ([]Warehouse, error)
+	UpdateWarehouse(ctx context.Context, id uint, warehouse *Warehouse) error
+	DeleteWarehouse(ctx context.Context, id uint) error
+	ListStockLevels(ctx context.Context, keys []StockKey) ([]WarehouseStock, error)
+}
+
+type MediaRepository interface {
+	CreateImage(ctx context.Context, image *ProductImage) error
+	GetImageByID(ctx context.Context, id uint) (*ProductImage, error)
+	ListImages(ctx context.Context, productID uint) ([]ProductImage, error)
+	ListImagesByProductIDs(ctx context.Context, productIDs []uint) ([]ProductImage, error)
+	UpdateImageAltText(ctx context.Context, id uint, altText string) error
+	ReorderImages(ctx context.Context, productID uint, imageIDs []uint) error
+	DeleteImage(ctx context.Context, id uint) (*ProductImage, error)
+}
+
+type ReviewRepository interface {
+	CreateReview(ctx context.Context, review *ProductReview) error
+	GetReviewByID(ctx context.Context, id uint) (*ProductReview, error)
+	ListReviews(ctx context.Context, filter ReviewFilter) ([]ProductReview, int, error)
+	UpdateReview(ctx context.Context, review *ProductReview) error
+	SetReviewStatus(ctx context.Context, id uint, status ReviewStatus, note string) (*ProductReview, error)
+	DeleteReview(ctx context.Context, id uint) (*ProductReview, error)
+}
+
+// TrashRepository lists, restores and permanently removes soft-deleted
+// products and categories.
+type TrashRepository interface {
+	ListTrashedProducts(ctx context.Context, page, perPage int) ([]Product, int, error)
+	ListTrashedCategories(ctx context.Context, page, perPage int) ([]Category, int, error)
+	RestoreProduct(ctx context.Context, id uint) (*Product, error)
+	RestoreCategory(ctx context.Context, id uint) (*Category, error)
+	// PurgeProduct returns the images of the purged product so their files
+	// can be removed as well.
+	PurgeProduct(ctx context.Context, id uint) ([]ProductImage, error)
+	PurgeCategory(ctx context.Context, id uint) error
+	ListExpiredProductIDs(ctx context.Context, deletedBefore time.Time, limit int) ([]uint, error)
+	// ListExpiredCategoryIDs orders children before their parents.
+	ListExpiredCategoryIDs(ctx context.Context, deletedBefore time.Time, limit int) ([]uint, error)
+}
+
+type PricingRepository interface {
+	SchedulePriceChange(ctx context.Context, change *ScheduledPriceChange) error
+	CancelScheduledPriceChange(ctx context.Context, id uint) (*ScheduledPriceChange, error)
+	ListScheduledPriceChanges(ctx context.Context, filter ScheduledPriceChangeFilter) ([]ScheduledPriceChange, int, error)
+	ListPriceHistory(ctx context.Context, productID uint) ([]PriceHistoryEntry, error)
+	ApplyDuePriceChanges(ctx context.Context, now time.Time, limit int) ([]ScheduledPriceChange, error)
 }
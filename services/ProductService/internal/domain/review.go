@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+type ReviewStatus string
+
+const (
+	ReviewStatusPending  ReviewStatus = "pending"
+	ReviewStatusApproved ReviewStatus = "approved"
+	ReviewStatusRejected ReviewStatus = "rejected"
+)
+
+func (s ReviewStatus) IsValid() bool {
+	return s == ReviewStatusPending || s == ReviewStatusApproved || s == ReviewStatusRejected
+}
+
+// ProductReview is a customer's rating of a product. Only approved reviews are
+// shown publicly and counted in the product's rating.
+type ProductReview struct {
+	ID             uint         `gorm:"primarykey"`
+	ProductID      uint         `json:"product_id"`
+	UserID         uint         `json:"user_id"`
+	Rating         int          `json:"rating"`
+	Title          string       `json:"title"`
+	Body           string       `json:"body"`
+	Status         ReviewStatus `json:"status"`
+	ModerationNote string       `json:"moderation_note"`
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// ReviewFilter narrows ListReviews; zero fields are not filtered on.
+type ReviewFilter struct {
+	ProductID uint
+	UserID    uint
+	Status    ReviewStatus
+	Page      int
+	PerPage   int
+}
@@ -0,0 +1,61 @@
+package domain
+
+type ProductSortBy string
+
+const (
+	SortByRelevance  ProductSortBy = "relevance"
+	SortByPriceAsc   ProductSortBy = "price_asc"
+	SortByPriceDesc  ProductSortBy = "price_desc"
+	SortByNewest     ProductSortBy = "newest"
+	SortByPopularity ProductSortBy = "popularity"
+)
+
+// ProductSearchFilter describes a catalog search. Zero values mean "not filtered".
+type ProductSearchFilter struct {
+	Query          string
+	MinPrice       *float32
+	MaxPrice       *float32
+	CategoryID     *uint
+	InStockOnly    bool
+	DiscountActive bool
+	Attributes     []AttributeFilter
+	SortBy         ProductSortBy
+	Page           int
+	PerPage        int
+}
+
+// PriceRange is a half-open [Min, Max) bucket; Max of 0 means unbounded.
+type PriceRange struct {
+	Min float32
+	Max float32
+}
+
+// SearchPriceRanges are the buckets reported in the price facet.
+var SearchPriceRanges = []PriceRange{
+	{Min: 0, Max: 25},
+	{Min: 25, Max: 50},
+	{Min: 50, Max: 100},
+	{Min: 100, Max: 250},
+	{Min: 250, Max: 500},
+	{Min: 500, Max: 0},
+}
+
+type CategoryFacet struct {
+	CategoryID uint
+	Name       string
+	Count      int
+}
+
+type PriceRangeFacet struct {
+	PriceRange
+	Count int
+}
+
+// SearchFacets holds filter counts computed over every product matching the
+// free-text query, so clients can show how many results each filter leaves.
+type SearchFacets struct {
+	Categories  []CategoryFacet
+	PriceRanges []PriceRangeFacet
+	InStock     int
+	OnDiscount  int
+}
@@ -2,17 +2,30 @@ package domain
 
 import (
 	"context"
+	"io"
 
 	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
 )
 
+// ImportBatchFunc imports one batch of validated rows and reports the rows
+// that failed.
+type ImportBatchFunc func(rows []dto.ImportProductRow) (*dto.ImportProductsResponse, error)
+
 type ProductUsecase interface {
 	CreateProduct(ctx context.Context, product *dto.CreateProductRequest) (*dto.ProductResponse, error)
 	GetProductByID(ctx context.Context, id uint) (*dto.ProductResponse, error)
-	ListProducts(ctx context.Context, page, perPage int) ([]dto.ProductResponse, int, error)
+	GetProductsByIDs(ctx context.Context, ids []uint) (*dto.ProductsByIDsResponse, error)
+	ListProducts(ctx context.Context, page, perPage int, attributes []dto.AttributeFilterRequest) ([]dto.ProductResponse, int, error)
 	UpdateProduct(ctx context.Context, id uint, product *dto.UpdateProductRequest) (*dto.ProductResponse, error)
+	SetMaxPerOrder(ctx context.Context, req *dto.SetMaxPerOrderRequest) error
 	DeleteProduct(ctx context.Context, id uint) error
-	RestockProduct(ctx context.Context, id uint, quantity int) error
+	SearchProducts(ctx context.Context, req *dto.SearchProductsRequest) (*dto.SearchProductsResponse, error)
+	// ImportProducts hands run a function importing one batch of validated
+	// rows. The batches of a dry run share one transaction, rolled back
+	// when run returns, so each batch sees the rows of the earlier ones.
+	ImportProducts(ctx context.Context, dryRun bool, run func(importBatch ImportBatchFunc) error) error
+	ExportProducts(ctx context.Context, batchSize int, send func([]dto.ProductResponse) error) error
+	GetCacheStats(ctx context.Context) *dto.CacheStatsResponse
 }
 
 type CategoryUsecase interface {
@@ -21,4 +34,87 @@ type CategoryUsecase interface {
 	ListCategories(ctx context.Context, page, perPage int) ([]dto.CategoryResponse, int, error)
 	UpdateCategory(ctx context.Context, id uint, category *dto.UpdateCategoryRequest) error
 	DeleteCategory(ctx context.Context, id uint) error
+	GetCategoryTree(ctx context.Context) ([]dto.CategoryTreeNode, error)
+	AssignProductCategories(ctx context.Context, req *dto.AssignProductCategoriesRequest) (*dto.ProductCategoriesResponse, error)
+	UnassignProductCategories(ctx context.Context, req *dto.UnassignProductCategoriesRequest) (*dto.ProductCategoriesResponse, error)
+	ListProductsByCategory(ctx context.Context, categoryID uint, includeDescendants bool, page, perPage int, attributes []dto.AttributeFilterRequest) ([]dto.ProductResponse, int, error)
+}
+
+type VariantUsecase interface {
+	CreateOption(ctx context.Context, req *dto.CreateProductOptionRequest) (*dto.ProductOptionResponse, error)
+	DeleteOption(ctx context.Context, productID, optionID uint) error
+	CreateVariant(ctx context.Context, req *dto.CreateProductVariantRequest) (*dto.ProductVariantResponse, error)
+	GetVariantByID(ctx context.Context, id uint) (*dto.ProductVariantResponse, error)
+	UpdateVariant(ctx context.Context, id uint, req *dto.UpdateProductVariantRequest) (*dto.ProductVariantResponse, error)
+	DeleteVariant(ctx context.Context, id uint) error
+}
+
+type AttributeUsecase interface {
+	CreateAttribute(ctx context.Context, req *dto.CreateAttributeRequest) (*dto.AttributeResponse, error)
+	// ListAttributes lists every attribute, or those applying to the
+	// category when categoryID is set.
+	ListAttributes(ctx context.Context, categoryID uint) ([]dto.AttributeResponse, error)
+	DeleteAttribute(ctx context.Context, id uint) error
+	AttachCategoryAttribute(ctx context.Context, req *dto.AttachCategoryAttributeRequest) ([]dto.AttributeResponse, error)
+	DetachCategoryAttribute(ctx context.Context, categoryID, attributeID uint) error
+	SetProductAttributes(ctx context.Context, req *dto.SetProductAttributesRequest) ([]dto.ProductAttributeResponse, error)
+}
+
+type InventoryUsecase interface {
+	RestockProduct(ctx context.Context, req *dto.RestockProductRequest) (*dto.StockMovementResponse, error)
+	RecordStockMovement(ctx context.Context, req *dto.RecordStockMovementRequest) (*dto.StockMovementResponse, error)
+	TransferStock(ctx context.Context, req *dto.TransferStockRequest) ([]dto.StockMovementResponse, error)
+	TakeStock(ctx context.Context, req *dto.StockItemsRequest) ([]dto.StockMovementResponse, error)
+	ReturnStock(ctx context.Context, req *dto.StockItemsRequest) ([]dto.StockMovementResponse, error)
+	ListStockMovements(ctx context.Context, req *dto.ListStockMovementsRequest) ([]dto.StockMovementResponse, int, error)
+}
+
+type LowStockUsecase interface {
+	SetReorderThreshold(ctx context.Context, req *dto.SetReorderThresholdRequest) error
+	ListLowStockProducts(ctx context.Context) ([]dto.LowStockItemResponse, error)
+	EvaluateLowStock(ctx context.Context) (int, error)
+}
+
+type WarehouseUsecase interface {
+	CreateWarehouse(ctx context.Context, req *dto.CreateWarehouseRequest) (*dto.WarehouseResponse, error)
+	GetWarehouseByID(ctx context.Context, id uint) (*dto.WarehouseResponse, error)
+	ListWarehouses(ctx context.Context, activeOnly bool) ([]dto.WarehouseResponse, error)
+	UpdateWarehouse(ctx context.Context, id uint, req *dto.UpdateWarehouseRequest) (*dto.WarehouseResponse, error)
+	DeleteWarehouse(ctx context.Context, id uint) error
+	GetStockAvailability(ctx context.Context, productID uint, variantID *uint) (*dto.StockAvailabilityResponse, error)
+	AllocateWarehouse(ctx context.Context, req *dto.AllocateWarehouseRequest) (*dto.AllocationResponse, error)
+}
+
+type MediaUsecase interface {
+	UploadProductImage(ctx context.Context, req *dto.UploadProductImageRequest, r io.Reader) (*dto.ProductImageResponse, error)
+	ListProductImages(ctx context.Context, productID uint) ([]dto.ProductImageResponse, error)
+	UpdateProductImage(ctx context.Context, id uint, req *dto.UpdateProductImageRequest) (*dto.ProductImageResponse, error)
+	ReorderProductImages(ctx context.Context, req *dto.ReorderProductImagesRequest) ([]dto.ProductImageResponse, error)
+	DeleteProductImage(ctx context.Context, id uint) error
+}
+
+type ReviewUsecase interface {
+	CreateReview(ctx context.Context, req *dto.CreateReviewRequest) (*dto.ReviewResponse, error)
+	ListReviews(ctx context.Context, req *dto.ListReviewsRequest) ([]dto.ReviewResponse, int, error)
+	UpdateReview(ctx context.Context, id uint, req *dto.UpdateReviewRequest) (*dto.ReviewResponse, error)
+	DeleteReview(ctx context.Context, id, userID uint) error
+	ModerateReview(ctx context.Context, id uint, req *dto.ModerateReviewRequest) (*dto.ReviewResponse, error)
+}
+
+type TrashUsecase interface {
+	ListTrashedProducts(ctx context.Context, req *dto.ListTrashRequest) ([]dto.TrashedProductResponse, int, error)
+	ListTrashedCategories(ctx context.Context, req *dto.ListTrashRequest) ([]dto.TrashedCategoryResponse, int, error)
+	RestoreProduct(ctx context.Context, id uint) (*dto.ProductResponse, error)
+	RestoreCategory(ctx context.Context, id uint) (*dto.CategoryResponse, error)
+	PurgeProduct(ctx context.Context, id uint) error
+	PurgeCategory(ctx context.Context, id uint) error
+	PurgeExpired(ctx context.Context) (int, error)
+}
+
+type PricingUsecase interface {
+	SchedulePriceChange(ctx context.Context, req *dto.SchedulePriceChangeRequest) (*dto.ScheduledPriceChangeResponse, error)
+	CancelScheduledPriceChange(ctx context.Context, id uint) (*dto.ScheduledPriceChangeResponse, error)
+	ListScheduledPriceChanges(ctx context.Context, req *dto.ListScheduledPriceChangesRequest) ([]dto.ScheduledPriceChangeResponse, int, error)
+	GetPriceTimeline(ctx context.Context, productID uint) (*dto.PriceTimelineResponse, error)
+	ApplyDuePriceChanges(ctx context.Context) (int, error)
 }
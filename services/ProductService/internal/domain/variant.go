@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ProductOption is a selectable dimension of a product such as size or color.
+// Values holds the choices available for that dimension in display order.
+type ProductOption struct {
+	ID        uint                 `gorm:"primarykey"`
+	ProductID uint                 `json:"product_id"`
+	Name      string               `json:"name"`
+	Position  int                  `json:"position"`
+	Values    []ProductOptionValue `gorm:"foreignKey:OptionID" json:"values"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type ProductOptionValue struct {
+	ID       uint   `gorm:"primarykey"`
+	OptionID uint   `json:"option_id"`
+	Value    string `json:"value"`
+	Position int    `json:"position"`
+}
+
+// ProductVariant is a purchasable combination of option values, e.g. "M / Red".
+// Price and ImageUrl override the parent product when set; stock is tracked
+// per variant.
+type ProductVariant struct {
+	gorm.Model
+	ProductID    uint                 `json:"product_id"`
+	SKU          string               `json:"sku"`
+	Price        *float32             `json:"price"`
+	Quantity     int                  `json:"quantity"`
+	ImageUrl     *string              `json:"image_url"`
+	OptionValues []ProductOptionValue `gorm:"many2many:product_variant_option_values;joinForeignKey:VariantID;joinReferences:OptionValueID" json:"option_values"`
+}
+
+// EffectivePrice returns the variant's own price or falls back to the product price.
+func (v *ProductVariant) EffectivePrice(productPrice float32) float32 {
+	if v.Price != nil {
+		return *v.Price
+	}
+	return productPrice
+}
+
+// ProductVariantOptionValue is a row of the variant <-> option value join table.
+type ProductVariantOptionValue struct {
+	VariantID     uint `gorm:"primaryKey"`
+	OptionValueID uint `gorm:"primaryKey"`
+}
+
+func (ProductVariantOptionValue) TableName() string {
+	return "product_variant_option_values"
+}
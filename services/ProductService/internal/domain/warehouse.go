@@ -0,0 +1,83 @@
+package domain
+
+import "time"
+
+type AllocationStrategy string
+
+const (
+	// AllocationNearest prefers the warehouse whose location matches the most
+	// of the shipping address, from country down to zip code.
+	AllocationNearest AllocationStrategy = "nearest"
+	// AllocationMostStock prefers the warehouse holding the most units of the
+	// requested items.
+	AllocationMostStock AllocationStrategy = "most_stock"
+)
+
+func (s AllocationStrategy) IsValid() bool {
+	return s == AllocationNearest || s == AllocationMostStock
+}
+
+// Warehouse is a stock location. Lower Priority wins ties during allocation.
+type Warehouse struct {
+	ID        uint   `gorm:"primarykey"`
+	Code      string `json:"code"`
+	Name      string `json:"name"`
+	Country   string `json:"country"`
+	State     string `json:"state"`
+	City      string `json:"city"`
+	ZipCode   string `json:"zip_code"`
+	Priority  int    `json:"priority"`
+	IsActive  bool   `json:"is_active"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// WarehouseStock is the on-hand quantity of a product, or of one of its
+// variants when VariantID is set, held in a single warehouse. Movements
+// recorded without a warehouse only change the product or variant total, so
+// the total can differ from the sum of its warehouse levels.
+type WarehouseStock struct {
+	ID          uint  `gorm:"primarykey"`
+	WarehouseID uint  `json:"warehouse_id"`
+	ProductID   uint  `json:"product_id"`
+	VariantID   *uint `json:"variant_id"`
+	Quantity    int   `json:"quantity"`
+	UpdatedAt   time.Time
+}
+
+func (WarehouseStock) TableName() string {
+	return "warehouse_stocks"
+}
+
+// StockTransfer moves Quantity units between two warehouses without changing
+// the total on-hand stock of the product or variant.
+type StockTransfer struct {
+	ProductID       uint
+	VariantID       *uint
+	FromWarehouseID uint
+	ToWarehouseID   uint
+	Quantity        int
+	Reason          string
+	Actor           string
+}
+
+// StockKey identifies a product, or one of its variants, in stock lookups.
+type StockKey struct {
+	ProductID uint
+	VariantID *uint
+}
+
+// AllocationItem is one order line that the allocated warehouse must cover.
+type AllocationItem struct {
+	StockKey
+	Quantity int
+}
+
+// ShippingAddress is the part of a delivery address used to rank warehouses
+// by proximity.
+type ShippingAddress struct {
+	Country string
+	State   string
+	City    string
+	ZipCode string
+}
@@ -0,0 +1,55 @@
+package rabbitmqEvents
+
+import (
+	"context"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/pkg/rabbitmq"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var _ domain.EventPublisher = (*Publisher)(nil)
+
+// Publisher sends domain events to a topic exchange, using the event name as
+// routing key. A nil connection disables publishing.
+type Publisher struct {
+	mq       *rabbitmq.RabbitMQ
+	exchange string
+	tracer   trace.Tracer
+}
+
+func NewPublisher(mq *rabbitmq.RabbitMQ, exchange string) *Publisher {
+	return &Publisher{
+		mq:       mq,
+		exchange: exchange,
+		tracer:   otel.Tracer("event-publisher"),
+	}
+}
+
+func (p *Publisher) Publish(ctx context.Context, routingKey string, event any) error {
+	if p.mq == nil {
+		logger.Debugf("RabbitMQ is disabled, skipping %s event", routingKey)
+		return nil
+	}
+
+	ctx, span := p.tracer.Start(ctx, "EventPublisher.Publish")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("messaging.destination", p.exchange),
+		attribute.String("messaging.routing_key", routingKey),
+	)
+
+	if err := p.mq.PublishJSON(ctx, p.exchange, routingKey, event); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "event published")
+	return nil
+}
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table products
+    add column if not exists sold_count int not null default 0,
+    add column search_vector tsvector generated always as (
+        setweight(to_tsvector('english', coalesce(name, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(short_description, '')), 'B') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'C')
+    ) stored;
+
+create index idx_products_search_vector on products using gin (search_vector);
+create index idx_products_price on products (price);
+create index idx_products_created_at on products (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index if exists idx_products_created_at;
+drop index if exists idx_products_price;
+drop index if exists idx_products_search_vector;
+alter table products
+    drop column if exists search_vector,
+    drop column if exists sold_count;
+-- +goose StatementEnd
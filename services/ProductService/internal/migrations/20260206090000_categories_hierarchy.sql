@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table categories
+    add column parent_id int references categories(id) on delete restrict,
+    add column slug varchar(120),
+    add column path varchar(255) not null default '';
+
+update categories
+set slug = trim(both '-' from lower(regexp_replace(name, '[^a-zA-Z0-9]+', '-', 'g'))) || '-' || id,
+    path = id::text;
+
+alter table categories alter column slug set not null;
+
+create unique index idx_categories_slug on categories (slug);
+create index idx_categories_parent_id on categories (parent_id);
+create index idx_categories_path on categories (path varchar_pattern_ops);
+
+create table if not exists product_categories (
+    product_id int not null references products(id) on delete cascade,
+    category_id int not null references categories(id) on delete cascade,
+    primary key (product_id, category_id)
+);
+
+create index idx_product_categories_category_id on product_categories (category_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists product_categories;
+drop index if exists idx_categories_path;
+drop index if exists idx_categories_parent_id;
+drop index if exists idx_categories_slug;
+alter table categories
+    drop column if exists path,
+    drop column if exists slug,
+    drop column if exists parent_id;
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+create table product_options (
+    id serial primary key,
+    product_id int not null references products(id) on delete cascade,
+    name varchar(50) not null,
+    position int not null default 0,
+    created_at timestamp with time zone default current_timestamp,
+    updated_at timestamp with time zone default current_timestamp,
+    unique (product_id, name)
+);
+
+create table product_option_values (
+    id serial primary key,
+    option_id int not null references product_options(id) on delete cascade,
+    value varchar(100) not null,
+    position int not null default 0,
+    unique (option_id, value)
+);
+
+create table product_variants (
+    id serial primary key,
+    product_id int not null references products(id) on delete cascade,
+    sku varchar(64) not null,
+    price float,
+    quantity int not null default 0,
+    image_url varchar(255),
+    created_at timestamp with time zone default current_timestamp,
+    updated_at timestamp with time zone default current_timestamp,
+    deleted_at timestamp with time zone
+);
+
+create unique index idx_product_variants_sku on product_variants (sku) where deleted_at is null;
+create index idx_product_variants_product_id on product_variants (product_id);
+
+create table product_variant_option_values (
+    variant_id int not null references product_variants(id) on delete cascade,
+    option_value_id int not null references product_option_values(id) on delete restrict,
+    primary key (variant_id, option_value_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table product_variant_option_values;
+drop table product_variants;
+drop table product_option_values;
+drop table product_options;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+create table stock_movements (
+    id serial primary key,
+    product_id int not null references products(id) on delete cascade,
+    variant_id int references product_variants(id) on delete cascade,
+    type varchar(20) not null,
+    quantity int not null,
+    balance_after int not null,
+    reason varchar(255) not null default '',
+    actor varchar(100) not null default '',
+    created_at timestamp with time zone default current_timestamp,
+    constraint stock_movements_type_check check (type in ('restock', 'sale', 'reservation', 'release', 'return', 'adjustment'))
+);
+
+create index idx_stock_movements_product_created on stock_movements (product_id, created_at desc);
+create index idx_stock_movements_variant_id on stock_movements (variant_id) where variant_id is not null;
+
+alter table products add constraint products_quantity_non_negative check (quantity >= 0) not valid;
+alter table product_variants add constraint product_variants_quantity_non_negative check (quantity >= 0) not valid;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table product_variants drop constraint product_variants_quantity_non_negative;
+alter table products drop constraint products_quantity_non_negative;
+drop table stock_movements;
+-- +goose StatementEnd
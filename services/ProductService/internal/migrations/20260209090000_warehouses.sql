@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+create table warehouses (
+    id serial primary key,
+    code varchar(32) not null unique,
+    name varchar(255) not null,
+    country varchar(100) not null default '',
+    state varchar(100) not null default '',
+    city varchar(100) not null default '',
+    zip_code varchar(20) not null default '',
+    priority int not null default 0,
+    is_active boolean not null default true,
+    created_at timestamp with time zone default current_timestamp,
+    updated_at timestamp with time zone default current_timestamp
+);
+
+create table warehouse_stocks (
+    id serial primary key,
+    warehouse_id int not null references warehouses(id) on delete cascade,
+    product_id int not null references products(id) on delete cascade,
+    variant_id int references product_variants(id) on delete cascade,
+    quantity int not null default 0,
+    updated_at timestamp with time zone default current_timestamp,
+    constraint warehouse_stocks_quantity_non_negative check (quantity >= 0)
+);
+
+create unique index idx_warehouse_stocks_location on warehouse_stocks (warehouse_id, product_id, coalesce(variant_id, 0));
+create index idx_warehouse_stocks_product on warehouse_stocks (product_id, variant_id);
+
+alter table stock_movements
+    add column warehouse_id int references warehouses(id) on delete set null,
+    add column warehouse_balance_after int;
+
+alter table stock_movements drop constraint stock_movements_type_check;
+alter table stock_movements add constraint stock_movements_type_check
+    check (type in ('restock', 'sale', 'reservation', 'release', 'return', 'adjustment', 'transfer'));
+
+create index idx_stock_movements_warehouse_id on stock_movements (warehouse_id) where warehouse_id is not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index idx_stock_movements_warehouse_id;
+delete from stock_movements where type = 'transfer';
+alter table stock_movements drop constraint stock_movements_type_check;
+alter table stock_movements add constraint stock_movements_type_check
+    check (type in ('restock', 'sale', 'reservation', 'release', 'return', 'adjustment'));
+alter table stock_movements
+    drop column warehouse_balance_after,
+    drop column warehouse_id;
+drop table warehouse_stocks;
+drop table warehouses;
+-- +goose StatementEnd
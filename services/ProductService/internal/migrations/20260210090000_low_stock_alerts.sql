@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table products add column reorder_threshold int not null default 0;
+alter table products add constraint products_reorder_threshold_non_negative check (reorder_threshold >= 0);
+
+create table low_stock_alerts (
+    id serial primary key,
+    product_id int not null references products(id) on delete cascade,
+    variant_id int references product_variants(id) on delete cascade,
+    quantity int not null,
+    reorder_threshold int not null,
+    raised_at timestamp with time zone default current_timestamp
+);
+
+create unique index idx_low_stock_alerts_item on low_stock_alerts (product_id, coalesce(variant_id, 0));
+create index idx_stock_movements_sales on stock_movements (created_at) where type = 'sale';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index idx_stock_movements_sales;
+drop table low_stock_alerts;
+alter table products drop constraint products_reorder_threshold_non_negative;
+alter table products drop column reorder_threshold;
+-- +goose StatementEnd
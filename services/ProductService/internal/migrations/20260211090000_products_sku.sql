@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table products add column sku varchar(64);
+
+create unique index idx_products_sku on products (sku) where sku is not null and deleted_at is null;
+create index idx_products_name on products (name) where deleted_at is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index idx_products_name;
+drop index idx_products_sku;
+alter table products drop column sku;
+-- +goose StatementEnd
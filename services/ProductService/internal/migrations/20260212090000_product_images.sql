@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+create table product_images (
+    id serial primary key,
+    product_id int not null references products(id) on delete cascade,
+    position int not null,
+    alt_text varchar(255) not null default '',
+    object_key varchar(255) not null,
+    thumbnail_key varchar(255) not null,
+    url varchar(512) not null,
+    thumbnail_url varchar(512) not null,
+    content_type varchar(50) not null,
+    size_bytes bigint not null,
+    width int not null,
+    height int not null,
+    created_at timestamp with time zone default current_timestamp,
+    updated_at timestamp with time zone default current_timestamp
+);
+
+create index idx_product_images_product_position on product_images (product_id, position);
+
+alter table products alter column image_url type varchar(512);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table products alter column image_url type varchar(255);
+drop table product_images;
+-- +goose StatementEnd
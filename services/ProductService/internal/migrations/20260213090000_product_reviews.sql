@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+create table product_reviews (
+    id serial primary key,
+    product_id int not null references products(id) on delete cascade,
+    user_id int not null,
+    rating smallint not null check (rating between 1 and 5),
+    title varchar(150) not null default '',
+    body text not null default '',
+    status varchar(20) not null default 'pending' check (status in ('pending', 'approved', 'rejected')),
+    moderation_note varchar(500) not null default '',
+    created_at timestamp with time zone default current_timestamp,
+    updated_at timestamp with time zone default current_timestamp
+);
+
+create unique index idx_product_reviews_product_user on product_reviews (product_id, user_id);
+create index idx_product_reviews_product_status on product_reviews (product_id, status, created_at desc);
+create index idx_product_reviews_status on product_reviews (status, created_at);
+
+alter table products
+    add column rating_average numeric(3, 2) not null default 0,
+    add column rating_count int not null default 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table products
+    drop column rating_count,
+    drop column rating_average;
+drop table product_reviews;
+-- +goose StatementEnd
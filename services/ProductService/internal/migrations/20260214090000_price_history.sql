@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+create table scheduled_price_changes (
+    id serial primary key,
+    product_id int not null references products(id) on delete cascade,
+    price decimal(10, 2) check (price > 0),
+    discount_type varchar(50),
+    discount_value decimal(10, 2),
+    remove_discount boolean not null default false,
+    effective_at timestamp with time zone not null,
+    status varchar(20) not null default 'pending' check (status in ('pending', 'applied', 'canceled')),
+    applied_at timestamp with time zone,
+    created_by varchar(100) not null default '',
+    created_at timestamp with time zone default current_timestamp,
+    updated_at timestamp with time zone default current_timestamp
+);
+
+create index idx_scheduled_price_changes_due on scheduled_price_changes (effective_at) where status = 'pending';
+create index idx_scheduled_price_changes_product on scheduled_price_changes (product_id, effective_at);
+
+create table product_price_history (
+    id serial primary key,
+    product_id int not null references products(id) on delete cascade,
+    price decimal(10, 2) not null,
+    discount_type varchar(50) not null default '',
+    discount_value decimal(10, 2) not null default 0,
+    source varchar(20) not null,
+    scheduled_change_id int references scheduled_price_changes(id) on delete set null,
+    changed_at timestamp with time zone default current_timestamp
+);
+
+create index idx_product_price_history_product on product_price_history (product_id, changed_at, id);
+
+-- Start every timeline with the price products have today.
+insert into product_price_history (product_id, price, discount_type, discount_value, source, changed_at)
+select id, price, coalesce(discount_type, ''), coalesce(discount_value, 0), 'initial', coalesce(updated_at, created_at, current_timestamp)
+from products
+where deleted_at is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table product_price_history;
+drop table scheduled_price_changes;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table categories add column deleted_at timestamp with time zone;
+
+create index idx_categories_deleted_at on categories (deleted_at);
+create index idx_products_trashed on products (deleted_at) where deleted_at is not null;
+
+-- Trashed categories keep their slug without blocking its reuse.
+drop index if exists idx_categories_slug;
+create unique index idx_categories_slug on categories (slug) where deleted_at is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from categories where deleted_at is not null;
+
+drop index if exists idx_categories_slug;
+create unique index idx_categories_slug on categories (slug);
+
+drop index if exists idx_products_trashed;
+drop index if exists idx_categories_deleted_at;
+alter table categories drop column if exists deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+create table attribute_definitions (
+    id serial primary key,
+    code varchar(64) not null,
+    name varchar(100) not null,
+    type varchar(16) not null check (type in ('string', 'number', 'boolean', 'enum')),
+    unit varchar(20),
+    options jsonb not null default '[]',
+    created_at timestamp with time zone default current_timestamp,
+    updated_at timestamp with time zone default current_timestamp
+);
+
+create unique index idx_attribute_definitions_code on attribute_definitions (code);
+
+-- Attributes attached to a category apply to its whole subtree.
+create table category_attributes (
+    category_id int not null references categories(id) on delete cascade,
+    attribute_id int not null references attribute_definitions(id) on delete cascade,
+    required boolean not null default false,
+    position int not null default 0,
+    primary key (category_id, attribute_id)
+);
+
+create index idx_category_attributes_attribute_id on category_attributes (attribute_id);
+
+-- One typed column is set per row, matching the attribute type.
+create table product_attribute_values (
+    product_id int not null references products(id) on delete cascade,
+    attribute_id int not null references attribute_definitions(id) on delete cascade,
+    value_text text,
+    value_number double precision,
+    value_bool boolean,
+    updated_at timestamp with time zone default current_timestamp,
+    primary key (product_id, attribute_id)
+);
+
+create index idx_product_attribute_values_text on product_attribute_values (attribute_id, value_text);
+create index idx_product_attribute_values_number on product_attribute_values (attribute_id, value_number);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table if exists product_attribute_values;
+drop table if exists category_attributes;
+drop table if exists attribute_definitions;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table products add column max_per_order int not null default 0;
+alter table products add constraint products_max_per_order_non_negative check (max_per_order >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table products drop constraint products_max_per_order_non_negative;
+alter table products drop column max_per_order;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table products add column tax_category varchar(32) not null default 'standard';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table products drop column tax_category;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Deleted variants used to keep their option value links, which kept the
+-- options of the product from being deleted.
+delete from product_variant_option_values
+where variant_id in (select id from product_variants where deleted_at is not null);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+select 1;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Slugs only have to be unique among siblings, so "shoes" can live under
+-- both "men" and "women". Root categories share parent 0.
+drop index if exists idx_categories_slug;
+create unique index idx_categories_slug on categories (coalesce(parent_id, 0), slug) where deleted_at is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+update categories c
+set slug = c.slug || '-' || c.id
+where c.deleted_at is null
+  and exists (
+    select 1 from categories o
+    where o.slug = c.slug and o.id < c.id and o.deleted_at is null
+  );
+
+drop index if exists idx_categories_slug;
+create unique index idx_categories_slug on categories (slug) where deleted_at is null;
+-- +goose StatementEnd
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+alter table stock_movements drop constraint stock_movements_type_check;
+alter table stock_movements add constraint stock_movements_type_check
+    check (type in ('restock', 'sale', 'reservation', 'release', 'return', 'adjustment', 'transfer', 'initial', 'import'));
+
+-- Give existing products and variants the opening entry they were created
+-- without: the balance before their first non-transfer movement, or their
+-- current stock when they have none.
+insert into stock_movements (product_id, variant_id, type, quantity, balance_after, reason, actor, created_at)
+select opening.product_id, opening.variant_id, 'initial', opening.quantity, opening.quantity, 'opening balance', 'system', opening.created_at
+from (
+    select p.id as product_id, null::int as variant_id, p.created_at,
+        coalesce((
+            select m.balance_after - m.quantity from stock_movements m
+            where m.product_id = p.id and m.variant_id is null and m.type <> 'transfer'
+            order by m.created_at, m.id limit 1
+        ), p.quantity) as quantity
+    from products p
+    union all
+    select v.product_id, v.id, v.created_at,
+        coalesce((
+            select m.balance_after - m.quantity from stock_movements m
+            where m.variant_id = v.id and m.type <> 'transfer'
+            order by m.created_at, m.id limit 1
+        ), v.quantity)
+    from product_variants v
+) opening
+where opening.quantity > 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+delete from stock_movements where type in ('initial', 'import');
+alter table stock_movements drop constraint stock_movements_type_check;
+alter table stock_movements add constraint stock_movements_type_check
+    check (type in ('restock', 'sale', 'reservation', 'release', 'return', 'adjustment', 'transfer'));
+-- +goose StatementEnd
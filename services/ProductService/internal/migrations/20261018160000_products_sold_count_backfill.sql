@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- sold_count came with the popularity sort but was only kept up to date once
+-- sales went through the stock ledger. Recount it from the ledger's sale and
+-- return movements so the sort reflects every recorded sale.
+update products p
+set sold_count = coalesce((
+    select greatest(-sum(m.quantity), 0) from stock_movements m
+    where m.product_id = p.id and m.type in ('sale', 'return')
+), 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- The recounted values are kept; sold_count is dropped with its column.
+select 1;
+-- +goose StatementEnd
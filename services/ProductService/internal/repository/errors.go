@@ -5,6 +5,15 @@ import "errors"
 var (
 	ErrProductNotFound     = errors.New("product not found")
 	ErrCategoryNotFound    = errors.New("category not found")
+	ErrParentNotFound      = errors.New("parent category not found")
+	ErrVariantNotFound     = errors.New("product variant not found")
+	ErrOptionNotFound      = errors.New("product option not found")
+	ErrWarehouseNotFound   = errors.New("warehouse not found")
+	ErrImageNotFound       = errors.New("product image not found")
+	ErrReviewNotFound      = errors.New("review not found")
+	ErrPriceChangeNotFound = errors.New("scheduled price change not found")
+	ErrAttributeNotFound   = errors.New("attribute not found")
+	ErrNotInTrash          = errors.New("item is not in the trash")
 	ErrDatabaseConnection  = errors.New("database connection error")
 	ErrDatabaseQuery       = errors.New("database query failed")
 	ErrForeignKeyViolation = errors.New("related record not found")
@@ -0,0 +1,333 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// categoryAttributeColumns selects a definition along with where and how
+	// it is attached.
+	categoryAttributeColumns = "attribute_definitions.*, category_attributes.category_id, category_attributes.required, category_attributes.position"
+
+	// attachedToAncestorSQL joins the live categories holding an attachment
+	// and matches the live category c when it is one of them or lies below.
+	attachedToAncestorSQL = "JOIN categories anc ON anc.id = category_attributes.category_id AND anc.deleted_at IS NULL" +
+		" JOIN attribute_definitions ON attribute_definitions.id = category_attributes.attribute_id"
+
+	ancestorPathSQL = "(c.path = anc.path OR c.path LIKE anc.path || '/%')"
+)
+
+type AttributeRepository struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+var _ domain.AttributeRepository = (*AttributeRepository)(nil)
+
+func NewAttributeRepository(db *gorm.DB) *AttributeRepository {
+	return &AttributeRepository{
+		db:     db,
+		tracer: otel.Tracer("attribute-repo"),
+	}
+}
+
+func (r *AttributeRepository) CreateAttribute(ctx context.Context, attr *domain.AttributeDefinition) error {
+	ctx, span := r.tracer.Start(ctx, "AttributeRepository.CreateAttribute")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("attribute.code", attr.Code))
+
+	if err := r.db.WithContext(ctx).Create(attr).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.ErrDuplicateAttributeCode
+		}
+		return mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("attribute.id", int(attr.ID)))
+	span.SetStatus(codes.Ok, "attribute created")
+	return nil
+}
+
+func (r *AttributeRepository) ListAttributes(ctx context.Context) ([]domain.AttributeDefinition, error) {
+	ctx, span := r.tracer.Start(ctx, "AttributeRepository.ListAttributes")
+	defer span.End()
+
+	attrs, err := gorm.G[domain.AttributeDefinition](r.db).Order("code").Find(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("attributes.count", len(attrs)))
+	span.SetStatus(codes.Ok, "attributes listed")
+	return attrs, nil
+}
+
+func (r *AttributeRepository) GetAttributesByCodes(ctx context.Context, attrCodes []string) ([]domain.AttributeDefinition, error) {
+	ctx, span := r.tracer.Start(ctx, "AttributeRepository.GetAttributesByCodes")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("attribute.codes.count", len(attrCodes)))
+
+	attrs, err := gorm.G[domain.AttributeDefinition](r.db).Where("code IN ?", attrCodes).Find(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetStatus(codes.Ok, "attributes fetched")
+	return attrs, nil
+}
+
+func (r *AttributeRepository) DeleteAttribute(ctx context.Context, id uint) ([]uint, error) {
+	ctx, span := r.tracer.Start(ctx, "AttributeRepository.DeleteAttribute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("attribute.id", int(id)))
+
+	var productIDs []uint
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&domain.ProductAttributeValue{}).
+			Where("attribute_id = ?", id).
+			Pluck("product_id", &productIDs).Error; err != nil {
+			return mapPostgresError(err)
+		}
+
+		// Attachments and values go with it through the foreign key cascades.
+		result := tx.Delete(&domain.AttributeDefinition{}, id)
+		if result.Error != nil {
+			return mapPostgresError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return repository.ErrAttributeNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("products.count", len(productIDs)))
+	span.SetStatus(codes.Ok, "attribute deleted")
+	return productIDs, nil
+}
+
+// AttachCategoryAttribute attaches the attribute to a live category, updating
+// the required flag and position when it is attached already.
+func (r *AttributeRepository) AttachCategoryAttribute(ctx context.Context, link *domain.CategoryAttribute) error {
+	ctx, span := r.tracer.Start(ctx, "AttributeRepository.AttachCategoryAttribute")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("category.id", int(link.CategoryID)),
+		attribute.Int("attribute.id", int(link.AttributeID)),
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Trashed categories still satisfy the foreign key, so lock a live one.
+		var category domain.Category
+		err := tx.Clauses(clause.Locking{Strength: "SHARE"}).
+			Where("id = ?", link.CategoryID).
+			First(&category).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return repository.ErrCategoryNotFound
+		}
+		if err != nil {
+			return mapPostgresError(err)
+		}
+
+		var attr domain.AttributeDefinition
+		err = tx.Where("id = ?", link.AttributeID).First(&attr).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return repository.ErrAttributeNotFound
+		}
+		if err != nil {
+			return mapPostgresError(err)
+		}
+
+		if err := tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "category_id"}, {Name: "attribute_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"required", "position"}),
+		}).Create(link).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "attribute attached")
+	return nil
+}
+
+func (r *AttributeRepository) DetachCategoryAttribute(ctx context.Context, categoryID, attributeID uint) error {
+	ctx, span := r.tracer.Start(ctx, "AttributeRepository.DetachCategoryAttribute")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("category.id", int(categoryID)),
+		attribute.Int("attribute.id", int(attributeID)),
+	)
+
+	rowsAffected, err := gorm.G[domain.CategoryAttribute](r.db).
+		Where("category_id = ? AND attribute_id = ?", categoryID, attributeID).
+		Delete(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return mapPostgresError(err)
+	}
+	if rowsAffected == 0 {
+		span.SetStatus(codes.Error, repository.ErrAttributeNotFound.Error())
+		return repository.ErrAttributeNotFound
+	}
+
+	span.SetStatus(codes.Ok, "attribute detached")
+	return nil
+}
+
+func (r *AttributeRepository) ListCategoryAttributes(ctx context.Context, categoryID uint) ([]domain.CategoryAttributeDefinition, error) {
+	ctx, span := r.tracer.Start(ctx, "AttributeRepository.ListCategoryAttributes")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("category.id", int(categoryID)))
+
+	var rows []domain.CategoryAttributeDefinition
+	err := r.db.WithContext(ctx).
+		Table("category_attributes").
+		Select(categoryAttributeColumns).
+		Joins(attachedToAncestorSQL).
+		Joins("JOIN categories c ON c.id = ? AND c.deleted_at IS NULL AND "+ancestorPathSQL, categoryID).
+		Order("length(anc.path), category_attributes.position, attribute_definitions.id").
+		Scan(&rows).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	attrs := mergeCategoryAttributes(rows)
+	span.SetAttributes(attribute.Int("attributes.count", len(attrs)))
+	span.SetStatus(codes.Ok, "category attributes listed")
+	return attrs, nil
+}
+
+func (r *AttributeRepository) ListApplicableAttributes(ctx context.Context, productID uint) ([]domain.CategoryAttributeDefinition, error) {
+	ctx, span := r.tracer.Start(ctx, "AttributeRepository.ListApplicableAttributes")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(productID)))
+
+	var rows []domain.CategoryAttributeDefinition
+	err := r.db.WithContext(ctx).
+		Table("category_attributes").
+		Select(categoryAttributeColumns).
+		Joins(attachedToAncestorSQL).
+		Joins("JOIN categories c ON c.deleted_at IS NULL AND "+ancestorPathSQL).
+		Joins("JOIN product_categories pc ON pc.category_id = c.id AND pc.product_id = ?", productID).
+		Order("length(anc.path), category_attributes.position, attribute_definitions.id").
+		Scan(&rows).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	attrs := mergeCategoryAttributes(rows)
+	span.SetAttributes(attribute.Int("attributes.count", len(attrs)))
+	span.SetStatus(codes.Ok, "applicable attributes listed")
+	return attrs, nil
+}
+
+func (r *AttributeRepository) SetProductAttributes(ctx context.Context, productID uint, values []domain.ProductAttributeValue) error {
+	ctx, span := r.tracer.Start(ctx, "AttributeRepository.SetProductAttributes")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(productID)),
+		attribute.Int("values.count", len(values)),
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("product_id = ?", productID).
+			Delete(&domain.ProductAttributeValue{}).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		if len(values) == 0 {
+			return nil
+		}
+		if err := tx.Omit(clause.Associations).Create(&values).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "product attributes set")
+	return nil
+}
+
+func (r *AttributeRepository) ListValuesByProductIDs(ctx context.Context, productIDs []uint) ([]domain.ProductAttributeValue, error) {
+	ctx, span := r.tracer.Start(ctx, "AttributeRepository.ListValuesByProductIDs")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.ids.count", len(productIDs)))
+
+	var values []domain.ProductAttributeValue
+	err := r.db.WithContext(ctx).
+		Joins("Attribute").
+		Where("product_attribute_values.product_id IN ?", productIDs).
+		Order("product_attribute_values.product_id, \"Attribute\".name").
+		Find(&values).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("values.count", len(values)))
+	span.SetStatus(codes.Ok, "attribute values listed")
+	return values, nil
+}
+
+// mergeCategoryAttributes keeps one row per attribute, the one attached
+// closest to the root, and makes it required when any attachment is.
+func mergeCategoryAttributes(rows []domain.CategoryAttributeDefinition) []domain.CategoryAttributeDefinition {
+	merged := make([]domain.CategoryAttributeDefinition, 0, len(rows))
+	index := make(map[uint]int, len(rows))
+	for _, row := range rows {
+		if i, ok := index[row.ID]; ok {
+			merged[i].Required = merged[i].Required || row.Required
+			continue
+		}
+		index[row.ID] = len(merged)
+		merged = append(merged, row)
+	}
+	return merged
+}
@@ -3,13 +3,17 @@ package postgresql
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
 	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/repository"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var _ domain.CategoryRepository = (*CategoryRepository)(nil)
@@ -30,11 +34,33 @@ func (r *CategoryRepository) CreateCategory(ctx context.Context, category *domai
 	ctx, span := r.tracer.Start(ctx, "CreateCategory")
 	defer span.End()
 
-	err := gorm.G[domain.Category](r.db).Create(ctx, category)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		parentPath := ""
+		if category.ParentID != nil {
+			parent, err := gorm.G[domain.Category](tx).Where("id = ?", *category.ParentID).First(ctx)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return repository.ErrParentNotFound
+				}
+				return mapPostgresError(err)
+			}
+			parentPath = parent.Path
+		}
+
+		if err := gorm.G[domain.Category](tx).Create(ctx, category); err != nil {
+			return mapCategoryError(err)
+		}
+
+		category.Path = childPath(parentPath, category.ID)
+		if _, err := gorm.G[domain.Category](tx).Where("id = ?", category.ID).Update(ctx, "path", category.Path); err != nil {
+			return mapPostgresError(err)
+		}
+		return nil
+	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "failed to create category")
-		return mapPostgresError(err)
+		return err
 	}
 
 	span.SetStatus(codes.Ok, "category created successfully")
@@ -75,7 +101,7 @@ func (r *CategoryRepository) UpdateCategory(ctx context.Context, id uint, catego
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "failed to update category")
-		return mapPostgresError(err)
+		return mapCategoryError(err)
 	}
 	if rowsAffected == 0 {
 		err := repository.ErrCategoryNotFound
@@ -138,3 +164,193 @@ func (r *CategoryRepository) DeleteCategory(ctx context.Context, id uint) error
 	return nil
 
 }
+
+func (r *CategoryRepository) ListAllCategories(ctx context.Context) ([]domain.Category, error) {
+	ctx, span := r.tracer.Start(ctx, "ListAllCategories")
+	defer span.End()
+
+	categories, err := gorm.G[domain.Category](r.db).
+		Order("path").
+		Find(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to list all categories")
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetStatus(codes.Ok, "all categories listed successfully")
+	return categories, nil
+}
+
+// MoveCategory re-parents a category and rewrites the paths of its whole
+// subtree in one transaction. A nil parentID moves it to the root.
+func (r *CategoryRepository) MoveCategory(ctx context.Context, id uint, parentID *uint) error {
+	ctx, span := r.tracer.Start(ctx, "MoveCategory")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		category, err := gorm.G[domain.Category](tx).Where("id = ?", id).First(ctx)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repository.ErrCategoryNotFound
+			}
+			return mapPostgresError(err)
+		}
+
+		parentPath := ""
+		if parentID != nil {
+			parent, err := gorm.G[domain.Category](tx).Where("id = ?", *parentID).First(ctx)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return repository.ErrParentNotFound
+				}
+				return mapPostgresError(err)
+			}
+			if isSameOrDescendant(parent.Path, category.Path) {
+				return domain.ErrCategoryCycle
+			}
+			parentPath = parent.Path
+		}
+
+		newPath := childPath(parentPath, category.ID)
+		if err := tx.Model(&domain.Category{}).
+			Where("id = ?", id).
+			Update("parent_id", parentID).Error; err != nil {
+			return mapCategoryError(err)
+		}
+
+		if err := tx.Exec(
+			"UPDATE categories SET path = ? || substring(path from ?), updated_at = now() WHERE path = ? OR path LIKE ?",
+			newPath, len(category.Path)+1, category.Path, category.Path+"/%",
+		).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to move category")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "category moved successfully")
+	return nil
+}
+
+func (r *CategoryRepository) CountChildren(ctx context.Context, id uint) (int, error) {
+	ctx, span := r.tracer.Start(ctx, "CountChildren")
+	defer span.End()
+
+	count, err := gorm.G[domain.Category](r.db).
+		Where("parent_id = ?", id).
+		Count(ctx, "*")
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to count child categories")
+		return 0, mapPostgresError(err)
+	}
+
+	span.SetStatus(codes.Ok, "child categories counted")
+	return int(count), nil
+}
+
+func (r *CategoryRepository) AssignProductCategories(ctx context.Context, productID uint, categoryIDs []uint) error {
+	ctx, span := r.tracer.Start(ctx, "AssignProductCategories")
+	defer span.End()
+
+	rows := make([]domain.ProductCategory, 0, len(categoryIDs))
+	seen := make(map[uint]struct{}, len(categoryIDs))
+	for _, categoryID := range categoryIDs {
+		if _, ok := seen[categoryID]; ok {
+			continue
+		}
+		seen[categoryID] = struct{}{}
+		rows = append(rows, domain.ProductCategory{ProductID: productID, CategoryID: categoryID})
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Trashed categories still satisfy the foreign key, so check for
+		// live ones explicitly.
+		var live int64
+		if err := tx.Model(&domain.Category{}).
+			Where("id IN ?", categoryIDs).
+			Count(&live).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		if int(live) != len(rows) {
+			return repository.ErrCategoryNotFound
+		}
+
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&rows).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to assign product categories")
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "product categories assigned")
+	return nil
+}
+
+func (r *CategoryRepository) UnassignProductCategories(ctx context.Context, productID uint, categoryIDs []uint) error {
+	ctx, span := r.tracer.Start(ctx, "UnassignProductCategories")
+	defer span.End()
+
+	if _, err := gorm.G[domain.ProductCategory](r.db).
+		Where("product_id = ? AND category_id IN ?", productID, categoryIDs).
+		Delete(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to unassign product categories")
+		return mapPostgresError(err)
+	}
+
+	span.SetStatus(codes.Ok, "product categories unassigned")
+	return nil
+}
+
+func (r *CategoryRepository) ListProductCategories(ctx context.Context, productID uint) ([]domain.Category, error) {
+	ctx, span := r.tracer.Start(ctx, "ListProductCategories")
+	defer span.End()
+
+	var categories []domain.Category
+	err := r.db.WithContext(ctx).
+		Joins("JOIN product_categories ON product_categories.category_id = categories.id").
+		Where("product_categories.product_id = ?", productID).
+		Order("categories.path").
+		Find(&categories).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to list product categories")
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetStatus(codes.Ok, "product categories listed")
+	return categories, nil
+}
+
+func childPath(parentPath string, id uint) string {
+	if parentPath == "" {
+		return fmt.Sprintf("%d", id)
+	}
+	return fmt.Sprintf("%s/%d", parentPath, id)
+}
+
+// isSameOrDescendant reports whether path is ancestorPath itself or lies in its subtree.
+func isSameOrDescendant(path, ancestorPath string) bool {
+	return path == ancestorPath || strings.HasPrefix(path, ancestorPath+"/")
+}
+
+// mapCategoryError reports a unique violation as the slug being taken by a
+// sibling, the only unique index on live categories besides the primary key.
+func mapCategoryError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return domain.ErrCategorySlugTaken
+	}
+	return mapPostgresError(err)
+}
@@ -0,0 +1,394 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// The guarded updates only match when the resulting stock stays non-negative,
+// so concurrent movements serialize on the row lock instead of overselling.
+const (
+	applyProductStockSQL = `UPDATE products SET quantity = quantity + ?, updated_at = now()
+		WHERE id = ? AND deleted_at IS NULL AND quantity + ? >= 0 RETURNING quantity`
+	applyVariantStockSQL = `UPDATE product_variants SET quantity = quantity + ?, updated_at = now()
+		WHERE id = ? AND product_id = ? AND deleted_at IS NULL AND quantity + ? >= 0 RETURNING quantity`
+	applySoldCountSQL = `UPDATE products SET sold_count = greatest(sold_count + ?, 0) WHERE id = ?`
+
+	// Warehouse rows are created lazily by the first inbound movement; outbound
+	// movements use the same non-negative guard as the totals above.
+	addWarehouseStockSQL = `INSERT INTO warehouse_stocks (warehouse_id, product_id, variant_id, quantity, updated_at)
+		VALUES (?, ?, ?, ?, now())
+		ON CONFLICT (warehouse_id, product_id, coalesce(variant_id, 0))
+		DO UPDATE SET quantity = warehouse_stocks.quantity + excluded.quantity, updated_at = now()
+		RETURNING quantity`
+	takeWarehouseStockSQL = `UPDATE warehouse_stocks SET quantity = quantity + ?, updated_at = now()
+		WHERE warehouse_id = ? AND product_id = ? AND coalesce(variant_id, 0) = ? AND quantity + ? >= 0
+		RETURNING quantity`
+	warehouseHeldStockSQL = `SELECT coalesce(sum(quantity), 0) FROM warehouse_stocks
+		WHERE product_id = ? AND coalesce(variant_id, 0) = ?`
+)
+
+type InventoryRepository struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+var _ domain.InventoryRepository = (*InventoryRepository)(nil)
+
+func NewInventoryRepository(db *gorm.DB) *InventoryRepository {
+	return &InventoryRepository{
+		db:     db,
+		tracer: otel.Tracer("inventory-repo"),
+	}
+}
+
+// RecordMovement applies the movement to on-hand stock, and to the warehouse
+// level when WarehouseID is set, and appends it to the ledger in one
+// transaction, filling in the balances.
+func (r *InventoryRepository) RecordMovement(ctx context.Context, movement *domain.StockMovement) error {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.RecordMovement")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(movement.ProductID)),
+		attribute.String("stock.type", string(movement.Type)),
+		attribute.Int("stock.quantity", movement.Quantity),
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := r.applyMovement(tx, movement); err != nil {
+			return err
+		}
+		if err := tx.Create(movement).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetAttributes(attribute.Int("stock.balance_after", movement.BalanceAfter))
+	span.SetStatus(codes.Ok, "stock movement recorded")
+	return nil
+}
+
+// RecordMovements applies the movements like RecordMovement, all of them or
+// none in one transaction.
+func (r *InventoryRepository) RecordMovements(ctx context.Context, movements []domain.StockMovement) error {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.RecordMovements")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("stock.movements.count", len(movements)))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for i := range movements {
+			movement := &movements[i]
+			if err := r.applyMovement(tx, movement); err != nil {
+				return err
+			}
+		}
+		if err := tx.Create(&movements).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "stock movements recorded")
+	return nil
+}
+
+// recordOpeningStock writes the ledger entry for the stock a new product, or
+// a new variant when variantID is set, starts with. The quantity is on hand
+// already, so nothing else changes; an item created without stock needs no
+// entry.
+func recordOpeningStock(tx *gorm.DB, productID uint, variantID *uint, movementType domain.StockMovementType, quantity int, reason string) error {
+	if quantity <= 0 {
+		return nil
+	}
+	movement := domain.StockMovement{
+		ProductID:    productID,
+		VariantID:    variantID,
+		Type:         movementType,
+		Quantity:     quantity,
+		BalanceAfter: quantity,
+		Reason:       reason,
+		Actor:        domain.SystemActor,
+	}
+	return mapPostgresError(tx.Create(&movement).Error)
+}
+
+// applyMovement changes on-hand stock, the warehouse level and the sold count
+// as the movement requires and fills in its balances.
+func (r *InventoryRepository) applyMovement(tx *gorm.DB, movement *domain.StockMovement) error {
+	var balance int
+	var result *gorm.DB
+	if movement.VariantID != nil {
+		result = tx.Raw(applyVariantStockSQL, movement.Quantity, *movement.VariantID, movement.ProductID, movement.Quantity).Scan(&balance)
+	} else {
+		result = tx.Raw(applyProductStockSQL, movement.Quantity, movement.ProductID, movement.Quantity).Scan(&balance)
+	}
+	if result.Error != nil {
+		return mapPostgresError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return r.missingStockRowError(tx, movement)
+	}
+	movement.BalanceAfter = balance
+
+	// Outbound movements without a warehouse may only take unassigned stock,
+	// so they never eat into units a warehouse holds.
+	if movement.WarehouseID == nil && movement.Quantity < 0 {
+		if err := ensureUnassignedStock(tx, movement); err != nil {
+			return err
+		}
+	}
+
+	if movement.WarehouseID != nil {
+		if err := ensureWarehouseExists(tx, *movement.WarehouseID); err != nil {
+			return err
+		}
+		key := domain.StockKey{ProductID: movement.ProductID, VariantID: movement.VariantID}
+		warehouseBalance, err := applyWarehouseStock(tx, *movement.WarehouseID, key, movement.Quantity)
+		if err != nil {
+			return err
+		}
+		movement.WarehouseBalanceAfter = &warehouseBalance
+	}
+
+	switch movement.Type {
+	case domain.StockMovementSale, domain.StockMovementReturn:
+		if err := tx.Exec(applySoldCountSQL, -movement.Quantity, movement.ProductID).Error; err != nil {
+			return mapPostgresError(err)
+		}
+	}
+	return nil
+}
+
+// TransferStock moves stock from one warehouse to another and records the
+// outbound and inbound legs as a pair of transfer movements.
+func (r *InventoryRepository) TransferStock(ctx context.Context, transfer domain.StockTransfer) ([]domain.StockMovement, error) {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.TransferStock")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(transfer.ProductID)),
+		attribute.Int("warehouse.from_id", int(transfer.FromWarehouseID)),
+		attribute.Int("warehouse.to_id", int(transfer.ToWarehouseID)),
+		attribute.Int("stock.quantity", transfer.Quantity),
+	)
+
+	var movements []domain.StockMovement
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := ensureWarehouseExists(tx, transfer.FromWarehouseID); err != nil {
+			return err
+		}
+		var destination domain.Warehouse
+		if err := tx.Where("id = ?", transfer.ToWarehouseID).First(&destination).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repository.ErrWarehouseNotFound
+			}
+			return mapPostgresError(err)
+		}
+		if !destination.IsActive {
+			return domain.ErrWarehouseInactive
+		}
+
+		key := domain.StockKey{ProductID: transfer.ProductID, VariantID: transfer.VariantID}
+		fromBalance, err := applyWarehouseStock(tx, transfer.FromWarehouseID, key, -transfer.Quantity)
+		if err != nil {
+			return err
+		}
+		toBalance, err := applyWarehouseStock(tx, transfer.ToWarehouseID, key, transfer.Quantity)
+		if err != nil {
+			return err
+		}
+
+		var balance int
+		if transfer.VariantID != nil {
+			err = tx.Model(&domain.ProductVariant{}).Select("quantity").Where("id = ?", *transfer.VariantID).Scan(&balance).Error
+		} else {
+			err = tx.Model(&domain.Product{}).Select("quantity").Where("id = ?", transfer.ProductID).Scan(&balance).Error
+		}
+		if err != nil {
+			return mapPostgresError(err)
+		}
+
+		fromID, toID := transfer.FromWarehouseID, transfer.ToWarehouseID
+		movements = []domain.StockMovement{
+			{
+				ProductID:             transfer.ProductID,
+				VariantID:             transfer.VariantID,
+				WarehouseID:           &fromID,
+				Type:                  domain.StockMovementTransfer,
+				Quantity:              -transfer.Quantity,
+				BalanceAfter:          balance,
+				WarehouseBalanceAfter: &fromBalance,
+				Reason:                transfer.Reason,
+				Actor:                 transfer.Actor,
+			},
+			{
+				ProductID:             transfer.ProductID,
+				VariantID:             transfer.VariantID,
+				WarehouseID:           &toID,
+				Type:                  domain.StockMovementTransfer,
+				Quantity:              transfer.Quantity,
+				BalanceAfter:          balance,
+				WarehouseBalanceAfter: &toBalance,
+				Reason:                transfer.Reason,
+				Actor:                 transfer.Actor,
+			},
+		}
+		if err := tx.Create(&movements).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "stock transferred")
+	return movements, nil
+}
+
+func (r *InventoryRepository) ListMovements(ctx context.Context, filter domain.StockMovementFilter) ([]domain.StockMovement, int, error) {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.ListMovements")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(filter.ProductID)),
+		attribute.Int("query.page", filter.Page),
+		attribute.Int("query.per_page", filter.PerPage),
+	)
+
+	query := r.db.WithContext(ctx).Model(&domain.StockMovement{}).Where("product_id = ?", filter.ProductID)
+	if filter.VariantID != nil {
+		query = query.Where("variant_id = ?", *filter.VariantID)
+	}
+	if filter.WarehouseID != nil {
+		query = query.Where("warehouse_id = ?", *filter.WarehouseID)
+	}
+	if filter.Type != "" {
+		query = query.Where("type = ?", filter.Type)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, mapPostgresError(err)
+	}
+
+	var movements []domain.StockMovement
+	err := query.Order("created_at DESC, id DESC").
+		Offset((filter.Page - 1) * filter.PerPage).
+		Limit(filter.PerPage).
+		Find(&movements).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("movements.count", len(movements)))
+	span.SetStatus(codes.Ok, "stock movements listed")
+	return movements, int(total), nil
+}
+
+// missingStockRowError tells a missing product or variant apart from a
+// movement that would have taken stock below zero.
+func (r *InventoryRepository) missingStockRowError(tx *gorm.DB, movement *domain.StockMovement) error {
+	var count int64
+	if movement.VariantID != nil {
+		err := tx.Model(&domain.ProductVariant{}).
+			Where("id = ? AND product_id = ?", *movement.VariantID, movement.ProductID).
+			Count(&count).Error
+		if err != nil {
+			return mapPostgresError(err)
+		}
+		if count == 0 {
+			return repository.ErrVariantNotFound
+		}
+		return domain.ErrInsufficientStock
+	}
+
+	if err := tx.Model(&domain.Product{}).Where("id = ?", movement.ProductID).Count(&count).Error; err != nil {
+		return mapPostgresError(err)
+	}
+	if count == 0 {
+		return repository.ErrProductNotFound
+	}
+	return domain.ErrInsufficientStock
+}
+
+// applyWarehouseStock changes the stock level of a product or variant in one
+// warehouse and returns the new level.
+func applyWarehouseStock(tx *gorm.DB, warehouseID uint, key domain.StockKey, delta int) (int, error) {
+	var balance int
+	var result *gorm.DB
+	if delta > 0 {
+		result = tx.Raw(addWarehouseStockSQL, warehouseID, key.ProductID, key.VariantID, delta).Scan(&balance)
+	} else {
+		var variantID uint
+		if key.VariantID != nil {
+			variantID = *key.VariantID
+		}
+		result = tx.Raw(takeWarehouseStockSQL, delta, warehouseID, key.ProductID, variantID, delta).Scan(&balance)
+	}
+	if result.Error != nil {
+		return 0, mapPostgresError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return 0, domain.ErrInsufficientStock
+	}
+	return balance, nil
+}
+
+// ensureUnassignedStock checks, once an outbound movement without a warehouse
+// has been applied, that the stock left still covers what the warehouses
+// hold. The applied movement locks the product or variant row, so concurrent
+// movements of it wait for this check.
+func ensureUnassignedStock(tx *gorm.DB, movement *domain.StockMovement) error {
+	var variantID uint
+	if movement.VariantID != nil {
+		variantID = *movement.VariantID
+	}
+
+	var held int
+	if err := tx.Raw(warehouseHeldStockSQL, movement.ProductID, variantID).Scan(&held).Error; err != nil {
+		return mapPostgresError(err)
+	}
+	if movement.BalanceAfter < held {
+		return domain.ErrInsufficientStock
+	}
+	return nil
+}
+
+func ensureWarehouseExists(tx *gorm.DB, warehouseID uint) error {
+	var count int64
+	if err := tx.Model(&domain.Warehouse{}).Where("id = ?", warehouseID).Count(&count).Error; err != nil {
+		return mapPostgresError(err)
+	}
+	if count == 0 {
+		return repository.ErrWarehouseNotFound
+	}
+	return nil
+}
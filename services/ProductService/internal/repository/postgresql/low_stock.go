@@ -0,0 +1,162 @@
+package postgresql
+
+import (
+	"context"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/repository"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+)
+
+// Products sold in variants are checked per variant against the product's
+// threshold; the product row itself only counts when it has no variants.
+const (
+	listLowStockSQL = `WITH sales AS (
+			SELECT product_id, coalesce(variant_id, 0) AS variant_key, sum(-quantity) AS units_sold
+			FROM stock_movements
+			WHERE type = 'sale' AND created_at >= ?
+			GROUP BY product_id, coalesce(variant_id, 0)
+		), levels AS (
+			SELECT p.id AS product_id, NULL::int AS variant_id, p.name, '' AS sku, p.quantity, p.reorder_threshold
+			FROM products p
+			WHERE p.deleted_at IS NULL AND p.reorder_threshold > 0
+				AND NOT EXISTS (SELECT 1 FROM product_variants v WHERE v.product_id = p.id AND v.deleted_at IS NULL)
+			UNION ALL
+			SELECT p.id, v.id, p.name, v.sku, v.quantity, p.reorder_threshold
+			FROM product_variants v
+			JOIN products p ON p.id = v.product_id
+			WHERE v.deleted_at IS NULL AND p.deleted_at IS NULL AND p.reorder_threshold > 0
+		)
+		SELECT l.product_id, l.variant_id, l.name, l.sku, l.quantity, l.reorder_threshold,
+			coalesce(s.units_sold, 0) AS units_sold
+		FROM levels l
+		LEFT JOIN sales s ON s.product_id = l.product_id AND s.variant_key = coalesce(l.variant_id, 0)
+		WHERE l.quantity < l.reorder_threshold
+		ORDER BY l.quantity - l.reorder_threshold, l.product_id, l.variant_id`
+	raiseLowStockAlertSQL = `INSERT INTO low_stock_alerts (product_id, variant_id, quantity, reorder_threshold, raised_at)
+		VALUES (?, ?, ?, ?, now())
+		ON CONFLICT (product_id, coalesce(variant_id, 0)) DO NOTHING`
+)
+
+func (r *InventoryRepository) SetReorderThreshold(ctx context.Context, productID uint, threshold int) error {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.SetReorderThreshold")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(productID)),
+		attribute.Int("product.reorder_threshold", threshold),
+	)
+
+	result := r.db.WithContext(ctx).
+		Model(&domain.Product{}).
+		Where("id = ?", productID).
+		Update("reorder_threshold", threshold)
+	if result.Error != nil {
+		span.RecordError(result.Error)
+		span.SetStatus(codes.Error, result.Error.Error())
+		return mapPostgresError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		span.SetStatus(codes.Error, repository.ErrProductNotFound.Error())
+		return repository.ErrProductNotFound
+	}
+
+	span.SetStatus(codes.Ok, "reorder threshold set")
+	return nil
+}
+
+func (r *InventoryRepository) ListLowStock(ctx context.Context, salesSince time.Time) ([]domain.LowStockItem, error) {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.ListLowStock")
+	defer span.End()
+
+	var items []domain.LowStockItem
+	if err := r.db.WithContext(ctx).Raw(listLowStockSQL, salesSince).Scan(&items).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("low_stock.count", len(items)))
+	span.SetStatus(codes.Ok, "low stock listed")
+	return items, nil
+}
+
+// RaiseLowStockAlert records an open alert for the item and reports whether it
+// is new, so an item that stays low is only announced once.
+func (r *InventoryRepository) RaiseLowStockAlert(ctx context.Context, item domain.LowStockItem) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.RaiseLowStockAlert")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(item.ProductID)))
+
+	result := r.db.WithContext(ctx).Exec(raiseLowStockAlertSQL, item.ProductID, item.VariantID, item.Quantity, item.ReorderThreshold)
+	if result.Error != nil {
+		span.RecordError(result.Error)
+		span.SetStatus(codes.Error, result.Error.Error())
+		return false, mapPostgresError(result.Error)
+	}
+
+	raised := result.RowsAffected > 0
+	span.SetAttributes(attribute.Bool("low_stock.raised", raised))
+	span.SetStatus(codes.Ok, "low stock alert recorded")
+	return raised, nil
+}
+
+func (r *InventoryRepository) ClearLowStockAlert(ctx context.Context, key domain.StockKey) error {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.ClearLowStockAlert")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(key.ProductID)))
+
+	var variantKey uint
+	if key.VariantID != nil {
+		variantKey = *key.VariantID
+	}
+	err := r.db.WithContext(ctx).
+		Exec("DELETE FROM low_stock_alerts WHERE product_id = ? AND coalesce(variant_id, 0) = ?", key.ProductID, variantKey).
+		Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return mapPostgresError(err)
+	}
+
+	span.SetStatus(codes.Ok, "low stock alert cleared")
+	return nil
+}
+
+// ResolveLowStockAlerts closes every open alert whose item is no longer low,
+// so it can be raised again the next time stock dips.
+func (r *InventoryRepository) ResolveLowStockAlerts(ctx context.Context, stillLow []domain.StockKey) error {
+	ctx, span := r.tracer.Start(ctx, "InventoryRepository.ResolveLowStockAlerts")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("low_stock.count", len(stillLow)))
+
+	var result *gorm.DB
+	if len(stillLow) > 0 {
+		pairs := make([][]any, 0, len(stillLow))
+		for _, key := range stillLow {
+			var variantKey uint
+			if key.VariantID != nil {
+				variantKey = *key.VariantID
+			}
+			pairs = append(pairs, []any{key.ProductID, variantKey})
+		}
+		result = r.db.WithContext(ctx).Exec("DELETE FROM low_stock_alerts WHERE (product_id, coalesce(variant_id, 0)) NOT IN ?", pairs)
+	} else {
+		result = r.db.WithContext(ctx).Exec("DELETE FROM low_stock_alerts")
+	}
+	if result.Error != nil {
+		span.RecordError(result.Error)
+		span.SetStatus(codes.Error, result.Error.Error())
+		return mapPostgresError(result.Error)
+	}
+
+	span.SetAttributes(attribute.Int("low_stock.resolved", int(result.RowsAffected)))
+	span.SetStatus(codes.Ok, "low stock alerts resolved")
+	return nil
+}
@@ -0,0 +1,259 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// syncPrimaryImageSQL mirrors the first image into products.image_url. A
+// product left without images keeps its own image_url, unless it pointed at
+// the removed image.
+const syncPrimaryImageSQL = `UPDATE products SET image_url = coalesce(
+		(SELECT url FROM product_images WHERE product_id = ? ORDER BY position, id LIMIT 1),
+		CASE WHEN image_url = ? THEN NULL ELSE image_url END)
+	WHERE id = ?`
+
+type MediaRepository struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+var _ domain.MediaRepository = (*MediaRepository)(nil)
+
+func NewMediaRepository(db *gorm.DB) *MediaRepository {
+	return &MediaRepository{
+		db:     db,
+		tracer: otel.Tracer("media-repo"),
+	}
+}
+
+// CreateImage appends the image after the existing images of its product.
+func (r *MediaRepository) CreateImage(ctx context.Context, image *domain.ProductImage) error {
+	ctx, span := r.tracer.Start(ctx, "MediaRepository.CreateImage")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(image.ProductID)))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Lock the product row so concurrent uploads get distinct positions.
+		var productIDs []uint
+		err := tx.Model(&domain.Product{}).
+			Where("id = ?", image.ProductID).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Pluck("id", &productIDs).Error
+		if err != nil {
+			return mapPostgresError(err)
+		}
+		if len(productIDs) == 0 {
+			return repository.ErrProductNotFound
+		}
+
+		var position int
+		err = tx.Model(&domain.ProductImage{}).
+			Where("product_id = ?", image.ProductID).
+			Select("coalesce(max(position) + 1, 0)").
+			Scan(&position).Error
+		if err != nil {
+			return mapPostgresError(err)
+		}
+
+		image.Position = position
+		if err := tx.Create(image).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		return syncPrimaryImage(tx, image.ProductID, "")
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetAttributes(attribute.Int("image.id", int(image.ID)))
+	span.SetStatus(codes.Ok, "image created")
+	return nil
+}
+
+func (r *MediaRepository) GetImageByID(ctx context.Context, id uint) (*domain.ProductImage, error) {
+	ctx, span := r.tracer.Start(ctx, "MediaRepository.GetImageByID")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("image.id", int(id)))
+
+	image, err := gorm.G[domain.ProductImage](r.db).Where("id = ?", id).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			span.SetStatus(codes.Error, repository.ErrImageNotFound.Error())
+			return nil, repository.ErrImageNotFound
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetStatus(codes.Ok, "image retrieved")
+	return &image, nil
+}
+
+func (r *MediaRepository) ListImages(ctx context.Context, productID uint) ([]domain.ProductImage, error) {
+	ctx, span := r.tracer.Start(ctx, "MediaRepository.ListImages")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(productID)))
+
+	images, err := gorm.G[domain.ProductImage](r.db).Where("product_id = ?", productID).Order("position, id").Find(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("images.count", len(images)))
+	span.SetStatus(codes.Ok, "images listed")
+	return images, nil
+}
+
+func (r *MediaRepository) ListImagesByProductIDs(ctx context.Context, productIDs []uint) ([]domain.ProductImage, error) {
+	ctx, span := r.tracer.Start(ctx, "MediaRepository.ListImagesByProductIDs")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.ids.count", len(productIDs)))
+
+	images, err := gorm.G[domain.ProductImage](r.db).Where("product_id IN ?", productIDs).Order("product_id, position, id").Find(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("images.count", len(images)))
+	span.SetStatus(codes.Ok, "images listed")
+	return images, nil
+}
+
+func (r *MediaRepository) UpdateImageAltText(ctx context.Context, id uint, altText string) error {
+	ctx, span := r.tracer.Start(ctx, "MediaRepository.UpdateImageAltText")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("image.id", int(id)))
+
+	// Update by column so clearing the alt text is not skipped as a zero value.
+	result := r.db.WithContext(ctx).
+		Model(&domain.ProductImage{}).
+		Where("id = ?", id).
+		Update("alt_text", altText)
+	if result.Error != nil {
+		span.RecordError(result.Error)
+		span.SetStatus(codes.Error, result.Error.Error())
+		return mapPostgresError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		span.SetStatus(codes.Error, repository.ErrImageNotFound.Error())
+		return repository.ErrImageNotFound
+	}
+
+	span.SetStatus(codes.Ok, "image updated")
+	return nil
+}
+
+// ReorderImages renumbers the images of a product in the given order. The
+// ids must be exactly the product's images.
+func (r *MediaRepository) ReorderImages(ctx context.Context, productID uint, imageIDs []uint) error {
+	ctx, span := r.tracer.Start(ctx, "MediaRepository.ReorderImages")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(productID)),
+		attribute.Int("images.count", len(imageIDs)),
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var existing []uint
+		err := tx.Model(&domain.ProductImage{}).
+			Where("product_id = ?", productID).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Pluck("id", &existing).Error
+		if err != nil {
+			return mapPostgresError(err)
+		}
+
+		if len(existing) != len(imageIDs) {
+			return domain.ErrInvalidImageOrder
+		}
+		remaining := make(map[uint]bool, len(existing))
+		for _, id := range existing {
+			remaining[id] = true
+		}
+		for _, id := range imageIDs {
+			if !remaining[id] {
+				return domain.ErrInvalidImageOrder
+			}
+			delete(remaining, id)
+		}
+
+		for position, id := range imageIDs {
+			err := tx.Model(&domain.ProductImage{}).
+				Where("id = ?", id).
+				Update("position", position).Error
+			if err != nil {
+				return mapPostgresError(err)
+			}
+		}
+		return syncPrimaryImage(tx, productID, "")
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "images reordered")
+	return nil
+}
+
+// DeleteImage removes the image row and returns it, so the caller can delete
+// the stored files.
+func (r *MediaRepository) DeleteImage(ctx context.Context, id uint) (*domain.ProductImage, error) {
+	ctx, span := r.tracer.Start(ctx, "MediaRepository.DeleteImage")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("image.id", int(id)))
+
+	var image domain.ProductImage
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("id = ?", id).First(&image).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repository.ErrImageNotFound
+			}
+			return mapPostgresError(err)
+		}
+		if err := tx.Delete(&image).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		return syncPrimaryImage(tx, image.ProductID, image.URL)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "image deleted")
+	return &image, nil
+}
+
+func syncPrimaryImage(tx *gorm.DB, productID uint, removedURL string) error {
+	if err := tx.Exec(syncPrimaryImageSQL, productID, removedURL, productID).Error; err != nil {
+		return mapPostgresError(err)
+	}
+	return nil
+}
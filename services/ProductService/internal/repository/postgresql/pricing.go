@@ -0,0 +1,301 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type PricingRepository struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+var _ domain.PricingRepository = (*PricingRepository)(nil)
+
+func NewPricingRepository(db *gorm.DB) *PricingRepository {
+	return &PricingRepository{
+		db:     db,
+		tracer: otel.Tracer("pricing-repo"),
+	}
+}
+
+func (r *PricingRepository) SchedulePriceChange(ctx context.Context, change *domain.ScheduledPriceChange) error {
+	ctx, span := r.tracer.Start(ctx, "PricingRepository.SchedulePriceChange")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(change.ProductID)),
+		attribute.String("price_change.effective_at", change.EffectiveAt.Format(time.RFC3339)),
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		_, found, err := loadPriceSnapshot(tx, change.ProductID)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return repository.ErrProductNotFound
+		}
+
+		change.Status = domain.ScheduledPriceChangePending
+		if err := tx.Create(change).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetAttributes(attribute.Int("price_change.id", int(change.ID)))
+	span.SetStatus(codes.Ok, "price change scheduled")
+	return nil
+}
+
+// CancelScheduledPriceChange cancels a pending change and returns it. Changes
+// that were already applied or canceled are left untouched.
+func (r *PricingRepository) CancelScheduledPriceChange(ctx context.Context, id uint) (*domain.ScheduledPriceChange, error) {
+	ctx, span := r.tracer.Start(ctx, "PricingRepository.CancelScheduledPriceChange")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("price_change.id", int(id)))
+
+	var change domain.ScheduledPriceChange
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&change, id).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repository.ErrPriceChangeNotFound
+			}
+			return mapPostgresError(err)
+		}
+		if change.Status != domain.ScheduledPriceChangePending {
+			return domain.ErrPriceChangeNotPending
+		}
+
+		change.Status = domain.ScheduledPriceChangeCanceled
+		if err := tx.Model(&change).Select("status").Updates(&change).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "price change canceled")
+	return &change, nil
+}
+
+func (r *PricingRepository) ListScheduledPriceChanges(ctx context.Context, filter domain.ScheduledPriceChangeFilter) ([]domain.ScheduledPriceChange, int, error) {
+	ctx, span := r.tracer.Start(ctx, "PricingRepository.ListScheduledPriceChanges")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(filter.ProductID)),
+		attribute.String("price_change.status", string(filter.Status)),
+		attribute.Int("query.page", filter.Page),
+		attribute.Int("query.per_page", filter.PerPage),
+	)
+
+	query := r.db.WithContext(ctx).Model(&domain.ScheduledPriceChange{})
+	if filter.ProductID != 0 {
+		query = query.Where("product_id = ?", filter.ProductID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, mapPostgresError(err)
+	}
+
+	var changes []domain.ScheduledPriceChange
+	err := query.Order("effective_at, id").
+		Offset((filter.Page - 1) * filter.PerPage).
+		Limit(filter.PerPage).
+		Find(&changes).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("price_changes.count", len(changes)))
+	span.SetStatus(codes.Ok, "price changes listed")
+	return changes, int(total), nil
+}
+
+// ListPriceHistory returns the price history of a product, oldest first.
+func (r *PricingRepository) ListPriceHistory(ctx context.Context, productID uint) ([]domain.PriceHistoryEntry, error) {
+	ctx, span := r.tracer.Start(ctx, "PricingRepository.ListPriceHistory")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(productID)))
+
+	entries, err := gorm.G[domain.PriceHistoryEntry](r.db).Where("product_id = ?", productID).Order("changed_at, id").Find(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("price_history.count", len(entries)))
+	span.SetStatus(codes.Ok, "price history listed")
+	return entries, nil
+}
+
+// ApplyDuePriceChanges applies up to limit pending changes that took effect by
+// now and returns the applied ones. Due rows are claimed with SKIP LOCKED, so
+// several service replicas can run the scheduler side by side. Changes of
+// products deleted in the meantime are canceled.
+func (r *PricingRepository) ApplyDuePriceChanges(ctx context.Context, now time.Time, limit int) ([]domain.ScheduledPriceChange, error) {
+	ctx, span := r.tracer.Start(ctx, "PricingRepository.ApplyDuePriceChanges")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("query.limit", limit))
+
+	var applied []domain.ScheduledPriceChange
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var due []domain.ScheduledPriceChange
+		err := tx.Where("status = ? AND effective_at <= ?", domain.ScheduledPriceChangePending, now).
+			Order("effective_at, id").
+			Limit(limit).
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Find(&due).Error
+		if err != nil {
+			return mapPostgresError(err)
+		}
+
+		for i := range due {
+			change := &due[i]
+			before, found, err := lockPriceSnapshot(tx, change.ProductID)
+			if err != nil {
+				return err
+			}
+			if !found {
+				change.Status = domain.ScheduledPriceChangeCanceled
+				if err := tx.Model(change).Select("status").Updates(change).Error; err != nil {
+					return mapPostgresError(err)
+				}
+				continue
+			}
+
+			if err := tx.Model(&domain.Product{}).Where("id = ?", change.ProductID).Updates(priceChangeColumns(change)).Error; err != nil {
+				return mapPostgresError(err)
+			}
+			if err := recordPriceChange(tx, change.ProductID, before, domain.PriceSourceSchedule, &change.ID); err != nil {
+				return err
+			}
+
+			appliedAt := now
+			change.Status = domain.ScheduledPriceChangeApplied
+			change.AppliedAt = &appliedAt
+			if err := tx.Model(change).Select("status", "applied_at").Updates(change).Error; err != nil {
+				return mapPostgresError(err)
+			}
+			applied = append(applied, *change)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("price_changes.applied", len(applied)))
+	span.SetStatus(codes.Ok, "due price changes applied")
+	return applied, nil
+}
+
+func priceChangeColumns(change *domain.ScheduledPriceChange) map[string]any {
+	columns := map[string]any{}
+	if change.Price != nil {
+		columns["price"] = *change.Price
+	}
+	switch {
+	case change.RemoveDiscount:
+		columns["discount_type"] = ""
+		columns["discount_value"] = 0
+	case change.DiscountType != nil:
+		columns["discount_type"] = *change.DiscountType
+		columns["discount_value"] = *change.DiscountValue
+	}
+	return columns
+}
+
+// priceSnapshot holds the columns tracked by the price history.
+type priceSnapshot struct {
+	Price         float32
+	DiscountType  domain.DiscountType
+	DiscountValue float32
+}
+
+func loadPriceSnapshot(tx *gorm.DB, productID uint) (*priceSnapshot, bool, error) {
+	return scanPriceSnapshot(tx.Model(&domain.Product{}), productID)
+}
+
+// lockPriceSnapshot locks the product row until the transaction ends, so
+// concurrent price writes record their history in commit order.
+func lockPriceSnapshot(tx *gorm.DB, productID uint) (*priceSnapshot, bool, error) {
+	return scanPriceSnapshot(tx.Model(&domain.Product{}).Clauses(clause.Locking{Strength: "UPDATE"}), productID)
+}
+
+func scanPriceSnapshot(query *gorm.DB, productID uint) (*priceSnapshot, bool, error) {
+	var snapshots []priceSnapshot
+	err := query.
+		Select("price, coalesce(discount_type, '') AS discount_type, coalesce(discount_value, 0) AS discount_value").
+		Where("id = ?", productID).
+		Scan(&snapshots).Error
+	if err != nil {
+		return nil, false, mapPostgresError(err)
+	}
+	if len(snapshots) == 0 {
+		return nil, false, nil
+	}
+	return &snapshots[0], true, nil
+}
+
+// recordPriceChange appends the current price of the product to its history
+// when it differs from before; a nil before always records, as for new
+// products.
+func recordPriceChange(tx *gorm.DB, productID uint, before *priceSnapshot, source domain.PriceChangeSource, scheduledChangeID *uint) error {
+	after, found, err := loadPriceSnapshot(tx, productID)
+	if err != nil {
+		return err
+	}
+	if !found || (before != nil && *before == *after) {
+		return nil
+	}
+
+	entry := domain.PriceHistoryEntry{
+		ProductID:         productID,
+		Price:             after.Price,
+		DiscountType:      after.DiscountType,
+		DiscountValue:     after.DiscountValue,
+		Source:            source,
+		ScheduledChangeID: scheduledChangeID,
+	}
+	if err := tx.Create(&entry).Error; err != nil {
+		return mapPostgresError(err)
+	}
+	return nil
+}
@@ -37,10 +37,19 @@ func (r *ProductRepository) CreateProduct(ctx context.Context, product *domain.P
 		attribute.Float64("product.price", float64(product.Price)),
 	)
 
-	if err := gorm.G[domain.Product](r.db).Create(ctx, product); err != nil {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(product).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		if err := recordOpeningStock(tx, product.ID, nil, domain.StockMovementInitial, product.Quantity, "product created"); err != nil {
+			return err
+		}
+		return recordPriceChange(tx, product.ID, nil, domain.PriceSourceCreate, nil)
+	})
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return mapPostgresError(err)
+		return err
 	}
 
 	span.SetAttributes(attribute.Int("product.id", int(product.ID)))
@@ -95,39 +104,54 @@ func (r *ProductRepository) UpdateProduct(ctx context.Context, id uint, product
 		attribute.String("product.name", product.Name),
 	)
 
-	rowsAffected, err := gorm.G[domain.Product](r.db).Where("id = ?", id).Updates(ctx, *product)
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		before, found, err := lockPriceSnapshot(tx, id)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return repository.ErrProductNotFound
+		}
+
+		// Stock only changes through the inventory ledger and ratings through
+		// reviews, never through a product update.
+		_, err = gorm.G[domain.Product](tx).Where("id = ?", id).Omit("quantity", "sold_count", "rating_average", "rating_count").Updates(ctx, *product)
+		if err != nil {
+			return mapPostgresError(err)
+		}
+		return recordPriceChange(tx, id, before, domain.PriceSourceUpdate, nil)
+	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return mapPostgresError(err)
-	}
-	if rowsAffected == 0 {
-		span.SetStatus(codes.Error, repository.ErrProductNotFound.Error())
-		return repository.ErrProductNotFound
+		return err
 	}
 
 	span.SetStatus(codes.Ok, "product updated")
 	return nil
 }
 
-func (r *ProductRepository) ListProducts(ctx context.Context, page, perPage int) ([]domain.Product, int, error) {
+func (r *ProductRepository) ListProducts(ctx context.Context, page, perPage int, attributes []domain.AttributeFilter) ([]domain.Product, int, error) {
 	ctx, span := r.tracer.Start(ctx, "ProductRepository.ListProducts")
 	defer span.End()
 
 	span.SetAttributes(
 		attribute.Int("query.page", page),
 		attribute.Int("query.per_page", perPage),
+		attribute.Int("query.attribute_filters", len(attributes)),
 	)
 
-	products, err := gorm.G[domain.Product](r.db).Offset((page - 1) * perPage).Limit(perPage).Find(ctx)
-	if err != nil {
+	query := applyAttributeFilters(r.db.WithContext(ctx).Model(&domain.Product{}), attributes)
+
+	var totalCount int64
+	if err := query.Count(&totalCount).Error; err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, 0, mapPostgresError(err)
 	}
 
-	totalCount, err := gorm.G[domain.Product](r.db).Count(ctx, "*")
-	if err != nil {
+	var products []domain.Product
+	if err := query.Offset((page - 1) * perPage).Limit(perPage).Find(&products).Error; err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, 0, mapPostgresError(err)
@@ -138,6 +162,70 @@ func (r *ProductRepository) ListProducts(ctx context.Context, page, perPage int)
 	return products, int(totalCount), nil
 }
 
+func (r *ProductRepository) ListProductsByCategory(ctx context.Context, categoryID uint, includeDescendants bool, page, perPage int, attributes []domain.AttributeFilter) ([]domain.Product, int, error) {
+	ctx, span := r.tracer.Start(ctx, "ProductRepository.ListProductsByCategory")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("category.id", int(categoryID)),
+		attribute.Bool("category.include_descendants", includeDescendants),
+		attribute.Int("query.page", page),
+		attribute.Int("query.per_page", perPage),
+		attribute.Int("query.attribute_filters", len(attributes)),
+	)
+
+	condition := inCategorySQL
+	if includeDescendants {
+		condition = inCategoryTreeSQL
+	}
+	query := applyAttributeFilters(r.db.WithContext(ctx).Model(&domain.Product{}).Where(condition, categoryID), attributes)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, mapPostgresError(err)
+	}
+
+	var products []domain.Product
+	if err := query.Order("products.id").Offset((page - 1) * perPage).Limit(perPage).Find(&products).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("products.count", len(products)))
+	span.SetStatus(codes.Ok, "products listed by category")
+	return products, int(total), nil
+}
+
+func (r *ProductRepository) SetMaxPerOrder(ctx context.Context, id uint, maxPerOrder int) error {
+	ctx, span := r.tracer.Start(ctx, "ProductRepository.SetMaxPerOrder")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(id)),
+		attribute.Int("product.max_per_order", maxPerOrder),
+	)
+
+	result := r.db.WithContext(ctx).
+		Model(&domain.Product{}).
+		Where("id = ?", id).
+		Update("max_per_order", maxPerOrder)
+	if result.Error != nil {
+		span.RecordError(result.Error)
+		span.SetStatus(codes.Error, result.Error.Error())
+		return mapPostgresError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		span.SetStatus(codes.Error, repository.ErrProductNotFound.Error())
+		return repository.ErrProductNotFound
+	}
+
+	span.SetStatus(codes.Ok, "max per order set")
+	return nil
+}
+
 func (r *ProductRepository) DeleteProduct(ctx context.Context, id uint) error {
 	ctx, span := r.tracer.Start(ctx, "ProductRepository.DeleteProduct")
 	defer span.End()
@@ -0,0 +1,171 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+)
+
+// errDryRun rolls back the transaction of a dry run.
+var errDryRun = errors.New("dry run")
+
+// DryRun runs fn with a repository whose writes all go to one transaction,
+// rolled back once fn returns. Every write made through it sees the earlier
+// ones, and the rows and locks it takes are held until fn returns.
+func (r *ProductRepository) DryRun(ctx context.Context, fn func(repo domain.ProductRepository) error) error {
+	ctx, span := r.tracer.Start(ctx, "ProductRepository.DryRun")
+	defer span.End()
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := fn(&ProductRepository{db: tx, tracer: r.tracer}); err != nil {
+			return err
+		}
+		return errDryRun
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "dry run rolled back")
+	return nil
+}
+
+// ImportProducts creates or updates every row in one transaction. Each row
+// runs under its own savepoint, so a failing row is reported in its result
+// without aborting the rest of the batch.
+func (r *ProductRepository) ImportProducts(ctx context.Context, rows []domain.ProductImportRow) ([]domain.ProductImportResult, error) {
+	ctx, span := r.tracer.Start(ctx, "ProductRepository.ImportProducts")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("import.rows.count", len(rows)))
+
+	var results []domain.ProductImportResult
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		results = make([]domain.ProductImportResult, 0, len(rows))
+		for i := range rows {
+			row := &rows[i]
+			if err := tx.SavePoint("import_row").Error; err != nil {
+				return mapPostgresError(err)
+			}
+
+			productID, created, err := upsertImportedProduct(tx, &row.Product)
+			if err != nil {
+				if rollbackErr := tx.RollbackTo("import_row").Error; rollbackErr != nil {
+					return mapPostgresError(rollbackErr)
+				}
+				results = append(results, domain.ProductImportResult{Line: row.Line, Err: err})
+				continue
+			}
+			results = append(results, domain.ProductImportResult{Line: row.Line, ProductID: productID, Created: created})
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "products imported")
+	return results, nil
+}
+
+// ListProductsAfter pages through the catalog by id, which stays stable while
+// products are added during a long export.
+func (r *ProductRepository) ListProductsAfter(ctx context.Context, afterID uint, limit int) ([]domain.Product, error) {
+	ctx, span := r.tracer.Start(ctx, "ProductRepository.ListProductsAfter")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("query.after_id", int(afterID)),
+		attribute.Int("query.limit", limit),
+	)
+
+	products, err := gorm.G[domain.Product](r.db).Where("id > ?", afterID).Order("id").Limit(limit).Find(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("products.count", len(products)))
+	span.SetStatus(codes.Ok, "products listed")
+	return products, nil
+}
+
+func upsertImportedProduct(tx *gorm.DB, product *domain.Product) (uint, bool, error) {
+	existing, err := findImportTarget(tx, product)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if existing == nil {
+		if err := tx.Create(product).Error; err != nil {
+			return 0, false, mapPostgresError(err)
+		}
+		if err := recordOpeningStock(tx, product.ID, nil, domain.StockMovementImport, product.Quantity, "product import"); err != nil {
+			return 0, false, err
+		}
+		if err := recordPriceChange(tx, product.ID, nil, domain.PriceSourceImport, nil); err != nil {
+			return 0, false, err
+		}
+		return product.ID, true, nil
+	}
+
+	before, _, err := lockPriceSnapshot(tx, existing.ID)
+	if err != nil {
+		return 0, false, err
+	}
+
+	// Stock only changes through the inventory ledger and ratings through
+	// reviews, so an import never overwrites them on an existing product.
+	err = tx.Model(&domain.Product{}).
+		Where("id = ?", existing.ID).
+		Omit("quantity", "sold_count", "rating_average", "rating_count").
+		Updates(product).Error
+	if err != nil {
+		return 0, false, mapPostgresError(err)
+	}
+	if err := recordPriceChange(tx, existing.ID, before, domain.PriceSourceImport, nil); err != nil {
+		return 0, false, err
+	}
+	return existing.ID, false, nil
+}
+
+// findImportTarget matches a row by sku first. Rows without a sku, or with a
+// sku not seen before, match by name; a row with a sku only claims a product
+// that has none yet.
+func findImportTarget(tx *gorm.DB, product *domain.Product) (*domain.Product, error) {
+	var matches []domain.Product
+	if product.SKU != nil {
+		if err := tx.Where("sku = ?", *product.SKU).Limit(1).Find(&matches).Error; err != nil {
+			return nil, mapPostgresError(err)
+		}
+		if len(matches) == 1 {
+			return &matches[0], nil
+		}
+	}
+
+	query := tx.Where("name = ?", product.Name)
+	if product.SKU != nil {
+		query = query.Where("sku IS NULL")
+	}
+	if err := query.Limit(2).Find(&matches).Error; err != nil {
+		return nil, mapPostgresError(err)
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, domain.ErrAmbiguousProductName
+	}
+}
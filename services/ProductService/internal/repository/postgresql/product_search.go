@@ -0,0 +1,226 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+)
+
+const (
+	// discountActiveSQL matches products whose discount applies right now.
+	discountActiveSQL = "(products.discount_value > 0 AND products.discount_type IN ('fixed', 'percent')" +
+		" AND (products.discount_start_date IS NULL OR products.discount_start_date <= now())" +
+		" AND (products.discount_end_date IS NULL OR products.discount_end_date >= now()))"
+
+	// effectivePriceSQL is the price a customer pays after an active discount.
+	effectivePriceSQL = "(CASE" +
+		" WHEN " + discountActiveSQL + " AND products.discount_type = 'percent' THEN products.price * (1 - LEAST(products.discount_value, 100) / 100)" +
+		" WHEN " + discountActiveSQL + " AND products.discount_type = 'fixed' THEN GREATEST(products.price - products.discount_value, 0)" +
+		" ELSE products.price END)"
+
+	// inCategorySQL matches products assigned directly to a live category.
+	inCategorySQL = "EXISTS (SELECT 1 FROM product_categories pc" +
+		" JOIN categories c ON c.id = pc.category_id AND c.deleted_at IS NULL" +
+		" WHERE pc.product_id = products.id AND pc.category_id = ?)"
+
+	// inCategoryTreeSQL matches products assigned to a live category or any of its live descendants.
+	inCategoryTreeSQL = "EXISTS (SELECT 1 FROM product_categories pc" +
+		" JOIN categories c ON c.id = pc.category_id AND c.deleted_at IS NULL" +
+		" JOIN categories root ON root.id = ? AND root.deleted_at IS NULL" +
+		" WHERE pc.product_id = products.id AND (c.path = root.path OR c.path LIKE root.path || '/%'))"
+
+	// attributeValueSQL opens a match on one attribute value of the product;
+	// callers append the value conditions and close the parenthesis.
+	attributeValueSQL = "EXISTS (SELECT 1 FROM product_attribute_values pav" +
+		" WHERE pav.product_id = products.id AND pav.attribute_id = ?"
+
+	searchQuerySQL = "products.search_vector @@ websearch_to_tsquery('english', ?)"
+	searchRankSQL  = "ts_rank(products.search_vector, websearch_to_tsquery('english', ?))"
+)
+
+func (r *ProductRepository) SearchProducts(ctx context.Context, filter domain.ProductSearchFilter) ([]domain.Product, int, error) {
+	ctx, span := r.tracer.Start(ctx, "ProductRepository.SearchProducts")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("search.query", filter.Query),
+		attribute.String("search.sort_by", string(filter.SortBy)),
+		attribute.Int("query.page", filter.Page),
+		attribute.Int("query.per_page", filter.PerPage),
+	)
+
+	query := applySearchFilters(r.db.WithContext(ctx).Model(&domain.Product{}), filter)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, mapPostgresError(err)
+	}
+
+	var products []domain.Product
+	if err := applySearchOrder(query, filter).
+		Offset((filter.Page - 1) * filter.PerPage).
+		Limit(filter.PerPage).
+		Find(&products).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, mapPostgresError(err)
+	}
+
+	span.SetAttributes(
+		attribute.Int("products.count", len(products)),
+		attribute.Int64("products.total", total),
+	)
+	span.SetStatus(codes.Ok, "products searched")
+	return products, int(total), nil
+}
+
+func (r *ProductRepository) SearchFacets(ctx context.Context, filter domain.ProductSearchFilter) (*domain.SearchFacets, error) {
+	ctx, span := r.tracer.Start(ctx, "ProductRepository.SearchFacets")
+	defer span.End()
+
+	// Facets are counted over the text matches only, so every filter value
+	// shows how many results it would leave.
+	base := domain.ProductSearchFilter{Query: filter.Query}
+
+	selects := []string{
+		"count(*) FILTER (WHERE products.quantity > 0) AS in_stock",
+		"count(*) FILTER (WHERE " + discountActiveSQL + ") AS on_discount",
+	}
+	args := make([]any, 0, len(domain.SearchPriceRanges)*2)
+	for i, pr := range domain.SearchPriceRanges {
+		if pr.Max > 0 {
+			selects = append(selects, fmt.Sprintf("count(*) FILTER (WHERE %s >= ? AND %s < ?) AS price_range_%d", effectivePriceSQL, effectivePriceSQL, i))
+			args = append(args, pr.Min, pr.Max)
+			continue
+		}
+		selects = append(selects, fmt.Sprintf("count(*) FILTER (WHERE %s >= ?) AS price_range_%d", effectivePriceSQL, i))
+		args = append(args, pr.Min)
+	}
+
+	row := map[string]any{}
+	if err := applySearchFilters(r.db.WithContext(ctx).Model(&domain.Product{}), base).
+		Select(strings.Join(selects, ", "), args...).
+		Take(&row).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	facets := &domain.SearchFacets{
+		InStock:     toInt(row["in_stock"]),
+		OnDiscount:  toInt(row["on_discount"]),
+		PriceRanges: make([]domain.PriceRangeFacet, 0, len(domain.SearchPriceRanges)),
+	}
+	for i, pr := range domain.SearchPriceRanges {
+		facets.PriceRanges = append(facets.PriceRanges, domain.PriceRangeFacet{
+			PriceRange: pr,
+			Count:      toInt(row[fmt.Sprintf("price_range_%d", i)]),
+		})
+	}
+
+	matching := applySearchFilters(r.db.WithContext(ctx).Model(&domain.Product{}), base).Select("products.id")
+	if err := r.db.WithContext(ctx).
+		Table("product_categories").
+		Select("categories.id AS category_id, categories.name AS name, count(*) AS count").
+		Joins("JOIN categories ON categories.id = product_categories.category_id").
+		Where("product_categories.product_id IN (?) AND categories.deleted_at IS NULL", matching).
+		Group("categories.id, categories.name").
+		Order("count DESC, categories.name").
+		Scan(&facets.Categories).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("facets.categories", len(facets.Categories)))
+	span.SetStatus(codes.Ok, "search facets computed")
+	return facets, nil
+}
+
+func applySearchFilters(query *gorm.DB, filter domain.ProductSearchFilter) *gorm.DB {
+	if filter.Query != "" {
+		query = query.Where(searchQuerySQL, filter.Query)
+	}
+	if filter.MinPrice != nil {
+		query = query.Where(effectivePriceSQL+" >= ?", *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		query = query.Where(effectivePriceSQL+" <= ?", *filter.MaxPrice)
+	}
+	if filter.CategoryID != nil {
+		query = query.Where(inCategoryTreeSQL, *filter.CategoryID)
+	}
+	if filter.InStockOnly {
+		query = query.Where("products.quantity > 0")
+	}
+	if filter.DiscountActive {
+		query = query.Where(discountActiveSQL)
+	}
+	return applyAttributeFilters(query, filter.Attributes)
+}
+
+// applyAttributeFilters keeps the products matching every filter.
+func applyAttributeFilters(query *gorm.DB, filters []domain.AttributeFilter) *gorm.DB {
+	for _, f := range filters {
+		condition := attributeValueSQL
+		args := []any{f.AttributeID}
+		if len(f.Texts) > 0 {
+			condition += " AND pav.value_text IN ?"
+			args = append(args, f.Texts)
+		}
+		if len(f.Numbers) > 0 {
+			condition += " AND pav.value_number IN ?"
+			args = append(args, f.Numbers)
+		}
+		if f.Bool != nil {
+			condition += " AND pav.value_bool = ?"
+			args = append(args, *f.Bool)
+		}
+		if f.Min != nil {
+			condition += " AND pav.value_number >= ?"
+			args = append(args, *f.Min)
+		}
+		if f.Max != nil {
+			condition += " AND pav.value_number <= ?"
+			args = append(args, *f.Max)
+		}
+		query = query.Where(condition+")", args...)
+	}
+	return query
+}
+
+func applySearchOrder(query *gorm.DB, filter domain.ProductSearchFilter) *gorm.DB {
+	switch filter.SortBy {
+	case domain.SortByPriceAsc:
+		return query.Order(effectivePriceSQL + " ASC, products.id")
+	case domain.SortByPriceDesc:
+		return query.Order(effectivePriceSQL + " DESC, products.id")
+	case domain.SortByPopularity:
+		return query.Order("products.sold_count DESC, products.id")
+	case domain.SortByRelevance:
+		if filter.Query != "" {
+			return query.Order(gorm.Expr(searchRankSQL+" DESC, products.id", filter.Query))
+		}
+	}
+	return query.Order("products.created_at DESC, products.id DESC")
+}
+
+func toInt(v any) int {
+	switch n := v.(type) {
+	case int64:
+		return int(n)
+	case int32:
+		return int(n)
+	case int:
+		return n
+	default:
+		return 0
+	}
+}
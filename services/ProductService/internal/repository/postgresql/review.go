@@ -0,0 +1,268 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// syncRatingSQL recomputes the rating summary of a product from its approved
+// reviews.
+const syncRatingSQL = `UPDATE products SET
+		rating_average = coalesce((SELECT round(avg(rating), 2) FROM product_reviews WHERE product_id = ? AND status = 'approved'), 0),
+		rating_count = (SELECT count(*) FROM product_reviews WHERE product_id = ? AND status = 'approved')
+	WHERE id = ?`
+
+type ReviewRepository struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+var _ domain.ReviewRepository = (*ReviewRepository)(nil)
+
+func NewReviewRepository(db *gorm.DB) *ReviewRepository {
+	return &ReviewRepository{
+		db:     db,
+		tracer: otel.Tracer("review-repo"),
+	}
+}
+
+func (r *ReviewRepository) CreateReview(ctx context.Context, review *domain.ProductReview) error {
+	ctx, span := r.tracer.Start(ctx, "ReviewRepository.CreateReview")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(review.ProductID)),
+		attribute.Int("review.user_id", int(review.UserID)),
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockReviewedProduct(tx, review.ProductID); err != nil {
+			return err
+		}
+		if err := tx.Create(review).Error; err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+				return domain.ErrDuplicateReview
+			}
+			return mapPostgresError(err)
+		}
+		return syncRating(tx, review.ProductID)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetAttributes(attribute.Int("review.id", int(review.ID)))
+	span.SetStatus(codes.Ok, "review created")
+	return nil
+}
+
+func (r *ReviewRepository) GetReviewByID(ctx context.Context, id uint) (*domain.ProductReview, error) {
+	ctx, span := r.tracer.Start(ctx, "ReviewRepository.GetReviewByID")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("review.id", int(id)))
+
+	review, err := gorm.G[domain.ProductReview](r.db).Where("id = ?", id).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			span.SetStatus(codes.Error, repository.ErrReviewNotFound.Error())
+			return nil, repository.ErrReviewNotFound
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetStatus(codes.Ok, "review retrieved")
+	return &review, nil
+}
+
+func (r *ReviewRepository) ListReviews(ctx context.Context, filter domain.ReviewFilter) ([]domain.ProductReview, int, error) {
+	ctx, span := r.tracer.Start(ctx, "ReviewRepository.ListReviews")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(filter.ProductID)),
+		attribute.String("review.status", string(filter.Status)),
+		attribute.Int("query.page", filter.Page),
+		attribute.Int("query.per_page", filter.PerPage),
+	)
+
+	query := r.db.WithContext(ctx).Model(&domain.ProductReview{})
+	if filter.ProductID != 0 {
+		query = query.Where("product_id = ?", filter.ProductID)
+	}
+	if filter.UserID != 0 {
+		query = query.Where("user_id = ?", filter.UserID)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, mapPostgresError(err)
+	}
+
+	var reviews []domain.ProductReview
+	err := query.Order("created_at DESC, id DESC").
+		Offset((filter.Page - 1) * filter.PerPage).
+		Limit(filter.PerPage).
+		Find(&reviews).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("reviews.count", len(reviews)))
+	span.SetStatus(codes.Ok, "reviews listed")
+	return reviews, int(total), nil
+}
+
+// UpdateReview writes the rating, text and status of the review.
+func (r *ReviewRepository) UpdateReview(ctx context.Context, review *domain.ProductReview) error {
+	ctx, span := r.tracer.Start(ctx, "ReviewRepository.UpdateReview")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("review.id", int(review.ID)))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockReviewedProduct(tx, review.ProductID); err != nil {
+			return err
+		}
+		// Select the columns so an emptied title or body is written too.
+		result := tx.Model(&domain.ProductReview{}).
+			Where("id = ?", review.ID).
+			Select("rating", "title", "body", "status", "moderation_note").
+			Updates(review)
+		if result.Error != nil {
+			return mapPostgresError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return repository.ErrReviewNotFound
+		}
+		return syncRating(tx, review.ProductID)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "review updated")
+	return nil
+}
+
+func (r *ReviewRepository) SetReviewStatus(ctx context.Context, id uint, status domain.ReviewStatus, note string) (*domain.ProductReview, error) {
+	ctx, span := r.tracer.Start(ctx, "ReviewRepository.SetReviewStatus")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("review.id", int(id)),
+		attribute.String("review.status", string(status)),
+	)
+
+	var review domain.ProductReview
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&review, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repository.ErrReviewNotFound
+			}
+			return mapPostgresError(err)
+		}
+		if err := lockReviewedProduct(tx, review.ProductID); err != nil {
+			return err
+		}
+
+		review.Status = status
+		review.ModerationNote = note
+		err := tx.Model(&review).
+			Select("status", "moderation_note").
+			Updates(&review).Error
+		if err != nil {
+			return mapPostgresError(err)
+		}
+		return syncRating(tx, review.ProductID)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "review status updated")
+	return &review, nil
+}
+
+// DeleteReview removes the review and returns it.
+func (r *ReviewRepository) DeleteReview(ctx context.Context, id uint) (*domain.ProductReview, error) {
+	ctx, span := r.tracer.Start(ctx, "ReviewRepository.DeleteReview")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("review.id", int(id)))
+
+	var review domain.ProductReview
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(&review, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return repository.ErrReviewNotFound
+			}
+			return mapPostgresError(err)
+		}
+		if err := lockReviewedProduct(tx, review.ProductID); err != nil {
+			return err
+		}
+		if err := tx.Delete(&domain.ProductReview{}, id).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		return syncRating(tx, review.ProductID)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "review deleted")
+	return &review, nil
+}
+
+// lockReviewedProduct serializes review changes of a product, so each rating
+// recount runs after the previous one committed and sees its review.
+func lockReviewedProduct(tx *gorm.DB, productID uint) error {
+	var productIDs []uint
+	err := tx.Model(&domain.Product{}).
+		Where("id = ?", productID).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Pluck("id", &productIDs).Error
+	if err != nil {
+		return mapPostgresError(err)
+	}
+	if len(productIDs) == 0 {
+		return repository.ErrProductNotFound
+	}
+	return nil
+}
+
+func syncRating(tx *gorm.DB, productID uint) error {
+	if err := tx.Exec(syncRatingSQL, productID, productID, productID).Error; err != nil {
+		return mapPostgresError(err)
+	}
+	return nil
+}
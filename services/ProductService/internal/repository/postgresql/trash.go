@@ -0,0 +1,331 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type TrashRepository struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+var _ domain.TrashRepository = (*TrashRepository)(nil)
+
+func NewTrashRepository(db *gorm.DB) *TrashRepository {
+	return &TrashRepository{
+		db:     db,
+		tracer: otel.Tracer("trash-repo"),
+	}
+}
+
+func (r *TrashRepository) ListTrashedProducts(ctx context.Context, page, perPage int) ([]domain.Product, int, error) {
+	ctx, span := r.tracer.Start(ctx, "TrashRepository.ListTrashedProducts")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("query.page", page),
+		attribute.Int("query.per_page", perPage),
+	)
+
+	query := r.db.WithContext(ctx).Unscoped().Model(&domain.Product{}).Where("deleted_at IS NOT NULL")
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, mapPostgresError(err)
+	}
+
+	var products []domain.Product
+	err := query.Order("deleted_at DESC, id DESC").
+		Offset((page - 1) * perPage).
+		Limit(perPage).
+		Find(&products).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("products.count", len(products)))
+	span.SetStatus(codes.Ok, "trashed products listed")
+	return products, int(total), nil
+}
+
+func (r *TrashRepository) ListTrashedCategories(ctx context.Context, page, perPage int) ([]domain.Category, int, error) {
+	ctx, span := r.tracer.Start(ctx, "TrashRepository.ListTrashedCategories")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("query.page", page),
+		attribute.Int("query.per_page", perPage),
+	)
+
+	query := r.db.WithContext(ctx).Unscoped().Model(&domain.Category{}).Where("deleted_at IS NOT NULL")
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, mapPostgresError(err)
+	}
+
+	var categories []domain.Category
+	err := query.Order("deleted_at DESC, id DESC").
+		Offset((page - 1) * perPage).
+		Limit(perPage).
+		Find(&categories).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("categories.count", len(categories)))
+	span.SetStatus(codes.Ok, "trashed categories listed")
+	return categories, int(total), nil
+}
+
+// RestoreProduct takes the product out of the trash. It fails with
+// domain.ErrRestoreConflict when a live product took over its SKU meanwhile.
+func (r *TrashRepository) RestoreProduct(ctx context.Context, id uint) (*domain.Product, error) {
+	ctx, span := r.tracer.Start(ctx, "TrashRepository.RestoreProduct")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(id)))
+
+	var product domain.Product
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockTrashed(tx, &product, id, repository.ErrProductNotFound); err != nil {
+			return err
+		}
+
+		if err := tx.Unscoped().Model(&domain.Product{}).
+			Where("id = ?", id).
+			Update("deleted_at", nil).Error; err != nil {
+			return mapRestoreError(err)
+		}
+		product.DeletedAt = gorm.DeletedAt{}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "product restored")
+	return &product, nil
+}
+
+// RestoreCategory takes the category out of the trash. Its parent has to be
+// live, and no live sibling may have taken over its slug.
+func (r *TrashRepository) RestoreCategory(ctx context.Context, id uint) (*domain.Category, error) {
+	ctx, span := r.tracer.Start(ctx, "TrashRepository.RestoreCategory")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("category.id", int(id)))
+
+	var category domain.Category
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := lockTrashed(tx, &category, id, repository.ErrCategoryNotFound); err != nil {
+			return err
+		}
+
+		if category.ParentID != nil {
+			// Lock the parent so it cannot be trashed before the restore commits.
+			var parent domain.Category
+			err := tx.Clauses(clause.Locking{Strength: "SHARE"}).
+				Where("id = ?", *category.ParentID).
+				First(&parent).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrCategoryParentTrash
+			}
+			if err != nil {
+				return mapPostgresError(err)
+			}
+		}
+
+		if err := tx.Unscoped().Model(&domain.Category{}).
+			Where("id = ?", id).
+			Update("deleted_at", nil).Error; err != nil {
+			return mapRestoreError(err)
+		}
+		category.DeletedAt = gorm.DeletedAt{}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "category restored")
+	return &category, nil
+}
+
+// PurgeProduct permanently deletes a trashed product; its variants, stock,
+// images and reviews go with it through the foreign key cascades.
+func (r *TrashRepository) PurgeProduct(ctx context.Context, id uint) ([]domain.ProductImage, error) {
+	ctx, span := r.tracer.Start(ctx, "TrashRepository.PurgeProduct")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(id)))
+
+	var images []domain.ProductImage
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var product domain.Product
+		if err := lockTrashed(tx, &product, id, repository.ErrProductNotFound); err != nil {
+			return err
+		}
+
+		if err := tx.Where("product_id = ?", id).Find(&images).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		if err := tx.Unscoped().Delete(&domain.Product{}, id).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("images.count", len(images)))
+	span.SetStatus(codes.Ok, "product purged")
+	return images, nil
+}
+
+// PurgeCategory permanently deletes a trashed category. Categories that still
+// have children, trashed or not, are kept until the children are purged.
+func (r *TrashRepository) PurgeCategory(ctx context.Context, id uint) error {
+	ctx, span := r.tracer.Start(ctx, "TrashRepository.PurgeCategory")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("category.id", int(id)))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var category domain.Category
+		if err := lockTrashed(tx, &category, id, repository.ErrCategoryNotFound); err != nil {
+			return err
+		}
+
+		var children int64
+		if err := tx.Unscoped().Model(&domain.Category{}).
+			Where("parent_id = ?", id).
+			Count(&children).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		if children > 0 {
+			return domain.ErrCategoryHasChildren
+		}
+
+		if err := tx.Unscoped().Delete(&domain.Category{}, id).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "category purged")
+	return nil
+}
+
+func (r *TrashRepository) ListExpiredProductIDs(ctx context.Context, deletedBefore time.Time, limit int) ([]uint, error) {
+	ctx, span := r.tracer.Start(ctx, "TrashRepository.ListExpiredProductIDs")
+	defer span.End()
+
+	var ids []uint
+	err := r.db.WithContext(ctx).Unscoped().Model(&domain.Product{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore).
+		Order("deleted_at, id").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("products.count", len(ids)))
+	span.SetStatus(codes.Ok, "expired products listed")
+	return ids, nil
+}
+
+func (r *TrashRepository) ListExpiredCategoryIDs(ctx context.Context, deletedBefore time.Time, limit int) ([]uint, error) {
+	ctx, span := r.tracer.Start(ctx, "TrashRepository.ListExpiredCategoryIDs")
+	defer span.End()
+
+	// Deeper paths first, so a subtree is purged from the leaves up.
+	var ids []uint
+	err := r.db.WithContext(ctx).Unscoped().Model(&domain.Category{}).
+		Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore).
+		Order("length(path) DESC, deleted_at, id").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("categories.count", len(ids)))
+	span.SetStatus(codes.Ok, "expired categories listed")
+	return ids, nil
+}
+
+// lockTrashed loads and locks the row with the given id into dest, failing
+// with repository.ErrNotInTrash when the row is live and notFound when there
+// is no such row at all.
+func lockTrashed(tx *gorm.DB, dest any, id uint, notFound error) error {
+	err := tx.Unscoped().
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(dest).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return notFound
+		}
+		return mapPostgresError(err)
+	}
+
+	var deletedAt gorm.DeletedAt
+	switch row := dest.(type) {
+	case *domain.Product:
+		deletedAt = row.DeletedAt
+	case *domain.Category:
+		deletedAt = row.DeletedAt
+	}
+	if !deletedAt.Valid {
+		return repository.ErrNotInTrash
+	}
+	return nil
+}
+
+// mapRestoreError reports a unique violation while restoring as a conflict
+// with the live row that now owns the SKU or slug.
+func mapRestoreError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return domain.ErrRestoreConflict
+	}
+	return mapPostgresError(err)
+}
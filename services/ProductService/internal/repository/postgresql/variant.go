@@ -0,0 +1,263 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type VariantRepository struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+var _ domain.VariantRepository = (*VariantRepository)(nil)
+
+func NewVariantRepository(db *gorm.DB) *VariantRepository {
+	return &VariantRepository{
+		db:     db,
+		tracer: otel.Tracer("variant-repo"),
+	}
+}
+
+func (r *VariantRepository) CreateOption(ctx context.Context, option *domain.ProductOption) error {
+	ctx, span := r.tracer.Start(ctx, "VariantRepository.CreateOption")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(option.ProductID)),
+		attribute.String("option.name", option.Name),
+	)
+
+	if err := r.db.WithContext(ctx).Create(option).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("option.id", int(option.ID)))
+	span.SetStatus(codes.Ok, "option created")
+	return nil
+}
+
+func (r *VariantRepository) ListOptions(ctx context.Context, productID uint) ([]domain.ProductOption, error) {
+	return r.ListOptionsByProductIDs(ctx, []uint{productID})
+}
+
+func (r *VariantRepository) ListOptionsByProductIDs(ctx context.Context, productIDs []uint) ([]domain.ProductOption, error) {
+	ctx, span := r.tracer.Start(ctx, "VariantRepository.ListOptionsByProductIDs")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.ids.count", len(productIDs)))
+
+	var options []domain.ProductOption
+	err := r.db.WithContext(ctx).
+		Preload("Values", func(db *gorm.DB) *gorm.DB { return db.Order("position, id") }).
+		Where("product_id IN ?", productIDs).
+		Order("product_id, position, id").
+		Find(&options).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("options.count", len(options)))
+	span.SetStatus(codes.Ok, "options listed")
+	return options, nil
+}
+
+func (r *VariantRepository) DeleteOption(ctx context.Context, productID, optionID uint) error {
+	ctx, span := r.tracer.Start(ctx, "VariantRepository.DeleteOption")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(productID)),
+		attribute.Int("option.id", int(optionID)),
+	)
+
+	rowsAffected, err := gorm.G[domain.ProductOption](r.db).Where("id = ? AND product_id = ?", optionID, productID).Delete(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return mapPostgresError(err)
+	}
+	if rowsAffected == 0 {
+		span.SetStatus(codes.Error, repository.ErrOptionNotFound.Error())
+		return repository.ErrOptionNotFound
+	}
+
+	span.SetStatus(codes.Ok, "option deleted")
+	return nil
+}
+
+func (r *VariantRepository) CreateVariant(ctx context.Context, variant *domain.ProductVariant, optionValueIDs []uint) error {
+	ctx, span := r.tracer.Start(ctx, "VariantRepository.CreateVariant")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(variant.ProductID)),
+		attribute.String("variant.sku", variant.SKU),
+	)
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(variant).Error; err != nil {
+			return mapPostgresError(err)
+		}
+		if err := recordOpeningStock(tx, variant.ProductID, &variant.ID, domain.StockMovementInitial, variant.Quantity, "variant created"); err != nil {
+			return err
+		}
+
+		links := make([]domain.ProductVariantOptionValue, 0, len(optionValueIDs))
+		for _, valueID := range optionValueIDs {
+			links = append(links, domain.ProductVariantOptionValue{VariantID: variant.ID, OptionValueID: valueID})
+		}
+		if len(links) > 0 {
+			if err := tx.Create(&links).Error; err != nil {
+				return mapPostgresError(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetAttributes(attribute.Int("variant.id", int(variant.ID)))
+	span.SetStatus(codes.Ok, "variant created")
+	return nil
+}
+
+func (r *VariantRepository) GetVariantByID(ctx context.Context, id uint) (*domain.ProductVariant, error) {
+	ctx, span := r.tracer.Start(ctx, "VariantRepository.GetVariantByID")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("variant.id", int(id)))
+
+	var variant domain.ProductVariant
+	if err := r.db.WithContext(ctx).Preload("OptionValues").Where("id = ?", id).First(&variant).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			span.SetStatus(codes.Error, repository.ErrVariantNotFound.Error())
+			return nil, repository.ErrVariantNotFound
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetStatus(codes.Ok, "variant retrieved")
+	return &variant, nil
+}
+
+func (r *VariantRepository) UpdateVariant(ctx context.Context, id uint, variant *domain.ProductVariant) error {
+	ctx, span := r.tracer.Start(ctx, "VariantRepository.UpdateVariant")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("variant.id", int(id)))
+
+	// Select the columns explicitly so clearing the price or image override
+	// writes NULL instead of being skipped as a zero value.
+	result := r.db.WithContext(ctx).
+		Model(&domain.ProductVariant{}).
+		Where("id = ?", id).
+		Select("sku", "price", "quantity", "image_url").
+		Updates(variant)
+	if result.Error != nil {
+		span.RecordError(result.Error)
+		span.SetStatus(codes.Error, result.Error.Error())
+		return mapPostgresError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		span.SetStatus(codes.Error, repository.ErrVariantNotFound.Error())
+		return repository.ErrVariantNotFound
+	}
+
+	span.SetStatus(codes.Ok, "variant updated")
+	return nil
+}
+
+// DeleteVariant soft-deletes the variant and drops its option value links,
+// so the options of a product left without live variants can be deleted.
+func (r *VariantRepository) DeleteVariant(ctx context.Context, id uint) error {
+	ctx, span := r.tracer.Start(ctx, "VariantRepository.DeleteVariant")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("variant.id", int(id)))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		rowsAffected, err := gorm.G[domain.ProductVariant](tx).Where("id = ?", id).Delete(ctx)
+		if err != nil {
+			return mapPostgresError(err)
+		}
+		if rowsAffected == 0 {
+			return repository.ErrVariantNotFound
+		}
+
+		if _, err := gorm.G[domain.ProductVariantOptionValue](tx).Where("variant_id = ?", id).Delete(ctx); err != nil {
+			return mapPostgresError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "variant deleted")
+	return nil
+}
+
+func (r *VariantRepository) ListVariants(ctx context.Context, productID uint) ([]domain.ProductVariant, error) {
+	return r.ListVariantsByProductIDs(ctx, []uint{productID})
+}
+
+func (r *VariantRepository) ListVariantsByProductIDs(ctx context.Context, productIDs []uint) ([]domain.ProductVariant, error) {
+	ctx, span := r.tracer.Start(ctx, "VariantRepository.ListVariantsByProductIDs")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.ids.count", len(productIDs)))
+
+	var variants []domain.ProductVariant
+	err := r.db.WithContext(ctx).
+		Preload("OptionValues").
+		Where("product_id IN ?", productIDs).
+		Order("product_id, id").
+		Find(&variants).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("variants.count", len(variants)))
+	span.SetStatus(codes.Ok, "variants listed")
+	return variants, nil
+}
+
+func (r *VariantRepository) CountVariants(ctx context.Context, productID uint) (int, error) {
+	ctx, span := r.tracer.Start(ctx, "VariantRepository.CountVariants")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(productID)))
+
+	count, err := gorm.G[domain.ProductVariant](r.db).Where("product_id = ?", productID).Count(ctx, "*")
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, mapPostgresError(err)
+	}
+
+	span.SetStatus(codes.Ok, "variants counted")
+	return int(count), nil
+}
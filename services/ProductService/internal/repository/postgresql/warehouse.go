@@ -0,0 +1,193 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+type WarehouseRepository struct {
+	db     *gorm.DB
+	tracer trace.Tracer
+}
+
+var _ domain.WarehouseRepository = (*WarehouseRepository)(nil)
+
+func NewWarehouseRepository(db *gorm.DB) *WarehouseRepository {
+	return &WarehouseRepository{
+		db:     db,
+		tracer: otel.Tracer("warehouse-repo"),
+	}
+}
+
+func (r *WarehouseRepository) CreateWarehouse(ctx context.Context, warehouse *domain.Warehouse) error {
+	ctx, span := r.tracer.Start(ctx, "WarehouseRepository.CreateWarehouse")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("warehouse.code", warehouse.Code))
+
+	if err := gorm.G[domain.Warehouse](r.db).Create(ctx, warehouse); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("warehouse.id", int(warehouse.ID)))
+	span.SetStatus(codes.Ok, "warehouse created")
+	return nil
+}
+
+func (r *WarehouseRepository) GetWarehouseByID(ctx context.Context, id uint) (*domain.Warehouse, error) {
+	ctx, span := r.tracer.Start(ctx, "WarehouseRepository.GetWarehouseByID")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("warehouse.id", int(id)))
+
+	warehouse, err := gorm.G[domain.Warehouse](r.db).Where("id = ?", id).First(ctx)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			span.SetStatus(codes.Error, repository.ErrWarehouseNotFound.Error())
+			return nil, repository.ErrWarehouseNotFound
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetStatus(codes.Ok, "warehouse retrieved")
+	return &warehouse, nil
+}
+
+func (r *WarehouseRepository) ListWarehouses(ctx context.Context, activeOnly bool) ([]domain.Warehouse, error) {
+	ctx, span := r.tracer.Start(ctx, "WarehouseRepository.ListWarehouses")
+	defer span.End()
+
+	span.SetAttributes(attribute.Bool("query.active_only", activeOnly))
+
+	query := r.db.WithContext(ctx).Model(&domain.Warehouse{})
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+
+	var warehouses []domain.Warehouse
+	if err := query.Order("priority, id").Find(&warehouses).Error; err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("warehouses.count", len(warehouses)))
+	span.SetStatus(codes.Ok, "warehouses listed")
+	return warehouses, nil
+}
+
+func (r *WarehouseRepository) UpdateWarehouse(ctx context.Context, id uint, warehouse *domain.Warehouse) error {
+	ctx, span := r.tracer.Start(ctx, "WarehouseRepository.UpdateWarehouse")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("warehouse.id", int(id)))
+
+	// Select the columns explicitly so deactivating a warehouse or clearing
+	// its address is written instead of being skipped as a zero value.
+	result := r.db.WithContext(ctx).
+		Model(&domain.Warehouse{}).
+		Where("id = ?", id).
+		Select("code", "name", "country", "state", "city", "zip_code", "priority", "is_active").
+		Updates(warehouse)
+	if result.Error != nil {
+		span.RecordError(result.Error)
+		span.SetStatus(codes.Error, result.Error.Error())
+		return mapPostgresError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		span.SetStatus(codes.Error, repository.ErrWarehouseNotFound.Error())
+		return repository.ErrWarehouseNotFound
+	}
+
+	span.SetStatus(codes.Ok, "warehouse updated")
+	return nil
+}
+
+// DeleteWarehouse removes an empty warehouse together with its zeroed stock
+// rows. Warehouses that still hold stock must be emptied by transfers first.
+func (r *WarehouseRepository) DeleteWarehouse(ctx context.Context, id uint) error {
+	ctx, span := r.tracer.Start(ctx, "WarehouseRepository.DeleteWarehouse")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("warehouse.id", int(id)))
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var stocked int64
+		err := tx.Model(&domain.WarehouseStock{}).
+			Where("warehouse_id = ? AND quantity > 0", id).
+			Count(&stocked).Error
+		if err != nil {
+			return mapPostgresError(err)
+		}
+		if stocked > 0 {
+			return domain.ErrWarehouseHasStock
+		}
+
+		result := tx.Where("id = ?", id).Delete(&domain.Warehouse{})
+		if result.Error != nil {
+			return mapPostgresError(result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return repository.ErrWarehouseNotFound
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "warehouse deleted")
+	return nil
+}
+
+// ListStockLevels returns the per-warehouse stock rows of the given products
+// and variants. A key without VariantID matches product-level stock only.
+func (r *WarehouseRepository) ListStockLevels(ctx context.Context, keys []domain.StockKey) ([]domain.WarehouseStock, error) {
+	ctx, span := r.tracer.Start(ctx, "WarehouseRepository.ListStockLevels")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("stock.keys.count", len(keys)))
+
+	if len(keys) == 0 {
+		span.SetStatus(codes.Ok, "no stock keys")
+		return nil, nil
+	}
+
+	conditions := r.db.Where("1 = 0")
+	for _, key := range keys {
+		if key.VariantID != nil {
+			conditions = conditions.Or("product_id = ? AND variant_id = ?", key.ProductID, *key.VariantID)
+		} else {
+			conditions = conditions.Or("product_id = ? AND variant_id IS NULL", key.ProductID)
+		}
+	}
+
+	var levels []domain.WarehouseStock
+	err := r.db.WithContext(ctx).
+		Where(conditions).
+		Order("warehouse_id, product_id, variant_id").
+		Find(&levels).Error
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, mapPostgresError(err)
+	}
+
+	span.SetAttributes(attribute.Int("stock.levels.count", len(levels)))
+	span.SetStatus(codes.Ok, "stock levels listed")
+	return levels, nil
+}
@@ -0,0 +1,89 @@
+package localStorage
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+)
+
+var _ domain.BlobStore = (*BlobStore)(nil)
+
+// BlobStore keeps media on the local filesystem under root. It suits
+// development and single-instance deployments; Handler serves the files.
+type BlobStore struct {
+	root    string
+	baseURL string
+}
+
+func NewBlobStore(root, baseURL string) (*BlobStore, error) {
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, err
+	}
+	return &BlobStore{
+		root:    root,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}, nil
+}
+
+func (s *BlobStore) Put(ctx context.Context, key string, data []byte, contentType string) (string, error) {
+	target := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return "", err
+	}
+
+	// Write to a temporary file first so readers never see a partial file.
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return "", err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+
+	return s.baseURL + "/" + key, nil
+}
+
+func (s *BlobStore) Delete(ctx context.Context, key string) error {
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Handler serves the stored files by key, without directory listings.
+func (s *BlobStore) Handler() http.Handler {
+	files := http.FileServer(http.Dir(s.root))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/") {
+			http.NotFound(w, r)
+			return
+		}
+		files.ServeHTTP(w, r)
+	})
+}
+
+func (s *BlobStore) path(key string) string {
+	// Clean as a rooted path so a key can never leave the root directory.
+	return filepath.Join(s.root, filepath.FromSlash(path.Clean("/"+key)))
+}
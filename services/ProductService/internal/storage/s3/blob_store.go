@@ -0,0 +1,214 @@
+package s3Storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+)
+
+const (
+	signingAlgorithm = "AWS4-HMAC-SHA256"
+	amzDateFormat    = "20060102T150405Z"
+	requestTimeout   = 30 * time.Second
+)
+
+var _ domain.BlobStore = (*BlobStore)(nil)
+
+type Config struct {
+	// Endpoint is the S3 API base URL, e.g. https://s3.eu-west-1.amazonaws.com
+	// or the URL of a MinIO server.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// UsePathStyle addresses objects as endpoint/bucket/key instead of
+	// bucket.endpoint/key; most self-hosted S3-compatible servers need it.
+	UsePathStyle bool
+	// PublicBaseURL is where stored objects are served from, such as a CDN.
+	// It defaults to the object URL on the endpoint.
+	PublicBaseURL string
+}
+
+// BlobStore keeps media in an S3-compatible bucket, signing requests with
+// AWS Signature Version 4. Objects are written without an ACL, so the bucket
+// policy or a CDN in front of it decides how they are served.
+type BlobStore struct {
+	cfg      Config
+	endpoint *url.URL
+	client   *http.Client
+}
+
+func NewBlobStore(cfg Config) (*BlobStore, error) {
+	endpoint, err := url.Parse(strings.TrimRight(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("invalid s3 endpoint %q", cfg.Endpoint)
+	}
+	if cfg.Bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+
+	cfg.PublicBaseURL = strings.TrimRight(cfg.PublicBaseURL, "/")
+	return &BlobStore{
+		cfg:      cfg,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+func (s *BlobStore) Put(ctx context.Context, key string, data []byte, contentType string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key).String(), bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	req.ContentLength = int64(len(data))
+	req.Header.Set("Content-Type", contentType)
+	s.sign(req, data, time.Now().UTC())
+
+	if err := s.do(req, http.StatusOK); err != nil {
+		return "", fmt.Errorf("s3 put %s: %w", key, err)
+	}
+	return s.publicURL(key), nil
+}
+
+func (s *BlobStore) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key).String(), nil)
+	if err != nil {
+		return err
+	}
+	s.sign(req, nil, time.Now().UTC())
+
+	// Deleting a missing object is not an error.
+	if err := s.do(req, http.StatusNoContent, http.StatusOK, http.StatusNotFound); err != nil {
+		return fmt.Errorf("s3 delete %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *BlobStore) do(req *http.Request, expected ...int) error {
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	for _, code := range expected {
+		if resp.StatusCode == code {
+			io.Copy(io.Discard, resp.Body)
+			return nil
+		}
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+func (s *BlobStore) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	if s.cfg.UsePathStyle {
+		u.Path = strings.TrimRight(u.Path, "/") + "/" + s.cfg.Bucket + "/" + key
+	} else {
+		u.Host = s.cfg.Bucket + "." + u.Host
+		u.Path = strings.TrimRight(u.Path, "/") + "/" + key
+	}
+	u.RawPath = uriEncodePath(u.Path)
+	return &u
+}
+
+func (s *BlobStore) publicURL(key string) string {
+	if s.cfg.PublicBaseURL != "" {
+		return s.cfg.PublicBaseURL + "/" + key
+	}
+	return s.objectURL(key).String()
+}
+
+// sign adds the Signature Version 4 headers for a request without a query
+// string, which covers the object PUT and DELETE calls made here.
+func (s *BlobStore) sign(req *http.Request, payload []byte, now time.Time) {
+	amzDate := now.Format(amzDateFormat)
+	date := amzDate[:8]
+	payloadHash := sha256Hex(payload)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": payloadHash,
+		"x-amz-date":           amzDate,
+	}
+	signedHeaders := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	if contentType := req.Header.Get("Content-Type"); contentType != "" {
+		headers["content-type"] = contentType
+		signedHeaders = []string{"content-type", "host", "x-amz-content-sha256", "x-amz-date"}
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, name := range signedHeaders {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		"",
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.cfg.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		signingAlgorithm,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.cfg.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		signingAlgorithm, s.cfg.AccessKeyID, scope, strings.Join(signedHeaders, ";"), signature))
+}
+
+// uriEncodePath percent-encodes everything except slashes and the RFC 3986
+// unreserved characters, as Signature Version 4 requires.
+func uriEncodePath(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
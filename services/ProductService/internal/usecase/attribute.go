@@ -0,0 +1,516 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// attributeCodePattern keeps codes usable as query parameter names.
+var attributeCodePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+type AttributeUsecase struct {
+	attributeRepo domain.AttributeRepository
+	categoryRepo  domain.CategoryRepository
+	productRepo   domain.ProductRepository
+	productCache  domain.ProductCache
+	tracer        trace.Tracer
+}
+
+var _ domain.AttributeUsecase = (*AttributeUsecase)(nil)
+
+func NewAttributeUsecase(attributeRepo domain.AttributeRepository, categoryRepo domain.CategoryRepository, productRepo domain.ProductRepository, productCache domain.ProductCache) *AttributeUsecase {
+	return &AttributeUsecase{
+		attributeRepo: attributeRepo,
+		categoryRepo:  categoryRepo,
+		productRepo:   productRepo,
+		productCache:  productCache,
+		tracer:        otel.Tracer("attribute-usecase"),
+	}
+}
+
+func (u *AttributeUsecase) CreateAttribute(ctx context.Context, req *dto.CreateAttributeRequest) (*dto.AttributeResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "AttributeUsecase.CreateAttribute")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("attribute.code", req.Code),
+		attribute.String("attribute.type", req.Type),
+	)
+
+	if !attributeCodePattern.MatchString(req.Code) {
+		err := domain.ErrInvalidAttributeCode
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	attrType := domain.AttributeType(req.Type)
+	if (attrType == domain.AttributeTypeEnum) != (len(req.Options) > 0) {
+		err := domain.ErrInvalidAttributeOptions
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	attr := &domain.AttributeDefinition{
+		Code:    req.Code,
+		Name:    strings.TrimSpace(req.Name),
+		Type:    attrType,
+		Unit:    req.Unit,
+		Options: req.Options,
+	}
+	if attr.Options == nil {
+		attr.Options = []string{}
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.CreateAttribute")
+	if err := u.attributeRepo.CreateAttribute(ctx, attr); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	response := mapAttributeToResponse(attr)
+	span.SetStatus(codes.Ok, "Attribute created")
+	return &response, nil
+}
+
+func (u *AttributeUsecase) ListAttributes(ctx context.Context, categoryID uint) ([]dto.AttributeResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "AttributeUsecase.ListAttributes")
+	defer span.End()
+
+	if categoryID == 0 {
+		attrs, err := u.attributeRepo.ListAttributes(ctx)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+
+		response := make([]dto.AttributeResponse, 0, len(attrs))
+		for i := range attrs {
+			response = append(response, mapAttributeToResponse(&attrs[i]))
+		}
+		span.SetAttributes(attribute.Int("attributes.count", len(response)))
+		span.SetStatus(codes.Ok, "Attributes listed")
+		return response, nil
+	}
+
+	span.SetAttributes(attribute.Int("category.id", int(categoryID)))
+
+	response, err := u.categoryAttributes(ctx, categoryID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("attributes.count", len(response)))
+	span.SetStatus(codes.Ok, "Category attributes listed")
+	return response, nil
+}
+
+// DeleteAttribute removes the attribute together with its category
+// attachments and product values.
+func (u *AttributeUsecase) DeleteAttribute(ctx context.Context, id uint) error {
+	ctx, span := u.tracer.Start(ctx, "AttributeUsecase.DeleteAttribute")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("attribute.id", int(id)))
+
+	productIDs, err := u.attributeRepo.DeleteAttribute(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	u.invalidateProducts(ctx, productIDs)
+	// Cached pages filtered by the attribute have to go as well.
+	invalidateProductLists(ctx, u.tracer, u.productCache)
+
+	span.SetAttributes(attribute.Int("products.count", len(productIDs)))
+	span.SetStatus(codes.Ok, "Attribute deleted")
+	return nil
+}
+
+// AttachCategoryAttribute attaches an attribute to a category and returns
+// every attribute now applying to it.
+func (u *AttributeUsecase) AttachCategoryAttribute(ctx context.Context, req *dto.AttachCategoryAttributeRequest) ([]dto.AttributeResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "AttributeUsecase.AttachCategoryAttribute")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("category.id", int(req.CategoryID)),
+		attribute.Int("attribute.id", int(req.AttributeID)),
+	)
+
+	link := &domain.CategoryAttribute{
+		CategoryID:  req.CategoryID,
+		AttributeID: req.AttributeID,
+		Required:    req.Required,
+		Position:    req.Position,
+	}
+	if err := u.attributeRepo.AttachCategoryAttribute(ctx, link); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response, err := u.categoryAttributes(ctx, req.CategoryID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Attribute attached")
+	return response, nil
+}
+
+// DetachCategoryAttribute stops the attribute from applying to the category.
+// Values already set on products are kept.
+func (u *AttributeUsecase) DetachCategoryAttribute(ctx context.Context, categoryID, attributeID uint) error {
+	ctx, span := u.tracer.Start(ctx, "AttributeUsecase.DetachCategoryAttribute")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("category.id", int(categoryID)),
+		attribute.Int("attribute.id", int(attributeID)),
+	)
+
+	if err := u.attributeRepo.DetachCategoryAttribute(ctx, categoryID, attributeID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Attribute detached")
+	return nil
+}
+
+// SetProductAttributes replaces the attribute values of a product. Every
+// value must belong to an attribute of the product's categories and parse as
+// its type, and every required attribute must be given.
+func (u *AttributeUsecase) SetProductAttributes(ctx context.Context, req *dto.SetProductAttributesRequest) ([]dto.ProductAttributeResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "AttributeUsecase.SetProductAttributes")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(req.ProductID)),
+		attribute.Int("values.count", len(req.Values)),
+	)
+
+	if _, err := u.productRepo.GetProductByID(ctx, req.ProductID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	applicable, err := u.attributeRepo.ListApplicableAttributes(ctx, req.ProductID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	byCode := make(map[string]*domain.CategoryAttributeDefinition, len(applicable))
+	for i := range applicable {
+		byCode[applicable[i].Code] = &applicable[i]
+	}
+
+	values := make([]domain.ProductAttributeValue, 0, len(req.Values))
+	given := make(map[string]struct{}, len(req.Values))
+	for _, v := range req.Values {
+		def, ok := byCode[v.Code]
+		if !ok {
+			err := fmt.Errorf("%w: %s", domain.ErrAttributeNotApplicable, v.Code)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		value, err := parseAttributeValue(&def.AttributeDefinition, v.Value)
+		if err != nil {
+			err = fmt.Errorf("%w: %s", err, v.Code)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		value.ProductID = req.ProductID
+		values = append(values, value)
+		given[v.Code] = struct{}{}
+	}
+
+	var missing []string
+	for _, def := range applicable {
+		if _, ok := given[def.Code]; def.Required && !ok {
+			missing = append(missing, def.Code)
+		}
+	}
+	if len(missing) > 0 {
+		err := fmt.Errorf("%w: %s", domain.ErrMissingRequiredAttributes, strings.Join(missing, ", "))
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.SetProductAttributes")
+	if err := u.attributeRepo.SetProductAttributes(ctx, req.ProductID, values); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	u.invalidateProducts(ctx, []uint{req.ProductID})
+
+	response := make([]dto.ProductAttributeResponse, 0, len(values))
+	for i := range values {
+		values[i].Attribute = byCode[req.Values[i].Code].AttributeDefinition
+		response = append(response, mapAttributeValueToResponse(&values[i]))
+	}
+	sort.Slice(response, func(i, j int) bool { return response[i].Name < response[j].Name })
+
+	span.SetStatus(codes.Ok, "Product attributes set")
+	return response, nil
+}
+
+func (u *AttributeUsecase) categoryAttributes(ctx context.Context, categoryID uint) ([]dto.AttributeResponse, error) {
+	if _, err := u.categoryRepo.GetCategoryByID(ctx, categoryID); err != nil {
+		return nil, err
+	}
+
+	attrs, err := u.attributeRepo.ListCategoryAttributes(ctx, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]dto.AttributeResponse, 0, len(attrs))
+	for i := range attrs {
+		mapped := mapAttributeToResponse(&attrs[i].AttributeDefinition)
+		mapped.CategoryID = &attrs[i].CategoryID
+		mapped.Required = attrs[i].Required
+		mapped.Position = attrs[i].Position
+		response = append(response, mapped)
+	}
+	return response, nil
+}
+
+func (u *AttributeUsecase) invalidateProducts(ctx context.Context, productIDs []uint) {
+	_, cacheSpan := u.tracer.Start(ctx, "Cache.DeleteProduct")
+	defer cacheSpan.End()
+
+	for _, id := range productIDs {
+		if err := u.productCache.DeleteProduct(ctx, id); err != nil {
+			cacheSpan.RecordError(err)
+			logger.Warnf("Failed to delete product from cache: %v", err)
+		}
+	}
+}
+
+// attachAttributes fills in the attribute values of every product in place,
+// loading them for the whole page in one query.
+func attachAttributes(ctx context.Context, attributeRepo domain.AttributeRepository, products []dto.ProductResponse) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(products))
+	byID := make(map[uint]*dto.ProductResponse, len(products))
+	for i := range products {
+		ids = append(ids, products[i].Id)
+		byID[products[i].Id] = &products[i]
+	}
+
+	values, err := attributeRepo.ListValuesByProductIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for i := range values {
+		if product, ok := byID[values[i].ProductID]; ok {
+			product.Attributes = append(product.Attributes, mapAttributeValueToResponse(&values[i]))
+		}
+	}
+	return nil
+}
+
+// resolveAttributeFilters looks up the attributes named by the filters and
+// parses the filter values as their types. The result is ordered by
+// attribute, so equal filters given in any order share cached pages.
+func resolveAttributeFilters(ctx context.Context, attributeRepo domain.AttributeRepository, reqs []dto.AttributeFilterRequest) ([]domain.AttributeFilter, error) {
+	if len(reqs) == 0 {
+		return nil, nil
+	}
+
+	attrCodes := make([]string, 0, len(reqs))
+	for _, req := range reqs {
+		attrCodes = append(attrCodes, req.Code)
+	}
+	attrs, err := attributeRepo.GetAttributesByCodes(ctx, attrCodes)
+	if err != nil {
+		return nil, err
+	}
+	byCode := make(map[string]*domain.AttributeDefinition, len(attrs))
+	for i := range attrs {
+		byCode[attrs[i].Code] = &attrs[i]
+	}
+
+	filters := make([]domain.AttributeFilter, 0, len(reqs))
+	for _, req := range reqs {
+		def, ok := byCode[req.Code]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", repository.ErrAttributeNotFound, req.Code)
+		}
+		filter, err := parseAttributeFilter(def, req)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", err, req.Code)
+		}
+		filters = append(filters, filter)
+	}
+
+	sort.SliceStable(filters, func(i, j int) bool { return filters[i].AttributeID < filters[j].AttributeID })
+	return filters, nil
+}
+
+func parseAttributeFilter(def *domain.AttributeDefinition, req dto.AttributeFilterRequest) (domain.AttributeFilter, error) {
+	filter := domain.AttributeFilter{AttributeID: def.ID, Type: def.Type}
+	if def.Type != domain.AttributeTypeNumber && (req.Min != nil || req.Max != nil) {
+		return filter, domain.ErrInvalidAttributeFilter
+	}
+
+	switch def.Type {
+	case domain.AttributeTypeNumber:
+		if req.Min != nil && req.Max != nil && *req.Min > *req.Max {
+			return filter, domain.ErrInvalidAttributeFilter
+		}
+		filter.Min, filter.Max = req.Min, req.Max
+		for _, raw := range req.Values {
+			number, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+			if err != nil {
+				return filter, domain.ErrInvalidAttributeFilter
+			}
+			filter.Numbers = append(filter.Numbers, number)
+		}
+	case domain.AttributeTypeBoolean:
+		if len(req.Values) > 1 {
+			return filter, domain.ErrInvalidAttributeFilter
+		}
+		if len(req.Values) == 1 {
+			b, err := strconv.ParseBool(strings.TrimSpace(req.Values[0]))
+			if err != nil {
+				return filter, domain.ErrInvalidAttributeFilter
+			}
+			filter.Bool = &b
+		}
+	case domain.AttributeTypeEnum:
+		for _, raw := range req.Values {
+			if !slices.Contains(def.Options, raw) {
+				return filter, domain.ErrInvalidAttributeFilter
+			}
+		}
+		filter.Texts = req.Values
+	default:
+		filter.Texts = req.Values
+	}
+	return filter, nil
+}
+
+// attributeFiltersName suffixes the name of a cached list page with a digest
+// of its attribute filters.
+func attributeFiltersName(filters []domain.AttributeFilter) string {
+	if len(filters) == 0 {
+		return ""
+	}
+	data, _ := json.Marshal(filters)
+	sum := sha256.Sum256(data)
+	return ":attributes=" + hex.EncodeToString(sum[:16])
+}
+
+// parseAttributeValue stores raw in the column matching the attribute type.
+func parseAttributeValue(def *domain.AttributeDefinition, raw string) (domain.ProductAttributeValue, error) {
+	value := domain.ProductAttributeValue{AttributeID: def.ID}
+	raw = strings.TrimSpace(raw)
+
+	switch def.Type {
+	case domain.AttributeTypeNumber:
+		number, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return value, domain.ErrInvalidAttributeValue
+		}
+		value.NumberValue = &number
+	case domain.AttributeTypeBoolean:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return value, domain.ErrInvalidAttributeValue
+		}
+		value.BoolValue = &b
+	case domain.AttributeTypeEnum:
+		if !slices.Contains(def.Options, raw) {
+			return value, domain.ErrInvalidAttributeValue
+		}
+		value.TextValue = &raw
+	default:
+		if raw == "" {
+			return value, domain.ErrInvalidAttributeValue
+		}
+		value.TextValue = &raw
+	}
+	return value, nil
+}
+
+// formatAttributeValue renders the typed value back to its string form.
+func formatAttributeValue(value *domain.ProductAttributeValue) string {
+	switch {
+	case value.NumberValue != nil:
+		return strconv.FormatFloat(*value.NumberValue, 'f', -1, 64)
+	case value.BoolValue != nil:
+		return strconv.FormatBool(*value.BoolValue)
+	case value.TextValue != nil:
+		return *value.TextValue
+	}
+	return ""
+}
+
+func mapAttributeToResponse(attr *domain.AttributeDefinition) dto.AttributeResponse {
+	return dto.AttributeResponse{
+		ID:      attr.ID,
+		Code:    attr.Code,
+		Name:    attr.Name,
+		Type:    string(attr.Type),
+		Unit:    attr.Unit,
+		Options: attr.Options,
+	}
+}
+
+func mapAttributeValueToResponse(value *domain.ProductAttributeValue) dto.ProductAttributeResponse {
+	return dto.ProductAttributeResponse{
+		Code:  value.Attribute.Code,
+		Name:  value.Attribute.Name,
+		Type:  string(value.Attribute.Type),
+		Unit:  value.Attribute.Unit,
+		Value: formatAttributeValue(value),
+	}
+}
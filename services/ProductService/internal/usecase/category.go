@@ -2,10 +2,14 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"unicode"
 
 	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
 	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 )
@@ -13,14 +17,22 @@ import (
 var _ domain.CategoryUsecase = (*CategoryUsecase)(nil)
 
 type CategoryUsecase struct {
-	categoryRepo domain.CategoryRepository
-	tracer       trace.Tracer
+	categoryRepo  domain.CategoryRepository
+	productRepo   domain.ProductRepository
+	variantRepo   domain.VariantRepository
+	attributeRepo domain.AttributeRepository
+	productCache  domain.ProductCache
+	tracer        trace.Tracer
 }
 
-func NewCategoryUsecase(categoryRepo domain.CategoryRepository) *CategoryUsecase {
+func NewCategoryUsecase(categoryRepo domain.CategoryRepository, productRepo domain.ProductRepository, variantRepo domain.VariantRepository, attributeRepo domain.AttributeRepository, productCache domain.ProductCache) *CategoryUsecase {
 	return &CategoryUsecase{
-		categoryRepo: categoryRepo,
-		tracer:       otel.Tracer("CategoryUsecase"),
+		categoryRepo:  categoryRepo,
+		productRepo:   productRepo,
+		variantRepo:   variantRepo,
+		attributeRepo: attributeRepo,
+		productCache:  productCache,
+		tracer:        otel.Tracer("CategoryUsecase"),
 	}
 }
 
@@ -28,8 +40,21 @@ func (u *CategoryUsecase) CreateCategory(ctx context.Context, categoryDTO *dto.C
 	ctx, span := u.tracer.Start(ctx, "CreateCategory")
 	defer span.End()
 
+	slug := slugify(categoryDTO.Name)
+	if categoryDTO.Slug != nil && *categoryDTO.Slug != "" {
+		slug = slugify(*categoryDTO.Slug)
+	}
+	if slug == "" {
+		err := domain.ErrEmptyCategorySlug
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid category slug")
+		return err
+	}
+
 	category := &domain.Category{
+		ParentID:    categoryDTO.ParentID,
 		Name:        categoryDTO.Name,
+		Slug:        slug,
 		Description: categoryDTO.Description,
 	}
 
@@ -55,11 +80,8 @@ func (u *CategoryUsecase) GetCategoryByID(ctx context.Context, id uint) (*dto.Ca
 	}
 
 	span.SetStatus(codes.Ok, "category retrieved successfully")
-	return &dto.CategoryResponse{
-		Id:          category.ID,
-		Name:        category.Name,
-		Description: category.Description,
-	}, nil
+	response := mapCategoryToResponse(category)
+	return &response, nil
 }
 
 func (u *CategoryUsecase) ListCategories(ctx context.Context, page, perPage int) ([]dto.CategoryResponse, int, error) {
@@ -74,12 +96,8 @@ func (u *CategoryUsecase) ListCategories(ctx context.Context, page, perPage int)
 	}
 
 	var categoryResponses []dto.CategoryResponse
-	for _, category := range categories {
-		categoryResponses = append(categoryResponses, dto.CategoryResponse{
-			Id:          category.ID,
-			Name:        category.Name,
-			Description: category.Description,
-		})
+	for i := range categories {
+		categoryResponses = append(categoryResponses, mapCategoryToResponse(&categories[i]))
 	}
 
 	span.SetStatus(codes.Ok, "categories listed successfully")
@@ -94,6 +112,15 @@ func (u *CategoryUsecase) UpdateCategory(ctx context.Context, id uint, categoryD
 		Name:        *categoryDTO.Name,
 		Description: categoryDTO.Description,
 	}
+	if categoryDTO.Slug != nil && *categoryDTO.Slug != "" {
+		category.Slug = slugify(*categoryDTO.Slug)
+		if category.Slug == "" {
+			err := domain.ErrEmptyCategorySlug
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "invalid category slug")
+			return err
+		}
+	}
 
 	err := u.categoryRepo.UpdateCategory(ctx, id, category)
 	if err != nil {
@@ -102,6 +129,21 @@ func (u *CategoryUsecase) UpdateCategory(ctx context.Context, id uint, categoryD
 		return err
 	}
 
+	if categoryDTO.ParentID != nil {
+		var parentID *uint
+		if *categoryDTO.ParentID > 0 {
+			parentID = categoryDTO.ParentID
+		}
+		if err := u.categoryRepo.MoveCategory(ctx, id, parentID); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, "failed to move category")
+			return err
+		}
+	}
+
+	// Search facets carry category names and category listings follow the tree.
+	invalidateProductLists(ctx, u.tracer, u.productCache)
+
 	span.SetStatus(codes.Ok, "category updated successfully")
 	return nil
 }
@@ -110,13 +152,239 @@ func (u *CategoryUsecase) DeleteCategory(ctx context.Context, id uint) error {
 	ctx, span := u.tracer.Start(ctx, "DeleteCategory")
 	defer span.End()
 
-	err := u.categoryRepo.DeleteCategory(ctx, id)
+	children, err := u.categoryRepo.CountChildren(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to count child categories")
+		return err
+	}
+	if children > 0 {
+		err := domain.ErrCategoryHasChildren
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	err = u.categoryRepo.DeleteCategory(ctx, id)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, "failed to delete category")
 		return err
 	}
 
+	invalidateProductLists(ctx, u.tracer, u.productCache)
+
 	span.SetStatus(codes.Ok, "category deleted successfully")
 	return nil
 }
+
+func (u *CategoryUsecase) GetCategoryTree(ctx context.Context) ([]dto.CategoryTreeNode, error) {
+	ctx, span := u.tracer.Start(ctx, "GetCategoryTree")
+	defer span.End()
+
+	categories, err := u.categoryRepo.ListAllCategories(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to load categories")
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("categories.count", len(categories)))
+	span.SetStatus(codes.Ok, "category tree built successfully")
+	return buildCategoryTree(categories), nil
+}
+
+func (u *CategoryUsecase) AssignProductCategories(ctx context.Context, req *dto.AssignProductCategoriesRequest) (*dto.ProductCategoriesResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "AssignProductCategories")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(req.ProductID)),
+		attribute.Int("categories.count", len(req.CategoryIDs)),
+	)
+
+	if _, err := u.productRepo.GetProductByID(ctx, req.ProductID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "product not found")
+		return nil, err
+	}
+
+	if err := u.categoryRepo.AssignProductCategories(ctx, req.ProductID, req.CategoryIDs); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to assign categories")
+		return nil, err
+	}
+
+	invalidateProductLists(ctx, u.tracer, u.productCache)
+
+	response, err := u.productCategories(ctx, req.ProductID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to list product categories")
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "categories assigned successfully")
+	return response, nil
+}
+
+func (u *CategoryUsecase) UnassignProductCategories(ctx context.Context, req *dto.UnassignProductCategoriesRequest) (*dto.ProductCategoriesResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "UnassignProductCategories")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(req.ProductID)),
+		attribute.Int("categories.count", len(req.CategoryIDs)),
+	)
+
+	if err := u.categoryRepo.UnassignProductCategories(ctx, req.ProductID, req.CategoryIDs); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to unassign categories")
+		return nil, err
+	}
+
+	invalidateProductLists(ctx, u.tracer, u.productCache)
+
+	response, err := u.productCategories(ctx, req.ProductID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to list product categories")
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "categories unassigned successfully")
+	return response, nil
+}
+
+func (u *CategoryUsecase) ListProductsByCategory(ctx context.Context, categoryID uint, includeDescendants bool, page, perPage int, attributes []dto.AttributeFilterRequest) ([]dto.ProductResponse, int, error) {
+	ctx, span := u.tracer.Start(ctx, "ListProductsByCategory")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("category.id", int(categoryID)),
+		attribute.Bool("category.include_descendants", includeDescendants),
+	)
+
+	if _, err := u.categoryRepo.GetCategoryByID(ctx, categoryID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "category not found")
+		return nil, 0, err
+	}
+
+	filters, err := resolveAttributeFilters(ctx, u.attributeRepo, attributes)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "invalid attribute filters")
+		return nil, 0, err
+	}
+
+	name := fmt.Sprintf("category=%d:descendants=%t:page=%d:per_page=%d", categoryID, includeDescendants, page, perPage) + attributeFiltersName(filters)
+	list, err := cachedProductList(ctx, u.tracer, u.productCache, name, func() (dto.ProductListPage, error) {
+		products, total, err := u.productRepo.ListProductsByCategory(ctx, categoryID, includeDescendants, page, perPage, filters)
+		if err != nil {
+			return dto.ProductListPage{}, err
+		}
+
+		response := make([]dto.ProductResponse, len(products))
+		for i := range products {
+			response[i] = mapProductToResponse(&products[i])
+		}
+
+		if err := attachVariantMatrix(ctx, u.variantRepo, response); err != nil {
+			return dto.ProductListPage{}, err
+		}
+		if err := attachAttributes(ctx, u.attributeRepo, response); err != nil {
+			return dto.ProductListPage{}, err
+		}
+		return dto.ProductListPage{Products: response, TotalCount: total}, nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "failed to list products by category")
+		return nil, 0, err
+	}
+
+	span.SetAttributes(attribute.Int("products.count", len(list.Products)))
+	span.SetStatus(codes.Ok, "products listed by category")
+	return list.Products, list.TotalCount, nil
+}
+
+func (u *CategoryUsecase) productCategories(ctx context.Context, productID uint) (*dto.ProductCategoriesResponse, error) {
+	categories, err := u.categoryRepo.ListProductCategories(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.ProductCategoriesResponse{
+		ProductID:  productID,
+		Categories: make([]dto.CategoryResponse, 0, len(categories)),
+	}
+	for i := range categories {
+		response.Categories = append(response.Categories, mapCategoryToResponse(&categories[i]))
+	}
+	return response, nil
+}
+
+// buildCategoryTree nests categories under their parents. Categories must be
+// ordered by path so every parent is seen before its children.
+func buildCategoryTree(categories []domain.Category) []dto.CategoryTreeNode {
+	childrenOf := make(map[uint][]uint, len(categories))
+	byID := make(map[uint]*domain.Category, len(categories))
+	var roots []uint
+	for i := range categories {
+		c := &categories[i]
+		byID[c.ID] = c
+		if c.ParentID == nil {
+			roots = append(roots, c.ID)
+			continue
+		}
+		childrenOf[*c.ParentID] = append(childrenOf[*c.ParentID], c.ID)
+	}
+
+	var build func(id uint) dto.CategoryTreeNode
+	build = func(id uint) dto.CategoryTreeNode {
+		node := dto.CategoryTreeNode{
+			CategoryResponse: mapCategoryToResponse(byID[id]),
+			Children:         make([]dto.CategoryTreeNode, 0, len(childrenOf[id])),
+		}
+		for _, childID := range childrenOf[id] {
+			node.Children = append(node.Children, build(childID))
+		}
+		return node
+	}
+
+	tree := make([]dto.CategoryTreeNode, 0, len(roots))
+	for _, id := range roots {
+		tree = append(tree, build(id))
+	}
+	return tree
+}
+
+func mapCategoryToResponse(category *domain.Category) dto.CategoryResponse {
+	return dto.CategoryResponse{
+		Id:          category.ID,
+		ParentID:    category.ParentID,
+		Name:        category.Name,
+		Slug:        category.Slug,
+		Path:        category.Path,
+		Description: category.Description,
+	}
+}
+
+// slugify lowercases s and joins its alphanumeric runs with hyphens.
+func slugify(s string) string {
+	var b strings.Builder
+	pendingDash := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if pendingDash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			pendingDash = false
+			continue
+		}
+		pendingDash = true
+	}
+	return b.String()
+}
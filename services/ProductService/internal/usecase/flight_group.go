@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"errors"
+	"sync"
+)
+
+// errFlightAborted is returned to waiters when the shared call panicked.
+var errFlightAborted = errors.New("shared load aborted")
+
+// flightGroup coalesces concurrent loads of the same key into a single call,
+// so an expired hot entry sends one query to the database instead of one per
+// request.
+type flightGroup[T any] struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall[T]
+}
+
+type flightCall[T any] struct {
+	done chan struct{}
+	val  T
+	err  error
+}
+
+// Do runs fn once per key at a time; callers arriving while it runs wait for
+// and share its result.
+func (g *flightGroup[T]) Do(key string, fn func() (T, error)) (T, error) {
+	call, started := g.start(key)
+	if started {
+		g.run(key, call, fn)
+	} else {
+		<-call.done
+	}
+	return call.val, call.err
+}
+
+// Go runs fn in the background unless a call for key is already in flight.
+func (g *flightGroup[T]) Go(key string, fn func() (T, error)) {
+	call, started := g.start(key)
+	if started {
+		go g.run(key, call, fn)
+	}
+}
+
+func (g *flightGroup[T]) start(key string) (*flightCall[T], bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if call, ok := g.calls[key]; ok {
+		return call, false
+	}
+	if g.calls == nil {
+		g.calls = make(map[string]*flightCall[T])
+	}
+	call := &flightCall[T]{done: make(chan struct{})}
+	g.calls[key] = call
+	return call, true
+}
+
+func (g *flightGroup[T]) run(key string, call *flightCall[T], fn func() (T, error)) {
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(call.done)
+	}()
+	call.err = errFlightAborted
+	call.val, call.err = fn()
+}
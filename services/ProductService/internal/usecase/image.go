@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"net/http"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+)
+
+// maxImagePixels rejects images whose decoded size would exhaust memory,
+// whatever their compressed size.
+const maxImagePixels = 40_000_000
+
+var imageExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+	"image/gif":  ".gif",
+}
+
+type processedImage struct {
+	contentType   string
+	extension     string
+	width         int
+	height        int
+	thumbnail     []byte
+	thumbnailType string
+}
+
+// processImage sniffs the real content type of data, ignoring whatever the
+// client claimed, and renders a thumbnail whose longer side is at most
+// thumbnailSize pixels. PNG and GIF thumbnails are PNG to keep transparency.
+func processImage(data []byte, thumbnailSize int) (*processedImage, error) {
+	contentType := http.DetectContentType(data)
+	extension, ok := imageExtensions[contentType]
+	if !ok {
+		return nil, domain.ErrUnsupportedImageType
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, domain.ErrUnsupportedImageType
+	}
+	if config.Width <= 0 || config.Height <= 0 || config.Width*config.Height > maxImagePixels {
+		return nil, domain.ErrImageDimensions
+	}
+
+	var src image.Image
+	switch contentType {
+	case "image/jpeg":
+		src, err = jpeg.Decode(bytes.NewReader(data))
+	case "image/png":
+		src, err = png.Decode(bytes.NewReader(data))
+	case "image/gif":
+		src, err = gif.Decode(bytes.NewReader(data))
+	}
+	if err != nil {
+		return nil, domain.ErrUnsupportedImageType
+	}
+
+	thumb := resizeToFit(src, thumbnailSize)
+	var buf bytes.Buffer
+	thumbnailType := "image/png"
+	if contentType == "image/jpeg" {
+		thumbnailType = contentType
+		err = jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: 85})
+	} else {
+		err = png.Encode(&buf, thumb)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &processedImage{
+		contentType:   contentType,
+		extension:     extension,
+		width:         config.Width,
+		height:        config.Height,
+		thumbnail:     buf.Bytes(),
+		thumbnailType: thumbnailType,
+	}, nil
+}
+
+// resizeToFit scales src down so its longer side is at most size, averaging
+// every source pixel that falls into a target pixel. Smaller images are only
+// converted, never enlarged.
+func resizeToFit(src image.Image, size int) *image.RGBA {
+	bounds := src.Bounds()
+	srcW, srcH := bounds.Dx(), bounds.Dy()
+
+	rgba := image.NewRGBA(image.Rect(0, 0, srcW, srcH))
+	draw.Draw(rgba, rgba.Bounds(), src, bounds.Min, draw.Src)
+	if srcW <= size && srcH <= size {
+		return rgba
+	}
+
+	dstW, dstH := size, size
+	if srcW >= srcH {
+		dstH = max(srcH*size/srcW, 1)
+	} else {
+		dstW = max(srcW*size/srcH, 1)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dstW, dstH))
+	for y := 0; y < dstH; y++ {
+		y0, y1 := y*srcH/dstH, max((y+1)*srcH/dstH, y*srcH/dstH+1)
+		for x := 0; x < dstW; x++ {
+			x0, x1 := x*srcW/dstW, max((x+1)*srcW/dstW, x*srcW/dstW+1)
+
+			var r, g, b, a, n int
+			for sy := y0; sy < y1; sy++ {
+				offset := rgba.PixOffset(x0, sy)
+				for sx := x0; sx < x1; sx++ {
+					r += int(rgba.Pix[offset])
+					g += int(rgba.Pix[offset+1])
+					b += int(rgba.Pix[offset+2])
+					a += int(rgba.Pix[offset+3])
+					offset += 4
+					n++
+				}
+			}
+
+			i := dst.PixOffset(x, y)
+			dst.Pix[i] = uint8(r / n)
+			dst.Pix[i+1] = uint8(g / n)
+			dst.Pix[i+2] = uint8(b / n)
+			dst.Pix[i+3] = uint8(a / n)
+		}
+	}
+	return dst
+}
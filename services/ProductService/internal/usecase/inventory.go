@@ -0,0 +1,344 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type InventoryUsecase struct {
+	inventoryRepo domain.InventoryRepository
+	productCache  domain.ProductCache
+	tracer        trace.Tracer
+}
+
+var _ domain.InventoryUsecase = (*InventoryUsecase)(nil)
+
+func NewInventoryUsecase(inventoryRepo domain.InventoryRepository, productCache domain.ProductCache) *InventoryUsecase {
+	return &InventoryUsecase{
+		inventoryRepo: inventoryRepo,
+		productCache:  productCache,
+		tracer:        otel.Tracer("inventory-usecase"),
+	}
+}
+
+func (u *InventoryUsecase) RestockProduct(ctx context.Context, req *dto.RestockProductRequest) (*dto.StockMovementResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "InventoryUsecase.RestockProduct")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(req.ProductID)),
+		attribute.Int("product.restock_quantity", req.Quantity),
+	)
+
+	movement, err := u.RecordStockMovement(ctx, &dto.RecordStockMovementRequest{
+		ProductID:   req.ProductID,
+		VariantID:   req.VariantID,
+		WarehouseID: req.WarehouseID,
+		Type:        string(domain.StockMovementRestock),
+		Quantity:    req.Quantity,
+		Reason:      req.Reason,
+		Actor:       req.Actor,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "product restocked")
+	return movement, nil
+}
+
+func (u *InventoryUsecase) RecordStockMovement(ctx context.Context, req *dto.RecordStockMovementRequest) (*dto.StockMovementResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "InventoryUsecase.RecordStockMovement")
+	defer span.End()
+
+	movementType := domain.StockMovementType(req.Type)
+	span.SetAttributes(
+		attribute.Int("product.id", int(req.ProductID)),
+		attribute.String("stock.type", req.Type),
+		attribute.Int("stock.quantity", req.Quantity),
+	)
+
+	delta, err := signedStockDelta(movementType, req.Quantity)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	actor := req.Actor
+	if actor == "" {
+		actor = domain.SystemActor
+	}
+
+	movement := &domain.StockMovement{
+		ProductID:   req.ProductID,
+		VariantID:   req.VariantID,
+		WarehouseID: req.WarehouseID,
+		Type:        movementType,
+		Quantity:    delta,
+		Reason:      req.Reason,
+		Actor:       actor,
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.RecordStockMovement")
+	if err := u.inventoryRepo.RecordMovement(ctx, movement); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.SetAttributes(attribute.Int("stock.balance_after", movement.BalanceAfter))
+	dbSpan.End()
+
+	_, cacheSpan := u.tracer.Start(ctx, "Cache.EvictProduct")
+	if err := u.productCache.EvictProduct(ctx, req.ProductID); err != nil {
+		cacheSpan.RecordError(err)
+		logger.Warnf("Failed to delete product from cache: %v", err)
+	}
+	cacheSpan.End()
+
+	span.SetStatus(codes.Ok, "stock movement recorded")
+	response := mapStockMovementToResponse(movement)
+	return &response, nil
+}
+
+func (u *InventoryUsecase) TransferStock(ctx context.Context, req *dto.TransferStockRequest) ([]dto.StockMovementResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "InventoryUsecase.TransferStock")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(req.ProductID)),
+		attribute.Int("warehouse.from_id", int(req.FromWarehouseID)),
+		attribute.Int("warehouse.to_id", int(req.ToWarehouseID)),
+		attribute.Int("stock.quantity", req.Quantity),
+	)
+
+	if req.FromWarehouseID == req.ToWarehouseID {
+		span.SetStatus(codes.Error, domain.ErrSameWarehouse.Error())
+		return nil, domain.ErrSameWarehouse
+	}
+	if req.Quantity <= 0 {
+		span.SetStatus(codes.Error, domain.ErrInvalidStockQuantity.Error())
+		return nil, domain.ErrInvalidStockQuantity
+	}
+
+	actor := req.Actor
+	if actor == "" {
+		actor = domain.SystemActor
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.TransferStock")
+	movements, err := u.inventoryRepo.TransferStock(ctx, domain.StockTransfer{
+		ProductID:       req.ProductID,
+		VariantID:       req.VariantID,
+		FromWarehouseID: req.FromWarehouseID,
+		ToWarehouseID:   req.ToWarehouseID,
+		Quantity:        req.Quantity,
+		Reason:          req.Reason,
+		Actor:           actor,
+	})
+	if err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	response := make([]dto.StockMovementResponse, len(movements))
+	for i := range movements {
+		response[i] = mapStockMovementToResponse(&movements[i])
+	}
+
+	span.SetStatus(codes.Ok, "stock transferred")
+	return response, nil
+}
+
+// TakeStock takes more stock for an allocated order, with one sale movement
+// per item, all of them or none.
+func (u *InventoryUsecase) TakeStock(ctx context.Context, req *dto.StockItemsRequest) ([]dto.StockMovementResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "InventoryUsecase.TakeStock")
+	defer span.End()
+
+	movements, err := u.recordItemMovements(ctx, req, domain.StockMovementSale)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "stock taken")
+	return movements, nil
+}
+
+// ReturnStock puts back stock taken for an order, with one return movement
+// per item, all of them or none.
+func (u *InventoryUsecase) ReturnStock(ctx context.Context, req *dto.StockItemsRequest) ([]dto.StockMovementResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "InventoryUsecase.ReturnStock")
+	defer span.End()
+
+	movements, err := u.recordItemMovements(ctx, req, domain.StockMovementReturn)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "stock returned")
+	return movements, nil
+}
+
+func (u *InventoryUsecase) recordItemMovements(ctx context.Context, req *dto.StockItemsRequest, movementType domain.StockMovementType) ([]dto.StockMovementResponse, error) {
+	items := make([]domain.AllocationItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		items = append(items, domain.AllocationItem{
+			StockKey: domain.StockKey{ProductID: item.ProductID, VariantID: item.VariantID},
+			Quantity: item.Quantity,
+		})
+	}
+	movements := itemMovements(req.WarehouseID, items, movementType, req.Reason, req.Actor)
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.RecordStockMovements")
+	if err := u.inventoryRepo.RecordMovements(ctx, movements); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		return nil, err
+	}
+	dbSpan.End()
+
+	deleteCachedProducts(ctx, u.productCache, items)
+
+	response := make([]dto.StockMovementResponse, len(movements))
+	for i := range movements {
+		response[i] = mapStockMovementToResponse(&movements[i])
+	}
+	return response, nil
+}
+
+func (u *InventoryUsecase) ListStockMovements(ctx context.Context, req *dto.ListStockMovementsRequest) ([]dto.StockMovementResponse, int, error) {
+	ctx, span := u.tracer.Start(ctx, "InventoryUsecase.ListStockMovements")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(req.ProductID)))
+
+	filter := domain.StockMovementFilter{
+		ProductID:   req.ProductID,
+		VariantID:   req.VariantID,
+		WarehouseID: req.WarehouseID,
+		Type:        domain.StockMovementType(req.Type),
+		Page:        req.Page,
+		PerPage:     req.PerPage,
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.ListStockMovements")
+	movements, total, err := u.inventoryRepo.ListMovements(ctx, filter)
+	if err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, err
+	}
+	dbSpan.End()
+
+	response := make([]dto.StockMovementResponse, len(movements))
+	for i := range movements {
+		response[i] = mapStockMovementToResponse(&movements[i])
+	}
+
+	span.SetAttributes(attribute.Int("movements.count", len(movements)))
+	span.SetStatus(codes.Ok, "stock movements listed")
+	return response, total, nil
+}
+
+// signedStockDelta turns a request quantity into the signed change applied to
+// stock. Typed movements take a positive quantity and derive the direction from
+// their type; adjustments pass their signed quantity through unchanged.
+// Transfers have two legs and are only recorded through TransferStock; initial
+// and import movements are only recorded when the stock is created.
+func signedStockDelta(movementType domain.StockMovementType, quantity int) (int, error) {
+	if movementType == domain.StockMovementTransfer {
+		return 0, domain.ErrInvalidMovementType
+	}
+	if movementType.IsOpening() {
+		return 0, domain.ErrOpeningMovementType
+	}
+	if movementType == domain.StockMovementAdjustment {
+		if quantity == 0 {
+			return 0, domain.ErrInvalidStockQuantity
+		}
+		return quantity, nil
+	}
+	if quantity <= 0 {
+		return 0, domain.ErrInvalidStockQuantity
+	}
+	return movementType.Sign() * quantity, nil
+}
+
+// itemMovements builds one movement of the given type per item, scoped to the
+// warehouse when warehouseID is set.
+func itemMovements(warehouseID *uint, items []domain.AllocationItem, movementType domain.StockMovementType, reason, actor string) []domain.StockMovement {
+	if actor == "" {
+		actor = domain.SystemActor
+	}
+
+	movements := make([]domain.StockMovement, 0, len(items))
+	for _, item := range items {
+		movements = append(movements, domain.StockMovement{
+			ProductID:   item.ProductID,
+			VariantID:   item.VariantID,
+			WarehouseID: warehouseID,
+			Type:        movementType,
+			Quantity:    movementType.Sign() * item.Quantity,
+			Reason:      reason,
+			Actor:       actor,
+		})
+	}
+	return movements
+}
+
+// deleteCachedProducts drops the cached copies of the items' products after
+// their stock changed. List pages are left cached.
+func deleteCachedProducts(ctx context.Context, productCache domain.ProductCache, items []domain.AllocationItem) {
+	seen := make(map[uint]struct{}, len(items))
+	for _, item := range items {
+		if _, ok := seen[item.ProductID]; ok {
+			continue
+		}
+		seen[item.ProductID] = struct{}{}
+		if err := productCache.EvictProduct(ctx, item.ProductID); err != nil {
+			logger.Warnf("Failed to delete product from cache: %v", err)
+		}
+	}
+}
+
+func mapStockMovementToResponse(m *domain.StockMovement) dto.StockMovementResponse {
+	return dto.StockMovementResponse{
+		ID:                    m.ID,
+		ProductID:             m.ProductID,
+		VariantID:             m.VariantID,
+		WarehouseID:           m.WarehouseID,
+		Type:                  string(m.Type),
+		Quantity:              m.Quantity,
+		BalanceAfter:          m.BalanceAfter,
+		WarehouseBalanceAfter: m.WarehouseBalanceAfter,
+		Reason:                m.Reason,
+		Actor:                 m.Actor,
+		CreatedAt:             m.CreatedAt,
+	}
+}
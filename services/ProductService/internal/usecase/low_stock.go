@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type LowStockUsecase struct {
+	inventoryRepo domain.InventoryRepository
+	publisher     domain.EventPublisher
+	productCache  domain.ProductCache
+	salesWindow   time.Duration
+	coverageDays  int
+	tracer        trace.Tracer
+}
+
+var _ domain.LowStockUsecase = (*LowStockUsecase)(nil)
+
+// NewLowStockUsecase derives sales rates from the sales window of the ledger
+// and suggests reorders that cover coverageDays of expected sales.
+func NewLowStockUsecase(inventoryRepo domain.InventoryRepository, publisher domain.EventPublisher, productCache domain.ProductCache, salesWindow time.Duration, coverageDays int) *LowStockUsecase {
+	return &LowStockUsecase{
+		inventoryRepo: inventoryRepo,
+		publisher:     publisher,
+		productCache:  productCache,
+		salesWindow:   salesWindow,
+		coverageDays:  coverageDays,
+		tracer:        otel.Tracer("low-stock-usecase"),
+	}
+}
+
+func (u *LowStockUsecase) SetReorderThreshold(ctx context.Context, req *dto.SetReorderThresholdRequest) error {
+	ctx, span := u.tracer.Start(ctx, "LowStockUsecase.SetReorderThreshold")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(req.ProductID)),
+		attribute.Int("product.reorder_threshold", req.Threshold),
+	)
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.SetReorderThreshold")
+	if err := u.inventoryRepo.SetReorderThreshold(ctx, req.ProductID, req.Threshold); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	dbSpan.End()
+
+	_, cacheSpan := u.tracer.Start(ctx, "Cache.EvictProduct")
+	if err := u.productCache.EvictProduct(ctx, req.ProductID); err != nil {
+		cacheSpan.RecordError(err)
+		logger.Warnf("Failed to delete product from cache: %v", err)
+	}
+	cacheSpan.End()
+
+	span.SetStatus(codes.Ok, "reorder threshold set")
+	return nil
+}
+
+func (u *LowStockUsecase) ListLowStockProducts(ctx context.Context) ([]dto.LowStockItemResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "LowStockUsecase.ListLowStockProducts")
+	defer span.End()
+
+	items, err := u.listLowStock(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := make([]dto.LowStockItemResponse, len(items))
+	for i := range items {
+		response[i] = u.mapLowStockItemToResponse(&items[i])
+	}
+
+	span.SetAttributes(attribute.Int("low_stock.count", len(items)))
+	span.SetStatus(codes.Ok, "low stock products listed")
+	return response, nil
+}
+
+// EvaluateLowStock publishes an inventory.low_stock event for every item that
+// newly fell below its threshold and resolves alerts of recovered items. It
+// returns the number of events published.
+func (u *LowStockUsecase) EvaluateLowStock(ctx context.Context) (int, error) {
+	ctx, span := u.tracer.Start(ctx, "LowStockUsecase.EvaluateLowStock")
+	defer span.End()
+
+	items, err := u.listLowStock(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, err
+	}
+
+	stillLow := make([]domain.StockKey, 0, len(items))
+	for _, item := range items {
+		stillLow = append(stillLow, domain.StockKey{ProductID: item.ProductID, VariantID: item.VariantID})
+	}
+	if err := u.inventoryRepo.ResolveLowStockAlerts(ctx, stillLow); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, err
+	}
+
+	published := 0
+	for i := range items {
+		item := &items[i]
+		raised, err := u.inventoryRepo.RaiseLowStockAlert(ctx, *item)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return published, err
+		}
+		if !raised {
+			continue
+		}
+
+		suggestion := u.mapLowStockItemToResponse(item)
+		event := domain.LowStockEvent{
+			ProductID:                item.ProductID,
+			VariantID:                item.VariantID,
+			Name:                     item.Name,
+			SKU:                      item.SKU,
+			Quantity:                 item.Quantity,
+			ReorderThreshold:         item.ReorderThreshold,
+			DailySalesRate:           suggestion.DailySalesRate,
+			SuggestedReorderQuantity: suggestion.SuggestedReorderQuantity,
+			OccurredAt:               time.Now().UTC(),
+		}
+		if err := u.publisher.Publish(ctx, domain.EventInventoryLowStock, event); err != nil {
+			// Drop the alert so the next evaluation retries the event.
+			logger.Warnf("Failed to publish low stock event for product %d: %v", item.ProductID, err)
+			if clearErr := u.inventoryRepo.ClearLowStockAlert(ctx, stillLow[i]); clearErr != nil {
+				logger.Warnf("Failed to clear low stock alert for product %d: %v", item.ProductID, clearErr)
+			}
+			continue
+		}
+		published++
+	}
+
+	span.SetAttributes(
+		attribute.Int("low_stock.count", len(items)),
+		attribute.Int("low_stock.published", published),
+	)
+	span.SetStatus(codes.Ok, "low stock evaluated")
+	return published, nil
+}
+
+// Run evaluates low stock every interval until done is closed.
+func (u *LowStockUsecase) Run(done <-chan any, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			published, err := u.EvaluateLowStock(ctx)
+			cancel()
+			if err != nil {
+				logger.Errorf("Low stock evaluation failed: %v", err)
+				continue
+			}
+			if published > 0 {
+				logger.Infof("Published %d low stock events", published)
+			}
+		}
+	}
+}
+
+func (u *LowStockUsecase) listLowStock(ctx context.Context) ([]domain.LowStockItem, error) {
+	_, dbSpan := u.tracer.Start(ctx, "Database.ListLowStock")
+	defer dbSpan.End()
+
+	items, err := u.inventoryRepo.ListLowStock(ctx, time.Now().Add(-u.salesWindow))
+	if err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return items, nil
+}
+
+func (u *LowStockUsecase) mapLowStockItemToResponse(item *domain.LowStockItem) dto.LowStockItemResponse {
+	windowDays := u.salesWindow.Hours() / 24
+	var dailyRate float64
+	if windowDays > 0 {
+		dailyRate = float64(item.UnitsSold) / windowDays
+	}
+
+	target := item.ReorderThreshold + int(math.Ceil(dailyRate*float64(u.coverageDays)))
+	return dto.LowStockItemResponse{
+		ProductID:                item.ProductID,
+		VariantID:                item.VariantID,
+		Name:                     item.Name,
+		SKU:                      item.SKU,
+		Quantity:                 item.Quantity,
+		ReorderThreshold:         item.ReorderThreshold,
+		UnitsSold:                item.UnitsSold,
+		DailySalesRate:           math.Round(dailyRate*100) / 100,
+		SuggestedReorderQuantity: max(target-item.Quantity, 0),
+	}
+}
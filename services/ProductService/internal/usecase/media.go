@@ -0,0 +1,299 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type MediaUsecase struct {
+	mediaRepo      domain.MediaRepository
+	blobStore      domain.BlobStore
+	productCache   domain.ProductCache
+	maxUploadBytes int64
+	thumbnailSize  int
+	tracer         trace.Tracer
+}
+
+var _ domain.MediaUsecase = (*MediaUsecase)(nil)
+
+func NewMediaUsecase(mediaRepo domain.MediaRepository, blobStore domain.BlobStore, productCache domain.ProductCache, maxUploadBytes int64, thumbnailSize int) *MediaUsecase {
+	return &MediaUsecase{
+		mediaRepo:      mediaRepo,
+		blobStore:      blobStore,
+		productCache:   productCache,
+		maxUploadBytes: maxUploadBytes,
+		thumbnailSize:  thumbnailSize,
+		tracer:         otel.Tracer("media-usecase"),
+	}
+}
+
+// UploadProductImage reads the image from r, stores it with a thumbnail and
+// appends it to the product's images.
+func (u *MediaUsecase) UploadProductImage(ctx context.Context, req *dto.UploadProductImageRequest, r io.Reader) (*dto.ProductImageResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "MediaUsecase.UploadProductImage")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(req.ProductID)))
+
+	// Read one byte past the limit to tell a full-size image from a larger one.
+	data, err := io.ReadAll(io.LimitReader(r, u.maxUploadBytes+1))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	if int64(len(data)) > u.maxUploadBytes {
+		span.SetStatus(codes.Error, domain.ErrImageTooLarge.Error())
+		return nil, domain.ErrImageTooLarge
+	}
+
+	_, processSpan := u.tracer.Start(ctx, "Image.Process")
+	processed, err := processImage(data, u.thumbnailSize)
+	if err != nil {
+		processSpan.RecordError(err)
+		processSpan.SetStatus(codes.Error, err.Error())
+		processSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	processSpan.End()
+
+	span.SetAttributes(
+		attribute.String("image.content_type", processed.contentType),
+		attribute.Int("image.size_bytes", len(data)),
+	)
+
+	name, err := randomObjectName()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	image := &domain.ProductImage{
+		ProductID:    req.ProductID,
+		AltText:      req.AltText,
+		ObjectKey:    fmt.Sprintf("products/%d/%s%s", req.ProductID, name, processed.extension),
+		ThumbnailKey: fmt.Sprintf("products/%d/%s_thumb%s", req.ProductID, name, imageExtensions[processed.thumbnailType]),
+		ContentType:  processed.contentType,
+		SizeBytes:    int64(len(data)),
+		Width:        processed.width,
+		Height:       processed.height,
+	}
+
+	_, storeSpan := u.tracer.Start(ctx, "Storage.PutImage")
+	image.URL, err = u.blobStore.Put(ctx, image.ObjectKey, data, processed.contentType)
+	if err == nil {
+		image.ThumbnailURL, err = u.blobStore.Put(ctx, image.ThumbnailKey, processed.thumbnail, processed.thumbnailType)
+		if err != nil {
+			u.deleteBlobs(ctx, image.ObjectKey)
+		}
+	}
+	if err != nil {
+		storeSpan.RecordError(err)
+		storeSpan.SetStatus(codes.Error, err.Error())
+		storeSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	storeSpan.End()
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.CreateImage")
+	if err := u.mediaRepo.CreateImage(ctx, image); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		u.deleteBlobs(ctx, image.ObjectKey, image.ThumbnailKey)
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	u.invalidateProduct(ctx, req.ProductID)
+
+	span.SetAttributes(attribute.Int("image.id", int(image.ID)))
+	span.SetStatus(codes.Ok, "Image uploaded successfully")
+	response := mapImageToResponse(image)
+	return &response, nil
+}
+
+func (u *MediaUsecase) ListProductImages(ctx context.Context, productID uint) ([]dto.ProductImageResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "MediaUsecase.ListProductImages")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(productID)))
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.ListImages")
+	images, err := u.mediaRepo.ListImages(ctx, productID)
+	if err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	span.SetAttributes(attribute.Int("images.count", len(images)))
+	span.SetStatus(codes.Ok, "Images listed successfully")
+	return mapImagesToResponse(images), nil
+}
+
+func (u *MediaUsecase) UpdateProductImage(ctx context.Context, id uint, req *dto.UpdateProductImageRequest) (*dto.ProductImageResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "MediaUsecase.UpdateProductImage")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("image.id", int(id)))
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.UpdateImageAltText")
+	if err := u.mediaRepo.UpdateImageAltText(ctx, id, req.AltText); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	image, err := u.mediaRepo.GetImageByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	u.invalidateProduct(ctx, image.ProductID)
+
+	span.SetStatus(codes.Ok, "Image updated successfully")
+	response := mapImageToResponse(image)
+	return &response, nil
+}
+
+func (u *MediaUsecase) ReorderProductImages(ctx context.Context, req *dto.ReorderProductImagesRequest) ([]dto.ProductImageResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "MediaUsecase.ReorderProductImages")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(req.ProductID)),
+		attribute.Int("images.count", len(req.ImageIDs)),
+	)
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.ReorderImages")
+	if err := u.mediaRepo.ReorderImages(ctx, req.ProductID, req.ImageIDs); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	u.invalidateProduct(ctx, req.ProductID)
+
+	images, err := u.mediaRepo.ListImages(ctx, req.ProductID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "Images reordered successfully")
+	return mapImagesToResponse(images), nil
+}
+
+// DeleteProductImage removes the image, then its stored files. A file that
+// cannot be deleted is only logged, since the image is already gone.
+func (u *MediaUsecase) DeleteProductImage(ctx context.Context, id uint) error {
+	ctx, span := u.tracer.Start(ctx, "MediaUsecase.DeleteProductImage")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("image.id", int(id)))
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.DeleteImage")
+	image, err := u.mediaRepo.DeleteImage(ctx, id)
+	if err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	dbSpan.End()
+
+	u.deleteBlobs(ctx, image.ObjectKey, image.ThumbnailKey)
+	u.invalidateProduct(ctx, image.ProductID)
+
+	span.SetStatus(codes.Ok, "Image deleted successfully")
+	return nil
+}
+
+func (u *MediaUsecase) deleteBlobs(ctx context.Context, keys ...string) {
+	_, storeSpan := u.tracer.Start(ctx, "Storage.DeleteImage")
+	defer storeSpan.End()
+
+	for _, key := range keys {
+		if err := u.blobStore.Delete(ctx, key); err != nil {
+			storeSpan.RecordError(err)
+			logger.Warnf("Failed to delete stored image %s: %v", key, err)
+		}
+	}
+}
+
+func (u *MediaUsecase) invalidateProduct(ctx context.Context, productID uint) {
+	_, cacheSpan := u.tracer.Start(ctx, "Cache.DeleteProduct")
+	defer cacheSpan.End()
+
+	if err := u.productCache.DeleteProduct(ctx, productID); err != nil {
+		cacheSpan.RecordError(err)
+		logger.Warnf("Failed to delete product from cache: %v", err)
+	}
+}
+
+func randomObjectName() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func mapImagesToResponse(images []domain.ProductImage) []dto.ProductImageResponse {
+	response := make([]dto.ProductImageResponse, 0, len(images))
+	for i := range images {
+		response = append(response, mapImageToResponse(&images[i]))
+	}
+	return response
+}
+
+func mapImageToResponse(image *domain.ProductImage) dto.ProductImageResponse {
+	return dto.ProductImageResponse{
+		ID:           image.ID,
+		ProductID:    image.ProductID,
+		Position:     image.Position,
+		AltText:      image.AltText,
+		URL:          image.URL,
+		ThumbnailURL: image.ThumbnailURL,
+		ContentType:  image.ContentType,
+		SizeBytes:    image.SizeBytes,
+		Width:        image.Width,
+		Height:       image.Height,
+		CreatedAt:    image.CreatedAt,
+	}
+}
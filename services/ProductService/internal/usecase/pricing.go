@@ -0,0 +1,322 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// priceChangeBatchSize bounds the changes applied in one transaction.
+	priceChangeBatchSize = 100
+	// priceTimelineUpcomingLimit bounds the pending changes in a timeline.
+	priceTimelineUpcomingLimit = 100
+)
+
+type PricingUsecase struct {
+	pricingRepo  domain.PricingRepository
+	productRepo  domain.ProductRepository
+	productCache domain.ProductCache
+	tracer       trace.Tracer
+}
+
+var _ domain.PricingUsecase = (*PricingUsecase)(nil)
+
+func NewPricingUsecase(pricingRepo domain.PricingRepository, productRepo domain.ProductRepository, productCache domain.ProductCache) *PricingUsecase {
+	return &PricingUsecase{
+		pricingRepo:  pricingRepo,
+		productRepo:  productRepo,
+		productCache: productCache,
+		tracer:       otel.Tracer("pricing-usecase"),
+	}
+}
+
+func (u *PricingUsecase) SchedulePriceChange(ctx context.Context, req *dto.SchedulePriceChangeRequest) (*dto.ScheduledPriceChangeResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "PricingUsecase.SchedulePriceChange")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(req.ProductID)),
+		attribute.String("price_change.effective_at", req.EffectiveAt.Format(time.RFC3339)),
+	)
+
+	if err := validatePriceChange(req); err != nil {
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	change := &domain.ScheduledPriceChange{
+		ProductID:      req.ProductID,
+		Price:          req.Price,
+		DiscountValue:  req.DiscountValue,
+		RemoveDiscount: req.RemoveDiscount,
+		EffectiveAt:    req.EffectiveAt.UTC(),
+		CreatedBy:      req.CreatedBy,
+	}
+	if req.DiscountType != nil {
+		discountType := domain.DiscountType(*req.DiscountType)
+		change.DiscountType = &discountType
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.SchedulePriceChange")
+	if err := u.pricingRepo.SchedulePriceChange(ctx, change); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	span.SetAttributes(attribute.Int("price_change.id", int(change.ID)))
+	span.SetStatus(codes.Ok, "price change scheduled")
+	response := mapScheduledPriceChangeToResponse(change)
+	return &response, nil
+}
+
+func (u *PricingUsecase) CancelScheduledPriceChange(ctx context.Context, id uint) (*dto.ScheduledPriceChangeResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "PricingUsecase.CancelScheduledPriceChange")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("price_change.id", int(id)))
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.CancelScheduledPriceChange")
+	change, err := u.pricingRepo.CancelScheduledPriceChange(ctx, id)
+	if err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	span.SetStatus(codes.Ok, "price change canceled")
+	response := mapScheduledPriceChangeToResponse(change)
+	return &response, nil
+}
+
+func (u *PricingUsecase) ListScheduledPriceChanges(ctx context.Context, req *dto.ListScheduledPriceChangesRequest) ([]dto.ScheduledPriceChangeResponse, int, error) {
+	ctx, span := u.tracer.Start(ctx, "PricingUsecase.ListScheduledPriceChanges")
+	defer span.End()
+
+	filter := domain.ScheduledPriceChangeFilter{
+		ProductID: req.ProductID,
+		Status:    domain.ScheduledPriceChangeStatus(req.Status),
+		Page:      req.Page,
+		PerPage:   req.PerPage,
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.ListScheduledPriceChanges")
+	changes, total, err := u.pricingRepo.ListScheduledPriceChanges(ctx, filter)
+	if err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, err
+	}
+	dbSpan.End()
+
+	response := make([]dto.ScheduledPriceChangeResponse, len(changes))
+	for i := range changes {
+		response[i] = mapScheduledPriceChangeToResponse(&changes[i])
+	}
+
+	span.SetAttributes(attribute.Int("price_changes.count", len(changes)))
+	span.SetStatus(codes.Ok, "price changes listed")
+	return response, total, nil
+}
+
+// GetPriceTimeline returns the recorded prices of a product followed by its
+// pending scheduled changes.
+func (u *PricingUsecase) GetPriceTimeline(ctx context.Context, productID uint) (*dto.PriceTimelineResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "PricingUsecase.GetPriceTimeline")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(productID)))
+
+	_, productSpan := u.tracer.Start(ctx, "Database.GetProductByID")
+	if _, err := u.productRepo.GetProductByID(ctx, productID); err != nil {
+		productSpan.RecordError(err)
+		productSpan.SetStatus(codes.Error, err.Error())
+		productSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	productSpan.End()
+
+	_, historySpan := u.tracer.Start(ctx, "Database.ListPriceHistory")
+	history, err := u.pricingRepo.ListPriceHistory(ctx, productID)
+	if err != nil {
+		historySpan.RecordError(err)
+		historySpan.SetStatus(codes.Error, err.Error())
+		historySpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	historySpan.End()
+
+	_, upcomingSpan := u.tracer.Start(ctx, "Database.ListScheduledPriceChanges")
+	upcoming, _, err := u.pricingRepo.ListScheduledPriceChanges(ctx, domain.ScheduledPriceChangeFilter{
+		ProductID: productID,
+		Status:    domain.ScheduledPriceChangePending,
+		Page:      1,
+		PerPage:   priceTimelineUpcomingLimit,
+	})
+	if err != nil {
+		upcomingSpan.RecordError(err)
+		upcomingSpan.SetStatus(codes.Error, err.Error())
+		upcomingSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	upcomingSpan.End()
+
+	response := &dto.PriceTimelineResponse{
+		ProductID: productID,
+		History:   make([]dto.PriceHistoryEntryResponse, len(history)),
+		Upcoming:  make([]dto.ScheduledPriceChangeResponse, len(upcoming)),
+	}
+	for i := range history {
+		response.History[i] = mapPriceHistoryEntryToResponse(&history[i])
+	}
+	for i := range upcoming {
+		response.Upcoming[i] = mapScheduledPriceChangeToResponse(&upcoming[i])
+	}
+
+	span.SetAttributes(
+		attribute.Int("price_history.count", len(history)),
+		attribute.Int("price_changes.count", len(upcoming)),
+	)
+	span.SetStatus(codes.Ok, "price timeline retrieved")
+	return response, nil
+}
+
+// ApplyDuePriceChanges applies every change that took effect and drops the
+// affected products from the cache. It returns the number of applied changes.
+func (u *PricingUsecase) ApplyDuePriceChanges(ctx context.Context) (int, error) {
+	ctx, span := u.tracer.Start(ctx, "PricingUsecase.ApplyDuePriceChanges")
+	defer span.End()
+
+	now := time.Now().UTC()
+	applied := 0
+	for {
+		_, dbSpan := u.tracer.Start(ctx, "Database.ApplyDuePriceChanges")
+		changes, err := u.pricingRepo.ApplyDuePriceChanges(ctx, now, priceChangeBatchSize)
+		if err != nil {
+			dbSpan.RecordError(err)
+			dbSpan.SetStatus(codes.Error, err.Error())
+			dbSpan.End()
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return applied, err
+		}
+		dbSpan.End()
+
+		for i := range changes {
+			u.invalidateProduct(ctx, changes[i].ProductID)
+		}
+		applied += len(changes)
+		if len(changes) < priceChangeBatchSize {
+			break
+		}
+	}
+
+	span.SetAttributes(attribute.Int("price_changes.applied", applied))
+	span.SetStatus(codes.Ok, "due price changes applied")
+	return applied, nil
+}
+
+// Run applies due price changes every interval until done is closed.
+func (u *PricingUsecase) Run(done <-chan any, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			applied, err := u.ApplyDuePriceChanges(ctx)
+			cancel()
+			if err != nil {
+				logger.Errorf("Applying scheduled price changes failed: %v", err)
+				continue
+			}
+			if applied > 0 {
+				logger.Infof("Applied %d scheduled price changes", applied)
+			}
+		}
+	}
+}
+
+func (u *PricingUsecase) invalidateProduct(ctx context.Context, productID uint) {
+	_, cacheSpan := u.tracer.Start(ctx, "Cache.DeleteProduct")
+	defer cacheSpan.End()
+
+	if err := u.productCache.DeleteProduct(ctx, productID); err != nil {
+		cacheSpan.RecordError(err)
+		logger.Warnf("Failed to delete product from cache: %v", err)
+	}
+}
+
+func validatePriceChange(req *dto.SchedulePriceChangeRequest) error {
+	if req.Price == nil && req.DiscountType == nil && req.DiscountValue == nil && !req.RemoveDiscount {
+		return domain.ErrEmptyPriceChange
+	}
+	// A discount needs both its type and value, and cannot be set and removed
+	// by the same change.
+	if (req.DiscountType == nil) != (req.DiscountValue == nil) || (req.RemoveDiscount && req.DiscountType != nil) {
+		return domain.ErrInvalidDiscount
+	}
+	if !req.EffectiveAt.After(time.Now()) {
+		return domain.ErrPriceChangeInPast
+	}
+	return nil
+}
+
+func mapScheduledPriceChangeToResponse(change *domain.ScheduledPriceChange) dto.ScheduledPriceChangeResponse {
+	response := dto.ScheduledPriceChangeResponse{
+		ID:             change.ID,
+		ProductID:      change.ProductID,
+		Price:          change.Price,
+		DiscountValue:  change.DiscountValue,
+		RemoveDiscount: change.RemoveDiscount,
+		EffectiveAt:    change.EffectiveAt,
+		Status:         string(change.Status),
+		AppliedAt:      change.AppliedAt,
+		CreatedBy:      change.CreatedBy,
+		CreatedAt:      change.CreatedAt,
+	}
+	if change.DiscountType != nil {
+		discountType := string(*change.DiscountType)
+		response.DiscountType = &discountType
+	}
+	return response
+}
+
+func mapPriceHistoryEntryToResponse(entry *domain.PriceHistoryEntry) dto.PriceHistoryEntryResponse {
+	return dto.PriceHistoryEntryResponse{
+		Price:             entry.Price,
+		DiscountType:      string(entry.DiscountType),
+		DiscountValue:     entry.DiscountValue,
+		Source:            string(entry.Source),
+		ScheduledChangeID: entry.ScheduledChangeID,
+		ChangedAt:         entry.ChangedAt,
+	}
+}
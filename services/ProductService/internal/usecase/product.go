@@ -3,11 +3,16 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"strconv"
 	"time"
 
 	"github.com/kareemhamed001/e-commerce/pkg/logger"
 	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
 	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/repository"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
@@ -17,21 +22,48 @@ import (
 const (
 	productCacheTTL     = 30 * time.Minute
 	productListCacheTTL = 1 * time.Hour
+	// productLoadTimeout bounds a database load shared by several requests,
+	// which no longer follows the cancellation of the request that started it.
+	productLoadTimeout = 5 * time.Second
+	// productEarlyExpiryBeta scales probabilistic early expiration; values
+	// above 1 refresh earlier.
+	productEarlyExpiryBeta = 1.0
 )
 
+// ProductCachePolicy tunes how cached products are served.
+type ProductCachePolicy struct {
+	// StaleWhileRevalidate serves an expired entry while one goroutine
+	// refreshes it, for at most StaleTTL past its expiry.
+	StaleWhileRevalidate bool
+	StaleTTL             time.Duration
+	// NegativeTTL is how long a missing product is remembered; 0 disables
+	// negative caching.
+	NegativeTTL time.Duration
+}
+
 type ProductUsecase struct {
-	productRepo  domain.ProductRepository
-	productCache domain.ProductCache
-	tracer       trace.Tracer
+	productRepo   domain.ProductRepository
+	variantRepo   domain.VariantRepository
+	mediaRepo     domain.MediaRepository
+	attributeRepo domain.AttributeRepository
+	productCache  domain.ProductCache
+	cachePolicy   ProductCachePolicy
+	// productFlights coalesces concurrent loads of the same product.
+	productFlights flightGroup[*dto.ProductResponse]
+	tracer         trace.Tracer
 }
 
 var _ domain.ProductUsecase = (*ProductUsecase)(nil)
 
-func NewProductUsecase(productRepo domain.ProductRepository, productCache domain.ProductCache) *ProductUsecase {
+func NewProductUsecase(productRepo domain.ProductRepository, variantRepo domain.VariantRepository, mediaRepo domain.MediaRepository, attributeRepo domain.AttributeRepository, productCache domain.ProductCache, cachePolicy ProductCachePolicy) *ProductUsecase {
 	return &ProductUsecase{
-		productRepo:  productRepo,
-		productCache: productCache,
-		tracer:       otel.Tracer("product-usecase"),
+		productRepo:   productRepo,
+		variantRepo:   variantRepo,
+		mediaRepo:     mediaRepo,
+		attributeRepo: attributeRepo,
+		productCache:  productCache,
+		cachePolicy:   cachePolicy,
+		tracer:        otel.Tracer("product-usecase"),
 	}
 }
 
@@ -45,16 +77,7 @@ func (u *ProductUsecase) CreateProduct(ctx context.Context, productDto *dto.Crea
 		attribute.Int("product.quantity", productDto.Quantity),
 	)
 
-	newProduct := &domain.Product{
-		Name:             productDto.Name,
-		ShortDescription: productDto.ShortDescription,
-		Description:      productDto.Description,
-		Price:            productDto.Price,
-		DiscountType:     domain.DiscountType(productDto.DiscountType),
-		DiscountValue:    productDto.DiscountValue,
-		ImageUrl:         productDto.ImageUrl,
-		Quantity:         productDto.Quantity,
-	}
+	newProduct := mapCreateRequestToProduct(productDto)
 
 	_, dbSpan := u.tracer.Start(ctx, "Database.CreateProduct")
 	if err := u.productRepo.CreateProduct(ctx, newProduct); err != nil {
@@ -68,20 +91,23 @@ func (u *ProductUsecase) CreateProduct(ctx context.Context, productDto *dto.Crea
 	dbSpan.SetAttributes(attribute.Int("product.id", int(newProduct.ID)))
 	dbSpan.End()
 
+	// Drops a negative entry cached for the new ID and bumps the list version.
+	_, cacheSpan := u.tracer.Start(ctx, "Cache.DeleteProduct")
+	if err := u.productCache.DeleteProduct(ctx, newProduct.ID); err != nil {
+		cacheSpan.RecordError(err)
+		logger.Warnf("Failed to delete product from cache: %v", err)
+	}
+	cacheSpan.End()
+
 	span.SetStatus(codes.Ok, "Product created successfully")
-	return &dto.ProductResponse{
-		Id:               newProduct.ID,
-		Name:             newProduct.Name,
-		ShortDescription: newProduct.ShortDescription,
-		Description:      newProduct.Description,
-		Price:            newProduct.Price,
-		DiscountType:     string(newProduct.DiscountType),
-		DiscountValue:    newProduct.DiscountValue,
-		ImageUrl:         newProduct.ImageUrl,
-		Quantity:         newProduct.Quantity,
-	}, nil
+	response := mapProductToResponse(newProduct)
+	return &response, nil
 }
 
+// GetProductByID serves the product from cache when possible. Concurrent
+// misses for the same product share one database load, entries are refreshed
+// in the background shortly before they expire, and with stale-while-revalidate
+// an expired entry is served while it is being refreshed.
 func (u *ProductUsecase) GetProductByID(ctx context.Context, id uint) (*dto.ProductResponse, error) {
 	ctx, span := u.tracer.Start(ctx, "ProductUsecase.GetProductByID")
 	defer span.End()
@@ -89,97 +115,427 @@ func (u *ProductUsecase) GetProductByID(ctx context.Context, id uint) (*dto.Prod
 	span.SetAttributes(attribute.Int("product.id", int(id)))
 
 	_, cacheSpan := u.tracer.Start(ctx, "Cache.GetProduct")
-	product, err := u.productCache.GetProduct(ctx, id)
+	entry, err := u.productCache.GetProduct(ctx, id)
 	if err == nil {
-		cacheSpan.SetAttributes(attribute.Bool("cache.hit", true))
-		cacheSpan.End()
-		logger.Debug("Product cache hit")
-		span.SetAttributes(
+		stale := !time.Now().Before(entry.FreshUntil)
+		cacheSpan.SetAttributes(
 			attribute.Bool("cache.hit", true),
-			attribute.String("product.name", product.Name),
+			attribute.Bool("cache.stale", stale),
 		)
-		span.SetStatus(codes.Ok, "Product found in cache")
-		return product, nil
+		cacheSpan.End()
+
+		if entry.Missing {
+			span.SetAttributes(attribute.Bool("cache.hit", true))
+			span.SetStatus(codes.Error, repository.ErrProductNotFound.Error())
+			return nil, repository.ErrProductNotFound
+		}
+
+		if !stale || u.cachePolicy.StaleWhileRevalidate {
+			if stale || expiresEarly(entry, time.Now()) {
+				u.refreshProductInBackground(ctx, id)
+			}
+			logger.Debug("Product cache hit")
+			span.SetAttributes(
+				attribute.Bool("cache.hit", true),
+				attribute.String("product.name", entry.Product.Name),
+			)
+			span.SetStatus(codes.Ok, "Product found in cache")
+			return entry.Product, nil
+		}
+	} else {
+		cacheSpan.SetAttributes(attribute.Bool("cache.hit", false))
+		cacheSpan.End()
 	}
-	cacheSpan.SetAttributes(attribute.Bool("cache.hit", false))
-	cacheSpan.End()
 
 	logger.Debug("Product cache miss, fetching from DB")
+	product, err := u.productFlights.Do(productFlightKey(id), func() (*dto.ProductResponse, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), productLoadTimeout)
+		defer cancel()
+		return u.loadProduct(loadCtx, id)
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Bool("cache.hit", false),
+		attribute.String("product.name", product.Name),
+	)
+	span.SetStatus(codes.Ok, "Product retrieved from database")
+	return product, nil
+}
+
+// loadProduct reads the product with its variants and images and caches it.
+// A missing product is cached as such for the negative TTL.
+func (u *ProductUsecase) loadProduct(ctx context.Context, id uint) (*dto.ProductResponse, error) {
+	started := time.Now()
+
 	_, dbSpan := u.tracer.Start(ctx, "Database.GetProductByID")
 	productObj, err := u.productRepo.GetProductByID(ctx, id)
 	if err != nil {
 		dbSpan.RecordError(err)
 		dbSpan.SetStatus(codes.Error, err.Error())
 		dbSpan.End()
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
+		if errors.Is(err, repository.ErrProductNotFound) && u.cachePolicy.NegativeTTL > 0 {
+			u.cacheProductEntry(ctx, id, &domain.CachedProduct{
+				Missing:    true,
+				FreshUntil: time.Now().Add(u.cachePolicy.NegativeTTL),
+			}, u.cachePolicy.NegativeTTL)
+		}
 		return nil, err
 	}
 	dbSpan.End()
 
-	newProduct := &dto.ProductResponse{
-		Id:               productObj.ID,
-		Name:             productObj.Name,
-		ShortDescription: productObj.ShortDescription,
-		Description:      productObj.Description,
-		Price:            productObj.Price,
-		DiscountType:     string(productObj.DiscountType),
-		DiscountValue:    productObj.DiscountValue,
-		ImageUrl:         productObj.ImageUrl,
-		Quantity:         productObj.Quantity,
+	mapped := []dto.ProductResponse{mapProductToResponse(productObj)}
+	_, variantSpan := u.tracer.Start(ctx, "Database.LoadVariantMatrix")
+	if err := attachVariantMatrix(ctx, u.variantRepo, mapped); err != nil {
+		variantSpan.RecordError(err)
+		variantSpan.SetStatus(codes.Error, err.Error())
+		variantSpan.End()
+		return nil, err
+	}
+	variantSpan.End()
+
+	_, attributeSpan := u.tracer.Start(ctx, "Database.LoadAttributes")
+	if err := attachAttributes(ctx, u.attributeRepo, mapped); err != nil {
+		attributeSpan.RecordError(err)
+		attributeSpan.SetStatus(codes.Error, err.Error())
+		attributeSpan.End()
+		return nil, err
+	}
+	attributeSpan.End()
+	newProduct := &mapped[0]
+
+	_, imageSpan := u.tracer.Start(ctx, "Database.ListImages")
+	images, err := u.mediaRepo.ListImages(ctx, id)
+	if err != nil {
+		imageSpan.RecordError(err)
+		imageSpan.SetStatus(codes.Error, err.Error())
+		imageSpan.End()
+		return nil, err
 	}
+	imageSpan.End()
+	newProduct.Images = mapImagesToResponse(images)
 
+	ttl := productCacheTTL
+	if u.cachePolicy.StaleWhileRevalidate {
+		ttl += u.cachePolicy.StaleTTL
+	}
+	u.cacheProductEntry(ctx, id, &domain.CachedProduct{
+		Product:    newProduct,
+		FreshUntil: time.Now().Add(productCacheTTL),
+		LoadTime:   time.Since(started),
+	}, ttl)
+
+	return newProduct, nil
+}
+
+// refreshProductInBackground reloads the product unless a load is already
+// running. It outlives the request, so it keeps the trace but not the
+// cancellation of ctx.
+func (u *ProductUsecase) refreshProductInBackground(ctx context.Context, id uint) {
+	refreshCtx := context.WithoutCancel(ctx)
+	u.productFlights.Go(productFlightKey(id), func() (*dto.ProductResponse, error) {
+		loadCtx, cancel := context.WithTimeout(refreshCtx, productLoadTimeout)
+		defer cancel()
+
+		product, err := u.loadProduct(loadCtx, id)
+		if err != nil && !errors.Is(err, repository.ErrProductNotFound) {
+			logger.Warnf("Failed to refresh cached product %d: %v", id, err)
+		}
+		return product, err
+	})
+}
+
+func (u *ProductUsecase) cacheProductEntry(ctx context.Context, id uint, entry *domain.CachedProduct, ttl time.Duration) {
 	_, setCacheSpan := u.tracer.Start(ctx, "Cache.SetProduct")
-	if err := u.productCache.SetProduct(ctx, newProduct, productCacheTTL); err != nil {
+	defer setCacheSpan.End()
+
+	setCacheSpan.SetAttributes(attribute.Bool("cache.negative", entry.Missing))
+	if err := u.productCache.SetProduct(ctx, id, entry, ttl); err != nil {
 		setCacheSpan.RecordError(err)
 		logger.Warnf("Failed to cache product: %v", err)
 	}
-	setCacheSpan.End()
+}
+
+// expiresEarly implements probabilistic early expiration (XFetch): the closer
+// an entry is to FreshUntil, and the longer it took to load, the likelier a
+// request refreshes it ahead of time, which spreads refreshes of a hot key.
+func expiresEarly(entry *domain.CachedProduct, now time.Time) bool {
+	if entry.LoadTime <= 0 {
+		return false
+	}
+	gap := time.Duration(float64(entry.LoadTime) * productEarlyExpiryBeta * -math.Log(rand.Float64()))
+	return !now.Add(gap).Before(entry.FreshUntil)
+}
+
+func productFlightKey(id uint) string {
+	return strconv.FormatUint(uint64(id), 10)
+}
+
+// GetProductsByIDs returns the requested products, in the order their IDs
+// first appear, reading through the product cache: cached entries are served
+// as GetProductByID serves them and every other product is loaded in one
+// query. Unknown IDs are reported in MissingIDs rather than as an error.
+func (u *ProductUsecase) GetProductsByIDs(ctx context.Context, ids []uint) (*dto.ProductsByIDsResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ProductUsecase.GetProductsByIDs")
+	defer span.End()
+
+	ids = uniqueProductIDs(ids)
+	span.SetAttributes(attribute.Int("product.ids.count", len(ids)))
+
+	found := make(map[uint]*dto.ProductResponse, len(ids))
+	toLoad := make([]uint, 0, len(ids))
+
+	_, cacheSpan := u.tracer.Start(ctx, "Cache.GetProducts")
+	entries, err := u.productCache.GetProducts(ctx, ids)
+	if err != nil {
+		cacheSpan.RecordError(err)
+	}
+	now := time.Now()
+	for _, id := range ids {
+		entry, ok := entries[id]
+		if !ok {
+			toLoad = append(toLoad, id)
+			continue
+		}
+		if entry.Missing {
+			continue
+		}
+
+		stale := !now.Before(entry.FreshUntil)
+		if stale && !u.cachePolicy.StaleWhileRevalidate {
+			toLoad = append(toLoad, id)
+			continue
+		}
+		if stale || expiresEarly(entry, now) {
+			u.refreshProductInBackground(ctx, id)
+		}
+		found[id] = entry.Product
+	}
+	cacheSpan.SetAttributes(
+		attribute.Int("cache.hits", len(ids)-len(toLoad)),
+		attribute.Int("cache.misses", len(toLoad)),
+	)
+	cacheSpan.End()
+
+	if len(toLoad) > 0 {
+		logger.Debugf("Product cache missed %d of %d products, fetching from DB", len(toLoad), len(ids))
+		loaded, err := u.loadProducts(ctx, toLoad)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		for id, product := range loaded {
+			found[id] = product
+		}
+	}
+
+	response := &dto.ProductsByIDsResponse{
+		Products:   make([]dto.ProductResponse, 0, len(found)),
+		MissingIDs: make([]uint, 0),
+	}
+	for _, id := range ids {
+		if product, ok := found[id]; ok {
+			response.Products = append(response.Products, *product)
+		} else {
+			response.MissingIDs = append(response.MissingIDs, id)
+		}
+	}
 
 	span.SetAttributes(
-		attribute.Bool("cache.hit", false),
-		attribute.String("product.name", newProduct.Name),
+		attribute.Int("products.count", len(response.Products)),
+		attribute.Int("products.missing", len(response.MissingIDs)),
 	)
-	span.SetStatus(codes.Ok, "Product retrieved from database")
-	return newProduct, nil
+	span.SetStatus(codes.Ok, "Products retrieved")
+	return response, nil
 }
 
-func (u *ProductUsecase) ListProducts(ctx context.Context, page, perPage int) ([]dto.ProductResponse, int, error) {
-	ctx, span := u.tracer.Start(ctx, "ProductUsecase.ListProducts")
-	defer span.End()
+// loadProducts is the batch form of loadProduct: it reads the products with
+// their variants and images in one pass and caches them, remembering the IDs
+// that do not exist for the negative TTL.
+func (u *ProductUsecase) loadProducts(ctx context.Context, ids []uint) (map[uint]*dto.ProductResponse, error) {
+	started := time.Now()
 
-	_, dbSpan := u.tracer.Start(ctx, "Database.ListProducts")
-	products, total, err := u.productRepo.ListProducts(ctx, page, perPage)
+	_, dbSpan := u.tracer.Start(ctx, "Database.GetProductsByIDs")
+	products, err := u.productRepo.GetProductsByIDs(ctx, ids)
 	if err != nil {
 		dbSpan.RecordError(err)
 		dbSpan.SetStatus(codes.Error, err.Error())
 		dbSpan.End()
+		return nil, err
+	}
+	dbSpan.End()
+
+	mapped := make([]dto.ProductResponse, 0, len(products))
+	for i := range products {
+		mapped = append(mapped, mapProductToResponse(&products[i]))
+	}
+
+	_, variantSpan := u.tracer.Start(ctx, "Database.LoadVariantMatrix")
+	if err := attachVariantMatrix(ctx, u.variantRepo, mapped); err != nil {
+		variantSpan.RecordError(err)
+		variantSpan.SetStatus(codes.Error, err.Error())
+		variantSpan.End()
+		return nil, err
+	}
+	variantSpan.End()
+
+	_, attributeSpan := u.tracer.Start(ctx, "Database.LoadAttributes")
+	if err := attachAttributes(ctx, u.attributeRepo, mapped); err != nil {
+		attributeSpan.RecordError(err)
+		attributeSpan.SetStatus(codes.Error, err.Error())
+		attributeSpan.End()
+		return nil, err
+	}
+	attributeSpan.End()
+
+	loaded := make(map[uint]*dto.ProductResponse, len(mapped))
+	for i := range mapped {
+		loaded[mapped[i].Id] = &mapped[i]
+	}
+
+	if len(loaded) > 0 {
+		_, imageSpan := u.tracer.Start(ctx, "Database.ListImagesByProductIDs")
+		images, err := u.mediaRepo.ListImagesByProductIDs(ctx, ids)
+		if err != nil {
+			imageSpan.RecordError(err)
+			imageSpan.SetStatus(codes.Error, err.Error())
+			imageSpan.End()
+			return nil, err
+		}
+		imageSpan.End()
+
+		byProduct := make(map[uint][]domain.ProductImage, len(loaded))
+		for _, image := range images {
+			byProduct[image.ProductID] = append(byProduct[image.ProductID], image)
+		}
+		for id, product := range loaded {
+			product.Images = mapImagesToResponse(byProduct[id])
+		}
+	}
+
+	loadTime := time.Since(started)
+	freshUntil := time.Now().Add(productCacheTTL)
+	entries := make(map[uint]*domain.CachedProduct, len(loaded))
+	for id, product := range loaded {
+		entries[id] = &domain.CachedProduct{Product: product, FreshUntil: freshUntil, LoadTime: loadTime}
+	}
+	ttl := productCacheTTL
+	if u.cachePolicy.StaleWhileRevalidate {
+		ttl += u.cachePolicy.StaleTTL
+	}
+	u.cacheProductEntries(ctx, entries, ttl)
+
+	if u.cachePolicy.NegativeTTL > 0 {
+		missing := make(map[uint]*domain.CachedProduct)
+		for _, id := range ids {
+			if _, ok := loaded[id]; !ok {
+				missing[id] = &domain.CachedProduct{
+					Missing:    true,
+					FreshUntil: time.Now().Add(u.cachePolicy.NegativeTTL),
+				}
+			}
+		}
+		u.cacheProductEntries(ctx, missing, u.cachePolicy.NegativeTTL)
+	}
+
+	return loaded, nil
+}
+
+func (u *ProductUsecase) cacheProductEntries(ctx context.Context, entries map[uint]*domain.CachedProduct, ttl time.Duration) {
+	if len(entries) == 0 {
+		return
+	}
+
+	_, setCacheSpan := u.tracer.Start(ctx, "Cache.SetProducts")
+	defer setCacheSpan.End()
+
+	setCacheSpan.SetAttributes(attribute.Int("cache.entries", len(entries)))
+	if err := u.productCache.SetProducts(ctx, entries, ttl); err != nil {
+		setCacheSpan.RecordError(err)
+		logger.Warnf("Failed to cache products: %v", err)
+	}
+}
+
+// uniqueProductIDs drops repeated IDs, keeping the first occurrence.
+func uniqueProductIDs(ids []uint) []uint {
+	seen := make(map[uint]struct{}, len(ids))
+	unique := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		unique = append(unique, id)
+	}
+	return unique
+}
+
+// ListProducts lists a page of the catalog, keeping only the products that
+// match every attribute filter.
+func (u *ProductUsecase) ListProducts(ctx context.Context, page, perPage int, attributes []dto.AttributeFilterRequest) ([]dto.ProductResponse, int, error) {
+	ctx, span := u.tracer.Start(ctx, "ProductUsecase.ListProducts")
+	defer span.End()
+
+	filters, err := resolveAttributeFilters(ctx, u.attributeRepo, attributes)
+	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return nil, 0, err
 	}
-	dbSpan.SetAttributes(attribute.Int("products.count", len(products)))
-	dbSpan.End()
 
-	span.SetAttributes(attribute.Int("products.count", len(products)))
-	span.SetStatus(codes.Ok, "Products retrieved from database")
-
-	productsMapped := make([]dto.ProductResponse, len(products))
-	for i, p := range products {
-		productsMapped[i] = dto.ProductResponse{
-			Id:               p.ID,
-			Name:             p.Name,
-			ShortDescription: p.ShortDescription,
-			Description:      p.Description,
-			Price:            p.Price,
-			DiscountType:     string(p.DiscountType),
-			DiscountValue:    p.DiscountValue,
-			ImageUrl:         p.ImageUrl,
-			Quantity:         p.Quantity,
+	name := fmt.Sprintf("all:page=%d:per_page=%d", page, perPage) + attributeFiltersName(filters)
+	list, err := cachedProductList(ctx, u.tracer, u.productCache, name, func() (dto.ProductListPage, error) {
+		_, dbSpan := u.tracer.Start(ctx, "Database.ListProducts")
+		products, total, err := u.productRepo.ListProducts(ctx, page, perPage, filters)
+		if err != nil {
+			dbSpan.RecordError(err)
+			dbSpan.SetStatus(codes.Error, err.Error())
+			dbSpan.End()
+			return dto.ProductListPage{}, err
+		}
+		dbSpan.SetAttributes(attribute.Int("products.count", len(products)))
+		dbSpan.End()
+
+		productsMapped := make([]dto.ProductResponse, len(products))
+		for i := range products {
+			productsMapped[i] = mapProductToResponse(&products[i])
+		}
+
+		_, variantSpan := u.tracer.Start(ctx, "Database.LoadVariantMatrix")
+		if err := attachVariantMatrix(ctx, u.variantRepo, productsMapped); err != nil {
+			variantSpan.RecordError(err)
+			variantSpan.SetStatus(codes.Error, err.Error())
+			variantSpan.End()
+			return dto.ProductListPage{}, err
 		}
+		variantSpan.End()
+
+		_, attributeSpan := u.tracer.Start(ctx, "Database.LoadAttributes")
+		if err := attachAttributes(ctx, u.attributeRepo, productsMapped); err != nil {
+			attributeSpan.RecordError(err)
+			attributeSpan.SetStatus(codes.Error, err.Error())
+			attributeSpan.End()
+			return dto.ProductListPage{}, err
+		}
+		attributeSpan.End()
+
+		return dto.ProductListPage{Products: productsMapped, TotalCount: total}, nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, err
 	}
 
-	return productsMapped, total, nil
+	span.SetAttributes(attribute.Int("products.count", len(list.Products)))
+	span.SetStatus(codes.Ok, "Products listed")
+
+	return list.Products, list.TotalCount, nil
 }
 
 func (u *ProductUsecase) UpdateProduct(ctx context.Context, id uint, product *dto.UpdateProductRequest) (*dto.ProductResponse, error) {
@@ -200,7 +556,9 @@ func (u *ProductUsecase) UpdateProduct(ctx context.Context, id uint, product *dt
 		DiscountType:     domain.DiscountType(*product.DiscountType),
 		DiscountValue:    *product.DiscountValue,
 		ImageUrl:         product.ImageUrl,
-		Quantity:         *product.Quantity,
+	}
+	if product.TaxCategory != nil {
+		newProduct.TaxCategory = *product.TaxCategory
 	}
 
 	_, dbSpan := u.tracer.Start(ctx, "Database.UpdateProduct")
@@ -232,37 +590,34 @@ func (u *ProductUsecase) UpdateProduct(ctx context.Context, id uint, product *dt
 	return nil, nil
 }
 
-func (u *ProductUsecase) RestockProduct(ctx context.Context, id uint, quantity int) error {
-	ctx, span := u.tracer.Start(ctx, "ProductUsecase.RestockProduct")
+func (u *ProductUsecase) SetMaxPerOrder(ctx context.Context, req *dto.SetMaxPerOrderRequest) error {
+	ctx, span := u.tracer.Start(ctx, "ProductUsecase.SetMaxPerOrder")
 	defer span.End()
 
 	span.SetAttributes(
-		attribute.Int("product.id", int(id)),
-		attribute.Int("product.restock_quantity", quantity),
+		attribute.Int("product.id", int(req.ProductID)),
+		attribute.Int("product.max_per_order", req.MaxPerOrder),
 	)
 
-	if quantity <= 0 {
-		err := errors.New("quantity must be greater than zero")
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		return err
-	}
-
-	product, err := u.productRepo.GetProductByID(ctx, id)
-	if err != nil {
+	_, dbSpan := u.tracer.Start(ctx, "Database.SetMaxPerOrder")
+	if err := u.productRepo.SetMaxPerOrder(ctx, req.ProductID, req.MaxPerOrder); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return err
 	}
+	dbSpan.End()
 
-	product.Quantity += quantity
-	if err := u.productRepo.UpdateProduct(ctx, id, product); err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		return err
+	_, cacheSpan := u.tracer.Start(ctx, "Cache.DeleteProduct")
+	if err := u.productCache.DeleteProduct(ctx, req.ProductID); err != nil {
+		cacheSpan.RecordError(err)
+		logger.Warnf("Failed to delete product from cache: %v", err)
 	}
+	cacheSpan.End()
 
-	span.SetStatus(codes.Ok, "product restocked")
+	span.SetStatus(codes.Ok, "max per order set")
 	return nil
 }
 
@@ -300,3 +655,41 @@ func (u *ProductUsecase) DeleteProduct(ctx context.Context, id uint) error {
 	span.SetStatus(codes.Ok, "Product deleted successfully")
 	return nil
 }
+
+func mapCreateRequestToProduct(req *dto.CreateProductRequest) *domain.Product {
+	return &domain.Product{
+		SKU:              req.SKU,
+		Name:             req.Name,
+		ShortDescription: req.ShortDescription,
+		Description:      req.Description,
+		Price:            req.Price,
+		DiscountType:     domain.DiscountType(req.DiscountType),
+		DiscountValue:    req.DiscountValue,
+		ImageUrl:         req.ImageUrl,
+		Quantity:         req.Quantity,
+		MaxPerOrder:      req.MaxPerOrder,
+		TaxCategory:      req.TaxCategory,
+	}
+}
+
+func mapProductToResponse(p *domain.Product) dto.ProductResponse {
+	return dto.ProductResponse{
+		Id:                p.ID,
+		SKU:               p.SKU,
+		Name:              p.Name,
+		ShortDescription:  p.ShortDescription,
+		Description:       p.Description,
+		Price:             p.Price,
+		DiscountType:      string(p.DiscountType),
+		DiscountValue:     p.DiscountValue,
+		DiscountStartDate: p.DiscountStartDate,
+		DiscountEndDate:   p.DiscountEndDate,
+		ImageUrl:          p.ImageUrl,
+		Quantity:          p.Quantity,
+		ReorderThreshold:  p.ReorderThreshold,
+		MaxPerOrder:       p.MaxPerOrder,
+		TaxCategory:       p.TaxCategory,
+		RatingAverage:     p.RatingAverage,
+		RatingCount:       p.RatingCount,
+	}
+}
@@ -0,0 +1,170 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// ImportProducts hands run a function that upserts one batch of validated
+// rows and reports the rows that failed. A real import writes each batch in
+// its own transaction; a dry run writes all of them into one transaction and
+// rolls it back once run returns, so its results match a real import of the
+// whole file.
+func (u *ProductUsecase) ImportProducts(ctx context.Context, dryRun bool, run func(importBatch domain.ImportBatchFunc) error) error {
+	ctx, span := u.tracer.Start(ctx, "ProductUsecase.ImportProducts")
+	defer span.End()
+
+	span.SetAttributes(attribute.Bool("import.dry_run", dryRun))
+
+	var err error
+	if dryRun {
+		_, dbSpan := u.tracer.Start(ctx, "Database.DryRun")
+		err = u.productRepo.DryRun(ctx, func(repo domain.ProductRepository) error {
+			return run(func(rows []dto.ImportProductRow) (*dto.ImportProductsResponse, error) {
+				return u.importBatch(ctx, repo, rows, true)
+			})
+		})
+		if err != nil {
+			dbSpan.RecordError(err)
+			dbSpan.SetStatus(codes.Error, err.Error())
+		}
+		dbSpan.End()
+	} else {
+		err = run(func(rows []dto.ImportProductRow) (*dto.ImportProductsResponse, error) {
+			return u.importBatch(ctx, u.productRepo, rows, false)
+		})
+	}
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Products imported successfully")
+	return nil
+}
+
+// importBatch upserts one batch through repo, in a single transaction.
+func (u *ProductUsecase) importBatch(ctx context.Context, repo domain.ProductRepository, rows []dto.ImportProductRow, dryRun bool) (*dto.ImportProductsResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ProductUsecase.importBatch")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("import.rows.count", len(rows)))
+
+	importRows := make([]domain.ProductImportRow, 0, len(rows))
+	for i := range rows {
+		importRows = append(importRows, domain.ProductImportRow{
+			Line:    rows[i].Line,
+			Product: *mapCreateRequestToProduct(&rows[i].Product),
+		})
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.ImportProducts")
+	results, err := repo.ImportProducts(ctx, importRows)
+	if err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	response := &dto.ImportProductsResponse{
+		DryRun:    dryRun,
+		TotalRows: len(rows),
+	}
+	var writtenIDs []uint
+	for i, result := range results {
+		switch {
+		case result.Err != nil:
+			response.Failed++
+			response.Errors = append(response.Errors, newImportRowError(&rows[i], result.Err))
+		case result.Created:
+			response.Created++
+			writtenIDs = append(writtenIDs, result.ProductID)
+		default:
+			response.Updated++
+			writtenIDs = append(writtenIDs, result.ProductID)
+		}
+	}
+
+	if !dryRun {
+		_, cacheSpan := u.tracer.Start(ctx, "Cache.DeleteProduct")
+		// Created IDs may still hold a negative entry from an earlier lookup.
+		for _, id := range writtenIDs {
+			if err := u.productCache.DeleteProduct(ctx, id); err != nil {
+				cacheSpan.RecordError(err)
+				logger.Warnf("Failed to delete product from cache: %v", err)
+			}
+		}
+		cacheSpan.End()
+	}
+
+	span.SetAttributes(
+		attribute.Int("import.created", response.Created),
+		attribute.Int("import.updated", response.Updated),
+		attribute.Int("import.failed", response.Failed),
+	)
+	span.SetStatus(codes.Ok, "Product batch imported")
+	return response, nil
+}
+
+// ExportProducts pages through the whole catalog in id order and hands each
+// page to send, stopping at the first error send returns.
+func (u *ProductUsecase) ExportProducts(ctx context.Context, batchSize int, send func([]dto.ProductResponse) error) error {
+	ctx, span := u.tracer.Start(ctx, "ProductUsecase.ExportProducts")
+	defer span.End()
+
+	exported := 0
+	var afterID uint
+	for {
+		products, err := u.productRepo.ListProductsAfter(ctx, afterID, batchSize)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+		if len(products) == 0 {
+			break
+		}
+
+		page := make([]dto.ProductResponse, 0, len(products))
+		for i := range products {
+			page = append(page, mapProductToResponse(&products[i]))
+		}
+		if err := send(page); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+
+		exported += len(products)
+		afterID = products[len(products)-1].ID
+		if len(products) < batchSize {
+			break
+		}
+	}
+
+	span.SetAttributes(attribute.Int("export.products.count", exported))
+	span.SetStatus(codes.Ok, "Products exported successfully")
+	return nil
+}
+
+func newImportRowError(row *dto.ImportProductRow, err error) dto.ImportRowError {
+	rowErr := dto.ImportRowError{
+		Line:  row.Line,
+		Name:  row.Product.Name,
+		Error: err.Error(),
+	}
+	if row.Product.SKU != nil {
+		rowErr.SKU = *row.Product.SKU
+	}
+	return rowErr
+}
@@ -0,0 +1,95 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// cachedProductList serves the list page called name from the cache, or loads
+// and caches it. The versioned key is resolved before loading, so a catalog
+// write that lands during the load leaves the stored page unreachable.
+func cachedProductList[T any](ctx context.Context, tracer trace.Tracer, cache domain.ProductCache, name string, load func() (T, error)) (T, error) {
+	_, cacheSpan := tracer.Start(ctx, "Cache.GetProductList")
+	key, err := cache.ProductListKey(ctx, name)
+	if err == nil {
+		var cached T
+		if err := cache.GetProductList(ctx, key, &cached); err == nil {
+			cacheSpan.SetAttributes(attribute.Bool("cache.hit", true))
+			cacheSpan.End()
+			logger.Debug("Product list cache hit")
+			return cached, nil
+		}
+	} else {
+		key = ""
+	}
+	cacheSpan.SetAttributes(attribute.Bool("cache.hit", false))
+	cacheSpan.End()
+
+	result, err := load()
+	if err != nil {
+		return result, err
+	}
+
+	if key != "" {
+		_, setCacheSpan := tracer.Start(ctx, "Cache.SetProductList")
+		if err := cache.SetProductList(ctx, key, result, productListCacheTTL); err != nil {
+			setCacheSpan.RecordError(err)
+			logger.Warnf("Failed to cache product list: %v", err)
+		}
+		setCacheSpan.End()
+	}
+	return result, nil
+}
+
+// GetCacheStats reports the cache hit and miss counters of this instance.
+func (u *ProductUsecase) GetCacheStats(ctx context.Context) *dto.CacheStatsResponse {
+	_, span := u.tracer.Start(ctx, "ProductUsecase.GetCacheStats")
+	defer span.End()
+
+	stats := u.productCache.Stats()
+	return &dto.CacheStatsResponse{
+		ProductHits:     stats.ProductHits,
+		ProductMisses:   stats.ProductMisses,
+		ProductStale:    stats.ProductStale,
+		ProductNegative: stats.ProductNegative,
+		ProductHitRatio: hitRatio(stats.ProductHits, stats.ProductMisses),
+		ListHits:        stats.ListHits,
+		ListMisses:      stats.ListMisses,
+		ListHitRatio:    hitRatio(stats.ListHits, stats.ListMisses),
+	}
+}
+
+func hitRatio(hits, misses uint64) float64 {
+	if hits+misses == 0 {
+		return 0
+	}
+	return math.Round(float64(hits)/float64(hits+misses)*1000) / 1000
+}
+
+// searchListName names the cached page of a search by a digest of its filter.
+func searchListName(filter domain.ProductSearchFilter) string {
+	data, _ := json.Marshal(filter)
+	sum := sha256.Sum256(data)
+	return "search:" + hex.EncodeToString(sum[:16])
+}
+
+// invalidateProductLists bumps the catalog version after writes that change
+// listings without touching a cached product, such as category changes.
+func invalidateProductLists(ctx context.Context, tracer trace.Tracer, cache domain.ProductCache) {
+	_, cacheSpan := tracer.Start(ctx, "Cache.InvalidateProductList")
+	defer cacheSpan.End()
+
+	if err := cache.InvalidateProductList(ctx); err != nil {
+		cacheSpan.RecordError(err)
+		logger.Warnf("Failed to invalidate product lists: %v", err)
+	}
+}
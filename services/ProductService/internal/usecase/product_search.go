@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+)
+
+func (u *ProductUsecase) SearchProducts(ctx context.Context, req *dto.SearchProductsRequest) (*dto.SearchProductsResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ProductUsecase.SearchProducts")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.String("search.query", req.Query),
+		attribute.String("search.sort_by", req.SortBy),
+	)
+
+	if req.MinPrice != nil && req.MaxPrice != nil && *req.MinPrice > *req.MaxPrice {
+		err := domain.ErrInvalidPriceRange
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	sortBy := domain.ProductSortBy(req.SortBy)
+	if sortBy == "" {
+		sortBy = domain.SortByRelevance
+	}
+
+	attributes, err := resolveAttributeFilters(ctx, u.attributeRepo, req.Attributes)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	filter := domain.ProductSearchFilter{
+		Query:          req.Query,
+		MinPrice:       req.MinPrice,
+		MaxPrice:       req.MaxPrice,
+		CategoryID:     req.CategoryID,
+		InStockOnly:    req.InStockOnly,
+		DiscountActive: req.DiscountActive,
+		Attributes:     attributes,
+		SortBy:         sortBy,
+		Page:           req.Page,
+		PerPage:        req.PerPage,
+	}
+
+	response, err := cachedProductList(ctx, u.tracer, u.productCache, searchListName(filter), func() (*dto.SearchProductsResponse, error) {
+		_, dbSpan := u.tracer.Start(ctx, "Database.SearchProducts")
+		products, total, err := u.productRepo.SearchProducts(ctx, filter)
+		if err != nil {
+			dbSpan.RecordError(err)
+			dbSpan.SetStatus(codes.Error, err.Error())
+			dbSpan.End()
+			return nil, err
+		}
+		dbSpan.SetAttributes(attribute.Int("products.count", len(products)))
+		dbSpan.End()
+
+		_, facetSpan := u.tracer.Start(ctx, "Database.SearchFacets")
+		facets, err := u.productRepo.SearchFacets(ctx, filter)
+		if err != nil {
+			facetSpan.RecordError(err)
+			facetSpan.SetStatus(codes.Error, err.Error())
+			facetSpan.End()
+			return nil, err
+		}
+		facetSpan.End()
+
+		response := &dto.SearchProductsResponse{
+			Products:   make([]dto.ProductResponse, len(products)),
+			TotalCount: total,
+			Facets:     mapSearchFacetsToResponse(facets),
+		}
+		for i := range products {
+			response.Products[i] = mapProductToResponse(&products[i])
+		}
+
+		_, variantSpan := u.tracer.Start(ctx, "Database.LoadVariantMatrix")
+		if err := attachVariantMatrix(ctx, u.variantRepo, response.Products); err != nil {
+			variantSpan.RecordError(err)
+			variantSpan.SetStatus(codes.Error, err.Error())
+			variantSpan.End()
+			return nil, err
+		}
+		variantSpan.End()
+
+		_, attributeSpan := u.tracer.Start(ctx, "Database.LoadAttributes")
+		if err := attachAttributes(ctx, u.attributeRepo, response.Products); err != nil {
+			attributeSpan.RecordError(err)
+			attributeSpan.SetStatus(codes.Error, err.Error())
+			attributeSpan.End()
+			return nil, err
+		}
+		attributeSpan.End()
+
+		return response, nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetAttributes(
+		attribute.Int("products.count", len(response.Products)),
+		attribute.Int("products.total", response.TotalCount),
+	)
+	span.SetStatus(codes.Ok, "Products searched")
+	return response, nil
+}
+
+func mapSearchFacetsToResponse(facets *domain.SearchFacets) dto.SearchFacetsResponse {
+	response := dto.SearchFacetsResponse{
+		Categories:  make([]dto.CategoryFacetResponse, 0, len(facets.Categories)),
+		PriceRanges: make([]dto.PriceRangeFacetResponse, 0, len(facets.PriceRanges)),
+		InStock:     facets.InStock,
+		OnDiscount:  facets.OnDiscount,
+	}
+	for _, c := range facets.Categories {
+		response.Categories = append(response.Categories, dto.CategoryFacetResponse{
+			CategoryID: c.CategoryID,
+			Name:       c.Name,
+			Count:      c.Count,
+		})
+	}
+	for _, pr := range facets.PriceRanges {
+		response.PriceRanges = append(response.PriceRanges, dto.PriceRangeFacetResponse{
+			Min:   pr.Min,
+			Max:   pr.Max,
+			Count: pr.Count,
+		})
+	}
+	return response
+}
@@ -0,0 +1,278 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	orderpb "github.com/kareemhamed001/e-commerce/shared/proto/v1/order"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const orderServiceTimeout = 3 * time.Second
+
+type ReviewUsecase struct {
+	reviewRepo   domain.ReviewRepository
+	productCache domain.ProductCache
+	orderClient  orderpb.OrderServiceClient
+	tracer       trace.Tracer
+}
+
+var _ domain.ReviewUsecase = (*ReviewUsecase)(nil)
+
+func NewReviewUsecase(reviewRepo domain.ReviewRepository, productCache domain.ProductCache, orderClient orderpb.OrderServiceClient) *ReviewUsecase {
+	return &ReviewUsecase{
+		reviewRepo:   reviewRepo,
+		productCache: productCache,
+		orderClient:  orderClient,
+		tracer:       otel.Tracer("review-usecase"),
+	}
+}
+
+// CreateReview stores a pending review after OrderService confirms the user
+// received the product. A user reviews each product once.
+func (u *ReviewUsecase) CreateReview(ctx context.Context, req *dto.CreateReviewRequest) (*dto.ReviewResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ReviewUsecase.CreateReview")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(req.ProductID)),
+		attribute.Int("review.user_id", int(req.UserID)),
+		attribute.Int("review.rating", req.Rating),
+	)
+
+	if err := u.ensurePurchased(ctx, req.UserID, req.ProductID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	review := &domain.ProductReview{
+		ProductID: req.ProductID,
+		UserID:    req.UserID,
+		Rating:    req.Rating,
+		Title:     strings.TrimSpace(req.Title),
+		Body:      strings.TrimSpace(req.Body),
+		Status:    domain.ReviewStatusPending,
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.CreateReview")
+	if err := u.reviewRepo.CreateReview(ctx, review); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	span.SetAttributes(attribute.Int("review.id", int(review.ID)))
+	span.SetStatus(codes.Ok, "review created")
+	response := mapReviewToResponse(review)
+	return &response, nil
+}
+
+func (u *ReviewUsecase) ListReviews(ctx context.Context, req *dto.ListReviewsRequest) ([]dto.ReviewResponse, int, error) {
+	ctx, span := u.tracer.Start(ctx, "ReviewUsecase.ListReviews")
+	defer span.End()
+
+	filter := domain.ReviewFilter{
+		ProductID: req.ProductID,
+		UserID:    req.UserID,
+		Status:    domain.ReviewStatus(req.Status),
+		Page:      req.Page,
+		PerPage:   req.PerPage,
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.ListReviews")
+	reviews, total, err := u.reviewRepo.ListReviews(ctx, filter)
+	if err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, err
+	}
+	dbSpan.End()
+
+	response := make([]dto.ReviewResponse, len(reviews))
+	for i := range reviews {
+		response[i] = mapReviewToResponse(&reviews[i])
+	}
+
+	span.SetAttributes(attribute.Int("reviews.count", len(reviews)))
+	span.SetStatus(codes.Ok, "reviews listed")
+	return response, total, nil
+}
+
+// UpdateReview lets the author change a review. The edited review goes back
+// to moderation and stops counting towards the rating until approved again.
+func (u *ReviewUsecase) UpdateReview(ctx context.Context, id uint, req *dto.UpdateReviewRequest) (*dto.ReviewResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ReviewUsecase.UpdateReview")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("review.id", int(id)))
+
+	review, err := u.reviewRepo.GetReviewByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	if review.UserID != req.UserID {
+		span.SetStatus(codes.Error, domain.ErrReviewForbidden.Error())
+		return nil, domain.ErrReviewForbidden
+	}
+
+	review.Rating = req.Rating
+	review.Title = strings.TrimSpace(req.Title)
+	review.Body = strings.TrimSpace(req.Body)
+	review.Status = domain.ReviewStatusPending
+	review.ModerationNote = ""
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.UpdateReview")
+	if err := u.reviewRepo.UpdateReview(ctx, review); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	u.invalidateProduct(ctx, review.ProductID)
+
+	span.SetStatus(codes.Ok, "review updated")
+	response := mapReviewToResponse(review)
+	return &response, nil
+}
+
+// DeleteReview removes a review of userID, or any review when userID is 0.
+func (u *ReviewUsecase) DeleteReview(ctx context.Context, id, userID uint) error {
+	ctx, span := u.tracer.Start(ctx, "ReviewUsecase.DeleteReview")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("review.id", int(id)))
+
+	if userID != 0 {
+		review, err := u.reviewRepo.GetReviewByID(ctx, id)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+		if review.UserID != userID {
+			span.SetStatus(codes.Error, domain.ErrReviewForbidden.Error())
+			return domain.ErrReviewForbidden
+		}
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.DeleteReview")
+	review, err := u.reviewRepo.DeleteReview(ctx, id)
+	if err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	dbSpan.End()
+
+	u.invalidateProduct(ctx, review.ProductID)
+
+	span.SetStatus(codes.Ok, "review deleted")
+	return nil
+}
+
+func (u *ReviewUsecase) ModerateReview(ctx context.Context, id uint, req *dto.ModerateReviewRequest) (*dto.ReviewResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "ReviewUsecase.ModerateReview")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("review.id", int(id)),
+		attribute.String("review.status", req.Status),
+	)
+
+	status := domain.ReviewStatus(req.Status)
+	if status != domain.ReviewStatusApproved && status != domain.ReviewStatusRejected {
+		span.SetStatus(codes.Error, domain.ErrInvalidReviewStatus.Error())
+		return nil, domain.ErrInvalidReviewStatus
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.SetReviewStatus")
+	review, err := u.reviewRepo.SetReviewStatus(ctx, id, status, strings.TrimSpace(req.Note))
+	if err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	u.invalidateProduct(ctx, review.ProductID)
+
+	span.SetStatus(codes.Ok, "review moderated")
+	response := mapReviewToResponse(review)
+	return &response, nil
+}
+
+func (u *ReviewUsecase) ensurePurchased(ctx context.Context, userID, productID uint) error {
+	ctx, orderSpan := u.tracer.Start(ctx, "OrderService.HasPurchasedProduct")
+	defer orderSpan.End()
+
+	ctx, cancel := context.WithTimeout(ctx, orderServiceTimeout)
+	defer cancel()
+
+	resp, err := u.orderClient.HasPurchasedProduct(ctx, &orderpb.HasPurchasedProductRequest{
+		UserId:    int64(userID),
+		ProductId: int64(productID),
+	})
+	if err != nil {
+		orderSpan.RecordError(err)
+		orderSpan.SetStatus(codes.Error, err.Error())
+		logger.Errorf("Failed to verify purchase with order service: %v", err)
+		return fmt.Errorf("%w: %v", domain.ErrPurchaseVerification, err)
+	}
+	if !resp.GetPurchased() {
+		return domain.ErrReviewNotPurchased
+	}
+	return nil
+}
+
+func (u *ReviewUsecase) invalidateProduct(ctx context.Context, productID uint) {
+	_, cacheSpan := u.tracer.Start(ctx, "Cache.DeleteProduct")
+	defer cacheSpan.End()
+
+	if err := u.productCache.DeleteProduct(ctx, productID); err != nil {
+		cacheSpan.RecordError(err)
+		logger.Warnf("Failed to delete product from cache: %v", err)
+	}
+}
+
+func mapReviewToResponse(review *domain.ProductReview) dto.ReviewResponse {
+	return dto.ReviewResponse{
+		ID:             review.ID,
+		ProductID:      review.ProductID,
+		UserID:         review.UserID,
+		Rating:         review.Rating,
+		Title:          review.Title,
+		Body:           review.Body,
+		Status:         string(review.Status),
+		ModerationNote: review.ModerationNote,
+		CreatedAt:      review.CreatedAt,
+		UpdatedAt:      review.UpdatedAt,
+	}
+}
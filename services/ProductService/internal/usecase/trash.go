@@ -0,0 +1,327 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/repository"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// trashPurgeBatchSize caps how many products and categories one retention
+// pass purges of each.
+const trashPurgeBatchSize = 100
+
+type TrashUsecase struct {
+	trashRepo      domain.TrashRepository
+	productUsecase domain.ProductUsecase
+	productCache   domain.ProductCache
+	blobStore      domain.BlobStore
+	publisher      domain.EventPublisher
+	// retention is how long trashed items are kept before the retention job
+	// purges them; 0 keeps them until purged by hand.
+	retention time.Duration
+	tracer    trace.Tracer
+}
+
+var _ domain.TrashUsecase = (*TrashUsecase)(nil)
+
+func NewTrashUsecase(trashRepo domain.TrashRepository, productUsecase domain.ProductUsecase, productCache domain.ProductCache, blobStore domain.BlobStore, publisher domain.EventPublisher, retention time.Duration) *TrashUsecase {
+	return &TrashUsecase{
+		trashRepo:      trashRepo,
+		productUsecase: productUsecase,
+		productCache:   productCache,
+		blobStore:      blobStore,
+		publisher:      publisher,
+		retention:      retention,
+		tracer:         otel.Tracer("trash-usecase"),
+	}
+}
+
+func (u *TrashUsecase) ListTrashedProducts(ctx context.Context, req *dto.ListTrashRequest) ([]dto.TrashedProductResponse, int, error) {
+	ctx, span := u.tracer.Start(ctx, "TrashUsecase.ListTrashedProducts")
+	defer span.End()
+
+	products, total, err := u.trashRepo.ListTrashedProducts(ctx, req.Page, req.PerPage)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, err
+	}
+
+	response := make([]dto.TrashedProductResponse, 0, len(products))
+	for i := range products {
+		product := &products[i]
+		response = append(response, dto.TrashedProductResponse{
+			ID:        product.ID,
+			SKU:       product.SKU,
+			Name:      product.Name,
+			Price:     product.Price,
+			DeletedAt: product.DeletedAt.Time,
+			PurgeAt:   u.purgeAt(product.DeletedAt.Time),
+		})
+	}
+
+	span.SetAttributes(attribute.Int("products.count", len(response)))
+	span.SetStatus(codes.Ok, "Trashed products listed")
+	return response, total, nil
+}
+
+func (u *TrashUsecase) ListTrashedCategories(ctx context.Context, req *dto.ListTrashRequest) ([]dto.TrashedCategoryResponse, int, error) {
+	ctx, span := u.tracer.Start(ctx, "TrashUsecase.ListTrashedCategories")
+	defer span.End()
+
+	categories, total, err := u.trashRepo.ListTrashedCategories(ctx, req.Page, req.PerPage)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, 0, err
+	}
+
+	response := make([]dto.TrashedCategoryResponse, 0, len(categories))
+	for i := range categories {
+		category := &categories[i]
+		response = append(response, dto.TrashedCategoryResponse{
+			ID:        category.ID,
+			ParentID:  category.ParentID,
+			Name:      category.Name,
+			Slug:      category.Slug,
+			Path:      category.Path,
+			DeletedAt: category.DeletedAt.Time,
+			PurgeAt:   u.purgeAt(category.DeletedAt.Time),
+		})
+	}
+
+	span.SetAttributes(attribute.Int("categories.count", len(response)))
+	span.SetStatus(codes.Ok, "Trashed categories listed")
+	return response, total, nil
+}
+
+// RestoreProduct takes a product out of the trash, loads it back into the
+// cache in place of the negative entry left by the delete, and announces it.
+func (u *TrashUsecase) RestoreProduct(ctx context.Context, id uint) (*dto.ProductResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "TrashUsecase.RestoreProduct")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(id)))
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.RestoreProduct")
+	product, err := u.trashRepo.RestoreProduct(ctx, id)
+	if err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	// Dropping the entry also moves the list pages past the restored product.
+	_, cacheSpan := u.tracer.Start(ctx, "Cache.DeleteProduct")
+	if err := u.productCache.DeleteProduct(ctx, id); err != nil {
+		cacheSpan.RecordError(err)
+		logger.Warnf("Failed to delete product from cache: %v", err)
+	}
+	cacheSpan.End()
+
+	response, err := u.productUsecase.GetProductByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		logger.Warnf("Failed to warm cache for restored product %d: %v", id, err)
+		mapped := mapProductToResponse(product)
+		response = &mapped
+	}
+
+	event := domain.ProductRestoredEvent{
+		ProductID:  product.ID,
+		Name:       product.Name,
+		Price:      product.Price,
+		OccurredAt: time.Now().UTC(),
+	}
+	if product.SKU != nil {
+		event.SKU = *product.SKU
+	}
+	if err := u.publisher.Publish(ctx, domain.EventProductRestored, event); err != nil {
+		logger.Warnf("Failed to publish product restored event for product %d: %v", id, err)
+	}
+
+	span.SetStatus(codes.Ok, "Product restored")
+	return response, nil
+}
+
+func (u *TrashUsecase) RestoreCategory(ctx context.Context, id uint) (*dto.CategoryResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "TrashUsecase.RestoreCategory")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("category.id", int(id)))
+
+	category, err := u.trashRepo.RestoreCategory(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Search facets and category listings include the category again.
+	invalidateProductLists(ctx, u.tracer, u.productCache)
+
+	event := domain.CategoryRestoredEvent{
+		CategoryID: category.ID,
+		ParentID:   category.ParentID,
+		Name:       category.Name,
+		Slug:       category.Slug,
+		OccurredAt: time.Now().UTC(),
+	}
+	if err := u.publisher.Publish(ctx, domain.EventCategoryRestored, event); err != nil {
+		logger.Warnf("Failed to publish category restored event for category %d: %v", id, err)
+	}
+
+	response := mapCategoryToResponse(category)
+	span.SetStatus(codes.Ok, "Category restored")
+	return &response, nil
+}
+
+// PurgeProduct permanently deletes a trashed product along with its stored
+// image files.
+func (u *TrashUsecase) PurgeProduct(ctx context.Context, id uint) error {
+	ctx, span := u.tracer.Start(ctx, "TrashUsecase.PurgeProduct")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(id)))
+
+	images, err := u.trashRepo.PurgeProduct(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	keys := make([]string, 0, 2*len(images))
+	for _, image := range images {
+		keys = append(keys, image.ObjectKey, image.ThumbnailKey)
+	}
+	u.deleteBlobs(ctx, keys...)
+
+	span.SetAttributes(attribute.Int("images.count", len(images)))
+	span.SetStatus(codes.Ok, "Product purged")
+	return nil
+}
+
+func (u *TrashUsecase) PurgeCategory(ctx context.Context, id uint) error {
+	ctx, span := u.tracer.Start(ctx, "TrashUsecase.PurgeCategory")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("category.id", int(id)))
+
+	if err := u.trashRepo.PurgeCategory(ctx, id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "Category purged")
+	return nil
+}
+
+// PurgeExpired purges the products and categories that have been in the
+// trash longer than the retention period and returns how many it removed.
+func (u *TrashUsecase) PurgeExpired(ctx context.Context) (int, error) {
+	ctx, span := u.tracer.Start(ctx, "TrashUsecase.PurgeExpired")
+	defer span.End()
+
+	if u.retention <= 0 {
+		span.SetStatus(codes.Ok, "Trash retention disabled")
+		return 0, nil
+	}
+	cutoff := time.Now().Add(-u.retention)
+
+	productIDs, err := u.trashRepo.ListExpiredProductIDs(ctx, cutoff, trashPurgeBatchSize)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, err
+	}
+	purged := 0
+	for _, id := range productIDs {
+		if err := u.PurgeProduct(ctx, id); err != nil {
+			// Restored since it was listed, or failing; retried next pass.
+			if !errors.Is(err, repository.ErrNotInTrash) {
+				logger.Warnf("Failed to purge trashed product %d: %v", id, err)
+			}
+			continue
+		}
+		purged++
+	}
+
+	categoryIDs, err := u.trashRepo.ListExpiredCategoryIDs(ctx, cutoff, trashPurgeBatchSize)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return purged, err
+	}
+	for _, id := range categoryIDs {
+		if err := u.PurgeCategory(ctx, id); err != nil {
+			if !errors.Is(err, repository.ErrNotInTrash) && !errors.Is(err, domain.ErrCategoryHasChildren) {
+				logger.Warnf("Failed to purge trashed category %d: %v", id, err)
+			}
+			continue
+		}
+		purged++
+	}
+
+	span.SetAttributes(attribute.Int("trash.purged", purged))
+	span.SetStatus(codes.Ok, "Expired trash purged")
+	return purged, nil
+}
+
+// Run purges expired trash every interval until done is closed.
+func (u *TrashUsecase) Run(done <-chan any, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+			purged, err := u.PurgeExpired(ctx)
+			cancel()
+			if err != nil {
+				logger.Errorf("Purging expired trash failed: %v", err)
+				continue
+			}
+			if purged > 0 {
+				logger.Infof("Purged %d expired trash items", purged)
+			}
+		}
+	}
+}
+
+func (u *TrashUsecase) purgeAt(deletedAt time.Time) *time.Time {
+	if u.retention <= 0 {
+		return nil
+	}
+	at := deletedAt.Add(u.retention)
+	return &at
+}
+
+func (u *TrashUsecase) deleteBlobs(ctx context.Context, keys ...string) {
+	_, storeSpan := u.tracer.Start(ctx, "Storage.DeleteImage")
+	defer storeSpan.End()
+
+	for _, key := range keys {
+		if err := u.blobStore.Delete(ctx, key); err != nil {
+			storeSpan.RecordError(err)
+			logger.Warnf("Failed to delete stored image %s: %v", key, err)
+		}
+	}
+}
@@ -0,0 +1,474 @@
+package usecase
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kareemhamed001/e-commerce/pkg/logger"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type VariantUsecase struct {
+	productRepo  domain.ProductRepository
+	variantRepo  domain.VariantRepository
+	productCache domain.ProductCache
+	tracer       trace.Tracer
+}
+
+var _ domain.VariantUsecase = (*VariantUsecase)(nil)
+
+func NewVariantUsecase(productRepo domain.ProductRepository, variantRepo domain.VariantRepository, productCache domain.ProductCache) *VariantUsecase {
+	return &VariantUsecase{
+		productRepo:  productRepo,
+		variantRepo:  variantRepo,
+		productCache: productCache,
+		tracer:       otel.Tracer("variant-usecase"),
+	}
+}
+
+func (u *VariantUsecase) CreateOption(ctx context.Context, req *dto.CreateProductOptionRequest) (*dto.ProductOptionResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "VariantUsecase.CreateOption")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(req.ProductID)),
+		attribute.String("option.name", req.Name),
+	)
+
+	if err := u.ensureNoVariants(ctx, req.ProductID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	existing, err := u.variantRepo.ListOptions(ctx, req.ProductID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	option := &domain.ProductOption{
+		ProductID: req.ProductID,
+		Name:      strings.TrimSpace(req.Name),
+		Position:  len(existing),
+		Values:    make([]domain.ProductOptionValue, 0, len(req.Values)),
+	}
+	for i, value := range req.Values {
+		option.Values = append(option.Values, domain.ProductOptionValue{
+			Value:    strings.TrimSpace(value),
+			Position: i,
+		})
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.CreateOption")
+	if err := u.variantRepo.CreateOption(ctx, option); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	u.invalidateProduct(ctx, req.ProductID)
+
+	span.SetStatus(codes.Ok, "option created")
+	response := mapOptionToResponse(option)
+	return &response, nil
+}
+
+func (u *VariantUsecase) DeleteOption(ctx context.Context, productID, optionID uint) error {
+	ctx, span := u.tracer.Start(ctx, "VariantUsecase.DeleteOption")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(productID)),
+		attribute.Int("option.id", int(optionID)),
+	)
+
+	if err := u.ensureNoVariants(ctx, productID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	if err := u.variantRepo.DeleteOption(ctx, productID, optionID); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	u.invalidateProduct(ctx, productID)
+
+	span.SetStatus(codes.Ok, "option deleted")
+	return nil
+}
+
+func (u *VariantUsecase) CreateVariant(ctx context.Context, req *dto.CreateProductVariantRequest) (*dto.ProductVariantResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "VariantUsecase.CreateVariant")
+	defer span.End()
+
+	span.SetAttributes(
+		attribute.Int("product.id", int(req.ProductID)),
+		attribute.String("variant.sku", req.SKU),
+	)
+
+	product, err := u.productRepo.GetProductByID(ctx, req.ProductID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	options, err := u.variantRepo.ListOptions(ctx, req.ProductID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if err := validateVariantSelection(options, req.OptionValueIDs); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	variants, err := u.variantRepo.ListVariants(ctx, req.ProductID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	key := variantKey(req.OptionValueIDs)
+	for i := range variants {
+		if variantKey(optionValueIDs(&variants[i])) == key {
+			err := domain.ErrDuplicateVariant
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+
+	variant := &domain.ProductVariant{
+		ProductID: req.ProductID,
+		SKU:       strings.TrimSpace(req.SKU),
+		Price:     req.Price,
+		Quantity:  req.Quantity,
+		ImageUrl:  req.ImageUrl,
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.CreateVariant")
+	if err := u.variantRepo.CreateVariant(ctx, variant, req.OptionValueIDs); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	u.invalidateProduct(ctx, req.ProductID)
+
+	variant.OptionValues = selectOptionValues(options, req.OptionValueIDs)
+	span.SetAttributes(attribute.Int("variant.id", int(variant.ID)))
+	span.SetStatus(codes.Ok, "variant created")
+	response := mapVariantToResponse(variant, product.Price, optionNamesByValueID(options))
+	return &response, nil
+}
+
+func (u *VariantUsecase) GetVariantByID(ctx context.Context, id uint) (*dto.ProductVariantResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "VariantUsecase.GetVariantByID")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("variant.id", int(id)))
+
+	response, err := u.loadVariant(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "variant retrieved")
+	return response, nil
+}
+
+func (u *VariantUsecase) UpdateVariant(ctx context.Context, id uint, req *dto.UpdateProductVariantRequest) (*dto.ProductVariantResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "VariantUsecase.UpdateVariant")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("variant.id", int(id)))
+
+	variant, err := u.variantRepo.GetVariantByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if req.SKU != nil {
+		variant.SKU = strings.TrimSpace(*req.SKU)
+	}
+	if req.Price != nil {
+		if *req.Price == 0 {
+			variant.Price = nil
+		} else {
+			variant.Price = req.Price
+		}
+	}
+	if req.Quantity != nil {
+		variant.Quantity = *req.Quantity
+	}
+	if req.ImageUrl != nil {
+		variant.ImageUrl = req.ImageUrl
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.UpdateVariant")
+	if err := u.variantRepo.UpdateVariant(ctx, id, variant); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	u.invalidateProduct(ctx, variant.ProductID)
+
+	response, err := u.loadVariant(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "variant updated")
+	return response, nil
+}
+
+func (u *VariantUsecase) DeleteVariant(ctx context.Context, id uint) error {
+	ctx, span := u.tracer.Start(ctx, "VariantUsecase.DeleteVariant")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("variant.id", int(id)))
+
+	variant, err := u.variantRepo.GetVariantByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	if err := u.variantRepo.DeleteVariant(ctx, id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	u.invalidateProduct(ctx, variant.ProductID)
+
+	span.SetStatus(codes.Ok, "variant deleted")
+	return nil
+}
+
+func (u *VariantUsecase) loadVariant(ctx context.Context, id uint) (*dto.ProductVariantResponse, error) {
+	variant, err := u.variantRepo.GetVariantByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := u.productRepo.GetProductByID(ctx, variant.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	options, err := u.variantRepo.ListOptions(ctx, variant.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := mapVariantToResponse(variant, product.Price, optionNamesByValueID(options))
+	return &response, nil
+}
+
+func (u *VariantUsecase) ensureNoVariants(ctx context.Context, productID uint) error {
+	if _, err := u.productRepo.GetProductByID(ctx, productID); err != nil {
+		return err
+	}
+
+	count, err := u.variantRepo.CountVariants(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return domain.ErrProductHasVariants
+	}
+	return nil
+}
+
+// invalidateProduct drops the cached product so the next read picks up the
+// updated variant matrix.
+func (u *VariantUsecase) invalidateProduct(ctx context.Context, productID uint) {
+	_, cacheSpan := u.tracer.Start(ctx, "Cache.DeleteProduct")
+	defer cacheSpan.End()
+
+	if err := u.productCache.DeleteProduct(ctx, productID); err != nil {
+		cacheSpan.RecordError(err)
+		logger.Warnf("Failed to delete product from cache: %v", err)
+	}
+}
+
+// attachVariantMatrix fills in the options and variants of every product in
+// place, loading them for the whole page in two queries.
+func attachVariantMatrix(ctx context.Context, variantRepo domain.VariantRepository, products []dto.ProductResponse) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, 0, len(products))
+	byID := make(map[uint]*dto.ProductResponse, len(products))
+	for i := range products {
+		ids = append(ids, products[i].Id)
+		byID[products[i].Id] = &products[i]
+	}
+
+	options, err := variantRepo.ListOptionsByProductIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	variants, err := variantRepo.ListVariantsByProductIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	names := optionNamesByValueID(options)
+	for i := range options {
+		if product, ok := byID[options[i].ProductID]; ok {
+			product.Options = append(product.Options, mapOptionToResponse(&options[i]))
+		}
+	}
+	for i := range variants {
+		if product, ok := byID[variants[i].ProductID]; ok {
+			product.Variants = append(product.Variants, mapVariantToResponse(&variants[i], product.Price, names))
+		}
+	}
+	return nil
+}
+
+// validateVariantSelection checks that valueIDs picks exactly one value of
+// every option defined on the product.
+func validateVariantSelection(options []domain.ProductOption, valueIDs []uint) error {
+	if len(valueIDs) != len(options) {
+		return domain.ErrInvalidVariantOptions
+	}
+
+	optionByValue := make(map[uint]uint)
+	for _, option := range options {
+		for _, value := range option.Values {
+			optionByValue[value.ID] = option.ID
+		}
+	}
+
+	seen := make(map[uint]bool, len(options))
+	for _, valueID := range valueIDs {
+		optionID, ok := optionByValue[valueID]
+		if !ok || seen[optionID] {
+			return domain.ErrInvalidVariantOptions
+		}
+		seen[optionID] = true
+	}
+	return nil
+}
+
+func selectOptionValues(options []domain.ProductOption, valueIDs []uint) []domain.ProductOptionValue {
+	wanted := make(map[uint]bool, len(valueIDs))
+	for _, id := range valueIDs {
+		wanted[id] = true
+	}
+
+	values := make([]domain.ProductOptionValue, 0, len(valueIDs))
+	for _, option := range options {
+		for _, value := range option.Values {
+			if wanted[value.ID] {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
+
+func optionNamesByValueID(options []domain.ProductOption) map[uint]string {
+	names := make(map[uint]string)
+	for _, option := range options {
+		for _, value := range option.Values {
+			names[value.ID] = option.Name
+		}
+	}
+	return names
+}
+
+func optionValueIDs(variant *domain.ProductVariant) []uint {
+	ids := make([]uint, 0, len(variant.OptionValues))
+	for _, value := range variant.OptionValues {
+		ids = append(ids, value.ID)
+	}
+	return ids
+}
+
+// variantKey is an order-independent identity for a combination of option values.
+func variantKey(valueIDs []uint) string {
+	sorted := append([]uint(nil), valueIDs...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	parts := make([]string, 0, len(sorted))
+	for _, id := range sorted {
+		parts = append(parts, strconv.FormatUint(uint64(id), 10))
+	}
+	return strings.Join(parts, ",")
+}
+
+func mapOptionToResponse(option *domain.ProductOption) dto.ProductOptionResponse {
+	values := make([]dto.ProductOptionValueResponse, 0, len(option.Values))
+	for _, value := range option.Values {
+		values = append(values, dto.ProductOptionValueResponse{
+			ID:    value.ID,
+			Value: value.Value,
+		})
+	}
+	return dto.ProductOptionResponse{
+		ID:       option.ID,
+		Name:     option.Name,
+		Position: option.Position,
+		Values:   values,
+	}
+}
+
+func mapVariantToResponse(variant *domain.ProductVariant, productPrice float32, optionNames map[uint]string) dto.ProductVariantResponse {
+	options := make(map[string]string, len(variant.OptionValues))
+	for _, value := range variant.OptionValues {
+		options[optionNames[value.ID]] = value.Value
+	}
+	return dto.ProductVariantResponse{
+		ID:               variant.ID,
+		ProductID:        variant.ProductID,
+		SKU:              variant.SKU,
+		Price:            variant.EffectivePrice(productPrice),
+		HasPriceOverride: variant.Price != nil,
+		Quantity:         variant.Quantity,
+		ImageUrl:         variant.ImageUrl,
+		OptionValueIDs:   optionValueIDs(variant),
+		Options:          options,
+	}
+}
@@ -0,0 +1,541 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/delivery/grpc/dto"
+	"github.com/kareemhamed001/e-commerce/services/ProductService/internal/domain"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type WarehouseUsecase struct {
+	warehouseRepo   domain.WarehouseRepository
+	inventoryRepo   domain.InventoryRepository
+	productRepo     domain.ProductRepository
+	variantRepo     domain.VariantRepository
+	productCache    domain.ProductCache
+	defaultStrategy domain.AllocationStrategy
+	tracer          trace.Tracer
+}
+
+var _ domain.WarehouseUsecase = (*WarehouseUsecase)(nil)
+
+func NewWarehouseUsecase(warehouseRepo domain.WarehouseRepository, inventoryRepo domain.InventoryRepository, productRepo domain.ProductRepository, variantRepo domain.VariantRepository, productCache domain.ProductCache, defaultStrategy domain.AllocationStrategy) *WarehouseUsecase {
+	return &WarehouseUsecase{
+		warehouseRepo:   warehouseRepo,
+		inventoryRepo:   inventoryRepo,
+		productRepo:     productRepo,
+		variantRepo:     variantRepo,
+		productCache:    productCache,
+		defaultStrategy: defaultStrategy,
+		tracer:          otel.Tracer("warehouse-usecase"),
+	}
+}
+
+func (u *WarehouseUsecase) CreateWarehouse(ctx context.Context, req *dto.CreateWarehouseRequest) (*dto.WarehouseResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "WarehouseUsecase.CreateWarehouse")
+	defer span.End()
+
+	span.SetAttributes(attribute.String("warehouse.code", req.Code))
+
+	warehouse := &domain.Warehouse{
+		Code:     strings.TrimSpace(req.Code),
+		Name:     strings.TrimSpace(req.Name),
+		Country:  strings.TrimSpace(req.Country),
+		State:    strings.TrimSpace(req.State),
+		City:     strings.TrimSpace(req.City),
+		ZipCode:  strings.TrimSpace(req.ZipCode),
+		Priority: req.Priority,
+		IsActive: req.IsActive == nil || *req.IsActive,
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.CreateWarehouse")
+	if err := u.warehouseRepo.CreateWarehouse(ctx, warehouse); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	span.SetAttributes(attribute.Int("warehouse.id", int(warehouse.ID)))
+	span.SetStatus(codes.Ok, "warehouse created")
+	response := mapWarehouseToResponse(warehouse)
+	return &response, nil
+}
+
+func (u *WarehouseUsecase) GetWarehouseByID(ctx context.Context, id uint) (*dto.WarehouseResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "WarehouseUsecase.GetWarehouseByID")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("warehouse.id", int(id)))
+
+	warehouse, err := u.warehouseRepo.GetWarehouseByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	span.SetStatus(codes.Ok, "warehouse retrieved")
+	response := mapWarehouseToResponse(warehouse)
+	return &response, nil
+}
+
+func (u *WarehouseUsecase) ListWarehouses(ctx context.Context, activeOnly bool) ([]dto.WarehouseResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "WarehouseUsecase.ListWarehouses")
+	defer span.End()
+
+	warehouses, err := u.warehouseRepo.ListWarehouses(ctx, activeOnly)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	response := make([]dto.WarehouseResponse, len(warehouses))
+	for i := range warehouses {
+		response[i] = mapWarehouseToResponse(&warehouses[i])
+	}
+
+	span.SetAttributes(attribute.Int("warehouses.count", len(warehouses)))
+	span.SetStatus(codes.Ok, "warehouses listed")
+	return response, nil
+}
+
+func (u *WarehouseUsecase) UpdateWarehouse(ctx context.Context, id uint, req *dto.UpdateWarehouseRequest) (*dto.WarehouseResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "WarehouseUsecase.UpdateWarehouse")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("warehouse.id", int(id)))
+
+	warehouse, err := u.warehouseRepo.GetWarehouseByID(ctx, id)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	if req.Code != nil {
+		warehouse.Code = strings.TrimSpace(*req.Code)
+	}
+	if req.Name != nil {
+		warehouse.Name = strings.TrimSpace(*req.Name)
+	}
+	if req.Country != nil {
+		warehouse.Country = strings.TrimSpace(*req.Country)
+	}
+	if req.State != nil {
+		warehouse.State = strings.TrimSpace(*req.State)
+	}
+	if req.City != nil {
+		warehouse.City = strings.TrimSpace(*req.City)
+	}
+	if req.ZipCode != nil {
+		warehouse.ZipCode = strings.TrimSpace(*req.ZipCode)
+	}
+	if req.Priority != nil {
+		warehouse.Priority = *req.Priority
+	}
+	if req.IsActive != nil {
+		warehouse.IsActive = *req.IsActive
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.UpdateWarehouse")
+	if err := u.warehouseRepo.UpdateWarehouse(ctx, id, warehouse); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	span.SetStatus(codes.Ok, "warehouse updated")
+	response := mapWarehouseToResponse(warehouse)
+	return &response, nil
+}
+
+func (u *WarehouseUsecase) DeleteWarehouse(ctx context.Context, id uint) error {
+	ctx, span := u.tracer.Start(ctx, "WarehouseUsecase.DeleteWarehouse")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("warehouse.id", int(id)))
+
+	if err := u.warehouseRepo.DeleteWarehouse(ctx, id); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+
+	span.SetStatus(codes.Ok, "warehouse deleted")
+	return nil
+}
+
+func (u *WarehouseUsecase) GetStockAvailability(ctx context.Context, productID uint, variantID *uint) (*dto.StockAvailabilityResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "WarehouseUsecase.GetStockAvailability")
+	defer span.End()
+
+	span.SetAttributes(attribute.Int("product.id", int(productID)))
+
+	onHand, err := u.onHandQuantity(ctx, productID, variantID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.ListStockLevels")
+	levels, err := u.warehouseRepo.ListStockLevels(ctx, []domain.StockKey{{ProductID: productID, VariantID: variantID}})
+	if err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	warehouses, err := u.warehouseRepo.ListWarehouses(ctx, false)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	warehousesByID := make(map[uint]*domain.Warehouse, len(warehouses))
+	for i := range warehouses {
+		warehousesByID[warehouses[i].ID] = &warehouses[i]
+	}
+
+	response := &dto.StockAvailabilityResponse{
+		ProductID: productID,
+		VariantID: variantID,
+		OnHand:    onHand,
+		Levels:    make([]dto.WarehouseStockLevelResponse, 0, len(levels)),
+	}
+	for _, level := range levels {
+		warehouse, ok := warehousesByID[level.WarehouseID]
+		if !ok {
+			continue
+		}
+		response.TotalQuantity += level.Quantity
+		response.Levels = append(response.Levels, dto.WarehouseStockLevelResponse{
+			WarehouseID:   warehouse.ID,
+			WarehouseCode: warehouse.Code,
+			WarehouseName: warehouse.Name,
+			IsActive:      warehouse.IsActive,
+			Quantity:      level.Quantity,
+		})
+	}
+	response.UnassignedQuantity = max(onHand-response.TotalQuantity, 0)
+
+	span.SetAttributes(attribute.Int("stock.total_quantity", response.TotalQuantity))
+	span.SetStatus(codes.Ok, "stock availability retrieved")
+	return response, nil
+}
+
+// AllocateWarehouse picks the single active warehouse that can ship every
+// requested item, ranked by the request strategy or the configured default.
+// When none can, the order ships from unassigned stock if that covers it, so
+// stock recorded without a warehouse stays sellable. With Reserve the items
+// are also taken out of stock; a warehouse that lost the units to a
+// concurrent allocation in the meantime is skipped for the next one.
+func (u *WarehouseUsecase) AllocateWarehouse(ctx context.Context, req *dto.AllocateWarehouseRequest) (*dto.AllocationResponse, error) {
+	ctx, span := u.tracer.Start(ctx, "WarehouseUsecase.AllocateWarehouse")
+	defer span.End()
+
+	strategy := u.defaultStrategy
+	if req.Strategy != "" {
+		strategy = domain.AllocationStrategy(req.Strategy)
+	}
+	if !strategy.IsValid() {
+		span.SetStatus(codes.Error, domain.ErrInvalidAllocationStrategy.Error())
+		return nil, domain.ErrInvalidAllocationStrategy
+	}
+	span.SetAttributes(
+		attribute.String("allocation.strategy", string(strategy)),
+		attribute.Int("allocation.items.count", len(req.Items)),
+		attribute.Bool("allocation.reserve", req.Reserve),
+	)
+
+	warehouses, err := u.warehouseRepo.ListWarehouses(ctx, true)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	// Merge lines for the same product or variant so they are checked
+	// against the warehouse level together.
+	required := make(map[stockKeyID]int, len(req.Items))
+	items := make([]domain.AllocationItem, 0, len(req.Items))
+	for _, item := range req.Items {
+		key := domain.StockKey{ProductID: item.ProductID, VariantID: item.VariantID}
+		id := stockKeyIDOf(key)
+		if _, seen := required[id]; !seen {
+			items = append(items, domain.AllocationItem{StockKey: key})
+		}
+		required[id] += item.Quantity
+	}
+	keys := make([]domain.StockKey, len(items))
+	for i := range items {
+		items[i].Quantity = required[stockKeyIDOf(items[i].StockKey)]
+		keys[i] = items[i].StockKey
+	}
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.ListStockLevels")
+	levels, err := u.warehouseRepo.ListStockLevels(ctx, keys)
+	if err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		dbSpan.End()
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	dbSpan.End()
+
+	stock := make(map[uint]map[stockKeyID]int)
+	for _, level := range levels {
+		if stock[level.WarehouseID] == nil {
+			stock[level.WarehouseID] = make(map[stockKeyID]int)
+		}
+		stock[level.WarehouseID][stockKeyIDOf(domain.StockKey{ProductID: level.ProductID, VariantID: level.VariantID})] = level.Quantity
+	}
+
+	address := domain.ShippingAddress{
+		Country: req.Country,
+		State:   req.State,
+		City:    req.City,
+		ZipCode: req.ZipCode,
+	}
+
+	candidates := make([]*warehouseCandidate, 0, len(warehouses))
+	for i := range warehouses {
+		if candidate, ok := newWarehouseCandidate(&warehouses[i], stock[warehouses[i].ID], required, address); ok {
+			candidates = append(candidates, candidate)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].outranks(candidates[j], strategy)
+	})
+
+	for _, candidate := range candidates {
+		response := &dto.AllocationResponse{Allocated: true, Strategy: string(strategy)}
+		if req.Reserve {
+			movements, err := u.takeStock(ctx, &candidate.warehouse.ID, items, req.Reason, req.Actor)
+			if errors.Is(err, domain.ErrInsufficientStock) {
+				continue
+			}
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return nil, err
+			}
+			response.Movements = movements
+		}
+
+		span.SetAttributes(attribute.Int("warehouse.id", int(candidate.warehouse.ID)))
+		span.SetStatus(codes.Ok, "warehouse allocated")
+		warehouse := mapWarehouseToResponse(candidate.warehouse)
+		response.Warehouse = &warehouse
+		return response, nil
+	}
+
+	// No warehouse ships the order; fall back to unassigned stock.
+	unavailable := domain.ErrNoFulfillingWarehouse
+	if len(warehouses) == 0 {
+		unavailable = domain.ErrInsufficientStock
+	}
+
+	response := &dto.AllocationResponse{Allocated: false, Strategy: string(strategy)}
+	if req.Reserve {
+		movements, err := u.takeStock(ctx, nil, items, req.Reason, req.Actor)
+		if errors.Is(err, domain.ErrInsufficientStock) {
+			err = unavailable
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		response.Movements = movements
+	} else {
+		covered, err := u.unassignedStockCovers(ctx, items, levels)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		if !covered {
+			span.SetStatus(codes.Error, unavailable.Error())
+			return nil, unavailable
+		}
+	}
+
+	span.SetStatus(codes.Ok, "allocated from unassigned stock")
+	return response, nil
+}
+
+// takeStock records a sale movement per item, in the warehouse or in
+// unassigned stock when warehouseID is nil, all of them or none.
+func (u *WarehouseUsecase) takeStock(ctx context.Context, warehouseID *uint, items []domain.AllocationItem, reason, actor string) ([]dto.StockMovementResponse, error) {
+	movements := itemMovements(warehouseID, items, domain.StockMovementSale, reason, actor)
+
+	_, dbSpan := u.tracer.Start(ctx, "Database.RecordStockMovements")
+	defer dbSpan.End()
+
+	if err := u.inventoryRepo.RecordMovements(ctx, movements); err != nil {
+		dbSpan.RecordError(err)
+		dbSpan.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	deleteCachedProducts(ctx, u.productCache, items)
+
+	response := make([]dto.StockMovementResponse, len(movements))
+	for i := range movements {
+		response[i] = mapStockMovementToResponse(&movements[i])
+	}
+	return response, nil
+}
+
+// unassignedStockCovers reports whether the on-hand stock no warehouse holds
+// covers every item. levels must hold every warehouse level of the items.
+func (u *WarehouseUsecase) unassignedStockCovers(ctx context.Context, items []domain.AllocationItem, levels []domain.WarehouseStock) (bool, error) {
+	held := make(map[stockKeyID]int, len(items))
+	for _, level := range levels {
+		held[stockKeyIDOf(domain.StockKey{ProductID: level.ProductID, VariantID: level.VariantID})] += level.Quantity
+	}
+
+	for _, item := range items {
+		onHand, err := u.onHandQuantity(ctx, item.ProductID, item.VariantID)
+		if err != nil {
+			return false, err
+		}
+		if onHand-held[stockKeyIDOf(item.StockKey)] < item.Quantity {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (u *WarehouseUsecase) onHandQuantity(ctx context.Context, productID uint, variantID *uint) (int, error) {
+	if variantID == nil {
+		product, err := u.productRepo.GetProductByID(ctx, productID)
+		if err != nil {
+			return 0, err
+		}
+		return product.Quantity, nil
+	}
+
+	variant, err := u.variantRepo.GetVariantByID(ctx, *variantID)
+	if err != nil {
+		return 0, err
+	}
+	if variant.ProductID != productID {
+		return 0, domain.ErrVariantMismatch
+	}
+	return variant.Quantity, nil
+}
+
+// stockKeyID is a comparable form of domain.StockKey; variant 0 stands for
+// product-level stock.
+type stockKeyID struct {
+	productID uint
+	variantID uint
+}
+
+func stockKeyIDOf(key domain.StockKey) stockKeyID {
+	id := stockKeyID{productID: key.ProductID}
+	if key.VariantID != nil {
+		id.variantID = *key.VariantID
+	}
+	return id
+}
+
+type warehouseCandidate struct {
+	warehouse *domain.Warehouse
+	proximity int
+	units     int
+}
+
+// newWarehouseCandidate reports whether the warehouse holds enough of every
+// required item and, if so, how close it is and how many units it holds.
+func newWarehouseCandidate(warehouse *domain.Warehouse, stock map[stockKeyID]int, required map[stockKeyID]int, address domain.ShippingAddress) (*warehouseCandidate, bool) {
+	candidate := &warehouseCandidate{
+		warehouse: warehouse,
+		proximity: addressProximity(warehouse, address),
+	}
+	for id, quantity := range required {
+		available := stock[id]
+		if available < quantity {
+			return nil, false
+		}
+		candidate.units += available
+	}
+	return candidate, true
+}
+
+func (c *warehouseCandidate) outranks(other *warehouseCandidate, strategy domain.AllocationStrategy) bool {
+	if strategy == domain.AllocationNearest && c.proximity != other.proximity {
+		return c.proximity > other.proximity
+	}
+	if strategy == domain.AllocationMostStock && c.units != other.units {
+		return c.units > other.units
+	}
+	if c.warehouse.Priority != other.warehouse.Priority {
+		return c.warehouse.Priority < other.warehouse.Priority
+	}
+	if c.units != other.units {
+		return c.units > other.units
+	}
+	return c.warehouse.ID < other.warehouse.ID
+}
+
+// addressProximity counts how many address levels, from country down to zip
+// code, the warehouse shares with the shipping address, stopping at the first
+// mismatch. Without coordinates this is the best available distance proxy.
+func addressProximity(warehouse *domain.Warehouse, address domain.ShippingAddress) int {
+	levels := [][2]string{
+		{warehouse.Country, address.Country},
+		{warehouse.State, address.State},
+		{warehouse.City, address.City},
+		{warehouse.ZipCode, address.ZipCode},
+	}
+	proximity := 0
+	for _, level := range levels {
+		a, b := strings.TrimSpace(level[0]), strings.TrimSpace(level[1])
+		if a == "" || b == "" || !strings.EqualFold(a, b) {
+			break
+		}
+		proximity++
+	}
+	return proximity
+}
+
+func mapWarehouseToResponse(w *domain.Warehouse) dto.WarehouseResponse {
+	return dto.WarehouseResponse{
+		ID:        w.ID,
+		Code:      w.Code,
+		Name:      w.Name,
+		Country:   w.Country,
+		State:     w.State,
+		City:      w.City,
+		ZipCode:   w.ZipCode,
+		Priority:  w.Priority,
+		IsActive:  w.IsActive,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
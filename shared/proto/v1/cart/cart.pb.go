@@ -21,9 +21,13 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Every cart request addresses either a user's cart (user_id) or a guest
+// cart (guest_token), never both. Mutations with expected_version set fail
+// with ABORTED, changing nothing, when the cart is at another version.
 type GetCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,2,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -65,13 +69,26 @@ func (x *GetCartRequest) GetUserId() int64 {
 	return 0
 }
 
+func (x *GetCartRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
 type AddItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// optional; required when the product has variants
+	VariantId int64 `protobuf:"varint,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	// optional; a new guest cart is started when neither user_id nor
+	// guest_token is set
+	GuestToken      string `protobuf:"bytes,5,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	ExpectedVersion *int64 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *AddItemRequest) Reset() {
@@ -125,13 +142,38 @@ func (x *AddItemRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *AddItemRequest) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *AddItemRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+func (x *AddItemRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type UpdateItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// 0 removes the line
+	Quantity        int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId       int64  `protobuf:"varint,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	GuestToken      string `protobuf:"bytes,5,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	ExpectedVersion *int64 `protobuf:"varint,6,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *UpdateItemRequest) Reset() {
@@ -185,12 +227,36 @@ func (x *UpdateItemRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *UpdateItemRequest) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *UpdateItemRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+func (x *UpdateItemRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type RemoveItemRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId       int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId       int64                  `protobuf:"varint,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	GuestToken      string                 `protobuf:"bytes,4,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,5,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *RemoveItemRequest) Reset() {
@@ -237,11 +303,34 @@ func (x *RemoveItemRequest) GetProductId() int64 {
 	return 0
 }
 
+func (x *RemoveItemRequest) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *RemoveItemRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+func (x *RemoveItemRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type ClearCartRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestToken      string                 `protobuf:"bytes,2,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	ExpectedVersion *int64                 `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *ClearCartRequest) Reset() {
@@ -281,6 +370,20 @@ func (x *ClearCartRequest) GetUserId() int64 {
 	return 0
 }
 
+func (x *ClearCartRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+func (x *ClearCartRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
 type ClearCartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -325,17 +428,93 @@ func (x *ClearCartResponse) GetSuccess() bool {
 	return false
 }
 
-type CartItem struct {
+// MergeCartRequest moves the guest cart into the user's cart and deletes it.
+// strategy decides the quantity of lines present in both carts: "sum" adds
+// them, "max" keeps the larger and "guest" keeps the guest quantity. Empty
+// uses the service default.
+type MergeCartRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	GuestToken    string                 `protobuf:"bytes,2,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	Strategy      string                 `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergeCartRequest) Reset() {
+	*x = MergeCartRequest{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergeCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartRequest) ProtoMessage() {}
+
+func (x *MergeCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartRequest.ProtoReflect.Descriptor instead.
+func (*MergeCartRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MergeCartRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *MergeCartRequest) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
+
+func (x *MergeCartRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+type CartItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId int64                  `protobuf:"varint,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Name      string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// the variant image if set, else the product image
+	ImageUrl string `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	// effective unit price: base_price less the product's active discount
+	UnitPrice float32 `protobuf:"fixed32,6,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// unit_price * quantity; the line totals add up to estimated_total
+	LineTotal float32 `protobuf:"fixed32,7,opt,name=line_total,json=lineTotal,proto3" json:"line_total,omitempty"`
+	// "in_stock", "low" or "out"; empty when pricing is unavailable
+	StockStatus string `protobuf:"bytes,8,opt,name=stock_status,json=stockStatus,proto3" json:"stock_status,omitempty"`
+	// unit price before discounts: the variant price for variants, else the product price
+	BasePrice     float32 `protobuf:"fixed32,9,opt,name=base_price,json=basePrice,proto3" json:"base_price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CartItem) Reset() {
 	*x = CartItem{}
-	mi := &file_shared_proto_v1_cart_proto_msgTypes[6]
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +526,7 @@ func (x *CartItem) String() string {
 func (*CartItem) ProtoMessage() {}
 
 func (x *CartItem) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_cart_proto_msgTypes[6]
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +539,7 @@ func (x *CartItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartItem.ProtoReflect.Descriptor instead.
 func (*CartItem) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{6}
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CartItem) GetProductId() int64 {
@@ -377,18 +556,169 @@ func (x *CartItem) GetQuantity() int32 {
 	return 0
 }
 
+func (x *CartItem) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *CartItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CartItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CartItem) GetUnitPrice() float32 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *CartItem) GetLineTotal() float32 {
+	if x != nil {
+		return x.LineTotal
+	}
+	return 0
+}
+
+func (x *CartItem) GetStockStatus() string {
+	if x != nil {
+		return x.StockStatus
+	}
+	return ""
+}
+
+func (x *CartItem) GetBasePrice() float32 {
+	if x != nil {
+		return x.BasePrice
+	}
+	return 0
+}
+
+// CartWarning flags a line that needs the shopper's attention.
+// code: "removed" (no longer in the catalog, left out of items and totals),
+// "repriced" (unit price changed since the line was added; old_price and
+// new_price are set) or "pricing_unavailable" (the catalog could not be
+// reached; items carry no prices).
+type CartWarning struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     int64                  `protobuf:"varint,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	OldPrice      *float32               `protobuf:"fixed32,5,opt,name=old_price,json=oldPrice,proto3,oneof" json:"old_price,omitempty"`
+	NewPrice      *float32               `protobuf:"fixed32,6,opt,name=new_price,json=newPrice,proto3,oneof" json:"new_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartWarning) Reset() {
+	*x = CartWarning{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartWarning) ProtoMessage() {}
+
+func (x *CartWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartWarning.ProtoReflect.Descriptor instead.
+func (*CartWarning) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CartWarning) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CartWarning) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CartWarning) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *CartWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CartWarning) GetOldPrice() float32 {
+	if x != nil && x.OldPrice != nil {
+		return *x.OldPrice
+	}
+	return 0
+}
+
+func (x *CartWarning) GetNewPrice() float32 {
+	if x != nil && x.NewPrice != nil {
+		return *x.NewPrice
+	}
+	return 0
+}
+
 type CartResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Items         []*CartItem            `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 	TotalQuantity int32                  `protobuf:"varint,3,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	// set for guest carts
+	GuestToken string `protobuf:"bytes,4,opt,name=guest_token,json=guestToken,proto3" json:"guest_token,omitempty"`
+	// sum of base_price * quantity over the lines, before product discounts
+	Subtotal float32 `protobuf:"fixed32,5,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+	// sum of the product discounts on the lines
+	EstimatedDiscount float32 `protobuf:"fixed32,6,opt,name=estimated_discount,json=estimatedDiscount,proto3" json:"estimated_discount,omitempty"`
+	// sum of the line totals: subtotal less estimated_discount
+	EstimatedTotal float32        `protobuf:"fixed32,7,opt,name=estimated_total,json=estimatedTotal,proto3" json:"estimated_total,omitempty"`
+	Warnings       []*CartWarning `protobuf:"bytes,8,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	// RFC 3339 time of the cart's last change, empty when unknown
+	UpdatedAt string `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// bumped by every change to the cart; 0 for a cart never changed
+	Version       int64 `protobuf:"varint,10,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CartResponse) Reset() {
 	*x = CartResponse{}
-	mi := &file_shared_proto_v1_cart_proto_msgTypes[7]
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -400,7 +730,7 @@ func (x *CartResponse) String() string {
 func (*CartResponse) ProtoMessage() {}
 
 func (x *CartResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_cart_proto_msgTypes[7]
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -413,7 +743,7 @@ func (x *CartResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartResponse.ProtoReflect.Descriptor instead.
 func (*CartResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{7}
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CartResponse) GetUserId() int64 {
@@ -437,47 +767,1060 @@ func (x *CartResponse) GetTotalQuantity() int32 {
 	return 0
 }
 
-var File_shared_proto_v1_cart_proto protoreflect.FileDescriptor
+func (x *CartResponse) GetGuestToken() string {
+	if x != nil {
+		return x.GuestToken
+	}
+	return ""
+}
 
-const file_shared_proto_v1_cart_proto_rawDesc = "" +
-	"\n" +
-	"\x1ashared/proto/v1/cart.proto\x12\x04cart\")\n" +
-	"\x0eGetCartRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"d\n" +
-	"\x0eAddItemRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\"g\n" +
-	"\x11UpdateItemRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\"K\n" +
-	"\x11RemoveItemRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\x03R\tproductId\"+\n" +
-	"\x10ClearCartRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"-\n" +
-	"\x11ClearCartResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"E\n" +
-	"\bCartItem\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"t\n" +
-	"\fCartResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12$\n" +
-	"\x05items\x18\x02 \x03(\v2\x0e.cart.CartItemR\x05items\x12%\n" +
-	"\x0etotal_quantity\x18\x03 \x01(\x05R\rtotalQuantity2\xab\x02\n" +
-	"\vCartService\x123\n" +
-	"\aGetCart\x12\x14.cart.GetCartRequest\x1a\x12.cart.CartResponse\x123\n" +
-	"\aAddItem\x12\x14.cart.AddItemRequest\x1a\x12.cart.CartResponse\x129\n" +
-	"\n" +
-	"UpdateItem\x12\x17.cart.UpdateItemRequest\x1a\x12.cart.CartResponse\x129\n" +
-	"\n" +
-	"RemoveItem\x12\x17.cart.RemoveItemRequest\x1a\x12.cart.CartResponse\x12<\n" +
-	"\tClearCart\x12\x16.cart.ClearCartRequest\x1a\x17.cart.ClearCartResponseB\x1bZ\x19shared/proto/v1/cart;cartb\x06proto3"
+func (x *CartResponse) GetSubtotal() float32 {
+	if x != nil {
+		return x.Subtotal
+	}
+	return 0
+}
+
+func (x *CartResponse) GetEstimatedDiscount() float32 {
+	if x != nil {
+		return x.EstimatedDiscount
+	}
+	return 0
+}
+
+func (x *CartResponse) GetEstimatedTotal() float32 {
+	if x != nil {
+		return x.EstimatedTotal
+	}
+	return 0
+}
+
+func (x *CartResponse) GetWarnings() []*CartWarning {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *CartResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *CartResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Lists are persistent and belong to a user. Every user has a
+// saved-for-later list with id "saved", created on first use, and any number
+// of wishlists. An empty list_id addresses the saved-for-later list.
+type ListListsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListListsRequest) Reset() {
+	*x = ListListsRequest{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListListsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListListsRequest) ProtoMessage() {}
+
+func (x *ListListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListListsRequest.ProtoReflect.Descriptor instead.
+func (*ListListsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListListsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CreateWishlistRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWishlistRequest) Reset() {
+	*x = CreateWishlistRequest{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWishlistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWishlistRequest) ProtoMessage() {}
+
+func (x *CreateWishlistRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWishlistRequest.ProtoReflect.Descriptor instead.
+func (*CreateWishlistRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreateWishlistRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateWishlistRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ListId        string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetListRequest) Reset() {
+	*x = GetListRequest{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetListRequest) ProtoMessage() {}
+
+func (x *GetListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetListRequest.ProtoReflect.Descriptor instead.
+func (*GetListRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetListRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetListRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+// DeleteListRequest deletes a wishlist; the saved-for-later list cannot be
+// deleted.
+type DeleteListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ListId        string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteListRequest) Reset() {
+	*x = DeleteListRequest{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListRequest) ProtoMessage() {}
+
+func (x *DeleteListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListRequest.ProtoReflect.Descriptor instead.
+func (*DeleteListRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteListRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteListRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+type DeleteListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteListResponse) Reset() {
+	*x = DeleteListResponse{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteListResponse) ProtoMessage() {}
+
+func (x *DeleteListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteListResponse.ProtoReflect.Descriptor instead.
+func (*DeleteListResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteListResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type AddListItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ListId        string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     int64                  `protobuf:"varint,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddListItemRequest) Reset() {
+	*x = AddListItemRequest{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddListItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddListItemRequest) ProtoMessage() {}
+
+func (x *AddListItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddListItemRequest.ProtoReflect.Descriptor instead.
+func (*AddListItemRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AddListItemRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AddListItemRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *AddListItemRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *AddListItemRequest) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *AddListItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// ListItemRequest addresses a list item, or for MoveToList the cart line to
+// move into the list. Moves always move the whole quantity.
+type ListItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ListId        string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     int64                  `protobuf:"varint,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItemRequest) Reset() {
+	*x = ListItemRequest{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemRequest) ProtoMessage() {}
+
+func (x *ListItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemRequest.ProtoReflect.Descriptor instead.
+func (*ListItemRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListItemRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListItemRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *ListItemRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListItemRequest) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+// ShareListRequest turns public sharing of a wishlist on or off. Turning it
+// on again issues a new share_token, revoking the old one.
+type ShareListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ListId        string                 `protobuf:"bytes,2,opt,name=list_id,json=listId,proto3" json:"list_id,omitempty"`
+	Shared        bool                   `protobuf:"varint,3,opt,name=shared,proto3" json:"shared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareListRequest) Reset() {
+	*x = ShareListRequest{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareListRequest) ProtoMessage() {}
+
+func (x *ShareListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareListRequest.ProtoReflect.Descriptor instead.
+func (*ShareListRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ShareListRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ShareListRequest) GetListId() string {
+	if x != nil {
+		return x.ListId
+	}
+	return ""
+}
+
+func (x *ShareListRequest) GetShared() bool {
+	if x != nil {
+		return x.Shared
+	}
+	return false
+}
+
+type GetSharedListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ShareToken    string                 `protobuf:"bytes,1,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharedListRequest) Reset() {
+	*x = GetSharedListRequest{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharedListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharedListRequest) ProtoMessage() {}
+
+func (x *GetSharedListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharedListRequest.ProtoReflect.Descriptor instead.
+func (*GetSharedListRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetSharedListRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+type ListItem struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId int64                  `protobuf:"varint,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Name      string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	ImageUrl  string                 `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	UnitPrice float32                `protobuf:"fixed32,6,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// "in_stock", "low" or "out"; empty when pricing is unavailable
+	StockStatus string `protobuf:"bytes,7,opt,name=stock_status,json=stockStatus,proto3" json:"stock_status,omitempty"`
+	// false when the product or variant is no longer in the catalog
+	Available     bool `protobuf:"varint,8,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItem) Reset() {
+	*x = ListItem{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItem) ProtoMessage() {}
+
+func (x *ListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItem.ProtoReflect.Descriptor instead.
+func (*ListItem) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListItem) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *ListItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ListItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListItem) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *ListItem) GetUnitPrice() float32 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *ListItem) GetStockStatus() string {
+	if x != nil {
+		return x.StockStatus
+	}
+	return ""
+}
+
+func (x *ListItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type ListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// empty for shared lists
+	UserId int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Name   string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// "saved_for_later" or "wishlist"
+	Kind string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	// set while the wishlist is shared
+	ShareToken    string      `protobuf:"bytes,5,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	Items         []*ListItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	TotalQuantity int32       `protobuf:"varint,7,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	// the catalog could not be reached; items carry no names or prices
+	PricingUnavailable bool `protobuf:"varint,8,opt,name=pricing_unavailable,json=pricingUnavailable,proto3" json:"pricing_unavailable,omitempty"`
+	// RFC 3339; empty for a saved-for-later list not created yet
+	CreatedAt     string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListResponse) Reset() {
+	*x = ListResponse{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListResponse) ProtoMessage() {}
+
+func (x *ListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListResponse.ProtoReflect.Descriptor instead.
+func (*ListResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListResponse) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ListResponse) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *ListResponse) GetItems() []*ListItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListResponse) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *ListResponse) GetPricingUnavailable() bool {
+	if x != nil {
+		return x.PricingUnavailable
+	}
+	return false
+}
+
+func (x *ListResponse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ListResponse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lists         []*ListResponse        `protobuf:"bytes,1,rep,name=lists,proto3" json:"lists,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListsResponse) Reset() {
+	*x = ListsResponse{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListsResponse) ProtoMessage() {}
+
+func (x *ListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListsResponse.ProtoReflect.Descriptor instead.
+func (*ListsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListsResponse) GetLists() []*ListResponse {
+	if x != nil {
+		return x.Lists
+	}
+	return nil
+}
+
+type MoveItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cart          *CartResponse          `protobuf:"bytes,1,opt,name=cart,proto3" json:"cart,omitempty"`
+	List          *ListResponse          `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveItemResponse) Reset() {
+	*x = MoveItemResponse{}
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveItemResponse) ProtoMessage() {}
+
+func (x *MoveItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_cart_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveItemResponse.ProtoReflect.Descriptor instead.
+func (*MoveItemResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_cart_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MoveItemResponse) GetCart() *CartResponse {
+	if x != nil {
+		return x.Cart
+	}
+	return nil
+}
+
+func (x *MoveItemResponse) GetList() *ListResponse {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+var File_shared_proto_v1_cart_proto protoreflect.FileDescriptor
+
+const file_shared_proto_v1_cart_proto_rawDesc = "" +
+	"\n" +
+	"\x1ashared/proto/v1/cart.proto\x12\x04cart\"J\n" +
+	"\x0eGetCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vguest_token\x18\x02 \x01(\tR\n" +
+	"guestToken\"\xe9\x01\n" +
+	"\x0eAddItemRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\x03R\tvariantId\x12\x1f\n" +
+	"\vguest_token\x18\x05 \x01(\tR\n" +
+	"guestToken\x12.\n" +
+	"\x10expected_version\x18\x06 \x01(\x03H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"\xec\x01\n" +
+	"\x11UpdateItemRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\x03R\tvariantId\x12\x1f\n" +
+	"\vguest_token\x18\x05 \x01(\tR\n" +
+	"guestToken\x12.\n" +
+	"\x10expected_version\x18\x06 \x01(\x03H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"\xd0\x01\n" +
+	"\x11RemoveItemRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\x03R\tvariantId\x12\x1f\n" +
+	"\vguest_token\x18\x04 \x01(\tR\n" +
+	"guestToken\x12.\n" +
+	"\x10expected_version\x18\x05 \x01(\x03H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"\x91\x01\n" +
+	"\x10ClearCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vguest_token\x18\x02 \x01(\tR\n" +
+	"guestToken\x12.\n" +
+	"\x10expected_version\x18\x03 \x01(\x03H\x00R\x0fexpectedVersion\x88\x01\x01B\x13\n" +
+	"\x11_expected_version\"-\n" +
+	"\x11ClearCartResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"h\n" +
+	"\x10MergeCartRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1f\n" +
+	"\vguest_token\x18\x02 \x01(\tR\n" +
+	"guestToken\x12\x1a\n" +
+	"\bstrategy\x18\x03 \x01(\tR\bstrategy\"\x95\x02\n" +
+	"\bCartItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\x03R\tvariantId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x06 \x01(\x02R\tunitPrice\x12\x1d\n" +
+	"\n" +
+	"line_total\x18\a \x01(\x02R\tlineTotal\x12!\n" +
+	"\fstock_status\x18\b \x01(\tR\vstockStatus\x12\x1d\n" +
+	"\n" +
+	"base_price\x18\t \x01(\x02R\tbasePrice\"\xd9\x01\n" +
+	"\vCartWarning\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\x03R\tvariantId\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\x12 \n" +
+	"\told_price\x18\x05 \x01(\x02H\x00R\boldPrice\x88\x01\x01\x12 \n" +
+	"\tnew_price\x18\x06 \x01(\x02H\x01R\bnewPrice\x88\x01\x01B\f\n" +
+	"\n" +
+	"_old_priceB\f\n" +
+	"\n" +
+	"_new_price\"\xf1\x02\n" +
+	"\fCartResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12$\n" +
+	"\x05items\x18\x02 \x03(\v2\x0e.cart.CartItemR\x05items\x12%\n" +
+	"\x0etotal_quantity\x18\x03 \x01(\x05R\rtotalQuantity\x12\x1f\n" +
+	"\vguest_token\x18\x04 \x01(\tR\n" +
+	"guestToken\x12\x1a\n" +
+	"\bsubtotal\x18\x05 \x01(\x02R\bsubtotal\x12-\n" +
+	"\x12estimated_discount\x18\x06 \x01(\x02R\x11estimatedDiscount\x12'\n" +
+	"\x0festimated_total\x18\a \x01(\x02R\x0eestimatedTotal\x12-\n" +
+	"\bwarnings\x18\b \x03(\v2\x11.cart.CartWarningR\bwarnings\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\x12\x18\n" +
+	"\aversion\x18\n" +
+	" \x01(\x03R\aversion\"+\n" +
+	"\x10ListListsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\"D\n" +
+	"\x15CreateWishlistRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"B\n" +
+	"\x0eGetListRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\tR\x06listId\"E\n" +
+	"\x11DeleteListRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\tR\x06listId\".\n" +
+	"\x12DeleteListResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa0\x01\n" +
+	"\x12AddListItemRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\tR\x06listId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\x03R\tvariantId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\"\x81\x01\n" +
+	"\x0fListItemRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\tR\x06listId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\x03R\tvariantId\"\\\n" +
+	"\x10ShareListRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x17\n" +
+	"\alist_id\x18\x02 \x01(\tR\x06listId\x12\x16\n" +
+	"\x06shared\x18\x03 \x01(\bR\x06shared\"7\n" +
+	"\x14GetSharedListRequest\x12\x1f\n" +
+	"\vshare_token\x18\x01 \x01(\tR\n" +
+	"shareToken\"\xf5\x01\n" +
+	"\bListItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\x03R\tvariantId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x06 \x01(\x02R\tunitPrice\x12!\n" +
+	"\fstock_status\x18\a \x01(\tR\vstockStatus\x12\x1c\n" +
+	"\tavailable\x18\b \x01(\bR\tavailable\"\xbc\x02\n" +
+	"\fListResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x1f\n" +
+	"\vshare_token\x18\x05 \x01(\tR\n" +
+	"shareToken\x12$\n" +
+	"\x05items\x18\x06 \x03(\v2\x0e.cart.ListItemR\x05items\x12%\n" +
+	"\x0etotal_quantity\x18\a \x01(\x05R\rtotalQuantity\x12/\n" +
+	"\x13pricing_unavailable\x18\b \x01(\bR\x12pricingUnavailable\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\"9\n" +
+	"\rListsResponse\x12(\n" +
+	"\x05lists\x18\x01 \x03(\v2\x12.cart.ListResponseR\x05lists\"b\n" +
+	"\x10MoveItemResponse\x12&\n" +
+	"\x04cart\x18\x01 \x01(\v2\x12.cart.CartResponseR\x04cart\x12&\n" +
+	"\x04list\x18\x02 \x01(\v2\x12.cart.ListResponseR\x04list2\xc5\a\n" +
+	"\vCartService\x123\n" +
+	"\aGetCart\x12\x14.cart.GetCartRequest\x1a\x12.cart.CartResponse\x123\n" +
+	"\aAddItem\x12\x14.cart.AddItemRequest\x1a\x12.cart.CartResponse\x129\n" +
+	"\n" +
+	"UpdateItem\x12\x17.cart.UpdateItemRequest\x1a\x12.cart.CartResponse\x129\n" +
+	"\n" +
+	"RemoveItem\x12\x17.cart.RemoveItemRequest\x1a\x12.cart.CartResponse\x12<\n" +
+	"\tClearCart\x12\x16.cart.ClearCartRequest\x1a\x17.cart.ClearCartResponse\x127\n" +
+	"\tMergeCart\x12\x16.cart.MergeCartRequest\x1a\x12.cart.CartResponse\x128\n" +
+	"\tListLists\x12\x16.cart.ListListsRequest\x1a\x13.cart.ListsResponse\x12A\n" +
+	"\x0eCreateWishlist\x12\x1b.cart.CreateWishlistRequest\x1a\x12.cart.ListResponse\x123\n" +
+	"\aGetList\x12\x14.cart.GetListRequest\x1a\x12.cart.ListResponse\x12?\n" +
+	"\n" +
+	"DeleteList\x12\x17.cart.DeleteListRequest\x1a\x18.cart.DeleteListResponse\x12;\n" +
+	"\vAddListItem\x12\x18.cart.AddListItemRequest\x1a\x12.cart.ListResponse\x12;\n" +
+	"\x0eRemoveListItem\x12\x15.cart.ListItemRequest\x1a\x12.cart.ListResponse\x12;\n" +
+	"\n" +
+	"MoveToList\x12\x15.cart.ListItemRequest\x1a\x16.cart.MoveItemResponse\x12;\n" +
+	"\n" +
+	"MoveToCart\x12\x15.cart.ListItemRequest\x1a\x16.cart.MoveItemResponse\x127\n" +
+	"\tShareList\x12\x16.cart.ShareListRequest\x1a\x12.cart.ListResponse\x12?\n" +
+	"\rGetSharedList\x12\x1a.cart.GetSharedListRequest\x1a\x12.cart.ListResponseB\x1bZ\x19shared/proto/v1/cart;cartb\x06proto3"
 
 var (
 	file_shared_proto_v1_cart_proto_rawDescOnce sync.Once
@@ -491,34 +1834,76 @@ func file_shared_proto_v1_cart_proto_rawDescGZIP() []byte {
 	return file_shared_proto_v1_cart_proto_rawDescData
 }
 
-var file_shared_proto_v1_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_shared_proto_v1_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_shared_proto_v1_cart_proto_goTypes = []any{
-	(*GetCartRequest)(nil),    // 0: cart.GetCartRequest
-	(*AddItemRequest)(nil),    // 1: cart.AddItemRequest
-	(*UpdateItemRequest)(nil), // 2: cart.UpdateItemRequest
-	(*RemoveItemRequest)(nil), // 3: cart.RemoveItemRequest
-	(*ClearCartRequest)(nil),  // 4: cart.ClearCartRequest
-	(*ClearCartResponse)(nil), // 5: cart.ClearCartResponse
-	(*CartItem)(nil),          // 6: cart.CartItem
-	(*CartResponse)(nil),      // 7: cart.CartResponse
+	(*GetCartRequest)(nil),        // 0: cart.GetCartRequest
+	(*AddItemRequest)(nil),        // 1: cart.AddItemRequest
+	(*UpdateItemRequest)(nil),     // 2: cart.UpdateItemRequest
+	(*RemoveItemRequest)(nil),     // 3: cart.RemoveItemRequest
+	(*ClearCartRequest)(nil),      // 4: cart.ClearCartRequest
+	(*ClearCartResponse)(nil),     // 5: cart.ClearCartResponse
+	(*MergeCartRequest)(nil),      // 6: cart.MergeCartRequest
+	(*CartItem)(nil),              // 7: cart.CartItem
+	(*CartWarning)(nil),           // 8: cart.CartWarning
+	(*CartResponse)(nil),          // 9: cart.CartResponse
+	(*ListListsRequest)(nil),      // 10: cart.ListListsRequest
+	(*CreateWishlistRequest)(nil), // 11: cart.CreateWishlistRequest
+	(*GetListRequest)(nil),        // 12: cart.GetListRequest
+	(*DeleteListRequest)(nil),     // 13: cart.DeleteListRequest
+	(*DeleteListResponse)(nil),    // 14: cart.DeleteListResponse
+	(*AddListItemRequest)(nil),    // 15: cart.AddListItemRequest
+	(*ListItemRequest)(nil),       // 16: cart.ListItemRequest
+	(*ShareListRequest)(nil),      // 17: cart.ShareListRequest
+	(*GetSharedListRequest)(nil),  // 18: cart.GetSharedListRequest
+	(*ListItem)(nil),              // 19: cart.ListItem
+	(*ListResponse)(nil),          // 20: cart.ListResponse
+	(*ListsResponse)(nil),         // 21: cart.ListsResponse
+	(*MoveItemResponse)(nil),      // 22: cart.MoveItemResponse
 }
 var file_shared_proto_v1_cart_proto_depIdxs = []int32{
-	6, // 0: cart.CartResponse.items:type_name -> cart.CartItem
-	0, // 1: cart.CartService.GetCart:input_type -> cart.GetCartRequest
-	1, // 2: cart.CartService.AddItem:input_type -> cart.AddItemRequest
-	2, // 3: cart.CartService.UpdateItem:input_type -> cart.UpdateItemRequest
-	3, // 4: cart.CartService.RemoveItem:input_type -> cart.RemoveItemRequest
-	4, // 5: cart.CartService.ClearCart:input_type -> cart.ClearCartRequest
-	7, // 6: cart.CartService.GetCart:output_type -> cart.CartResponse
-	7, // 7: cart.CartService.AddItem:output_type -> cart.CartResponse
-	7, // 8: cart.CartService.UpdateItem:output_type -> cart.CartResponse
-	7, // 9: cart.CartService.RemoveItem:output_type -> cart.CartResponse
-	5, // 10: cart.CartService.ClearCart:output_type -> cart.ClearCartResponse
-	6, // [6:11] is the sub-list for method output_type
-	1, // [1:6] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	7,  // 0: cart.CartResponse.items:type_name -> cart.CartItem
+	8,  // 1: cart.CartResponse.warnings:type_name -> cart.CartWarning
+	19, // 2: cart.ListResponse.items:type_name -> cart.ListItem
+	20, // 3: cart.ListsResponse.lists:type_name -> cart.ListResponse
+	9,  // 4: cart.MoveItemResponse.cart:type_name -> cart.CartResponse
+	20, // 5: cart.MoveItemResponse.list:type_name -> cart.ListResponse
+	0,  // 6: cart.CartService.GetCart:input_type -> cart.GetCartRequest
+	1,  // 7: cart.CartService.AddItem:input_type -> cart.AddItemRequest
+	2,  // 8: cart.CartService.UpdateItem:input_type -> cart.UpdateItemRequest
+	3,  // 9: cart.CartService.RemoveItem:input_type -> cart.RemoveItemRequest
+	4,  // 10: cart.CartService.ClearCart:input_type -> cart.ClearCartRequest
+	6,  // 11: cart.CartService.MergeCart:input_type -> cart.MergeCartRequest
+	10, // 12: cart.CartService.ListLists:input_type -> cart.ListListsRequest
+	11, // 13: cart.CartService.CreateWishlist:input_type -> cart.CreateWishlistRequest
+	12, // 14: cart.CartService.GetList:input_type -> cart.GetListRequest
+	13, // 15: cart.CartService.DeleteList:input_type -> cart.DeleteListRequest
+	15, // 16: cart.CartService.AddListItem:input_type -> cart.AddListItemRequest
+	16, // 17: cart.CartService.RemoveListItem:input_type -> cart.ListItemRequest
+	16, // 18: cart.CartService.MoveToList:input_type -> cart.ListItemRequest
+	16, // 19: cart.CartService.MoveToCart:input_type -> cart.ListItemRequest
+	17, // 20: cart.CartService.ShareList:input_type -> cart.ShareListRequest
+	18, // 21: cart.CartService.GetSharedList:input_type -> cart.GetSharedListRequest
+	9,  // 22: cart.CartService.GetCart:output_type -> cart.CartResponse
+	9,  // 23: cart.CartService.AddItem:output_type -> cart.CartResponse
+	9,  // 24: cart.CartService.UpdateItem:output_type -> cart.CartResponse
+	9,  // 25: cart.CartService.RemoveItem:output_type -> cart.CartResponse
+	5,  // 26: cart.CartService.ClearCart:output_type -> cart.ClearCartResponse
+	9,  // 27: cart.CartService.MergeCart:output_type -> cart.CartResponse
+	21, // 28: cart.CartService.ListLists:output_type -> cart.ListsResponse
+	20, // 29: cart.CartService.CreateWishlist:output_type -> cart.ListResponse
+	20, // 30: cart.CartService.GetList:output_type -> cart.ListResponse
+	14, // 31: cart.CartService.DeleteList:output_type -> cart.DeleteListResponse
+	20, // 32: cart.CartService.AddListItem:output_type -> cart.ListResponse
+	20, // 33: cart.CartService.RemoveListItem:output_type -> cart.ListResponse
+	22, // 34: cart.CartService.MoveToList:output_type -> cart.MoveItemResponse
+	22, // 35: cart.CartService.MoveToCart:output_type -> cart.MoveItemResponse
+	20, // 36: cart.CartService.ShareList:output_type -> cart.ListResponse
+	20, // 37: cart.CartService.GetSharedList:output_type -> cart.ListResponse
+	22, // [22:38] is the sub-list for method output_type
+	6,  // [6:22] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_shared_proto_v1_cart_proto_init() }
@@ -526,13 +1911,18 @@ func file_shared_proto_v1_cart_proto_init() {
 	if File_shared_proto_v1_cart_proto != nil {
 		return
 	}
+	file_shared_proto_v1_cart_proto_msgTypes[1].OneofWrappers = []any{}
+	file_shared_proto_v1_cart_proto_msgTypes[2].OneofWrappers = []any{}
+	file_shared_proto_v1_cart_proto_msgTypes[3].OneofWrappers = []any{}
+	file_shared_proto_v1_cart_proto_msgTypes[4].OneofWrappers = []any{}
+	file_shared_proto_v1_cart_proto_msgTypes[8].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shared_proto_v1_cart_proto_rawDesc), len(file_shared_proto_v1_cart_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
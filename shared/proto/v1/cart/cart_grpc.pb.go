@@ -19,11 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CartService_GetCart_FullMethodName    = "/cart.CartService/GetCart"
-	CartService_AddItem_FullMethodName    = "/cart.CartService/AddItem"
-	CartService_UpdateItem_FullMethodName = "/cart.CartService/UpdateItem"
-	CartService_RemoveItem_FullMethodName = "/cart.CartService/RemoveItem"
-	CartService_ClearCart_FullMethodName  = "/cart.CartService/ClearCart"
+	CartService_GetCart_FullMethodName        = "/cart.CartService/GetCart"
+	CartService_AddItem_FullMethodName        = "/cart.CartService/AddItem"
+	CartService_UpdateItem_FullMethodName     = "/cart.CartService/UpdateItem"
+	CartService_RemoveItem_FullMethodName     = "/cart.CartService/RemoveItem"
+	CartService_ClearCart_FullMethodName      = "/cart.CartService/ClearCart"
+	CartService_MergeCart_FullMethodName      = "/cart.CartService/MergeCart"
+	CartService_ListLists_FullMethodName      = "/cart.CartService/ListLists"
+	CartService_CreateWishlist_FullMethodName = "/cart.CartService/CreateWishlist"
+	CartService_GetList_FullMethodName        = "/cart.CartService/GetList"
+	CartService_DeleteList_FullMethodName     = "/cart.CartService/DeleteList"
+	CartService_AddListItem_FullMethodName    = "/cart.CartService/AddListItem"
+	CartService_RemoveListItem_FullMethodName = "/cart.CartService/RemoveListItem"
+	CartService_MoveToList_FullMethodName     = "/cart.CartService/MoveToList"
+	CartService_MoveToCart_FullMethodName     = "/cart.CartService/MoveToCart"
+	CartService_ShareList_FullMethodName      = "/cart.CartService/ShareList"
+	CartService_GetSharedList_FullMethodName  = "/cart.CartService/GetSharedList"
 )
 
 // CartServiceClient is the client API for CartService service.
@@ -35,6 +46,18 @@ type CartServiceClient interface {
 	UpdateItem(ctx context.Context, in *UpdateItemRequest, opts ...grpc.CallOption) (*CartResponse, error)
 	RemoveItem(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*CartResponse, error)
 	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error)
+	MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*CartResponse, error)
+	// Saved-for-later list and wishlists
+	ListLists(ctx context.Context, in *ListListsRequest, opts ...grpc.CallOption) (*ListsResponse, error)
+	CreateWishlist(ctx context.Context, in *CreateWishlistRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	GetList(ctx context.Context, in *GetListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	DeleteList(ctx context.Context, in *DeleteListRequest, opts ...grpc.CallOption) (*DeleteListResponse, error)
+	AddListItem(ctx context.Context, in *AddListItemRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	RemoveListItem(ctx context.Context, in *ListItemRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	MoveToList(ctx context.Context, in *ListItemRequest, opts ...grpc.CallOption) (*MoveItemResponse, error)
+	MoveToCart(ctx context.Context, in *ListItemRequest, opts ...grpc.CallOption) (*MoveItemResponse, error)
+	ShareList(ctx context.Context, in *ShareListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	GetSharedList(ctx context.Context, in *GetSharedListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 }
 
 type cartServiceClient struct {
@@ -95,6 +118,116 @@ func (c *cartServiceClient) ClearCart(ctx context.Context, in *ClearCartRequest,
 	return out, nil
 }
 
+func (c *cartServiceClient) MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CartResponse)
+	err := c.cc.Invoke(ctx, CartService_MergeCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ListLists(ctx context.Context, in *ListListsRequest, opts ...grpc.CallOption) (*ListsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListsResponse)
+	err := c.cc.Invoke(ctx, CartService_ListLists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) CreateWishlist(ctx context.Context, in *CreateWishlistRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, CartService_CreateWishlist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) GetList(ctx context.Context, in *GetListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, CartService_GetList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) DeleteList(ctx context.Context, in *DeleteListRequest, opts ...grpc.CallOption) (*DeleteListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteListResponse)
+	err := c.cc.Invoke(ctx, CartService_DeleteList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) AddListItem(ctx context.Context, in *AddListItemRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, CartService_AddListItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) RemoveListItem(ctx context.Context, in *ListItemRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, CartService_RemoveListItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) MoveToList(ctx context.Context, in *ListItemRequest, opts ...grpc.CallOption) (*MoveItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveItemResponse)
+	err := c.cc.Invoke(ctx, CartService_MoveToList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) MoveToCart(ctx context.Context, in *ListItemRequest, opts ...grpc.CallOption) (*MoveItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveItemResponse)
+	err := c.cc.Invoke(ctx, CartService_MoveToCart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ShareList(ctx context.Context, in *ShareListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, CartService_ShareList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) GetSharedList(ctx context.Context, in *GetSharedListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, CartService_GetSharedList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CartServiceServer is the server API for CartService service.
 // All implementations must embed UnimplementedCartServiceServer
 // for forward compatibility.
@@ -104,6 +237,18 @@ type CartServiceServer interface {
 	UpdateItem(context.Context, *UpdateItemRequest) (*CartResponse, error)
 	RemoveItem(context.Context, *RemoveItemRequest) (*CartResponse, error)
 	ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error)
+	MergeCart(context.Context, *MergeCartRequest) (*CartResponse, error)
+	// Saved-for-later list and wishlists
+	ListLists(context.Context, *ListListsRequest) (*ListsResponse, error)
+	CreateWishlist(context.Context, *CreateWishlistRequest) (*ListResponse, error)
+	GetList(context.Context, *GetListRequest) (*ListResponse, error)
+	DeleteList(context.Context, *DeleteListRequest) (*DeleteListResponse, error)
+	AddListItem(context.Context, *AddListItemRequest) (*ListResponse, error)
+	RemoveListItem(context.Context, *ListItemRequest) (*ListResponse, error)
+	MoveToList(context.Context, *ListItemRequest) (*MoveItemResponse, error)
+	MoveToCart(context.Context, *ListItemRequest) (*MoveItemResponse, error)
+	ShareList(context.Context, *ShareListRequest) (*ListResponse, error)
+	GetSharedList(context.Context, *GetSharedListRequest) (*ListResponse, error)
 	mustEmbedUnimplementedCartServiceServer()
 }
 
@@ -129,6 +274,39 @@ func (UnimplementedCartServiceServer) RemoveItem(context.Context, *RemoveItemReq
 func (UnimplementedCartServiceServer) ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
 }
+func (UnimplementedCartServiceServer) MergeCart(context.Context, *MergeCartRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCart not implemented")
+}
+func (UnimplementedCartServiceServer) ListLists(context.Context, *ListListsRequest) (*ListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLists not implemented")
+}
+func (UnimplementedCartServiceServer) CreateWishlist(context.Context, *CreateWishlistRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWishlist not implemented")
+}
+func (UnimplementedCartServiceServer) GetList(context.Context, *GetListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedCartServiceServer) DeleteList(context.Context, *DeleteListRequest) (*DeleteListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteList not implemented")
+}
+func (UnimplementedCartServiceServer) AddListItem(context.Context, *AddListItemRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddListItem not implemented")
+}
+func (UnimplementedCartServiceServer) RemoveListItem(context.Context, *ListItemRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveListItem not implemented")
+}
+func (UnimplementedCartServiceServer) MoveToList(context.Context, *ListItemRequest) (*MoveItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToList not implemented")
+}
+func (UnimplementedCartServiceServer) MoveToCart(context.Context, *ListItemRequest) (*MoveItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToCart not implemented")
+}
+func (UnimplementedCartServiceServer) ShareList(context.Context, *ShareListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShareList not implemented")
+}
+func (UnimplementedCartServiceServer) GetSharedList(context.Context, *GetSharedListRequest) (*ListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedList not implemented")
+}
 func (UnimplementedCartServiceServer) mustEmbedUnimplementedCartServiceServer() {}
 func (UnimplementedCartServiceServer) testEmbeddedByValue()                     {}
 
@@ -240,6 +418,204 @@ func _CartService_ClearCart_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CartService_MergeCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).MergeCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_MergeCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).MergeCart(ctx, req.(*MergeCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ListLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ListLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_ListLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ListLists(ctx, req.(*ListListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_CreateWishlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWishlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).CreateWishlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_CreateWishlist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).CreateWishlist(ctx, req.(*CreateWishlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_GetList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).GetList(ctx, req.(*GetListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_DeleteList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).DeleteList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_DeleteList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).DeleteList(ctx, req.(*DeleteListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_AddListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddListItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).AddListItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_AddListItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).AddListItem(ctx, req.(*AddListItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_RemoveListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).RemoveListItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_RemoveListItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).RemoveListItem(ctx, req.(*ListItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_MoveToList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).MoveToList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_MoveToList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).MoveToList(ctx, req.(*ListItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_MoveToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).MoveToCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_MoveToCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).MoveToCart(ctx, req.(*ListItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ShareList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ShareList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_ShareList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ShareList(ctx, req.(*ShareListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_GetSharedList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).GetSharedList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CartService_GetSharedList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).GetSharedList(ctx, req.(*GetSharedListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CartService_ServiceDesc is the grpc.ServiceDesc for CartService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +643,50 @@ var CartService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearCart",
 			Handler:    _CartService_ClearCart_Handler,
 		},
+		{
+			MethodName: "MergeCart",
+			Handler:    _CartService_MergeCart_Handler,
+		},
+		{
+			MethodName: "ListLists",
+			Handler:    _CartService_ListLists_Handler,
+		},
+		{
+			MethodName: "CreateWishlist",
+			Handler:    _CartService_CreateWishlist_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _CartService_GetList_Handler,
+		},
+		{
+			MethodName: "DeleteList",
+			Handler:    _CartService_DeleteList_Handler,
+		},
+		{
+			MethodName: "AddListItem",
+			Handler:    _CartService_AddListItem_Handler,
+		},
+		{
+			MethodName: "RemoveListItem",
+			Handler:    _CartService_RemoveListItem_Handler,
+		},
+		{
+			MethodName: "MoveToList",
+			Handler:    _CartService_MoveToList_Handler,
+		},
+		{
+			MethodName: "MoveToCart",
+			Handler:    _CartService_MoveToCart_Handler,
+		},
+		{
+			MethodName: "ShareList",
+			Handler:    _CartService_ShareList_Handler,
+		},
+		{
+			MethodName: "GetSharedList",
+			Handler:    _CartService_GetSharedList_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "shared/proto/v1/cart.proto",
@@ -22,9 +22,11 @@ const (
 )
 
 type OrderItemInput struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity  int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// optional; required when the product has variants
+	VariantId     int64 `protobuf:"varint,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,15 +75,33 @@ func (x *OrderItemInput) GetQuantity() int32 {
 	return 0
 }
 
+func (x *OrderItemInput) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
 type CreateOrderRequest struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	UserId               int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	ShippingCost         float32                `protobuf:"fixed32,2,opt,name=shipping_cost,json=shippingCost,proto3" json:"shipping_cost,omitempty"`
-	ShippingDurationDays int32                  `protobuf:"varint,3,opt,name=shipping_duration_days,json=shippingDurationDays,proto3" json:"shipping_duration_days,omitempty"`
-	Discount             float32                `protobuf:"fixed32,4,opt,name=discount,proto3" json:"discount,omitempty"`
-	Items                []*OrderItemInput      `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// ignored; shipping is priced from shipping_option
+	//
+	// Deprecated: Marked as deprecated in shared/proto/v1/order.proto.
+	ShippingCost float32 `protobuf:"fixed32,2,opt,name=shipping_cost,json=shippingCost,proto3" json:"shipping_cost,omitempty"`
+	// ignored; shipping is priced from shipping_option
+	//
+	// Deprecated: Marked as deprecated in shared/proto/v1/order.proto.
+	ShippingDurationDays int32             `protobuf:"varint,3,opt,name=shipping_duration_days,json=shippingDurationDays,proto3" json:"shipping_duration_days,omitempty"`
+	Discount             float32           `protobuf:"fixed32,4,opt,name=discount,proto3" json:"discount,omitempty"`
+	Items                []*OrderItemInput `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty"`
+	// the user's shipping address, used to price shipping and pick the nearest
+	// warehouse
+	AddressId int64 `protobuf:"varint,6,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	// id of one of the options returned by GetShippingOptions
+	ShippingOption string `protobuf:"bytes,7,opt,name=shipping_option,json=shippingOption,proto3" json:"shipping_option,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateOrderRequest) Reset() {
@@ -121,6 +141,7 @@ func (x *CreateOrderRequest) GetUserId() int64 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in shared/proto/v1/order.proto.
 func (x *CreateOrderRequest) GetShippingCost() float32 {
 	if x != nil {
 		return x.ShippingCost
@@ -128,6 +149,7 @@ func (x *CreateOrderRequest) GetShippingCost() float32 {
 	return 0
 }
 
+// Deprecated: Marked as deprecated in shared/proto/v1/order.proto.
 func (x *CreateOrderRequest) GetShippingDurationDays() int32 {
 	if x != nil {
 		return x.ShippingDurationDays
@@ -149,6 +171,20 @@ func (x *CreateOrderRequest) GetItems() []*OrderItemInput {
 	return nil
 }
 
+func (x *CreateOrderRequest) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+func (x *CreateOrderRequest) GetShippingOption() string {
+	if x != nil {
+		return x.ShippingOption
+	}
+	return ""
+}
+
 type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
@@ -398,6 +434,7 @@ type AddOrderItemRequest struct {
 	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	VariantId     int64                  `protobuf:"varint,4,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -453,6 +490,13 @@ func (x *AddOrderItemRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *AddOrderItemRequest) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
 type AddOrderItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Order         *Order                 `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
@@ -701,8 +745,16 @@ type Order struct {
 	Items                []*OrderItem           `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
 	CreatedAt            string                 `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt            string                 `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	AddressId            int64                  `protobuf:"varint,11,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	// warehouse allocated to fulfill the order; 0 when none is configured
+	WarehouseId    int64  `protobuf:"varint,12,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ShippingOption string `protobuf:"bytes,13,opt,name=shipping_option,json=shippingOption,proto3" json:"shipping_option,omitempty"`
+	// total tax of the items
+	Tax float32 `protobuf:"fixed32,14,opt,name=tax,proto3" json:"tax,omitempty"`
+	// true when the item prices include the tax, so it is not added to total
+	TaxInclusive  bool `protobuf:"varint,15,opt,name=tax_inclusive,json=taxInclusive,proto3" json:"tax_inclusive,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Order) Reset() {
@@ -805,6 +857,41 @@ func (x *Order) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *Order) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+func (x *Order) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *Order) GetShippingOption() string {
+	if x != nil {
+		return x.ShippingOption
+	}
+	return ""
+}
+
+func (x *Order) GetTax() float32 {
+	if x != nil {
+		return x.Tax
+	}
+	return 0
+}
+
+func (x *Order) GetTaxInclusive() bool {
+	if x != nil {
+		return x.TaxInclusive
+	}
+	return false
+}
+
 type OrderItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -813,6 +900,11 @@ type OrderItem struct {
 	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	UnitPrice     float32                `protobuf:"fixed32,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
 	TotalPrice    float32                `protobuf:"fixed32,6,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	VariantId     int64                  `protobuf:"varint,7,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,8,opt,name=sku,proto3" json:"sku,omitempty"`
+	TaxCategory   string                 `protobuf:"bytes,9,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	TaxRate       float32                `protobuf:"fixed32,10,opt,name=tax_rate,json=taxRate,proto3" json:"tax_rate,omitempty"`
+	TaxAmount     float32                `protobuf:"fixed32,11,opt,name=tax_amount,json=taxAmount,proto3" json:"tax_amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -889,76 +981,1152 @@ func (x *OrderItem) GetTotalPrice() float32 {
 	return 0
 }
 
-var File_shared_proto_v1_order_proto protoreflect.FileDescriptor
+func (x *OrderItem) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
 
-const file_shared_proto_v1_order_proto_rawDesc = "" +
-	"\n" +
-	"\x1bshared/proto/v1/order.proto\x12\x05order\"K\n" +
-	"\x0eOrderItemInput\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xd1\x01\n" +
-	"\x12CreateOrderRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
-	"\rshipping_cost\x18\x02 \x01(\x02R\fshippingCost\x124\n" +
-	"\x16shipping_duration_days\x18\x03 \x01(\x05R\x14shippingDurationDays\x12\x1a\n" +
-	"\bdiscount\x18\x04 \x01(\x02R\bdiscount\x12+\n" +
-	"\x05items\x18\x05 \x03(\v2\x15.order.OrderItemInputR\x05items\"9\n" +
-	"\x13CreateOrderResponse\x12\"\n" +
-	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"%\n" +
-	"\x13GetOrderByIDRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\":\n" +
-	"\x14GetOrderByIDResponse\x12\"\n" +
-	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"[\n" +
-	"\x11ListOrdersRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
-	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12\x17\n" +
-	"\auser_id\x18\x03 \x01(\x03R\x06userId\"[\n" +
-	"\x12ListOrdersResponse\x12$\n" +
-	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"k\n" +
-	"\x13AddOrderItemRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x03 \x01(\x05R\bquantity\":\n" +
-	"\x14AddOrderItemResponse\x12\"\n" +
-	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"L\n" +
-	"\x16RemoveOrderItemRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
-	"\aitem_id\x18\x02 \x01(\x03R\x06itemId\"=\n" +
-	"\x17RemoveOrderItemResponse\x12\"\n" +
-	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"M\n" +
-	"\x18UpdateOrderStatusRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"?\n" +
-	"\x19UpdateOrderStatusResponse\x12\"\n" +
-	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"\xbb\x02\n" +
-	"\x05Order\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12#\n" +
-	"\rshipping_cost\x18\x03 \x01(\x02R\fshippingCost\x124\n" +
-	"\x16shipping_duration_days\x18\x04 \x01(\x05R\x14shippingDurationDays\x12\x1a\n" +
-	"\bdiscount\x18\x05 \x01(\x02R\bdiscount\x12\x14\n" +
-	"\x05total\x18\x06 \x01(\x02R\x05total\x12\x16\n" +
-	"\x06status\x18\a \x01(\tR\x06status\x12&\n" +
-	"\x05items\x18\b \x03(\v2\x10.order.OrderItemR\x05items\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\n" +
-	" \x01(\tR\tupdatedAt\"\xb1\x01\n" +
-	"\tOrderItem\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
-	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x1d\n" +
-	"\n" +
-	"product_id\x18\x03 \x01(\x03R\tproductId\x12\x1a\n" +
-	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x1d\n" +
-	"\n" +
-	"unit_price\x18\x05 \x01(\x02R\tunitPrice\x12\x1f\n" +
-	"\vtotal_price\x18\x06 \x01(\x02R\n" +
-	"totalPrice2\xd3\x03\n" +
+func (x *OrderItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *OrderItem) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+func (x *OrderItem) GetTaxRate() float32 {
+	if x != nil {
+		return x.TaxRate
+	}
+	return 0
+}
+
+func (x *OrderItem) GetTaxAmount() float32 {
+	if x != nil {
+		return x.TaxAmount
+	}
+	return 0
+}
+
+type HasPurchasedProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HasPurchasedProductRequest) Reset() {
+	*x = HasPurchasedProductRequest{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HasPurchasedProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasPurchasedProductRequest) ProtoMessage() {}
+
+func (x *HasPurchasedProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasPurchasedProductRequest.ProtoReflect.Descriptor instead.
+func (*HasPurchasedProductRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *HasPurchasedProductRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *HasPurchasedProductRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type HasPurchasedProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Purchased     bool                   `protobuf:"varint,1,opt,name=purchased,proto3" json:"purchased,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HasPurchasedProductResponse) Reset() {
+	*x = HasPurchasedProductResponse{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HasPurchasedProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasPurchasedProductResponse) ProtoMessage() {}
+
+func (x *HasPurchasedProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasPurchasedProductResponse.ProtoReflect.Descriptor instead.
+func (*HasPurchasedProductResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *HasPurchasedProductResponse) GetPurchased() bool {
+	if x != nil {
+		return x.Purchased
+	}
+	return false
+}
+
+type GetShippingOptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AddressId     int64                  `protobuf:"varint,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
+	Items         []*OrderItemInput      `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShippingOptionsRequest) Reset() {
+	*x = GetShippingOptionsRequest{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShippingOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShippingOptionsRequest) ProtoMessage() {}
+
+func (x *GetShippingOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShippingOptionsRequest.ProtoReflect.Descriptor instead.
+func (*GetShippingOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetShippingOptionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetShippingOptionsRequest) GetAddressId() int64 {
+	if x != nil {
+		return x.AddressId
+	}
+	return 0
+}
+
+func (x *GetShippingOptionsRequest) GetItems() []*OrderItemInput {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type ShippingOption struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Cost         float32                `protobuf:"fixed32,3,opt,name=cost,proto3" json:"cost,omitempty"`
+	DeliveryDays int32                  `protobuf:"varint,4,opt,name=delivery_days,json=deliveryDays,proto3" json:"delivery_days,omitempty"`
+	// true when the order qualifies for free shipping with this option
+	Free          bool `protobuf:"varint,5,opt,name=free,proto3" json:"free,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShippingOption) Reset() {
+	*x = ShippingOption{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShippingOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShippingOption) ProtoMessage() {}
+
+func (x *ShippingOption) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShippingOption.ProtoReflect.Descriptor instead.
+func (*ShippingOption) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ShippingOption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ShippingOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShippingOption) GetCost() float32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *ShippingOption) GetDeliveryDays() int32 {
+	if x != nil {
+		return x.DeliveryDays
+	}
+	return 0
+}
+
+func (x *ShippingOption) GetFree() bool {
+	if x != nil {
+		return x.Free
+	}
+	return false
+}
+
+type GetShippingOptionsResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Options []*ShippingOption      `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	// destination zone the options were priced for
+	Zone          string `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShippingOptionsResponse) Reset() {
+	*x = GetShippingOptionsResponse{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShippingOptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShippingOptionsResponse) ProtoMessage() {}
+
+func (x *GetShippingOptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShippingOptionsResponse.ProtoReflect.Descriptor instead.
+func (*GetShippingOptionsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetShippingOptionsResponse) GetOptions() []*ShippingOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *GetShippingOptionsResponse) GetZone() string {
+	if x != nil {
+		return x.Zone
+	}
+	return ""
+}
+
+type AuthorizePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PaymentMethod string                 `protobuf:"bytes,3,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthorizePaymentRequest) Reset() {
+	*x = AuthorizePaymentRequest{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthorizePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizePaymentRequest) ProtoMessage() {}
+
+func (x *AuthorizePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizePaymentRequest.ProtoReflect.Descriptor instead.
+func (*AuthorizePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AuthorizePaymentRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *AuthorizePaymentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AuthorizePaymentRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+type CapturePaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     int64                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CapturePaymentRequest) Reset() {
+	*x = CapturePaymentRequest{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CapturePaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CapturePaymentRequest) ProtoMessage() {}
+
+func (x *CapturePaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CapturePaymentRequest.ProtoReflect.Descriptor instead.
+func (*CapturePaymentRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CapturePaymentRequest) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+type VoidPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId     int64                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VoidPaymentRequest) Reset() {
+	*x = VoidPaymentRequest{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VoidPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoidPaymentRequest) ProtoMessage() {}
+
+func (x *VoidPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoidPaymentRequest.ProtoReflect.Descriptor instead.
+func (*VoidPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *VoidPaymentRequest) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+type RefundPaymentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PaymentId int64                  `protobuf:"varint,1,opt,name=payment_id,json=paymentId,proto3" json:"payment_id,omitempty"`
+	// 0 refunds whatever has not been refunded yet
+	Amount        float32 `protobuf:"fixed32,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundPaymentRequest) Reset() {
+	*x = RefundPaymentRequest{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundPaymentRequest) ProtoMessage() {}
+
+func (x *RefundPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundPaymentRequest.ProtoReflect.Descriptor instead.
+func (*RefundPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *RefundPaymentRequest) GetPaymentId() int64 {
+	if x != nil {
+		return x.PaymentId
+	}
+	return 0
+}
+
+func (x *RefundPaymentRequest) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type ListOrderPaymentsRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// optional; when set the order must belong to this user
+	UserId        int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderPaymentsRequest) Reset() {
+	*x = ListOrderPaymentsRequest{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderPaymentsRequest) ProtoMessage() {}
+
+func (x *ListOrderPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListOrderPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListOrderPaymentsRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ListOrderPaymentsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ListOrderPaymentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payments      []*Payment             `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListOrderPaymentsResponse) Reset() {
+	*x = ListOrderPaymentsResponse{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListOrderPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrderPaymentsResponse) ProtoMessage() {}
+
+func (x *ListOrderPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrderPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListOrderPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListOrderPaymentsResponse) GetPayments() []*Payment {
+	if x != nil {
+		return x.Payments
+	}
+	return nil
+}
+
+type HandlePaymentWebhookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// raw request body, exactly as the provider signed it
+	Payload       []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	Signature     string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandlePaymentWebhookRequest) Reset() {
+	*x = HandlePaymentWebhookRequest{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandlePaymentWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandlePaymentWebhookRequest) ProtoMessage() {}
+
+func (x *HandlePaymentWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandlePaymentWebhookRequest.ProtoReflect.Descriptor instead.
+func (*HandlePaymentWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *HandlePaymentWebhookRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *HandlePaymentWebhookRequest) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+type HandlePaymentWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandlePaymentWebhookResponse) Reset() {
+	*x = HandlePaymentWebhookResponse{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandlePaymentWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandlePaymentWebhookResponse) ProtoMessage() {}
+
+func (x *HandlePaymentWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandlePaymentWebhookResponse.ProtoReflect.Descriptor instead.
+func (*HandlePaymentWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HandlePaymentWebhookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type PaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payment       *Payment               `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentResponse) Reset() {
+	*x = PaymentResponse{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentResponse) ProtoMessage() {}
+
+func (x *PaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentResponse.ProtoReflect.Descriptor instead.
+func (*PaymentResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PaymentResponse) GetPayment() *Payment {
+	if x != nil {
+		return x.Payment
+	}
+	return nil
+}
+
+type Payment struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId        int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Provider       string                 `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	ProviderRef    string                 `protobuf:"bytes,4,opt,name=provider_ref,json=providerRef,proto3" json:"provider_ref,omitempty"`
+	Amount         float32                `protobuf:"fixed32,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	AmountCaptured float32                `protobuf:"fixed32,8,opt,name=amount_captured,json=amountCaptured,proto3" json:"amount_captured,omitempty"`
+	AmountRefunded float32                `protobuf:"fixed32,9,opt,name=amount_refunded,json=amountRefunded,proto3" json:"amount_refunded,omitempty"`
+	Transactions   []*PaymentTransaction  `protobuf:"bytes,10,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Payment) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Payment) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Payment) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *Payment) GetProviderRef() string {
+	if x != nil {
+		return x.ProviderRef
+	}
+	return ""
+}
+
+func (x *Payment) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Payment) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Payment) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Payment) GetAmountCaptured() float32 {
+	if x != nil {
+		return x.AmountCaptured
+	}
+	return 0
+}
+
+func (x *Payment) GetAmountRefunded() float32 {
+	if x != nil {
+		return x.AmountRefunded
+	}
+	return 0
+}
+
+func (x *Payment) GetTransactions() []*PaymentTransaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *Payment) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Payment) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type PaymentTransaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Operation     string                 `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	Amount        float32                `protobuf:"fixed32,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Succeeded     bool                   `protobuf:"varint,4,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	ProviderRef   string                 `protobuf:"bytes,5,opt,name=provider_ref,json=providerRef,proto3" json:"provider_ref,omitempty"`
+	FailureReason string                 `protobuf:"bytes,6,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentTransaction) Reset() {
+	*x = PaymentTransaction{}
+	mi := &file_shared_proto_v1_order_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentTransaction) ProtoMessage() {}
+
+func (x *PaymentTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_order_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentTransaction.ProtoReflect.Descriptor instead.
+func (*PaymentTransaction) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_order_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PaymentTransaction) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PaymentTransaction) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *PaymentTransaction) GetAmount() float32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PaymentTransaction) GetSucceeded() bool {
+	if x != nil {
+		return x.Succeeded
+	}
+	return false
+}
+
+func (x *PaymentTransaction) GetProviderRef() string {
+	if x != nil {
+		return x.ProviderRef
+	}
+	return ""
+}
+
+func (x *PaymentTransaction) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *PaymentTransaction) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+var File_shared_proto_v1_order_proto protoreflect.FileDescriptor
+
+const file_shared_proto_v1_order_proto_rawDesc = "" +
+	"\n" +
+	"\x1bshared/proto/v1/order.proto\x12\x05order\"j\n" +
+	"\x0eOrderItemInput\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\x03R\tvariantId\"\xa1\x02\n" +
+	"\x12CreateOrderRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12'\n" +
+	"\rshipping_cost\x18\x02 \x01(\x02B\x02\x18\x01R\fshippingCost\x128\n" +
+	"\x16shipping_duration_days\x18\x03 \x01(\x05B\x02\x18\x01R\x14shippingDurationDays\x12\x1a\n" +
+	"\bdiscount\x18\x04 \x01(\x02R\bdiscount\x12+\n" +
+	"\x05items\x18\x05 \x03(\v2\x15.order.OrderItemInputR\x05items\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x06 \x01(\x03R\taddressId\x12'\n" +
+	"\x0fshipping_option\x18\a \x01(\tR\x0eshippingOption\"9\n" +
+	"\x13CreateOrderResponse\x12\"\n" +
+	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"%\n" +
+	"\x13GetOrderByIDRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\":\n" +
+	"\x14GetOrderByIDResponse\x12\"\n" +
+	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"[\n" +
+	"\x11ListOrdersRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\x05R\aperPage\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\"[\n" +
+	"\x12ListOrdersResponse\x12$\n" +
+	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\x8a\x01\n" +
+	"\x13AddOrderItemRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x04 \x01(\x03R\tvariantId\":\n" +
+	"\x14AddOrderItemResponse\x12\"\n" +
+	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"L\n" +
+	"\x16RemoveOrderItemRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\aitem_id\x18\x02 \x01(\x03R\x06itemId\"=\n" +
+	"\x17RemoveOrderItemResponse\x12\"\n" +
+	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"M\n" +
+	"\x18UpdateOrderStatusRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"?\n" +
+	"\x19UpdateOrderStatusResponse\x12\"\n" +
+	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\"\xdd\x03\n" +
+	"\x05Order\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12#\n" +
+	"\rshipping_cost\x18\x03 \x01(\x02R\fshippingCost\x124\n" +
+	"\x16shipping_duration_days\x18\x04 \x01(\x05R\x14shippingDurationDays\x12\x1a\n" +
+	"\bdiscount\x18\x05 \x01(\x02R\bdiscount\x12\x14\n" +
+	"\x05total\x18\x06 \x01(\x02R\x05total\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12&\n" +
+	"\x05items\x18\b \x03(\v2\x10.order.OrderItemR\x05items\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\v \x01(\x03R\taddressId\x12!\n" +
+	"\fwarehouse_id\x18\f \x01(\x03R\vwarehouseId\x12'\n" +
+	"\x0fshipping_option\x18\r \x01(\tR\x0eshippingOption\x12\x10\n" +
+	"\x03tax\x18\x0e \x01(\x02R\x03tax\x12#\n" +
+	"\rtax_inclusive\x18\x0f \x01(\bR\ftaxInclusive\"\xbf\x02\n" +
+	"\tOrderItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\x02R\tunitPrice\x12\x1f\n" +
+	"\vtotal_price\x18\x06 \x01(\x02R\n" +
+	"totalPrice\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\a \x01(\x03R\tvariantId\x12\x10\n" +
+	"\x03sku\x18\b \x01(\tR\x03sku\x12!\n" +
+	"\ftax_category\x18\t \x01(\tR\vtaxCategory\x12\x19\n" +
+	"\btax_rate\x18\n" +
+	" \x01(\x02R\ataxRate\x12\x1d\n" +
+	"\n" +
+	"tax_amount\x18\v \x01(\x02R\ttaxAmount\"T\n" +
+	"\x1aHasPurchasedProductRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\";\n" +
+	"\x1bHasPurchasedProductResponse\x12\x1c\n" +
+	"\tpurchased\x18\x01 \x01(\bR\tpurchased\"\x80\x01\n" +
+	"\x19GetShippingOptionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\x03R\taddressId\x12+\n" +
+	"\x05items\x18\x03 \x03(\v2\x15.order.OrderItemInputR\x05items\"\x81\x01\n" +
+	"\x0eShippingOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04cost\x18\x03 \x01(\x02R\x04cost\x12#\n" +
+	"\rdelivery_days\x18\x04 \x01(\x05R\fdeliveryDays\x12\x12\n" +
+	"\x04free\x18\x05 \x01(\bR\x04free\"a\n" +
+	"\x1aGetShippingOptionsResponse\x12/\n" +
+	"\aoptions\x18\x01 \x03(\v2\x15.order.ShippingOptionR\aoptions\x12\x12\n" +
+	"\x04zone\x18\x02 \x01(\tR\x04zone\"t\n" +
+	"\x17AuthorizePaymentRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12%\n" +
+	"\x0epayment_method\x18\x03 \x01(\tR\rpaymentMethod\"6\n" +
+	"\x15CapturePaymentRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x03R\tpaymentId\"3\n" +
+	"\x12VoidPaymentRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x03R\tpaymentId\"M\n" +
+	"\x14RefundPaymentRequest\x12\x1d\n" +
+	"\n" +
+	"payment_id\x18\x01 \x01(\x03R\tpaymentId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x02R\x06amount\"N\n" +
+	"\x18ListOrderPaymentsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"G\n" +
+	"\x19ListOrderPaymentsResponse\x12*\n" +
+	"\bpayments\x18\x01 \x03(\v2\x0e.order.PaymentR\bpayments\"U\n" +
+	"\x1bHandlePaymentWebhookRequest\x12\x18\n" +
+	"\apayload\x18\x01 \x01(\fR\apayload\x12\x1c\n" +
+	"\tsignature\x18\x02 \x01(\tR\tsignature\"8\n" +
+	"\x1cHandlePaymentWebhookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\";\n" +
+	"\x0fPaymentResponse\x12(\n" +
+	"\apayment\x18\x01 \x01(\v2\x0e.order.PaymentR\apayment\"\x8e\x03\n" +
+	"\aPayment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x1a\n" +
+	"\bprovider\x18\x03 \x01(\tR\bprovider\x12!\n" +
+	"\fprovider_ref\x18\x04 \x01(\tR\vproviderRef\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x02R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12'\n" +
+	"\x0famount_captured\x18\b \x01(\x02R\x0eamountCaptured\x12'\n" +
+	"\x0famount_refunded\x18\t \x01(\x02R\x0eamountRefunded\x12=\n" +
+	"\ftransactions\x18\n" +
+	" \x03(\v2\x19.order.PaymentTransactionR\ftransactions\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\"\xe1\x01\n" +
+	"\x12PaymentTransaction\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\toperation\x18\x02 \x01(\tR\toperation\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x02R\x06amount\x12\x1c\n" +
+	"\tsucceeded\x18\x04 \x01(\bR\tsucceeded\x12!\n" +
+	"\fprovider_ref\x18\x05 \x01(\tR\vproviderRef\x12%\n" +
+	"\x0efailure_reason\x18\x06 \x01(\tR\rfailureReason\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt2\xe1\b\n" +
 	"\fOrderService\x12D\n" +
 	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\x12G\n" +
 	"\fGetOrderByID\x12\x1a.order.GetOrderByIDRequest\x1a\x1b.order.GetOrderByIDResponse\x12A\n" +
@@ -966,7 +2134,15 @@ const file_shared_proto_v1_order_proto_rawDesc = "" +
 	"ListOrders\x12\x18.order.ListOrdersRequest\x1a\x19.order.ListOrdersResponse\x12G\n" +
 	"\fAddOrderItem\x12\x1a.order.AddOrderItemRequest\x1a\x1b.order.AddOrderItemResponse\x12P\n" +
 	"\x0fRemoveOrderItem\x12\x1d.order.RemoveOrderItemRequest\x1a\x1e.order.RemoveOrderItemResponse\x12V\n" +
-	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a .order.UpdateOrderStatusResponseB\x1dZ\x1bshared/proto/v1/order;orderb\x06proto3"
+	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a .order.UpdateOrderStatusResponse\x12\\\n" +
+	"\x13HasPurchasedProduct\x12!.order.HasPurchasedProductRequest\x1a\".order.HasPurchasedProductResponse\x12Y\n" +
+	"\x12GetShippingOptions\x12 .order.GetShippingOptionsRequest\x1a!.order.GetShippingOptionsResponse\x12J\n" +
+	"\x10AuthorizePayment\x12\x1e.order.AuthorizePaymentRequest\x1a\x16.order.PaymentResponse\x12F\n" +
+	"\x0eCapturePayment\x12\x1c.order.CapturePaymentRequest\x1a\x16.order.PaymentResponse\x12@\n" +
+	"\vVoidPayment\x12\x19.order.VoidPaymentRequest\x1a\x16.order.PaymentResponse\x12D\n" +
+	"\rRefundPayment\x12\x1b.order.RefundPaymentRequest\x1a\x16.order.PaymentResponse\x12V\n" +
+	"\x11ListOrderPayments\x12\x1f.order.ListOrderPaymentsRequest\x1a .order.ListOrderPaymentsResponse\x12_\n" +
+	"\x14HandlePaymentWebhook\x12\".order.HandlePaymentWebhookRequest\x1a#.order.HandlePaymentWebhookResponseB\x1dZ\x1bshared/proto/v1/order;orderb\x06proto3"
 
 var (
 	file_shared_proto_v1_order_proto_rawDescOnce sync.Once
@@ -980,23 +2156,39 @@ func file_shared_proto_v1_order_proto_rawDescGZIP() []byte {
 	return file_shared_proto_v1_order_proto_rawDescData
 }
 
-var file_shared_proto_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_shared_proto_v1_order_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_shared_proto_v1_order_proto_goTypes = []any{
-	(*OrderItemInput)(nil),            // 0: order.OrderItemInput
-	(*CreateOrderRequest)(nil),        // 1: order.CreateOrderRequest
-	(*CreateOrderResponse)(nil),       // 2: order.CreateOrderResponse
-	(*GetOrderByIDRequest)(nil),       // 3: order.GetOrderByIDRequest
-	(*GetOrderByIDResponse)(nil),      // 4: order.GetOrderByIDResponse
-	(*ListOrdersRequest)(nil),         // 5: order.ListOrdersRequest
-	(*ListOrdersResponse)(nil),        // 6: order.ListOrdersResponse
-	(*AddOrderItemRequest)(nil),       // 7: order.AddOrderItemRequest
-	(*AddOrderItemResponse)(nil),      // 8: order.AddOrderItemResponse
-	(*RemoveOrderItemRequest)(nil),    // 9: order.RemoveOrderItemRequest
-	(*RemoveOrderItemResponse)(nil),   // 10: order.RemoveOrderItemResponse
-	(*UpdateOrderStatusRequest)(nil),  // 11: order.UpdateOrderStatusRequest
-	(*UpdateOrderStatusResponse)(nil), // 12: order.UpdateOrderStatusResponse
-	(*Order)(nil),                     // 13: order.Order
-	(*OrderItem)(nil),                 // 14: order.OrderItem
+	(*OrderItemInput)(nil),               // 0: order.OrderItemInput
+	(*CreateOrderRequest)(nil),           // 1: order.CreateOrderRequest
+	(*CreateOrderResponse)(nil),          // 2: order.CreateOrderResponse
+	(*GetOrderByIDRequest)(nil),          // 3: order.GetOrderByIDRequest
+	(*GetOrderByIDResponse)(nil),         // 4: order.GetOrderByIDResponse
+	(*ListOrdersRequest)(nil),            // 5: order.ListOrdersRequest
+	(*ListOrdersResponse)(nil),           // 6: order.ListOrdersResponse
+	(*AddOrderItemRequest)(nil),          // 7: order.AddOrderItemRequest
+	(*AddOrderItemResponse)(nil),         // 8: order.AddOrderItemResponse
+	(*RemoveOrderItemRequest)(nil),       // 9: order.RemoveOrderItemRequest
+	(*RemoveOrderItemResponse)(nil),      // 10: order.RemoveOrderItemResponse
+	(*UpdateOrderStatusRequest)(nil),     // 11: order.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil),    // 12: order.UpdateOrderStatusResponse
+	(*Order)(nil),                        // 13: order.Order
+	(*OrderItem)(nil),                    // 14: order.OrderItem
+	(*HasPurchasedProductRequest)(nil),   // 15: order.HasPurchasedProductRequest
+	(*HasPurchasedProductResponse)(nil),  // 16: order.HasPurchasedProductResponse
+	(*GetShippingOptionsRequest)(nil),    // 17: order.GetShippingOptionsRequest
+	(*ShippingOption)(nil),               // 18: order.ShippingOption
+	(*GetShippingOptionsResponse)(nil),   // 19: order.GetShippingOptionsResponse
+	(*AuthorizePaymentRequest)(nil),      // 20: order.AuthorizePaymentRequest
+	(*CapturePaymentRequest)(nil),        // 21: order.CapturePaymentRequest
+	(*VoidPaymentRequest)(nil),           // 22: order.VoidPaymentRequest
+	(*RefundPaymentRequest)(nil),         // 23: order.RefundPaymentRequest
+	(*ListOrderPaymentsRequest)(nil),     // 24: order.ListOrderPaymentsRequest
+	(*ListOrderPaymentsResponse)(nil),    // 25: order.ListOrderPaymentsResponse
+	(*HandlePaymentWebhookRequest)(nil),  // 26: order.HandlePaymentWebhookRequest
+	(*HandlePaymentWebhookResponse)(nil), // 27: order.HandlePaymentWebhookResponse
+	(*PaymentResponse)(nil),              // 28: order.PaymentResponse
+	(*Payment)(nil),                      // 29: order.Payment
+	(*PaymentTransaction)(nil),           // 30: order.PaymentTransaction
 }
 var file_shared_proto_v1_order_proto_depIdxs = []int32{
 	0,  // 0: order.CreateOrderRequest.items:type_name -> order.OrderItemInput
@@ -1007,23 +2199,44 @@ var file_shared_proto_v1_order_proto_depIdxs = []int32{
 	13, // 5: order.RemoveOrderItemResponse.order:type_name -> order.Order
 	13, // 6: order.UpdateOrderStatusResponse.order:type_name -> order.Order
 	14, // 7: order.Order.items:type_name -> order.OrderItem
-	1,  // 8: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	3,  // 9: order.OrderService.GetOrderByID:input_type -> order.GetOrderByIDRequest
-	5,  // 10: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
-	7,  // 11: order.OrderService.AddOrderItem:input_type -> order.AddOrderItemRequest
-	9,  // 12: order.OrderService.RemoveOrderItem:input_type -> order.RemoveOrderItemRequest
-	11, // 13: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
-	2,  // 14: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
-	4,  // 15: order.OrderService.GetOrderByID:output_type -> order.GetOrderByIDResponse
-	6,  // 16: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
-	8,  // 17: order.OrderService.AddOrderItem:output_type -> order.AddOrderItemResponse
-	10, // 18: order.OrderService.RemoveOrderItem:output_type -> order.RemoveOrderItemResponse
-	12, // 19: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
-	14, // [14:20] is the sub-list for method output_type
-	8,  // [8:14] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	0,  // 8: order.GetShippingOptionsRequest.items:type_name -> order.OrderItemInput
+	18, // 9: order.GetShippingOptionsResponse.options:type_name -> order.ShippingOption
+	29, // 10: order.ListOrderPaymentsResponse.payments:type_name -> order.Payment
+	29, // 11: order.PaymentResponse.payment:type_name -> order.Payment
+	30, // 12: order.Payment.transactions:type_name -> order.PaymentTransaction
+	1,  // 13: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	3,  // 14: order.OrderService.GetOrderByID:input_type -> order.GetOrderByIDRequest
+	5,  // 15: order.OrderService.ListOrders:input_type -> order.ListOrdersRequest
+	7,  // 16: order.OrderService.AddOrderItem:input_type -> order.AddOrderItemRequest
+	9,  // 17: order.OrderService.RemoveOrderItem:input_type -> order.RemoveOrderItemRequest
+	11, // 18: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
+	15, // 19: order.OrderService.HasPurchasedProduct:input_type -> order.HasPurchasedProductRequest
+	17, // 20: order.OrderService.GetShippingOptions:input_type -> order.GetShippingOptionsRequest
+	20, // 21: order.OrderService.AuthorizePayment:input_type -> order.AuthorizePaymentRequest
+	21, // 22: order.OrderService.CapturePayment:input_type -> order.CapturePaymentRequest
+	22, // 23: order.OrderService.VoidPayment:input_type -> order.VoidPaymentRequest
+	23, // 24: order.OrderService.RefundPayment:input_type -> order.RefundPaymentRequest
+	24, // 25: order.OrderService.ListOrderPayments:input_type -> order.ListOrderPaymentsRequest
+	26, // 26: order.OrderService.HandlePaymentWebhook:input_type -> order.HandlePaymentWebhookRequest
+	2,  // 27: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
+	4,  // 28: order.OrderService.GetOrderByID:output_type -> order.GetOrderByIDResponse
+	6,  // 29: order.OrderService.ListOrders:output_type -> order.ListOrdersResponse
+	8,  // 30: order.OrderService.AddOrderItem:output_type -> order.AddOrderItemResponse
+	10, // 31: order.OrderService.RemoveOrderItem:output_type -> order.RemoveOrderItemResponse
+	12, // 32: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
+	16, // 33: order.OrderService.HasPurchasedProduct:output_type -> order.HasPurchasedProductResponse
+	19, // 34: order.OrderService.GetShippingOptions:output_type -> order.GetShippingOptionsResponse
+	28, // 35: order.OrderService.AuthorizePayment:output_type -> order.PaymentResponse
+	28, // 36: order.OrderService.CapturePayment:output_type -> order.PaymentResponse
+	28, // 37: order.OrderService.VoidPayment:output_type -> order.PaymentResponse
+	28, // 38: order.OrderService.RefundPayment:output_type -> order.PaymentResponse
+	25, // 39: order.OrderService.ListOrderPayments:output_type -> order.ListOrderPaymentsResponse
+	27, // 40: order.OrderService.HandlePaymentWebhook:output_type -> order.HandlePaymentWebhookResponse
+	27, // [27:41] is the sub-list for method output_type
+	13, // [13:27] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_shared_proto_v1_order_proto_init() }
@@ -1037,7 +2250,7 @@ func file_shared_proto_v1_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shared_proto_v1_order_proto_rawDesc), len(file_shared_proto_v1_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
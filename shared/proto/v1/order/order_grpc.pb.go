@@ -19,12 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_CreateOrder_FullMethodName       = "/order.OrderService/CreateOrder"
-	OrderService_GetOrderByID_FullMethodName      = "/order.OrderService/GetOrderByID"
-	OrderService_ListOrders_FullMethodName        = "/order.OrderService/ListOrders"
-	OrderService_AddOrderItem_FullMethodName      = "/order.OrderService/AddOrderItem"
-	OrderService_RemoveOrderItem_FullMethodName   = "/order.OrderService/RemoveOrderItem"
-	OrderService_UpdateOrderStatus_FullMethodName = "/order.OrderService/UpdateOrderStatus"
+	OrderService_CreateOrder_FullMethodName          = "/order.OrderService/CreateOrder"
+	OrderService_GetOrderByID_FullMethodName         = "/order.OrderService/GetOrderByID"
+	OrderService_ListOrders_FullMethodName           = "/order.OrderService/ListOrders"
+	OrderService_AddOrderItem_FullMethodName         = "/order.OrderService/AddOrderItem"
+	OrderService_RemoveOrderItem_FullMethodName      = "/order.OrderService/RemoveOrderItem"
+	OrderService_UpdateOrderStatus_FullMethodName    = "/order.OrderService/UpdateOrderStatus"
+	OrderService_HasPurchasedProduct_FullMethodName  = "/order.OrderService/HasPurchasedProduct"
+	OrderService_GetShippingOptions_FullMethodName   = "/order.OrderService/GetShippingOptions"
+	OrderService_AuthorizePayment_FullMethodName     = "/order.OrderService/AuthorizePayment"
+	OrderService_CapturePayment_FullMethodName       = "/order.OrderService/CapturePayment"
+	OrderService_VoidPayment_FullMethodName          = "/order.OrderService/VoidPayment"
+	OrderService_RefundPayment_FullMethodName        = "/order.OrderService/RefundPayment"
+	OrderService_ListOrderPayments_FullMethodName    = "/order.OrderService/ListOrderPayments"
+	OrderService_HandlePaymentWebhook_FullMethodName = "/order.OrderService/HandlePaymentWebhook"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -45,6 +53,22 @@ type OrderServiceClient interface {
 	RemoveOrderItem(ctx context.Context, in *RemoveOrderItemRequest, opts ...grpc.CallOption) (*RemoveOrderItemResponse, error)
 	// Update order status
 	UpdateOrderStatus(ctx context.Context, in *UpdateOrderStatusRequest, opts ...grpc.CallOption) (*UpdateOrderStatusResponse, error)
+	// Report whether the user has a delivered order containing the product
+	HasPurchasedProduct(ctx context.Context, in *HasPurchasedProductRequest, opts ...grpc.CallOption) (*HasPurchasedProductResponse, error)
+	// Price the shipping options available for the items sent to the address
+	GetShippingOptions(ctx context.Context, in *GetShippingOptionsRequest, opts ...grpc.CallOption) (*GetShippingOptionsResponse, error)
+	// Authorize the order total on the customer's payment method
+	AuthorizePayment(ctx context.Context, in *AuthorizePaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	// Capture an authorized payment; the order becomes paid
+	CapturePayment(ctx context.Context, in *CapturePaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	// Release an authorized payment without capturing it
+	VoidPayment(ctx context.Context, in *VoidPaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	// Refund part or all of a captured payment
+	RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	// List the payments of an order
+	ListOrderPayments(ctx context.Context, in *ListOrderPaymentsRequest, opts ...grpc.CallOption) (*ListOrderPaymentsResponse, error)
+	// Apply a signed payment provider webhook
+	HandlePaymentWebhook(ctx context.Context, in *HandlePaymentWebhookRequest, opts ...grpc.CallOption) (*HandlePaymentWebhookResponse, error)
 }
 
 type orderServiceClient struct {
@@ -115,6 +139,86 @@ func (c *orderServiceClient) UpdateOrderStatus(ctx context.Context, in *UpdateOr
 	return out, nil
 }
 
+func (c *orderServiceClient) HasPurchasedProduct(ctx context.Context, in *HasPurchasedProductRequest, opts ...grpc.CallOption) (*HasPurchasedProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HasPurchasedProductResponse)
+	err := c.cc.Invoke(ctx, OrderService_HasPurchasedProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetShippingOptions(ctx context.Context, in *GetShippingOptionsRequest, opts ...grpc.CallOption) (*GetShippingOptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetShippingOptionsResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetShippingOptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) AuthorizePayment(ctx context.Context, in *AuthorizePaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentResponse)
+	err := c.cc.Invoke(ctx, OrderService_AuthorizePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CapturePayment(ctx context.Context, in *CapturePaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentResponse)
+	err := c.cc.Invoke(ctx, OrderService_CapturePayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) VoidPayment(ctx context.Context, in *VoidPaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentResponse)
+	err := c.cc.Invoke(ctx, OrderService_VoidPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PaymentResponse)
+	err := c.cc.Invoke(ctx, OrderService_RefundPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListOrderPayments(ctx context.Context, in *ListOrderPaymentsRequest, opts ...grpc.CallOption) (*ListOrderPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrderPaymentsResponse)
+	err := c.cc.Invoke(ctx, OrderService_ListOrderPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) HandlePaymentWebhook(ctx context.Context, in *HandlePaymentWebhookRequest, opts ...grpc.CallOption) (*HandlePaymentWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HandlePaymentWebhookResponse)
+	err := c.cc.Invoke(ctx, OrderService_HandlePaymentWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -133,6 +237,22 @@ type OrderServiceServer interface {
 	RemoveOrderItem(context.Context, *RemoveOrderItemRequest) (*RemoveOrderItemResponse, error)
 	// Update order status
 	UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error)
+	// Report whether the user has a delivered order containing the product
+	HasPurchasedProduct(context.Context, *HasPurchasedProductRequest) (*HasPurchasedProductResponse, error)
+	// Price the shipping options available for the items sent to the address
+	GetShippingOptions(context.Context, *GetShippingOptionsRequest) (*GetShippingOptionsResponse, error)
+	// Authorize the order total on the customer's payment method
+	AuthorizePayment(context.Context, *AuthorizePaymentRequest) (*PaymentResponse, error)
+	// Capture an authorized payment; the order becomes paid
+	CapturePayment(context.Context, *CapturePaymentRequest) (*PaymentResponse, error)
+	// Release an authorized payment without capturing it
+	VoidPayment(context.Context, *VoidPaymentRequest) (*PaymentResponse, error)
+	// Refund part or all of a captured payment
+	RefundPayment(context.Context, *RefundPaymentRequest) (*PaymentResponse, error)
+	// List the payments of an order
+	ListOrderPayments(context.Context, *ListOrderPaymentsRequest) (*ListOrderPaymentsResponse, error)
+	// Apply a signed payment provider webhook
+	HandlePaymentWebhook(context.Context, *HandlePaymentWebhookRequest) (*HandlePaymentWebhookResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -161,6 +281,30 @@ func (UnimplementedOrderServiceServer) RemoveOrderItem(context.Context, *RemoveO
 func (UnimplementedOrderServiceServer) UpdateOrderStatus(context.Context, *UpdateOrderStatusRequest) (*UpdateOrderStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrderStatus not implemented")
 }
+func (UnimplementedOrderServiceServer) HasPurchasedProduct(context.Context, *HasPurchasedProductRequest) (*HasPurchasedProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasPurchasedProduct not implemented")
+}
+func (UnimplementedOrderServiceServer) GetShippingOptions(context.Context, *GetShippingOptionsRequest) (*GetShippingOptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetShippingOptions not implemented")
+}
+func (UnimplementedOrderServiceServer) AuthorizePayment(context.Context, *AuthorizePaymentRequest) (*PaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizePayment not implemented")
+}
+func (UnimplementedOrderServiceServer) CapturePayment(context.Context, *CapturePaymentRequest) (*PaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CapturePayment not implemented")
+}
+func (UnimplementedOrderServiceServer) VoidPayment(context.Context, *VoidPaymentRequest) (*PaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidPayment not implemented")
+}
+func (UnimplementedOrderServiceServer) RefundPayment(context.Context, *RefundPaymentRequest) (*PaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundPayment not implemented")
+}
+func (UnimplementedOrderServiceServer) ListOrderPayments(context.Context, *ListOrderPaymentsRequest) (*ListOrderPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrderPayments not implemented")
+}
+func (UnimplementedOrderServiceServer) HandlePaymentWebhook(context.Context, *HandlePaymentWebhookRequest) (*HandlePaymentWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandlePaymentWebhook not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -290,6 +434,150 @@ func _OrderService_UpdateOrderStatus_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_HasPurchasedProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasPurchasedProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).HasPurchasedProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_HasPurchasedProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).HasPurchasedProduct(ctx, req.(*HasPurchasedProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetShippingOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetShippingOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetShippingOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetShippingOptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetShippingOptions(ctx, req.(*GetShippingOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_AuthorizePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).AuthorizePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_AuthorizePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).AuthorizePayment(ctx, req.(*AuthorizePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CapturePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CapturePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CapturePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CapturePayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CapturePayment(ctx, req.(*CapturePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_VoidPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).VoidPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_VoidPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).VoidPayment(ctx, req.(*VoidPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RefundPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RefundPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RefundPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RefundPayment(ctx, req.(*RefundPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListOrderPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrderPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListOrderPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_ListOrderPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListOrderPayments(ctx, req.(*ListOrderPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_HandlePaymentWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HandlePaymentWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).HandlePaymentWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_HandlePaymentWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).HandlePaymentWebhook(ctx, req.(*HandlePaymentWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -321,6 +609,38 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateOrderStatus",
 			Handler:    _OrderService_UpdateOrderStatus_Handler,
 		},
+		{
+			MethodName: "HasPurchasedProduct",
+			Handler:    _OrderService_HasPurchasedProduct_Handler,
+		},
+		{
+			MethodName: "GetShippingOptions",
+			Handler:    _OrderService_GetShippingOptions_Handler,
+		},
+		{
+			MethodName: "AuthorizePayment",
+			Handler:    _OrderService_AuthorizePayment_Handler,
+		},
+		{
+			MethodName: "CapturePayment",
+			Handler:    _OrderService_CapturePayment_Handler,
+		},
+		{
+			MethodName: "VoidPayment",
+			Handler:    _OrderService_VoidPayment_Handler,
+		},
+		{
+			MethodName: "RefundPayment",
+			Handler:    _OrderService_RefundPayment_Handler,
+		},
+		{
+			MethodName: "ListOrderPayments",
+			Handler:    _OrderService_ListOrderPayments_Handler,
+		},
+		{
+			MethodName: "HandlePaymentWebhook",
+			Handler:    _OrderService_HandlePaymentWebhook_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "shared/proto/v1/order.proto",
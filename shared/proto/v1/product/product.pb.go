@@ -70,6 +70,133 @@ func (DiscountType) EnumDescriptor() ([]byte, []int) {
 	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{0}
 }
 
+type ProductSortBy int32
+
+const (
+	ProductSortBy_SORT_RELEVANCE  ProductSortBy = 0
+	ProductSortBy_SORT_PRICE_ASC  ProductSortBy = 1
+	ProductSortBy_SORT_PRICE_DESC ProductSortBy = 2
+	ProductSortBy_SORT_NEWEST     ProductSortBy = 3
+	ProductSortBy_SORT_POPULARITY ProductSortBy = 4
+)
+
+// Enum value maps for ProductSortBy.
+var (
+	ProductSortBy_name = map[int32]string{
+		0: "SORT_RELEVANCE",
+		1: "SORT_PRICE_ASC",
+		2: "SORT_PRICE_DESC",
+		3: "SORT_NEWEST",
+		4: "SORT_POPULARITY",
+	}
+	ProductSortBy_value = map[string]int32{
+		"SORT_RELEVANCE":  0,
+		"SORT_PRICE_ASC":  1,
+		"SORT_PRICE_DESC": 2,
+		"SORT_NEWEST":     3,
+		"SORT_POPULARITY": 4,
+	}
+)
+
+func (x ProductSortBy) Enum() *ProductSortBy {
+	p := new(ProductSortBy)
+	*p = x
+	return p
+}
+
+func (x ProductSortBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductSortBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_v1_product_proto_enumTypes[1].Descriptor()
+}
+
+func (ProductSortBy) Type() protoreflect.EnumType {
+	return &file_shared_proto_v1_product_proto_enumTypes[1]
+}
+
+func (x ProductSortBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductSortBy.Descriptor instead.
+func (ProductSortBy) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{1}
+}
+
+type StockMovementType int32
+
+const (
+	StockMovementType_STOCK_MOVEMENT_UNSPECIFIED StockMovementType = 0
+	StockMovementType_STOCK_MOVEMENT_RESTOCK     StockMovementType = 1
+	StockMovementType_STOCK_MOVEMENT_SALE        StockMovementType = 2
+	StockMovementType_STOCK_MOVEMENT_RESERVATION StockMovementType = 3
+	StockMovementType_STOCK_MOVEMENT_RELEASE     StockMovementType = 4
+	StockMovementType_STOCK_MOVEMENT_RETURN      StockMovementType = 5
+	StockMovementType_STOCK_MOVEMENT_ADJUSTMENT  StockMovementType = 6
+	StockMovementType_STOCK_MOVEMENT_TRANSFER    StockMovementType = 7
+	// the stock a product or variant was created with; list filter only
+	StockMovementType_STOCK_MOVEMENT_INITIAL StockMovementType = 8
+	// the stock a product was imported with; list filter only
+	StockMovementType_STOCK_MOVEMENT_IMPORT StockMovementType = 9
+)
+
+// Enum value maps for StockMovementType.
+var (
+	StockMovementType_name = map[int32]string{
+		0: "STOCK_MOVEMENT_UNSPECIFIED",
+		1: "STOCK_MOVEMENT_RESTOCK",
+		2: "STOCK_MOVEMENT_SALE",
+		3: "STOCK_MOVEMENT_RESERVATION",
+		4: "STOCK_MOVEMENT_RELEASE",
+		5: "STOCK_MOVEMENT_RETURN",
+		6: "STOCK_MOVEMENT_ADJUSTMENT",
+		7: "STOCK_MOVEMENT_TRANSFER",
+		8: "STOCK_MOVEMENT_INITIAL",
+		9: "STOCK_MOVEMENT_IMPORT",
+	}
+	StockMovementType_value = map[string]int32{
+		"STOCK_MOVEMENT_UNSPECIFIED": 0,
+		"STOCK_MOVEMENT_RESTOCK":     1,
+		"STOCK_MOVEMENT_SALE":        2,
+		"STOCK_MOVEMENT_RESERVATION": 3,
+		"STOCK_MOVEMENT_RELEASE":     4,
+		"STOCK_MOVEMENT_RETURN":      5,
+		"STOCK_MOVEMENT_ADJUSTMENT":  6,
+		"STOCK_MOVEMENT_TRANSFER":    7,
+		"STOCK_MOVEMENT_INITIAL":     8,
+		"STOCK_MOVEMENT_IMPORT":      9,
+	}
+)
+
+func (x StockMovementType) Enum() *StockMovementType {
+	p := new(StockMovementType)
+	*p = x
+	return p
+}
+
+func (x StockMovementType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StockMovementType) Descriptor() protoreflect.EnumDescriptor {
+	return file_shared_proto_v1_product_proto_enumTypes[2].Descriptor()
+}
+
+func (StockMovementType) Type() protoreflect.EnumType {
+	return &file_shared_proto_v1_product_proto_enumTypes[2]
+}
+
+func (x StockMovementType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StockMovementType.Descriptor instead.
+func (StockMovementType) EnumDescriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{2}
+}
+
 type CreateProductRequest struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -80,8 +207,13 @@ type CreateProductRequest struct {
 	DiscountValue    float32                `protobuf:"fixed32,6,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
 	ImageUrl         string                 `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
 	Quantity         int32                  `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	Sku              string                 `protobuf:"bytes,9,opt,name=sku,proto3" json:"sku,omitempty"`
+	// caps the quantity per cart or order; 0 means no limit
+	MaxPerOrder int32 `protobuf:"varint,10,opt,name=max_per_order,json=maxPerOrder,proto3" json:"max_per_order,omitempty"`
+	// tax class the order's tax rates are looked up by; empty means standard
+	TaxCategory   string `protobuf:"bytes,11,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateProductRequest) Reset() {
@@ -170,6 +302,27 @@ func (x *CreateProductRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *CreateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetMaxPerOrder() int32 {
+	if x != nil {
+		return x.MaxPerOrder
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
 type CreateProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
@@ -214,6 +367,144 @@ func (x *CreateProductResponse) GetProduct() *Product {
 	return nil
 }
 
+type GetCacheStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCacheStatsRequest) Reset() {
+	*x = GetCacheStatsRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCacheStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCacheStatsRequest) ProtoMessage() {}
+
+func (x *GetCacheStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCacheStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetCacheStatsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{2}
+}
+
+type GetCacheStatsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductHits     uint64                 `protobuf:"varint,1,opt,name=product_hits,json=productHits,proto3" json:"product_hits,omitempty"`
+	ProductMisses   uint64                 `protobuf:"varint,2,opt,name=product_misses,json=productMisses,proto3" json:"product_misses,omitempty"`
+	ProductHitRatio float64                `protobuf:"fixed64,3,opt,name=product_hit_ratio,json=productHitRatio,proto3" json:"product_hit_ratio,omitempty"`
+	ListHits        uint64                 `protobuf:"varint,4,opt,name=list_hits,json=listHits,proto3" json:"list_hits,omitempty"`
+	ListMisses      uint64                 `protobuf:"varint,5,opt,name=list_misses,json=listMisses,proto3" json:"list_misses,omitempty"`
+	ListHitRatio    float64                `protobuf:"fixed64,6,opt,name=list_hit_ratio,json=listHitRatio,proto3" json:"list_hit_ratio,omitempty"`
+	// hits served past their freshness while being refreshed
+	ProductStale uint64 `protobuf:"varint,7,opt,name=product_stale,json=productStale,proto3" json:"product_stale,omitempty"`
+	// hits on products cached as missing
+	ProductNegative uint64 `protobuf:"varint,8,opt,name=product_negative,json=productNegative,proto3" json:"product_negative,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetCacheStatsResponse) Reset() {
+	*x = GetCacheStatsResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCacheStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCacheStatsResponse) ProtoMessage() {}
+
+func (x *GetCacheStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCacheStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetCacheStatsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCacheStatsResponse) GetProductHits() uint64 {
+	if x != nil {
+		return x.ProductHits
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetProductMisses() uint64 {
+	if x != nil {
+		return x.ProductMisses
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetProductHitRatio() float64 {
+	if x != nil {
+		return x.ProductHitRatio
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetListHits() uint64 {
+	if x != nil {
+		return x.ListHits
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetListMisses() uint64 {
+	if x != nil {
+		return x.ListMisses
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetListHitRatio() float64 {
+	if x != nil {
+		return x.ListHitRatio
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetProductStale() uint64 {
+	if x != nil {
+		return x.ProductStale
+	}
+	return 0
+}
+
+func (x *GetCacheStatsResponse) GetProductNegative() uint64 {
+	if x != nil {
+		return x.ProductNegative
+	}
+	return 0
+}
+
 type GetProductByIDRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -223,7 +514,7 @@ type GetProductByIDRequest struct {
 
 func (x *GetProductByIDRequest) Reset() {
 	*x = GetProductByIDRequest{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[2]
+	mi := &file_shared_proto_v1_product_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -235,7 +526,7 @@ func (x *GetProductByIDRequest) String() string {
 func (*GetProductByIDRequest) ProtoMessage() {}
 
 func (x *GetProductByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[2]
+	mi := &file_shared_proto_v1_product_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -248,7 +539,7 @@ func (x *GetProductByIDRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductByIDRequest.ProtoReflect.Descriptor instead.
 func (*GetProductByIDRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{2}
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetProductByIDRequest) GetId() int64 {
@@ -267,7 +558,7 @@ type GetProductByIDResponse struct {
 
 func (x *GetProductByIDResponse) Reset() {
 	*x = GetProductByIDResponse{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[3]
+	mi := &file_shared_proto_v1_product_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -279,7 +570,7 @@ func (x *GetProductByIDResponse) String() string {
 func (*GetProductByIDResponse) ProtoMessage() {}
 
 func (x *GetProductByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[3]
+	mi := &file_shared_proto_v1_product_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,7 +583,7 @@ func (x *GetProductByIDResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProductByIDResponse.ProtoReflect.Descriptor instead.
 func (*GetProductByIDResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{3}
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GetProductByIDResponse) GetProduct() *Product {
@@ -302,29 +593,28 @@ func (x *GetProductByIDResponse) GetProduct() *Product {
 	return nil
 }
 
-type ListProductsRequest struct {
+type GetProductsByIDsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[4]
+func (x *GetProductsByIDsRequest) Reset() {
+	*x = GetProductsByIDsRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *GetProductsByIDsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*GetProductsByIDsRequest) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[4]
+func (x *GetProductsByIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -335,48 +625,42 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *ListProductsRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use GetProductsByIDsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductsByIDsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ListProductsRequest) GetPerPage() int32 {
+func (x *GetProductsByIDsRequest) GetIds() []int64 {
 	if x != nil {
-		return x.PerPage
+		return x.Ids
 	}
-	return 0
+	return nil
 }
 
-type ListProductsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+type GetProductsByIDsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// in the order of the first occurrence of each id in the request
+	Products      []*Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	MissingIds    []int64    `protobuf:"varint,2,rep,packed,name=missing_ids,json=missingIds,proto3" json:"missing_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductsResponse) Reset() {
-	*x = ListProductsResponse{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[5]
+func (x *GetProductsByIDsResponse) Reset() {
+	*x = GetProductsByIDsResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsResponse) String() string {
+func (x *GetProductsByIDsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsResponse) ProtoMessage() {}
+func (*GetProductsByIDsResponse) ProtoMessage() {}
 
-func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[5]
+func (x *GetProductsByIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -387,55 +671,49 @@ func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
-func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetProductsByIDsResponse.ProtoReflect.Descriptor instead.
+func (*GetProductsByIDsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ListProductsResponse) GetProducts() []*Product {
+func (x *GetProductsByIDsResponse) GetProducts() []*Product {
 	if x != nil {
 		return x.Products
 	}
 	return nil
 }
 
-func (x *ListProductsResponse) GetTotalCount() int32 {
+func (x *GetProductsByIDsResponse) GetMissingIds() []int64 {
 	if x != nil {
-		return x.TotalCount
+		return x.MissingIds
 	}
-	return 0
+	return nil
 }
 
-type UpdateProductRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ShortDescription string                 `protobuf:"bytes,3,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
-	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price            float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
-	DiscountType     DiscountType           `protobuf:"varint,6,opt,name=discount_type,json=discountType,proto3,enum=product.DiscountType" json:"discount_type,omitempty"`
-	DiscountValue    float32                `protobuf:"fixed32,7,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
-	ImageUrl         string                 `protobuf:"bytes,8,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Quantity         int32                  `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ListProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Attributes    []*AttributeFilter     `protobuf:"bytes,3,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[6]
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *ListProductsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*ListProductsRequest) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[6]
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -446,10 +724,133 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{6}
-}
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListProductsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetAttributes() []*AttributeFilter {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type UpdateProductRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortDescription string                 `protobuf:"bytes,3,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price            float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
+	DiscountType     DiscountType           `protobuf:"varint,6,opt,name=discount_type,json=discountType,proto3,enum=product.DiscountType" json:"discount_type,omitempty"`
+	DiscountValue    float32                `protobuf:"fixed32,7,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
+	ImageUrl         string                 `protobuf:"bytes,8,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	// ignored: use RestockProduct or RecordStockMovement to change stock
+	//
+	// Deprecated: Marked as deprecated in shared/proto/v1/product.proto.
+	Quantity int32 `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// empty leaves the tax category unchanged
+	TaxCategory   string `protobuf:"bytes,10,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{10}
+}
 
 func (x *UpdateProductRequest) GetId() int32 {
 	if x != nil {
@@ -507,6 +908,7 @@ func (x *UpdateProductRequest) GetImageUrl() string {
 	return ""
 }
 
+// Deprecated: Marked as deprecated in shared/proto/v1/product.proto.
 func (x *UpdateProductRequest) GetQuantity() int32 {
 	if x != nil {
 		return x.Quantity
@@ -514,6 +916,13 @@ func (x *UpdateProductRequest) GetQuantity() int32 {
 	return 0
 }
 
+func (x *UpdateProductRequest) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
 type UpdateProductResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
@@ -523,7 +932,7 @@ type UpdateProductResponse struct {
 
 func (x *UpdateProductResponse) Reset() {
 	*x = UpdateProductResponse{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[7]
+	mi := &file_shared_proto_v1_product_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -535,7 +944,7 @@ func (x *UpdateProductResponse) String() string {
 func (*UpdateProductResponse) ProtoMessage() {}
 
 func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[7]
+	mi := &file_shared_proto_v1_product_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,7 +957,7 @@ func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
 func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{7}
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateProductResponse) GetProduct() *Product {
@@ -567,7 +976,7 @@ type DeleteProductRequest struct {
 
 func (x *DeleteProductRequest) Reset() {
 	*x = DeleteProductRequest{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[8]
+	mi := &file_shared_proto_v1_product_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -579,7 +988,7 @@ func (x *DeleteProductRequest) String() string {
 func (*DeleteProductRequest) ProtoMessage() {}
 
 func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[8]
+	mi := &file_shared_proto_v1_product_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +1001,7 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{8}
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DeleteProductRequest) GetId() int64 {
@@ -611,7 +1020,7 @@ type DeleteProductResponse struct {
 
 func (x *DeleteProductResponse) Reset() {
 	*x = DeleteProductResponse{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[9]
+	mi := &file_shared_proto_v1_product_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -623,7 +1032,7 @@ func (x *DeleteProductResponse) String() string {
 func (*DeleteProductResponse) ProtoMessage() {}
 
 func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[9]
+	mi := &file_shared_proto_v1_product_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +1045,7 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{9}
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DeleteProductResponse) GetSuccess() bool {
@@ -646,36 +1055,37 @@ func (x *DeleteProductResponse) GetSuccess() bool {
 	return false
 }
 
-type Product struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ShortDescription string                 `protobuf:"bytes,3,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
-	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Price            float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
-	DiscountType     string                 `protobuf:"bytes,6,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
-	DiscountValue    float32                `protobuf:"fixed32,7,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
-	ImageUrl         string                 `protobuf:"bytes,8,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
-	Quantity         int32                  `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *Product) Reset() {
-	*x = Product{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[10]
+type SearchProductsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	MinPrice       float32                `protobuf:"fixed32,2,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`
+	MaxPrice       float32                `protobuf:"fixed32,3,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+	CategoryId     int64                  `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	InStockOnly    bool                   `protobuf:"varint,5,opt,name=in_stock_only,json=inStockOnly,proto3" json:"in_stock_only,omitempty"`
+	DiscountActive bool                   `protobuf:"varint,6,opt,name=discount_active,json=discountActive,proto3" json:"discount_active,omitempty"`
+	SortBy         ProductSortBy          `protobuf:"varint,7,opt,name=sort_by,json=sortBy,proto3,enum=product.ProductSortBy" json:"sort_by,omitempty"`
+	Page           int32                  `protobuf:"varint,8,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage        int32                  `protobuf:"varint,9,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Attributes     []*AttributeFilter     `protobuf:"bytes,10,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Product) String() string {
+func (x *SearchProductsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Product) ProtoMessage() {}
+func (*SearchProductsRequest) ProtoMessage() {}
 
-func (x *Product) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[10]
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -686,97 +1096,105 @@ func (x *Product) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Product.ProtoReflect.Descriptor instead.
-func (*Product) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Product) GetId() int32 {
+func (x *SearchProductsRequest) GetQuery() string {
 	if x != nil {
-		return x.Id
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetMinPrice() float32 {
+	if x != nil {
+		return x.MinPrice
 	}
 	return 0
 }
 
-func (x *Product) GetName() string {
+func (x *SearchProductsRequest) GetMaxPrice() float32 {
 	if x != nil {
-		return x.Name
+		return x.MaxPrice
 	}
-	return ""
+	return 0
 }
 
-func (x *Product) GetShortDescription() string {
+func (x *SearchProductsRequest) GetCategoryId() int64 {
 	if x != nil {
-		return x.ShortDescription
+		return x.CategoryId
 	}
-	return ""
+	return 0
 }
 
-func (x *Product) GetDescription() string {
+func (x *SearchProductsRequest) GetInStockOnly() bool {
 	if x != nil {
-		return x.Description
+		return x.InStockOnly
 	}
-	return ""
+	return false
 }
 
-func (x *Product) GetPrice() float32 {
+func (x *SearchProductsRequest) GetDiscountActive() bool {
 	if x != nil {
-		return x.Price
+		return x.DiscountActive
 	}
-	return 0
+	return false
 }
 
-func (x *Product) GetDiscountType() string {
+func (x *SearchProductsRequest) GetSortBy() ProductSortBy {
 	if x != nil {
-		return x.DiscountType
+		return x.SortBy
 	}
-	return ""
+	return ProductSortBy_SORT_RELEVANCE
 }
 
-func (x *Product) GetDiscountValue() float32 {
+func (x *SearchProductsRequest) GetPage() int32 {
 	if x != nil {
-		return x.DiscountValue
+		return x.Page
 	}
 	return 0
 }
 
-func (x *Product) GetImageUrl() string {
+func (x *SearchProductsRequest) GetPerPage() int32 {
 	if x != nil {
-		return x.ImageUrl
+		return x.PerPage
 	}
-	return ""
+	return 0
 }
 
-func (x *Product) GetQuantity() int32 {
+func (x *SearchProductsRequest) GetAttributes() []*AttributeFilter {
 	if x != nil {
-		return x.Quantity
+		return x.Attributes
 	}
-	return 0
+	return nil
 }
 
-type CreateCategoryRequest struct {
+type SearchProductsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Facets        *SearchFacets          `protobuf:"bytes,3,opt,name=facets,proto3" json:"facets,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryRequest) Reset() {
-	*x = CreateCategoryRequest{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[11]
+func (x *SearchProductsResponse) Reset() {
+	*x = SearchProductsResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryRequest) String() string {
+func (x *SearchProductsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryRequest) ProtoMessage() {}
+func (*SearchProductsResponse) ProtoMessage() {}
 
-func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[11]
+func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -787,48 +1205,57 @@ func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
+func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CreateCategoryRequest) GetName() string {
+func (x *SearchProductsResponse) GetProducts() []*Product {
 	if x != nil {
-		return x.Name
+		return x.Products
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateCategoryRequest) GetDescription() string {
+func (x *SearchProductsResponse) GetTotalCount() int32 {
 	if x != nil {
-		return x.Description
+		return x.TotalCount
 	}
-	return ""
+	return 0
 }
 
-type CreateCategoryResponse struct {
+func (x *SearchProductsResponse) GetFacets() *SearchFacets {
+	if x != nil {
+		return x.Facets
+	}
+	return nil
+}
+
+type SearchFacets struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Categories    []*CategoryFacet       `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	PriceRanges   []*PriceRangeFacet     `protobuf:"bytes,2,rep,name=price_ranges,json=priceRanges,proto3" json:"price_ranges,omitempty"`
+	InStock       int32                  `protobuf:"varint,3,opt,name=in_stock,json=inStock,proto3" json:"in_stock,omitempty"`
+	OnDiscount    int32                  `protobuf:"varint,4,opt,name=on_discount,json=onDiscount,proto3" json:"on_discount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCategoryResponse) Reset() {
-	*x = CreateCategoryResponse{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[12]
+func (x *SearchFacets) Reset() {
+	*x = SearchFacets{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCategoryResponse) String() string {
+func (x *SearchFacets) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCategoryResponse) ProtoMessage() {}
+func (*SearchFacets) ProtoMessage() {}
 
-func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[12]
+func (x *SearchFacets) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -839,47 +1266,7326 @@ func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
-func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use SearchFacets.ProtoReflect.Descriptor instead.
+func (*SearchFacets) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateCategoryResponse) GetSuccess() bool {
+func (x *SearchFacets) GetCategories() []*CategoryFacet {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetPriceRanges() []*PriceRangeFacet {
+	if x != nil {
+		return x.PriceRanges
+	}
+	return nil
+}
+
+func (x *SearchFacets) GetInStock() int32 {
+	if x != nil {
+		return x.InStock
+	}
+	return 0
+}
+
+func (x *SearchFacets) GetOnDiscount() int32 {
+	if x != nil {
+		return x.OnDiscount
+	}
+	return 0
+}
+
+type CategoryFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryFacet) Reset() {
+	*x = CategoryFacet{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryFacet) ProtoMessage() {}
+
+func (x *CategoryFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryFacet.ProtoReflect.Descriptor instead.
+func (*CategoryFacet) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CategoryFacet) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *CategoryFacet) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CategoryFacet) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PriceRangeFacet struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           float32                `protobuf:"fixed32,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float32                `protobuf:"fixed32,2,opt,name=max,proto3" json:"max,omitempty"`
+	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceRangeFacet) Reset() {
+	*x = PriceRangeFacet{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceRangeFacet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceRangeFacet) ProtoMessage() {}
+
+func (x *PriceRangeFacet) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceRangeFacet.ProtoReflect.Descriptor instead.
+func (*PriceRangeFacet) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PriceRangeFacet) GetMin() float32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *PriceRangeFacet) GetMax() float32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *PriceRangeFacet) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type Product struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ShortDescription string                 `protobuf:"bytes,3,opt,name=short_description,json=shortDescription,proto3" json:"short_description,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Price            float32                `protobuf:"fixed32,5,opt,name=price,proto3" json:"price,omitempty"`
+	DiscountType     string                 `protobuf:"bytes,6,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	DiscountValue    float32                `protobuf:"fixed32,7,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
+	ImageUrl         string                 `protobuf:"bytes,8,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	Quantity         int32                  `protobuf:"varint,9,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Options          []*ProductOption       `protobuf:"bytes,10,rep,name=options,proto3" json:"options,omitempty"`
+	Variants         []*ProductVariant      `protobuf:"bytes,11,rep,name=variants,proto3" json:"variants,omitempty"`
+	ReorderThreshold int32                  `protobuf:"varint,12,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	Sku              string                 `protobuf:"bytes,13,opt,name=sku,proto3" json:"sku,omitempty"`
+	Images           []*ProductImage        `protobuf:"bytes,14,rep,name=images,proto3" json:"images,omitempty"`
+	// average of the approved reviews, 0 when there are none
+	RatingAverage float64             `protobuf:"fixed64,15,opt,name=rating_average,json=ratingAverage,proto3" json:"rating_average,omitempty"`
+	RatingCount   int32               `protobuf:"varint,16,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
+	Attributes    []*ProductAttribute `protobuf:"bytes,17,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	// 0 means no limit
+	MaxPerOrder int32  `protobuf:"varint,18,opt,name=max_per_order,json=maxPerOrder,proto3" json:"max_per_order,omitempty"`
+	TaxCategory string `protobuf:"bytes,19,opt,name=tax_category,json=taxCategory,proto3" json:"tax_category,omitempty"`
+	// whether the discount applies right now, within its start and end dates
+	DiscountActive bool `protobuf:"varint,20,opt,name=discount_active,json=discountActive,proto3" json:"discount_active,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Product) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetShortDescription() string {
+	if x != nil {
+		return x.ShortDescription
+	}
+	return ""
+}
+
+func (x *Product) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Product) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Product) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *Product) GetDiscountValue() float32 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *Product) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *Product) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Product) GetOptions() []*ProductOption {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Product) GetVariants() []*ProductVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *Product) GetReorderThreshold() int32 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+func (x *Product) GetRatingAverage() float64 {
+	if x != nil {
+		return x.RatingAverage
+	}
+	return 0
+}
+
+func (x *Product) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+func (x *Product) GetAttributes() []*ProductAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Product) GetMaxPerOrder() int32 {
+	if x != nil {
+		return x.MaxPerOrder
+	}
+	return 0
+}
+
+func (x *Product) GetTaxCategory() string {
+	if x != nil {
+		return x.TaxCategory
+	}
+	return ""
+}
+
+func (x *Product) GetDiscountActive() bool {
+	if x != nil {
+		return x.DiscountActive
+	}
+	return false
+}
+
+type ProductOption struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	Values        []*ProductOptionValue  `protobuf:"bytes,4,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductOption) Reset() {
+	*x = ProductOption{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductOption) ProtoMessage() {}
+
+func (x *ProductOption) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductOption.ProtoReflect.Descriptor instead.
+func (*ProductOption) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ProductOption) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductOption) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductOption) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ProductOption) GetValues() []*ProductOptionValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ProductOptionValue struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductOptionValue) Reset() {
+	*x = ProductOptionValue{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductOptionValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductOptionValue) ProtoMessage() {}
+
+func (x *ProductOptionValue) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductOptionValue.ProtoReflect.Descriptor instead.
+func (*ProductOptionValue) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ProductOptionValue) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductOptionValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type ProductVariant struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku       string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	// effective unit price: the override if set, else the product price
+	Price            float32 `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`
+	HasPriceOverride bool    `protobuf:"varint,5,opt,name=has_price_override,json=hasPriceOverride,proto3" json:"has_price_override,omitempty"`
+	Quantity         int32   `protobuf:"varint,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ImageUrl         string  `protobuf:"bytes,7,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	OptionValueIds   []int64 `protobuf:"varint,8,rep,packed,name=option_value_ids,json=optionValueIds,proto3" json:"option_value_ids,omitempty"`
+	// option name -> selected value, e.g. {"size": "M", "color": "Red"}
+	Options       map[string]string `protobuf:"bytes,9,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductVariant) Reset() {
+	*x = ProductVariant{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductVariant) ProtoMessage() {}
+
+func (x *ProductVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductVariant.ProtoReflect.Descriptor instead.
+func (*ProductVariant) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ProductVariant) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductVariant) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductVariant) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ProductVariant) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ProductVariant) GetHasPriceOverride() bool {
+	if x != nil {
+		return x.HasPriceOverride
+	}
+	return false
+}
+
+func (x *ProductVariant) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ProductVariant) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *ProductVariant) GetOptionValueIds() []int64 {
+	if x != nil {
+		return x.OptionValueIds
+	}
+	return nil
+}
+
+func (x *ProductVariant) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type CreateProductOptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []string               `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductOptionRequest) Reset() {
+	*x = CreateProductOptionRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductOptionRequest) ProtoMessage() {}
+
+func (x *CreateProductOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductOptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductOptionRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CreateProductOptionRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CreateProductOptionRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductOptionRequest) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type ProductOptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Option        *ProductOption         `protobuf:"bytes,1,opt,name=option,proto3" json:"option,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductOptionResponse) Reset() {
+	*x = ProductOptionResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductOptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductOptionResponse) ProtoMessage() {}
+
+func (x *ProductOptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductOptionResponse.ProtoReflect.Descriptor instead.
+func (*ProductOptionResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ProductOptionResponse) GetOption() *ProductOption {
+	if x != nil {
+		return x.Option
+	}
+	return nil
+}
+
+type DeleteProductOptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	OptionId      int64                  `protobuf:"varint,2,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductOptionRequest) Reset() {
+	*x = DeleteProductOptionRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductOptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductOptionRequest) ProtoMessage() {}
+
+func (x *DeleteProductOptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductOptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductOptionRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *DeleteProductOptionRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *DeleteProductOptionRequest) GetOptionId() int64 {
+	if x != nil {
+		return x.OptionId
+	}
+	return 0
+}
+
+type DeleteProductOptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductOptionResponse) Reset() {
+	*x = DeleteProductOptionResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductOptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductOptionResponse) ProtoMessage() {}
+
+func (x *DeleteProductOptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductOptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductOptionResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteProductOptionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type CreateProductVariantRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku       string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	// 0 means no override
+	Price          float32 `protobuf:"fixed32,3,opt,name=price,proto3" json:"price,omitempty"`
+	Quantity       int32   `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ImageUrl       string  `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty"`
+	OptionValueIds []int64 `protobuf:"varint,6,rep,packed,name=option_value_ids,json=optionValueIds,proto3" json:"option_value_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateProductVariantRequest) Reset() {
+	*x = CreateProductVariantRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductVariantRequest) ProtoMessage() {}
+
+func (x *CreateProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateProductVariantRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CreateProductVariantRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductVariantRequest) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *CreateProductVariantRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateProductVariantRequest) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+func (x *CreateProductVariantRequest) GetOptionValueIds() []int64 {
+	if x != nil {
+		return x.OptionValueIds
+	}
+	return nil
+}
+
+type GetProductVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductVariantRequest) Reset() {
+	*x = GetProductVariantRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductVariantRequest) ProtoMessage() {}
+
+func (x *GetProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*GetProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetProductVariantRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UpdateProductVariantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku   *string                `protobuf:"bytes,2,opt,name=sku,proto3,oneof" json:"sku,omitempty"`
+	// set to replace the price override; 0 clears it
+	Price         *float32 `protobuf:"fixed32,3,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	Quantity      *int32   `protobuf:"varint,4,opt,name=quantity,proto3,oneof" json:"quantity,omitempty"`
+	ImageUrl      *string  `protobuf:"bytes,5,opt,name=image_url,json=imageUrl,proto3,oneof" json:"image_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductVariantRequest) Reset() {
+	*x = UpdateProductVariantRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductVariantRequest) ProtoMessage() {}
+
+func (x *UpdateProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *UpdateProductVariantRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProductVariantRequest) GetSku() string {
+	if x != nil && x.Sku != nil {
+		return *x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateProductVariantRequest) GetPrice() float32 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *UpdateProductVariantRequest) GetQuantity() int32 {
+	if x != nil && x.Quantity != nil {
+		return *x.Quantity
+	}
+	return 0
+}
+
+func (x *UpdateProductVariantRequest) GetImageUrl() string {
+	if x != nil && x.ImageUrl != nil {
+		return *x.ImageUrl
+	}
+	return ""
+}
+
+type ProductVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Variant       *ProductVariant        `protobuf:"bytes,1,opt,name=variant,proto3" json:"variant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductVariantResponse) Reset() {
+	*x = ProductVariantResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductVariantResponse) ProtoMessage() {}
+
+func (x *ProductVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductVariantResponse.ProtoReflect.Descriptor instead.
+func (*ProductVariantResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ProductVariantResponse) GetVariant() *ProductVariant {
+	if x != nil {
+		return x.Variant
+	}
+	return nil
+}
+
+type DeleteProductVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductVariantRequest) Reset() {
+	*x = DeleteProductVariantRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductVariantRequest) ProtoMessage() {}
+
+func (x *DeleteProductVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductVariantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductVariantRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteProductVariantRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteProductVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductVariantResponse) Reset() {
+	*x = DeleteProductVariantResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductVariantResponse) ProtoMessage() {}
+
+func (x *DeleteProductVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductVariantResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductVariantResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteProductVariantResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RestockProductRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// optional; restocks the variant instead of the product
+	VariantId int64  `protobuf:"varint,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Quantity  int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason    string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor     string `protobuf:"bytes,5,opt,name=actor,proto3" json:"actor,omitempty"`
+	// optional; also adds the stock to this warehouse
+	WarehouseId   int64 `protobuf:"varint,6,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestockProductRequest) Reset() {
+	*x = RestockProductRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestockProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestockProductRequest) ProtoMessage() {}
+
+func (x *RestockProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestockProductRequest.ProtoReflect.Descriptor instead.
+func (*RestockProductRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RestockProductRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *RestockProductRequest) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *RestockProductRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RestockProductRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RestockProductRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *RestockProductRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+type RecordStockMovementRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId int64                  `protobuf:"varint,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Type      StockMovementType      `protobuf:"varint,3,opt,name=type,proto3,enum=product.StockMovementType" json:"type,omitempty"`
+	// positive amount; for adjustments the signed change to apply
+	Quantity int32  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason   string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor    string `protobuf:"bytes,6,opt,name=actor,proto3" json:"actor,omitempty"`
+	// optional; also applies the movement to this warehouse
+	WarehouseId   int64 `protobuf:"varint,7,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordStockMovementRequest) Reset() {
+	*x = RecordStockMovementRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordStockMovementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordStockMovementRequest) ProtoMessage() {}
+
+func (x *RecordStockMovementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordStockMovementRequest.ProtoReflect.Descriptor instead.
+func (*RecordStockMovementRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RecordStockMovementRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *RecordStockMovementRequest) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *RecordStockMovementRequest) GetType() StockMovementType {
+	if x != nil {
+		return x.Type
+	}
+	return StockMovementType_STOCK_MOVEMENT_UNSPECIFIED
+}
+
+func (x *RecordStockMovementRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *RecordStockMovementRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RecordStockMovementRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *RecordStockMovementRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+type StockMovement struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId int64                  `protobuf:"varint,3,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Type      string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	// signed change applied to on-hand stock
+	Quantity     int32  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	BalanceAfter int32  `protobuf:"varint,6,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	Reason       string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor        string `protobuf:"bytes,8,opt,name=actor,proto3" json:"actor,omitempty"`
+	CreatedAt    string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	WarehouseId  int64  `protobuf:"varint,10,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	// stock level in the warehouse after the movement, when warehouse_id is set
+	WarehouseBalanceAfter *int32 `protobuf:"varint,11,opt,name=warehouse_balance_after,json=warehouseBalanceAfter,proto3,oneof" json:"warehouse_balance_after,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *StockMovement) Reset() {
+	*x = StockMovement{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovement) ProtoMessage() {}
+
+func (x *StockMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
+func (*StockMovement) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StockMovement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockMovement) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *StockMovement) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockMovement) GetBalanceAfter() int32 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockMovement) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StockMovement) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *StockMovement) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetWarehouseBalanceAfter() int32 {
+	if x != nil && x.WarehouseBalanceAfter != nil {
+		return *x.WarehouseBalanceAfter
+	}
+	return 0
+}
+
+type StockMovementResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movement      *StockMovement         `protobuf:"bytes,1,opt,name=movement,proto3" json:"movement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockMovementResponse) Reset() {
+	*x = StockMovementResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovementResponse) ProtoMessage() {}
+
+func (x *StockMovementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovementResponse.ProtoReflect.Descriptor instead.
+func (*StockMovementResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StockMovementResponse) GetMovement() *StockMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+type ListStockMovementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     int64                  `protobuf:"varint,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Type          StockMovementType      `protobuf:"varint,3,opt,name=type,proto3,enum=product.StockMovementType" json:"type,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,5,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	WarehouseId   int64                  `protobuf:"varint,6,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsRequest) Reset() {
+	*x = ListStockMovementsRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsRequest) ProtoMessage() {}
+
+func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListStockMovementsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetType() StockMovementType {
+	if x != nil {
+		return x.Type
+	}
+	return StockMovementType_STOCK_MOVEMENT_UNSPECIFIED
+}
+
+func (x *ListStockMovementsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+type ListStockMovementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movements     []*StockMovement       `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsResponse) Reset() {
+	*x = ListStockMovementsResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsResponse) ProtoMessage() {}
+
+func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListStockMovementsResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+func (x *ListStockMovementsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type SetReorderThresholdRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// 0 disables low-stock checks for the product
+	Threshold     int32 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReorderThresholdRequest) Reset() {
+	*x = SetReorderThresholdRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReorderThresholdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReorderThresholdRequest) ProtoMessage() {}
+
+func (x *SetReorderThresholdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReorderThresholdRequest.ProtoReflect.Descriptor instead.
+func (*SetReorderThresholdRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *SetReorderThresholdRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetReorderThresholdRequest) GetThreshold() int32 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type SetReorderThresholdResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetReorderThresholdResponse) Reset() {
+	*x = SetReorderThresholdResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetReorderThresholdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetReorderThresholdResponse) ProtoMessage() {}
+
+func (x *SetReorderThresholdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetReorderThresholdResponse.ProtoReflect.Descriptor instead.
+func (*SetReorderThresholdResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetReorderThresholdResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetMaxPerOrderRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// 0 removes the limit
+	MaxPerOrder   int32 `protobuf:"varint,2,opt,name=max_per_order,json=maxPerOrder,proto3" json:"max_per_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaxPerOrderRequest) Reset() {
+	*x = SetMaxPerOrderRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaxPerOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaxPerOrderRequest) ProtoMessage() {}
+
+func (x *SetMaxPerOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaxPerOrderRequest.ProtoReflect.Descriptor instead.
+func (*SetMaxPerOrderRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SetMaxPerOrderRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SetMaxPerOrderRequest) GetMaxPerOrder() int32 {
+	if x != nil {
+		return x.MaxPerOrder
+	}
+	return 0
+}
+
+type SetMaxPerOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMaxPerOrderResponse) Reset() {
+	*x = SetMaxPerOrderResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMaxPerOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMaxPerOrderResponse) ProtoMessage() {}
+
+func (x *SetMaxPerOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMaxPerOrderResponse.ProtoReflect.Descriptor instead.
+func (*SetMaxPerOrderResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *SetMaxPerOrderResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListLowStockProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockProductsRequest) Reset() {
+	*x = ListLowStockProductsRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockProductsRequest) ProtoMessage() {}
+
+func (x *ListLowStockProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListLowStockProductsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{43}
+}
+
+type LowStockItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId        int64                  `protobuf:"varint,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Name             string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Sku              string                 `protobuf:"bytes,4,opt,name=sku,proto3" json:"sku,omitempty"`
+	Quantity         int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	ReorderThreshold int32                  `protobuf:"varint,6,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	// units sold in the sales window
+	UnitsSold                int32   `protobuf:"varint,7,opt,name=units_sold,json=unitsSold,proto3" json:"units_sold,omitempty"`
+	DailySalesRate           float64 `protobuf:"fixed64,8,opt,name=daily_sales_rate,json=dailySalesRate,proto3" json:"daily_sales_rate,omitempty"`
+	SuggestedReorderQuantity int32   `protobuf:"varint,9,opt,name=suggested_reorder_quantity,json=suggestedReorderQuantity,proto3" json:"suggested_reorder_quantity,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *LowStockItem) Reset() {
+	*x = LowStockItem{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LowStockItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LowStockItem) ProtoMessage() {}
+
+func (x *LowStockItem) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LowStockItem.ProtoReflect.Descriptor instead.
+func (*LowStockItem) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LowStockItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *LowStockItem) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *LowStockItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LowStockItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *LowStockItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *LowStockItem) GetReorderThreshold() int32 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *LowStockItem) GetUnitsSold() int32 {
+	if x != nil {
+		return x.UnitsSold
+	}
+	return 0
+}
+
+func (x *LowStockItem) GetDailySalesRate() float64 {
+	if x != nil {
+		return x.DailySalesRate
+	}
+	return 0
+}
+
+func (x *LowStockItem) GetSuggestedReorderQuantity() int32 {
+	if x != nil {
+		return x.SuggestedReorderQuantity
+	}
+	return 0
+}
+
+type ListLowStockProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*LowStockItem        `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLowStockProductsResponse) Reset() {
+	*x = ListLowStockProductsResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLowStockProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLowStockProductsResponse) ProtoMessage() {}
+
+func (x *ListLowStockProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLowStockProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListLowStockProductsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListLowStockProductsResponse) GetItems() []*LowStockItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// The first message carries the options; every message may carry the next
+// chunk of the file.
+type ImportProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// csv | ndjson
+	Format        string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	DryRun        bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Data          []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsRequest) Reset() {
+	*x = ImportProductsRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsRequest) ProtoMessage() {}
+
+func (x *ImportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ImportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ImportProductsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportProductsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportProductsRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// line of the row in the file, the CSV header is line 1
+	Line          int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Sku           string `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ImportRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ImportProductsResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	DryRun    bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	TotalRows int32                  `protobuf:"varint,2,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	Created   int32                  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Updated   int32                  `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	Failed    int32                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	// capped; failed holds the full count
+	Errors        []*ImportRowError `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportProductsResponse) Reset() {
+	*x = ImportProductsResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportProductsResponse) ProtoMessage() {}
+
+func (x *ImportProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportProductsResponse.ProtoReflect.Descriptor instead.
+func (*ImportProductsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ImportProductsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportProductsResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportProductsResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ExportProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// csv | ndjson
+	Format        string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsRequest) Reset() {
+	*x = ExportProductsRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsRequest) ProtoMessage() {}
+
+func (x *ExportProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsRequest.ProtoReflect.Descriptor instead.
+func (*ExportProductsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ExportProductsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportProductsChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportProductsChunk) Reset() {
+	*x = ExportProductsChunk{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportProductsChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportProductsChunk) ProtoMessage() {}
+
+func (x *ExportProductsChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportProductsChunk.ProtoReflect.Descriptor instead.
+func (*ExportProductsChunk) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExportProductsChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ProductImage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Position      int32                  `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	AltText       string                 `protobuf:"bytes,4,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	ThumbnailUrl  string                 `protobuf:"bytes,6,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	ContentType   string                 `protobuf:"bytes,7,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	SizeBytes     int64                  `protobuf:"varint,8,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	Width         int32                  `protobuf:"varint,9,opt,name=width,proto3" json:"width,omitempty"`
+	Height        int32                  `protobuf:"varint,10,opt,name=height,proto3" json:"height,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductImage) Reset() {
+	*x = ProductImage{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductImage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductImage) ProtoMessage() {}
+
+func (x *ProductImage) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductImage.ProtoReflect.Descriptor instead.
+func (*ProductImage) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ProductImage) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProductImage) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductImage) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ProductImage) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *ProductImage) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ProductImage) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *ProductImage) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ProductImage) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *ProductImage) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *ProductImage) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProductImage) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// The first message carries the metadata; every message may carry the next
+// chunk of the image.
+type UploadProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	AltText       string                 `protobuf:"bytes,2,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadProductImageRequest) Reset() {
+	*x = UploadProductImageRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadProductImageRequest) ProtoMessage() {}
+
+func (x *UploadProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadProductImageRequest.ProtoReflect.Descriptor instead.
+func (*UploadProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UploadProductImageRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *UploadProductImageRequest) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *UploadProductImageRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         *ProductImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductImageResponse) Reset() {
+	*x = ProductImageResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductImageResponse) ProtoMessage() {}
+
+func (x *ProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductImageResponse.ProtoReflect.Descriptor instead.
+func (*ProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ProductImageResponse) GetImage() *ProductImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type ListProductImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductImagesRequest) Reset() {
+	*x = ListProductImagesRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductImagesRequest) ProtoMessage() {}
+
+func (x *ListProductImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*ListProductImagesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListProductImagesRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type ListProductImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*ProductImage        `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductImagesResponse) Reset() {
+	*x = ListProductImagesResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductImagesResponse) ProtoMessage() {}
+
+func (x *ListProductImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductImagesResponse.ProtoReflect.Descriptor instead.
+func (*ListProductImagesResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListProductImagesResponse) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type UpdateProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AltText       string                 `protobuf:"bytes,2,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductImageRequest) Reset() {
+	*x = UpdateProductImageRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductImageRequest) ProtoMessage() {}
+
+func (x *UpdateProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductImageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UpdateProductImageRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateProductImageRequest) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+type ReorderProductImagesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// every image of the product, in the new display order
+	ImageIds      []int64 `protobuf:"varint,2,rep,packed,name=image_ids,json=imageIds,proto3" json:"image_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReorderProductImagesRequest) Reset() {
+	*x = ReorderProductImagesRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReorderProductImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReorderProductImagesRequest) ProtoMessage() {}
+
+func (x *ReorderProductImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReorderProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*ReorderProductImagesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ReorderProductImagesRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReorderProductImagesRequest) GetImageIds() []int64 {
+	if x != nil {
+		return x.ImageIds
+	}
+	return nil
+}
+
+type DeleteProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductImageRequest) Reset() {
+	*x = DeleteProductImageRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductImageRequest) ProtoMessage() {}
+
+func (x *DeleteProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductImageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *DeleteProductImageRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductImageResponse) Reset() {
+	*x = DeleteProductImageResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductImageResponse) ProtoMessage() {}
+
+func (x *DeleteProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductImageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteProductImageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type Review struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId    int64                  `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rating    int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Title     string                 `protobuf:"bytes,5,opt,name=title,proto3" json:"title,omitempty"`
+	Body      string                 `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	// pending, approved or rejected
+	Status         string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ModerationNote string `protobuf:"bytes,8,opt,name=moderation_note,json=moderationNote,proto3" json:"moderation_note,omitempty"`
+	CreatedAt      string `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *Review) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Review) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *Review) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Review) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *Review) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Review) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Review) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Review) GetModerationNote() string {
+	if x != nil {
+		return x.ModerationNote
+	}
+	return ""
+}
+
+func (x *Review) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Review) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Title         string                 `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReviewRequest) Reset() {
+	*x = CreateReviewRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReviewRequest) ProtoMessage() {}
+
+func (x *CreateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateReviewRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateReviewRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateReviewRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type ReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewResponse) Reset() {
+	*x = ReviewResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewResponse) ProtoMessage() {}
+
+func (x *ReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewResponse.ProtoReflect.Descriptor instead.
+func (*ReviewResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type ListProductReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductReviewsRequest) Reset() {
+	*x = ListProductReviewsRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductReviewsRequest) ProtoMessage() {}
+
+func (x *ListProductReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListProductReviewsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListProductReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductReviewsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type ListReviewsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// optional filters
+	ProductId     int64  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId        int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32  `protobuf:"varint,5,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewsRequest) Reset() {
+	*x = ListReviewsRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsRequest) ProtoMessage() {}
+
+func (x *ListReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListReviewsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListReviewsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListReviewsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReviewsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type ListReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewsResponse) Reset() {
+	*x = ListReviewsResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsResponse) ProtoMessage() {}
+
+func (x *ListReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListReviewsResponse) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListReviewsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type UpdateReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the author; only they may edit the review
+	UserId        int64  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rating        int32  `protobuf:"varint,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	Title         string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateReviewRequest) Reset() {
+	*x = UpdateReviewRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateReviewRequest) ProtoMessage() {}
+
+func (x *UpdateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateReviewRequest.ProtoReflect.Descriptor instead.
+func (*UpdateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateReviewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *UpdateReviewRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateReviewRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type DeleteReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// the author; 0 skips the ownership check for admins
+	UserId        int64 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewRequest) Reset() {
+	*x = DeleteReviewRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewRequest) ProtoMessage() {}
+
+func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteReviewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteReviewRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewResponse) Reset() {
+	*x = DeleteReviewResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewResponse) ProtoMessage() {}
+
+func (x *DeleteReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReviewResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *DeleteReviewResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ModerateReviewRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// approved or rejected
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Note          string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateReviewRequest) Reset() {
+	*x = ModerateReviewRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReviewRequest) ProtoMessage() {}
+
+func (x *ModerateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReviewRequest.ProtoReflect.Descriptor instead.
+func (*ModerateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ModerateReviewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ModerateReviewRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ModerateReviewRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ScheduledPriceChange struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Price     *float32               `protobuf:"fixed32,3,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	// fixed or percent
+	DiscountType   *string  `protobuf:"bytes,4,opt,name=discount_type,json=discountType,proto3,oneof" json:"discount_type,omitempty"`
+	DiscountValue  *float32 `protobuf:"fixed32,5,opt,name=discount_value,json=discountValue,proto3,oneof" json:"discount_value,omitempty"`
+	RemoveDiscount bool     `protobuf:"varint,6,opt,name=remove_discount,json=removeDiscount,proto3" json:"remove_discount,omitempty"`
+	EffectiveAt    string   `protobuf:"bytes,7,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	// pending, applied or canceled
+	Status        string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	AppliedAt     string `protobuf:"bytes,9,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
+	CreatedBy     string `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt     string `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledPriceChange) Reset() {
+	*x = ScheduledPriceChange{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledPriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPriceChange) ProtoMessage() {}
+
+func (x *ScheduledPriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPriceChange.ProtoReflect.Descriptor instead.
+func (*ScheduledPriceChange) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *ScheduledPriceChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ScheduledPriceChange) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ScheduledPriceChange) GetPrice() float32 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *ScheduledPriceChange) GetDiscountType() string {
+	if x != nil && x.DiscountType != nil {
+		return *x.DiscountType
+	}
+	return ""
+}
+
+func (x *ScheduledPriceChange) GetDiscountValue() float32 {
+	if x != nil && x.DiscountValue != nil {
+		return *x.DiscountValue
+	}
+	return 0
+}
+
+func (x *ScheduledPriceChange) GetRemoveDiscount() bool {
+	if x != nil {
+		return x.RemoveDiscount
+	}
+	return false
+}
+
+func (x *ScheduledPriceChange) GetEffectiveAt() string {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return ""
+}
+
+func (x *ScheduledPriceChange) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ScheduledPriceChange) GetAppliedAt() string {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return ""
+}
+
+func (x *ScheduledPriceChange) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *ScheduledPriceChange) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type SchedulePriceChangeRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// unset fields keep their value at effective_at
+	Price         *float32      `protobuf:"fixed32,2,opt,name=price,proto3,oneof" json:"price,omitempty"`
+	DiscountType  *DiscountType `protobuf:"varint,3,opt,name=discount_type,json=discountType,proto3,enum=product.DiscountType,oneof" json:"discount_type,omitempty"`
+	DiscountValue *float32      `protobuf:"fixed32,4,opt,name=discount_value,json=discountValue,proto3,oneof" json:"discount_value,omitempty"`
+	// ends the current discount
+	RemoveDiscount bool `protobuf:"varint,5,opt,name=remove_discount,json=removeDiscount,proto3" json:"remove_discount,omitempty"`
+	// RFC 3339, must be in the future
+	EffectiveAt   string `protobuf:"bytes,6,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	CreatedBy     string `protobuf:"bytes,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeRequest) Reset() {
+	*x = SchedulePriceChangeRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeRequest) ProtoMessage() {}
+
+func (x *SchedulePriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SchedulePriceChangeRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetPrice() float32 {
+	if x != nil && x.Price != nil {
+		return *x.Price
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetDiscountType() DiscountType {
+	if x != nil && x.DiscountType != nil {
+		return *x.DiscountType
+	}
+	return DiscountType_DISCOUNT_NONE
+}
+
+func (x *SchedulePriceChangeRequest) GetDiscountValue() float32 {
+	if x != nil && x.DiscountValue != nil {
+		return *x.DiscountValue
+	}
+	return 0
+}
+
+func (x *SchedulePriceChangeRequest) GetRemoveDiscount() bool {
+	if x != nil {
+		return x.RemoveDiscount
+	}
+	return false
+}
+
+func (x *SchedulePriceChangeRequest) GetEffectiveAt() string {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return ""
+}
+
+func (x *SchedulePriceChangeRequest) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+type ScheduledPriceChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Change        *ScheduledPriceChange  `protobuf:"bytes,1,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledPriceChangeResponse) Reset() {
+	*x = ScheduledPriceChangeResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledPriceChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledPriceChangeResponse) ProtoMessage() {}
+
+func (x *ScheduledPriceChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledPriceChangeResponse.ProtoReflect.Descriptor instead.
+func (*ScheduledPriceChangeResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ScheduledPriceChangeResponse) GetChange() *ScheduledPriceChange {
+	if x != nil {
+		return x.Change
+	}
+	return nil
+}
+
+type CancelScheduledPriceChangeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelScheduledPriceChangeRequest) Reset() {
+	*x = CancelScheduledPriceChangeRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelScheduledPriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledPriceChangeRequest) ProtoMessage() {}
+
+func (x *CancelScheduledPriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledPriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledPriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CancelScheduledPriceChangeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListScheduledPriceChangesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// optional filters
+	ProductId     int64  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledPriceChangesRequest) Reset() {
+	*x = ListScheduledPriceChangesRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledPriceChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledPriceChangesRequest) ProtoMessage() {}
+
+func (x *ListScheduledPriceChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledPriceChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledPriceChangesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListScheduledPriceChangesRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListScheduledPriceChangesRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListScheduledPriceChangesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListScheduledPriceChangesRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type ListScheduledPriceChangesResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Changes       []*ScheduledPriceChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	TotalCount    int32                   `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledPriceChangesResponse) Reset() {
+	*x = ListScheduledPriceChangesResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledPriceChangesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledPriceChangesResponse) ProtoMessage() {}
+
+func (x *ListScheduledPriceChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledPriceChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledPriceChangesResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListScheduledPriceChangesResponse) GetChanges() []*ScheduledPriceChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *ListScheduledPriceChangesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type PriceHistoryEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Price         float32                `protobuf:"fixed32,1,opt,name=price,proto3" json:"price,omitempty"`
+	DiscountType  string                 `protobuf:"bytes,2,opt,name=discount_type,json=discountType,proto3" json:"discount_type,omitempty"`
+	DiscountValue float32                `protobuf:"fixed32,3,opt,name=discount_value,json=discountValue,proto3" json:"discount_value,omitempty"`
+	// initial, create, update, import or schedule
+	Source            string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	ScheduledChangeId int64  `protobuf:"varint,5,opt,name=scheduled_change_id,json=scheduledChangeId,proto3" json:"scheduled_change_id,omitempty"`
+	ChangedAt         string `protobuf:"bytes,6,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PriceHistoryEntry) Reset() {
+	*x = PriceHistoryEntry{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceHistoryEntry) ProtoMessage() {}
+
+func (x *PriceHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceHistoryEntry.ProtoReflect.Descriptor instead.
+func (*PriceHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *PriceHistoryEntry) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceHistoryEntry) GetDiscountType() string {
+	if x != nil {
+		return x.DiscountType
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetDiscountValue() float32 {
+	if x != nil {
+		return x.DiscountValue
+	}
+	return 0
+}
+
+func (x *PriceHistoryEntry) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PriceHistoryEntry) GetScheduledChangeId() int64 {
+	if x != nil {
+		return x.ScheduledChangeId
+	}
+	return 0
+}
+
+func (x *PriceHistoryEntry) GetChangedAt() string {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return ""
+}
+
+type GetPriceTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceTimelineRequest) Reset() {
+	*x = GetPriceTimelineRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceTimelineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceTimelineRequest) ProtoMessage() {}
+
+func (x *GetPriceTimelineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceTimelineRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetPriceTimelineRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type GetPriceTimelineResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	// oldest first
+	History []*PriceHistoryEntry `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+	// pending changes in the order they take effect
+	Upcoming      []*ScheduledPriceChange `protobuf:"bytes,3,rep,name=upcoming,proto3" json:"upcoming,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceTimelineResponse) Reset() {
+	*x = GetPriceTimelineResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceTimelineResponse) ProtoMessage() {}
+
+func (x *GetPriceTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetPriceTimelineResponse) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *GetPriceTimelineResponse) GetHistory() []*PriceHistoryEntry {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *GetPriceTimelineResponse) GetUpcoming() []*ScheduledPriceChange {
+	if x != nil {
+		return x.Upcoming
+	}
+	return nil
+}
+
+type TrashedProduct struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sku       string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name      string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Price     float32                `protobuf:"fixed32,4,opt,name=price,proto3" json:"price,omitempty"`
+	DeletedAt string                 `protobuf:"bytes,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// empty when trashed items are only purged by hand
+	PurgeAt       string `protobuf:"bytes,6,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashedProduct) Reset() {
+	*x = TrashedProduct{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashedProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashedProduct) ProtoMessage() {}
+
+func (x *TrashedProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashedProduct.ProtoReflect.Descriptor instead.
+func (*TrashedProduct) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *TrashedProduct) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TrashedProduct) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *TrashedProduct) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrashedProduct) GetPrice() float32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *TrashedProduct) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *TrashedProduct) GetPurgeAt() string {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return ""
+}
+
+type TrashedCategory struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ParentId      int64                  `protobuf:"varint,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Slug          string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	Path          string                 `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	DeletedAt     string                 `protobuf:"bytes,6,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	PurgeAt       string                 `protobuf:"bytes,7,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrashedCategory) Reset() {
+	*x = TrashedCategory{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrashedCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrashedCategory) ProtoMessage() {}
+
+func (x *TrashedCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrashedCategory.ProtoReflect.Descriptor instead.
+func (*TrashedCategory) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *TrashedCategory) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TrashedCategory) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *TrashedCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TrashedCategory) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *TrashedCategory) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *TrashedCategory) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *TrashedCategory) GetPurgeAt() string {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return ""
+}
+
+type ListTrashedProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashedProductsRequest) Reset() {
+	*x = ListTrashedProductsRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashedProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashedProductsRequest) ProtoMessage() {}
+
+func (x *ListTrashedProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashedProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashedProductsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListTrashedProductsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTrashedProductsRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type ListTrashedProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*TrashedProduct      `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashedProductsResponse) Reset() {
+	*x = ListTrashedProductsResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashedProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashedProductsResponse) ProtoMessage() {}
+
+func (x *ListTrashedProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashedProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashedProductsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListTrashedProductsResponse) GetProducts() []*TrashedProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListTrashedProductsResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type ListTrashedCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashedCategoriesRequest) Reset() {
+	*x = ListTrashedCategoriesRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashedCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashedCategoriesRequest) ProtoMessage() {}
+
+func (x *ListTrashedCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashedCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListTrashedCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListTrashedCategoriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTrashedCategoriesRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type ListTrashedCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*TrashedCategory     `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTrashedCategoriesResponse) Reset() {
+	*x = ListTrashedCategoriesResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTrashedCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTrashedCategoriesResponse) ProtoMessage() {}
+
+func (x *ListTrashedCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTrashedCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListTrashedCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListTrashedCategoriesResponse) GetCategories() []*TrashedCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListTrashedCategoriesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type RestoreProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductRequest) Reset() {
+	*x = RestoreProductRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductRequest) ProtoMessage() {}
+
+func (x *RestoreProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProductRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RestoreProductRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductResponse) Reset() {
+	*x = RestoreProductResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductResponse) ProtoMessage() {}
+
+func (x *RestoreProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductResponse.ProtoReflect.Descriptor instead.
+func (*RestoreProductResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *RestoreProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type RestoreCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCategoryRequest) Reset() {
+	*x = RestoreCategoryRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCategoryRequest) ProtoMessage() {}
+
+func (x *RestoreCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCategoryRequest.ProtoReflect.Descriptor instead.
+func (*RestoreCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RestoreCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RestoreCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreCategoryResponse) Reset() {
+	*x = RestoreCategoryResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreCategoryResponse) ProtoMessage() {}
+
+func (x *RestoreCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreCategoryResponse.ProtoReflect.Descriptor instead.
+func (*RestoreCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RestoreCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type PurgeProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeProductRequest) Reset() {
+	*x = PurgeProductRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeProductRequest) ProtoMessage() {}
+
+func (x *PurgeProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeProductRequest.ProtoReflect.Descriptor instead.
+func (*PurgeProductRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *PurgeProductRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PurgeProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeProductResponse) Reset() {
+	*x = PurgeProductResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeProductResponse) ProtoMessage() {}
+
+func (x *PurgeProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeProductResponse.ProtoReflect.Descriptor instead.
+func (*PurgeProductResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *PurgeProductResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type PurgeCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeCategoryRequest) Reset() {
+	*x = PurgeCategoryRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeCategoryRequest) ProtoMessage() {}
+
+func (x *PurgeCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeCategoryRequest.ProtoReflect.Descriptor instead.
+func (*PurgeCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PurgeCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type PurgeCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeCategoryResponse) Reset() {
+	*x = PurgeCategoryResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeCategoryResponse) ProtoMessage() {}
+
+func (x *PurgeCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeCategoryResponse.ProtoReflect.Descriptor instead.
+func (*PurgeCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PurgeCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type Warehouse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Country       string                 `protobuf:"bytes,4,opt,name=country,proto3" json:"country,omitempty"`
+	State         string                 `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	City          string                 `protobuf:"bytes,6,opt,name=city,proto3" json:"city,omitempty"`
+	ZipCode       string                 `protobuf:"bytes,7,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty"`
+	Priority      int32                  `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	IsActive      bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Warehouse) Reset() {
+	*x = Warehouse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warehouse) ProtoMessage() {}
+
+func (x *Warehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warehouse.ProtoReflect.Descriptor instead.
+func (*Warehouse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *Warehouse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Warehouse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Warehouse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Warehouse) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Warehouse) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Warehouse) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Warehouse) GetZipCode() string {
+	if x != nil {
+		return x.ZipCode
+	}
+	return ""
+}
+
+func (x *Warehouse) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Warehouse) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Warehouse) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Warehouse) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type CreateWarehouseRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Code     string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name     string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Country  string                 `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	State    string                 `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	City     string                 `protobuf:"bytes,5,opt,name=city,proto3" json:"city,omitempty"`
+	ZipCode  string                 `protobuf:"bytes,6,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty"`
+	Priority int32                  `protobuf:"varint,7,opt,name=priority,proto3" json:"priority,omitempty"`
+	// defaults to true
+	IsActive      *bool `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseRequest) Reset() {
+	*x = CreateWarehouseRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseRequest) ProtoMessage() {}
+
+func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CreateWarehouseRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetZipCode() string {
+	if x != nil {
+		return x.ZipCode
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreateWarehouseRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type GetWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWarehouseRequest) Reset() {
+	*x = GetWarehouseRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWarehouseRequest) ProtoMessage() {}
+
+func (x *GetWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*GetWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetWarehouseRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListWarehousesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveOnly    bool                   `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesRequest) Reset() {
+	*x = ListWarehousesRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesRequest) ProtoMessage() {}
+
+func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesRequest.ProtoReflect.Descriptor instead.
+func (*ListWarehousesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ListWarehousesRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListWarehousesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouses    []*Warehouse           `protobuf:"bytes,1,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesResponse) Reset() {
+	*x = ListWarehousesResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesResponse) ProtoMessage() {}
+
+func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesResponse.ProtoReflect.Descriptor instead.
+func (*ListWarehousesResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ListWarehousesResponse) GetWarehouses() []*Warehouse {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+type UpdateWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          *string                `protobuf:"bytes,2,opt,name=code,proto3,oneof" json:"code,omitempty"`
+	Name          *string                `protobuf:"bytes,3,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Country       *string                `protobuf:"bytes,4,opt,name=country,proto3,oneof" json:"country,omitempty"`
+	State         *string                `protobuf:"bytes,5,opt,name=state,proto3,oneof" json:"state,omitempty"`
+	City          *string                `protobuf:"bytes,6,opt,name=city,proto3,oneof" json:"city,omitempty"`
+	ZipCode       *string                `protobuf:"bytes,7,opt,name=zip_code,json=zipCode,proto3,oneof" json:"zip_code,omitempty"`
+	Priority      *int32                 `protobuf:"varint,8,opt,name=priority,proto3,oneof" json:"priority,omitempty"`
+	IsActive      *bool                  `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWarehouseRequest) Reset() {
+	*x = UpdateWarehouseRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWarehouseRequest) ProtoMessage() {}
+
+func (x *UpdateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *UpdateWarehouseRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateWarehouseRequest) GetCode() string {
+	if x != nil && x.Code != nil {
+		return *x.Code
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetState() string {
+	if x != nil && x.State != nil {
+		return *x.State
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetCity() string {
+	if x != nil && x.City != nil {
+		return *x.City
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetZipCode() string {
+	if x != nil && x.ZipCode != nil {
+		return *x.ZipCode
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetPriority() int32 {
+	if x != nil && x.Priority != nil {
+		return *x.Priority
+	}
+	return 0
+}
+
+func (x *UpdateWarehouseRequest) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+type WarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouse     *Warehouse             `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarehouseResponse) Reset() {
+	*x = WarehouseResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseResponse) ProtoMessage() {}
+
+func (x *WarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseResponse.ProtoReflect.Descriptor instead.
+func (*WarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *WarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+type DeleteWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWarehouseRequest) Reset() {
+	*x = DeleteWarehouseRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWarehouseRequest) ProtoMessage() {}
+
+func (x *DeleteWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DeleteWarehouseRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteWarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWarehouseResponse) Reset() {
+	*x = DeleteWarehouseResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWarehouseResponse) ProtoMessage() {}
+
+func (x *DeleteWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DeleteWarehouseResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type TransferStockRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId       int64                  `protobuf:"varint,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	FromWarehouseId int64                  `protobuf:"varint,3,opt,name=from_warehouse_id,json=fromWarehouseId,proto3" json:"from_warehouse_id,omitempty"`
+	ToWarehouseId   int64                  `protobuf:"varint,4,opt,name=to_warehouse_id,json=toWarehouseId,proto3" json:"to_warehouse_id,omitempty"`
+	Quantity        int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason          string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor           string                 `protobuf:"bytes,7,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransferStockRequest) Reset() {
+	*x = TransferStockRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockRequest) ProtoMessage() {}
+
+func (x *TransferStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockRequest.ProtoReflect.Descriptor instead.
+func (*TransferStockRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *TransferStockRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetFromWarehouseId() int64 {
+	if x != nil {
+		return x.FromWarehouseId
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetToWarehouseId() int64 {
+	if x != nil {
+		return x.ToWarehouseId
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type TransferStockResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the outbound and inbound legs of the transfer
+	Movements     []*StockMovement `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferStockResponse) Reset() {
+	*x = TransferStockResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockResponse) ProtoMessage() {}
+
+func (x *TransferStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockResponse.ProtoReflect.Descriptor instead.
+func (*TransferStockResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *TransferStockResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+type GetStockAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     int64                  `protobuf:"varint,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockAvailabilityRequest) Reset() {
+	*x = GetStockAvailabilityRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetStockAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetStockAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetStockAvailabilityRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *GetStockAvailabilityRequest) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+type WarehouseStockLevel struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	WarehouseCode string                 `protobuf:"bytes,2,opt,name=warehouse_code,json=warehouseCode,proto3" json:"warehouse_code,omitempty"`
+	WarehouseName string                 `protobuf:"bytes,3,opt,name=warehouse_name,json=warehouseName,proto3" json:"warehouse_name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Quantity      int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarehouseStockLevel) Reset() {
+	*x = WarehouseStockLevel{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarehouseStockLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseStockLevel) ProtoMessage() {}
+
+func (x *WarehouseStockLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseStockLevel.ProtoReflect.Descriptor instead.
+func (*WarehouseStockLevel) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *WarehouseStockLevel) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *WarehouseStockLevel) GetWarehouseCode() string {
+	if x != nil {
+		return x.WarehouseCode
+	}
+	return ""
+}
+
+func (x *WarehouseStockLevel) GetWarehouseName() string {
+	if x != nil {
+		return x.WarehouseName
+	}
+	return ""
+}
+
+func (x *WarehouseStockLevel) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *WarehouseStockLevel) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type StockAvailabilityResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId int64                  `protobuf:"varint,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	// total on-hand stock of the product or variant
+	OnHand int32 `protobuf:"varint,3,opt,name=on_hand,json=onHand,proto3" json:"on_hand,omitempty"`
+	// stock summed across all warehouses
+	TotalQuantity int32 `protobuf:"varint,4,opt,name=total_quantity,json=totalQuantity,proto3" json:"total_quantity,omitempty"`
+	// on-hand stock not yet placed in any warehouse
+	UnassignedQuantity int32                  `protobuf:"varint,5,opt,name=unassigned_quantity,json=unassignedQuantity,proto3" json:"unassigned_quantity,omitempty"`
+	Levels             []*WarehouseStockLevel `protobuf:"bytes,6,rep,name=levels,proto3" json:"levels,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *StockAvailabilityResponse) Reset() {
+	*x = StockAvailabilityResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockAvailabilityResponse) ProtoMessage() {}
+
+func (x *StockAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*StockAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *StockAvailabilityResponse) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockAvailabilityResponse) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *StockAvailabilityResponse) GetOnHand() int32 {
+	if x != nil {
+		return x.OnHand
+	}
+	return 0
+}
+
+func (x *StockAvailabilityResponse) GetTotalQuantity() int32 {
+	if x != nil {
+		return x.TotalQuantity
+	}
+	return 0
+}
+
+func (x *StockAvailabilityResponse) GetUnassignedQuantity() int32 {
+	if x != nil {
+		return x.UnassignedQuantity
+	}
+	return 0
+}
+
+func (x *StockAvailabilityResponse) GetLevels() []*WarehouseStockLevel {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+type AllocationItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	VariantId     int64                  `protobuf:"varint,2,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocationItem) Reset() {
+	*x = AllocationItem{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocationItem) ProtoMessage() {}
+
+func (x *AllocationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocationItem.ProtoReflect.Descriptor instead.
+func (*AllocationItem) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *AllocationItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *AllocationItem) GetVariantId() int64 {
+	if x != nil {
+		return x.VariantId
+	}
+	return 0
+}
+
+func (x *AllocationItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type AllocateWarehouseRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Items   []*AllocationItem      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Country string                 `protobuf:"bytes,2,opt,name=country,proto3" json:"country,omitempty"`
+	State   string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	City    string                 `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	ZipCode string                 `protobuf:"bytes,5,opt,name=zip_code,json=zipCode,proto3" json:"zip_code,omitempty"`
+	// nearest or most_stock; empty uses the service default
+	Strategy string `protobuf:"bytes,6,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// also takes the items out of stock with sale movements, so concurrent
+	// allocations cannot be given the same units
+	Reserve       bool   `protobuf:"varint,7,opt,name=reserve,proto3" json:"reserve,omitempty"`
+	Reason        string `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor         string `protobuf:"bytes,9,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocateWarehouseRequest) Reset() {
+	*x = AllocateWarehouseRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateWarehouseRequest) ProtoMessage() {}
+
+func (x *AllocateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*AllocateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *AllocateWarehouseRequest) GetItems() []*AllocationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AllocateWarehouseRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *AllocateWarehouseRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *AllocateWarehouseRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *AllocateWarehouseRequest) GetZipCode() string {
+	if x != nil {
+		return x.ZipCode
+	}
+	return ""
+}
+
+func (x *AllocateWarehouseRequest) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *AllocateWarehouseRequest) GetReserve() bool {
+	if x != nil {
+		return x.Reserve
+	}
+	return false
+}
+
+func (x *AllocateWarehouseRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AllocateWarehouseRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type AllocateWarehouseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// false when no active warehouse holds every item and unassigned stock,
+	// not yet placed in any warehouse, covers them instead
+	Allocated bool       `protobuf:"varint,1,opt,name=allocated,proto3" json:"allocated,omitempty"`
+	Strategy  string     `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Warehouse *Warehouse `protobuf:"bytes,3,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	// the sale movements, when reserve was set
+	Movements     []*StockMovement `protobuf:"bytes,4,rep,name=movements,proto3" json:"movements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocateWarehouseResponse) Reset() {
+	*x = AllocateWarehouseResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocateWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocateWarehouseResponse) ProtoMessage() {}
+
+func (x *AllocateWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocateWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*AllocateWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *AllocateWarehouseResponse) GetAllocated() bool {
+	if x != nil {
+		return x.Allocated
+	}
+	return false
+}
+
+func (x *AllocateWarehouseResponse) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *AllocateWarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+func (x *AllocateWarehouseResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+type StockItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// the warehouse the order was allocated; 0 for unassigned stock
+	WarehouseId   int64             `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Items         []*AllocationItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Reason        string            `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Actor         string            `protobuf:"bytes,4,opt,name=actor,proto3" json:"actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockItemsRequest) Reset() {
+	*x = StockItemsRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockItemsRequest) ProtoMessage() {}
+
+func (x *StockItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockItemsRequest.ProtoReflect.Descriptor instead.
+func (*StockItemsRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *StockItemsRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *StockItemsRequest) GetItems() []*AllocationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *StockItemsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockItemsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type StockItemsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movements     []*StockMovement       `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockItemsResponse) Reset() {
+	*x = StockItemsResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockItemsResponse) ProtoMessage() {}
+
+func (x *StockItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockItemsResponse.ProtoReflect.Descriptor instead.
+func (*StockItemsResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *StockItemsResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+type CreateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	ParentId      int64                  `protobuf:"varint,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Slug          string                 `protobuf:"bytes,4,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *CreateCategoryRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type CreateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CreateCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateCategoryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetCategoryByIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryByIDRequest) Reset() {
+	*x = GetCategoryByIDRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryByIDRequest) ProtoMessage() {}
+
+func (x *GetCategoryByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryByIDRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetCategoryByIDRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetCategoryByIDResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryByIDResponse) Reset() {
+	*x = GetCategoryByIDResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryByIDResponse) ProtoMessage() {}
+
+func (x *GetCategoryByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryByIDResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryByIDResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *GetCategoryByIDResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *ListCategoriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCategoriesRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+type ListCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListCategoriesResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+type UpdateCategoryRequest struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// set to move the category; 0 moves it to the root
+	ParentId      *int64 `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3,oneof" json:"parent_id,omitempty"`
+	Slug          string `protobuf:"bytes,5,opt,name=slug,proto3" json:"slug,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *UpdateCategoryRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetParentId() int64 {
+	if x != nil && x.ParentId != nil {
+		return *x.ParentId
+	}
+	return 0
+}
+
+func (x *UpdateCategoryRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type UpdateCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *UpdateCategoryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateCategoryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *DeleteCategoryRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *DeleteCategoryResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *CreateCategoryResponse) GetMessage() string {
+type Category struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ParentId      int64                  `protobuf:"varint,4,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Slug          string                 `protobuf:"bytes,5,opt,name=slug,proto3" json:"slug,omitempty"`
+	Path          string                 `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *Category) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Category) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+func (x *Category) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+func (x *Category) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type CategoryNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Children      []*CategoryNode        `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryNode) Reset() {
+	*x = CategoryNode{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryNode) ProtoMessage() {}
+
+func (x *CategoryNode) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryNode.ProtoReflect.Descriptor instead.
+func (*CategoryNode) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *CategoryNode) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryNode) GetChildren() []*CategoryNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type GetCategoryTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeRequest) Reset() {
+	*x = GetCategoryTreeRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeRequest) ProtoMessage() {}
+
+func (x *GetCategoryTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{124}
+}
+
+type GetCategoryTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roots         []*CategoryNode        `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeResponse) Reset() {
+	*x = GetCategoryTreeResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeResponse) ProtoMessage() {}
+
+func (x *GetCategoryTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *GetCategoryTreeResponse) GetRoots() []*CategoryNode {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+type AssignProductCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	CategoryIds   []int64                `protobuf:"varint,2,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignProductCategoriesRequest) Reset() {
+	*x = AssignProductCategoriesRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignProductCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignProductCategoriesRequest) ProtoMessage() {}
+
+func (x *AssignProductCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignProductCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*AssignProductCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *AssignProductCategoriesRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *AssignProductCategoriesRequest) GetCategoryIds() []int64 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+type UnassignProductCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	CategoryIds   []int64                `protobuf:"varint,2,rep,packed,name=category_ids,json=categoryIds,proto3" json:"category_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignProductCategoriesRequest) Reset() {
+	*x = UnassignProductCategoriesRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignProductCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignProductCategoriesRequest) ProtoMessage() {}
+
+func (x *UnassignProductCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignProductCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*UnassignProductCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *UnassignProductCategoriesRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *UnassignProductCategoriesRequest) GetCategoryIds() []int64 {
+	if x != nil {
+		return x.CategoryIds
+	}
+	return nil
+}
+
+type ProductCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Categories    []*Category            `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductCategoriesResponse) Reset() {
+	*x = ProductCategoriesResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductCategoriesResponse) ProtoMessage() {}
+
+func (x *ProductCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ProductCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *ProductCategoriesResponse) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ProductCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type ListProductsByCategoryRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId         int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	IncludeDescendants bool                   `protobuf:"varint,2,opt,name=include_descendants,json=includeDescendants,proto3" json:"include_descendants,omitempty"`
+	Page               int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage            int32                  `protobuf:"varint,4,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Attributes         []*AttributeFilter     `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListProductsByCategoryRequest) Reset() {
+	*x = ListProductsByCategoryRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsByCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsByCategoryRequest) ProtoMessage() {}
+
+func (x *ListProductsByCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsByCategoryRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsByCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *ListProductsByCategoryRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *ListProductsByCategoryRequest) GetIncludeDescendants() bool {
+	if x != nil {
+		return x.IncludeDescendants
+	}
+	return false
+}
+
+func (x *ListProductsByCategoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsByCategoryRequest) GetPerPage() int32 {
+	if x != nil {
+		return x.PerPage
+	}
+	return 0
+}
+
+func (x *ListProductsByCategoryRequest) GetAttributes() []*AttributeFilter {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type Attribute struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code  string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Name  string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// string, number, boolean or enum
+	Type    string   `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Unit    string   `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	Options []string `protobuf:"bytes,6,rep,name=options,proto3" json:"options,omitempty"`
+	// set when listing the attributes of a category; category_id is where the
+	// attribute is attached, possibly an ancestor
+	CategoryId    int64 `protobuf:"varint,7,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Required      bool  `protobuf:"varint,8,opt,name=required,proto3" json:"required,omitempty"`
+	Position      int32 `protobuf:"varint,9,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attribute) Reset() {
+	*x = Attribute{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attribute) ProtoMessage() {}
+
+func (x *Attribute) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attribute.ProtoReflect.Descriptor instead.
+func (*Attribute) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *Attribute) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Attribute) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Attribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Attribute) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Attribute) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *Attribute) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *Attribute) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *Attribute) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *Attribute) GetPosition() int32 {
 	if x != nil {
-		return x.Message
+		return x.Position
+	}
+	return 0
+}
+
+type ProductAttribute struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	Value         string                 `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductAttribute) Reset() {
+	*x = ProductAttribute{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductAttribute) ProtoMessage() {}
+
+func (x *ProductAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductAttribute.ProtoReflect.Descriptor instead.
+func (*ProductAttribute) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *ProductAttribute) GetCode() string {
+	if x != nil {
+		return x.Code
 	}
 	return ""
 }
 
-type GetCategoryByIDRequest struct {
+func (x *ProductAttribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductAttribute) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProductAttribute) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ProductAttribute) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// matches products whose value is one of values and, for number attributes,
+// lies within [min, max]
+type AttributeFilter struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Min           *float64               `protobuf:"fixed64,3,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	Max           *float64               `protobuf:"fixed64,4,opt,name=max,proto3,oneof" json:"max,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoryByIDRequest) Reset() {
-	*x = GetCategoryByIDRequest{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[13]
+func (x *AttributeFilter) Reset() {
+	*x = AttributeFilter{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoryByIDRequest) String() string {
+func (x *AttributeFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoryByIDRequest) ProtoMessage() {}
+func (*AttributeFilter) ProtoMessage() {}
 
-func (x *GetCategoryByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[13]
+func (x *AttributeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,40 +8596,65 @@ func (x *GetCategoryByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoryByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetCategoryByIDRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use AttributeFilter.ProtoReflect.Descriptor instead.
+func (*AttributeFilter) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *GetCategoryByIDRequest) GetId() int64 {
+func (x *AttributeFilter) GetCode() string {
 	if x != nil {
-		return x.Id
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AttributeFilter) GetValues() []string {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *AttributeFilter) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
 	}
 	return 0
 }
 
-type GetCategoryByIDResponse struct {
+func (x *AttributeFilter) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+type CreateAttributeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Category      *Category              `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Unit          string                 `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	Options       []string               `protobuf:"bytes,5,rep,name=options,proto3" json:"options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCategoryByIDResponse) Reset() {
-	*x = GetCategoryByIDResponse{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[14]
+func (x *CreateAttributeRequest) Reset() {
+	*x = CreateAttributeRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCategoryByIDResponse) String() string {
+func (x *CreateAttributeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCategoryByIDResponse) ProtoMessage() {}
+func (*CreateAttributeRequest) ProtoMessage() {}
 
-func (x *GetCategoryByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[14]
+func (x *CreateAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -934,41 +8665,245 @@ func (x *GetCategoryByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCategoryByIDResponse.ProtoReflect.Descriptor instead.
-func (*GetCategoryByIDResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use CreateAttributeRequest.ProtoReflect.Descriptor instead.
+func (*CreateAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *GetCategoryByIDResponse) GetCategory() *Category {
+func (x *CreateAttributeRequest) GetCode() string {
 	if x != nil {
-		return x.Category
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateAttributeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAttributeRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CreateAttributeRequest) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *CreateAttributeRequest) GetOptions() []string {
+	if x != nil {
+		return x.Options
 	}
 	return nil
 }
 
-type ListCategoriesRequest struct {
+type AttributeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PerPage       int32                  `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Attribute     *Attribute             `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCategoriesRequest) Reset() {
-	*x = ListCategoriesRequest{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[15]
+func (x *AttributeResponse) Reset() {
+	*x = AttributeResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesRequest) String() string {
+func (x *AttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeResponse) ProtoMessage() {}
+
+func (x *AttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeResponse.ProtoReflect.Descriptor instead.
+func (*AttributeResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *AttributeResponse) GetAttribute() *Attribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+type ListAttributesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 0 lists every attribute
+	CategoryId    int64 `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttributesRequest) Reset() {
+	*x = ListAttributesRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttributesRequest) ProtoMessage() {}
+
+func (x *ListAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttributesRequest.ProtoReflect.Descriptor instead.
+func (*ListAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *ListAttributesRequest) GetCategoryId() int64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+type ListAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attributes    []*Attribute           `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAttributesResponse) Reset() {
+	*x = ListAttributesResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAttributesResponse) ProtoMessage() {}
+
+func (x *ListAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAttributesResponse.ProtoReflect.Descriptor instead.
+func (*ListAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ListAttributesResponse) GetAttributes() []*Attribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type DeleteAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttributeRequest) Reset() {
+	*x = DeleteAttributeRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttributeRequest) ProtoMessage() {}
+
+func (x *DeleteAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttributeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *DeleteAttributeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttributeResponse) Reset() {
+	*x = DeleteAttributeResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttributeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesRequest) ProtoMessage() {}
+func (*DeleteAttributeResponse) ProtoMessage() {}
 
-func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[15]
+func (x *DeleteAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -979,48 +8914,43 @@ func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
-func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *ListCategoriesRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use DeleteAttributeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{138}
 }
 
-func (x *ListCategoriesRequest) GetPerPage() int32 {
+func (x *DeleteAttributeResponse) GetSuccess() bool {
 	if x != nil {
-		return x.PerPage
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-type ListCategoriesResponse struct {
+type AttachCategoryAttributeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	AttributeId   int64                  `protobuf:"varint,2,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	Required      bool                   `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	Position      int32                  `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCategoriesResponse) Reset() {
-	*x = ListCategoriesResponse{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[16]
+func (x *AttachCategoryAttributeRequest) Reset() {
+	*x = AttachCategoryAttributeRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesResponse) String() string {
+func (x *AttachCategoryAttributeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesResponse) ProtoMessage() {}
+func (*AttachCategoryAttributeRequest) ProtoMessage() {}
 
-func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[16]
+func (x *AttachCategoryAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1031,49 +8961,62 @@ func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use AttachCategoryAttributeRequest.ProtoReflect.Descriptor instead.
+func (*AttachCategoryAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{139}
 }
 
-func (x *ListCategoriesResponse) GetCategories() []*Category {
+func (x *AttachCategoryAttributeRequest) GetCategoryId() int64 {
 	if x != nil {
-		return x.Categories
+		return x.CategoryId
 	}
-	return nil
+	return 0
 }
 
-func (x *ListCategoriesResponse) GetTotalCount() int32 {
+func (x *AttachCategoryAttributeRequest) GetAttributeId() int64 {
 	if x != nil {
-		return x.TotalCount
+		return x.AttributeId
 	}
 	return 0
 }
 
-type UpdateCategoryRequest struct {
+func (x *AttachCategoryAttributeRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *AttachCategoryAttributeRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type DetachCategoryAttributeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	CategoryId    int64                  `protobuf:"varint,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	AttributeId   int64                  `protobuf:"varint,2,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCategoryRequest) Reset() {
-	*x = UpdateCategoryRequest{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[17]
+func (x *DetachCategoryAttributeRequest) Reset() {
+	*x = DetachCategoryAttributeRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCategoryRequest) String() string {
+func (x *DetachCategoryAttributeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCategoryRequest) ProtoMessage() {}
+func (*DetachCategoryAttributeRequest) ProtoMessage() {}
 
-func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[17]
+func (x *DetachCategoryAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1084,55 +9027,47 @@ func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use DetachCategoryAttributeRequest.ProtoReflect.Descriptor instead.
+func (*DetachCategoryAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{140}
 }
 
-func (x *UpdateCategoryRequest) GetId() int32 {
+func (x *DetachCategoryAttributeRequest) GetCategoryId() int64 {
 	if x != nil {
-		return x.Id
+		return x.CategoryId
 	}
 	return 0
 }
 
-func (x *UpdateCategoryRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateCategoryRequest) GetDescription() string {
+func (x *DetachCategoryAttributeRequest) GetAttributeId() int64 {
 	if x != nil {
-		return x.Description
+		return x.AttributeId
 	}
-	return ""
+	return 0
 }
 
-type UpdateCategoryResponse struct {
+type DetachCategoryAttributeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCategoryResponse) Reset() {
-	*x = UpdateCategoryResponse{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[18]
+func (x *DetachCategoryAttributeResponse) Reset() {
+	*x = DetachCategoryAttributeResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCategoryResponse) String() string {
+func (x *DetachCategoryAttributeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCategoryResponse) ProtoMessage() {}
+func (*DetachCategoryAttributeResponse) ProtoMessage() {}
 
-func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[18]
+func (x *DetachCategoryAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,47 +9078,41 @@ func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DetachCategoryAttributeResponse.ProtoReflect.Descriptor instead.
+func (*DetachCategoryAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{141}
 }
 
-func (x *UpdateCategoryResponse) GetSuccess() bool {
+func (x *DetachCategoryAttributeResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *UpdateCategoryResponse) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
-}
-
-type DeleteCategoryRequest struct {
+type ProductAttributeValue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCategoryRequest) Reset() {
-	*x = DeleteCategoryRequest{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[19]
+func (x *ProductAttributeValue) Reset() {
+	*x = ProductAttributeValue{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCategoryRequest) String() string {
+func (x *ProductAttributeValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCategoryRequest) ProtoMessage() {}
+func (*ProductAttributeValue) ProtoMessage() {}
 
-func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[19]
+func (x *ProductAttributeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1194,40 +9123,48 @@ func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ProductAttributeValue.ProtoReflect.Descriptor instead.
+func (*ProductAttributeValue) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{142}
 }
 
-func (x *DeleteCategoryRequest) GetId() int64 {
+func (x *ProductAttributeValue) GetCode() string {
 	if x != nil {
-		return x.Id
+		return x.Code
 	}
-	return 0
+	return ""
 }
 
-type DeleteCategoryResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+func (x *ProductAttributeValue) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SetProductAttributesRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	ProductId     int64                    `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Values        []*ProductAttributeValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCategoryResponse) Reset() {
-	*x = DeleteCategoryResponse{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[20]
+func (x *SetProductAttributesRequest) Reset() {
+	*x = SetProductAttributesRequest{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCategoryResponse) String() string {
+func (x *SetProductAttributesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCategoryResponse) ProtoMessage() {}
+func (*SetProductAttributesRequest) ProtoMessage() {}
 
-func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[20]
+func (x *SetProductAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1238,42 +9175,47 @@ func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SetProductAttributesRequest.ProtoReflect.Descriptor instead.
+func (*SetProductAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{143}
 }
 
-func (x *DeleteCategoryResponse) GetSuccess() bool {
+func (x *SetProductAttributesRequest) GetProductId() int64 {
 	if x != nil {
-		return x.Success
+		return x.ProductId
 	}
-	return false
+	return 0
 }
 
-type Category struct {
+func (x *SetProductAttributesRequest) GetValues() []*ProductAttributeValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type SetProductAttributesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Attributes    []*ProductAttribute    `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Category) Reset() {
-	*x = Category{}
-	mi := &file_shared_proto_v1_product_proto_msgTypes[21]
+func (x *SetProductAttributesResponse) Reset() {
+	*x = SetProductAttributesResponse{}
+	mi := &file_shared_proto_v1_product_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Category) String() string {
+func (x *SetProductAttributesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Category) ProtoMessage() {}
+func (*SetProductAttributesResponse) ProtoMessage() {}
 
-func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_shared_proto_v1_product_proto_msgTypes[21]
+func (x *SetProductAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_shared_proto_v1_product_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,37 +9226,23 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Category.ProtoReflect.Descriptor instead.
-func (*Category) Descriptor() ([]byte, []int) {
-	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *Category) GetId() int32 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Category) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use SetProductAttributesResponse.ProtoReflect.Descriptor instead.
+func (*SetProductAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_shared_proto_v1_product_proto_rawDescGZIP(), []int{144}
 }
 
-func (x *Category) GetDescription() string {
+func (x *SetProductAttributesResponse) GetAttributes() []*ProductAttribute {
 	if x != nil {
-		return x.Description
+		return x.Attributes
 	}
-	return ""
+	return nil
 }
 
 var File_shared_proto_v1_product_proto protoreflect.FileDescriptor
 
 const file_shared_proto_v1_product_proto_rawDesc = "" +
 	"\n" +
-	"\x1dshared/proto/v1/product.proto\x12\aproduct\"\xab\x02\n" +
+	"\x1dshared/proto/v1/product.proto\x12\aproduct\"\x84\x03\n" +
 	"\x14CreateProductRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12+\n" +
 	"\x11short_description\x18\x02 \x01(\tR\x10shortDescription\x12 \n" +
@@ -1323,20 +9251,44 @@ const file_shared_proto_v1_product_proto_rawDesc = "" +
 	"\rdiscount_type\x18\x05 \x01(\x0e2\x15.product.DiscountTypeR\fdiscountType\x12%\n" +
 	"\x0ediscount_value\x18\x06 \x01(\x02R\rdiscountValue\x12\x1b\n" +
 	"\timage_url\x18\a \x01(\tR\bimageUrl\x12\x1a\n" +
-	"\bquantity\x18\b \x01(\x05R\bquantity\"C\n" +
+	"\bquantity\x18\b \x01(\x05R\bquantity\x12\x10\n" +
+	"\x03sku\x18\t \x01(\tR\x03sku\x12\"\n" +
+	"\rmax_per_order\x18\n" +
+	" \x01(\x05R\vmaxPerOrder\x12!\n" +
+	"\ftax_category\x18\v \x01(\tR\vtaxCategory\"C\n" +
 	"\x15CreateProductResponse\x12*\n" +
-	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\"'\n" +
+	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\"\x16\n" +
+	"\x14GetCacheStatsRequest\"\xc1\x02\n" +
+	"\x15GetCacheStatsResponse\x12!\n" +
+	"\fproduct_hits\x18\x01 \x01(\x04R\vproductHits\x12%\n" +
+	"\x0eproduct_misses\x18\x02 \x01(\x04R\rproductMisses\x12*\n" +
+	"\x11product_hit_ratio\x18\x03 \x01(\x01R\x0fproductHitRatio\x12\x1b\n" +
+	"\tlist_hits\x18\x04 \x01(\x04R\blistHits\x12\x1f\n" +
+	"\vlist_misses\x18\x05 \x01(\x04R\n" +
+	"listMisses\x12$\n" +
+	"\x0elist_hit_ratio\x18\x06 \x01(\x01R\flistHitRatio\x12#\n" +
+	"\rproduct_stale\x18\a \x01(\x04R\fproductStale\x12)\n" +
+	"\x10product_negative\x18\b \x01(\x04R\x0fproductNegative\"'\n" +
 	"\x15GetProductByIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"D\n" +
 	"\x16GetProductByIDResponse\x12*\n" +
-	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\"D\n" +
+	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\"+\n" +
+	"\x17GetProductsByIDsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"i\n" +
+	"\x18GetProductsByIDsResponse\x12,\n" +
+	"\bproducts\x18\x01 \x03(\v2\x10.product.ProductR\bproducts\x12\x1f\n" +
+	"\vmissing_ids\x18\x02 \x03(\x03R\n" +
+	"missingIds\"~\n" +
 	"\x13ListProductsRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
-	"\bper_page\x18\x02 \x01(\x05R\aperPage\"e\n" +
+	"\bper_page\x18\x02 \x01(\x05R\aperPage\x128\n" +
+	"\n" +
+	"attributes\x18\x03 \x03(\v2\x18.product.AttributeFilterR\n" +
+	"attributes\"e\n" +
 	"\x14ListProductsResponse\x12,\n" +
 	"\bproducts\x18\x01 \x03(\v2\x10.product.ProductR\bproducts\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\xbb\x02\n" +
+	"totalCount\"\xe2\x02\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
@@ -1345,14 +9297,53 @@ const file_shared_proto_v1_product_proto_rawDesc = "" +
 	"\x05price\x18\x05 \x01(\x02R\x05price\x12:\n" +
 	"\rdiscount_type\x18\x06 \x01(\x0e2\x15.product.DiscountTypeR\fdiscountType\x12%\n" +
 	"\x0ediscount_value\x18\a \x01(\x02R\rdiscountValue\x12\x1b\n" +
-	"\timage_url\x18\b \x01(\tR\bimageUrl\x12\x1a\n" +
-	"\bquantity\x18\t \x01(\x05R\bquantity\"C\n" +
+	"\timage_url\x18\b \x01(\tR\bimageUrl\x12\x1e\n" +
+	"\bquantity\x18\t \x01(\x05B\x02\x18\x01R\bquantity\x12!\n" +
+	"\ftax_category\x18\n" +
+	" \x01(\tR\vtaxCategory\"C\n" +
 	"\x15UpdateProductResponse\x12*\n" +
 	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\"&\n" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"1\n" +
 	"\x15DeleteProductResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x97\x02\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xef\x02\n" +
+	"\x15SearchProductsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1b\n" +
+	"\tmin_price\x18\x02 \x01(\x02R\bminPrice\x12\x1b\n" +
+	"\tmax_price\x18\x03 \x01(\x02R\bmaxPrice\x12\x1f\n" +
+	"\vcategory_id\x18\x04 \x01(\x03R\n" +
+	"categoryId\x12\"\n" +
+	"\rin_stock_only\x18\x05 \x01(\bR\vinStockOnly\x12'\n" +
+	"\x0fdiscount_active\x18\x06 \x01(\bR\x0ediscountActive\x12/\n" +
+	"\asort_by\x18\a \x01(\x0e2\x16.product.ProductSortByR\x06sortBy\x12\x12\n" +
+	"\x04page\x18\b \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\t \x01(\x05R\aperPage\x128\n" +
+	"\n" +
+	"attributes\x18\n" +
+	" \x03(\v2\x18.product.AttributeFilterR\n" +
+	"attributes\"\x96\x01\n" +
+	"\x16SearchProductsResponse\x12,\n" +
+	"\bproducts\x18\x01 \x03(\v2\x10.product.ProductR\bproducts\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\x12-\n" +
+	"\x06facets\x18\x03 \x01(\v2\x15.product.SearchFacetsR\x06facets\"\xbf\x01\n" +
+	"\fSearchFacets\x126\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x16.product.CategoryFacetR\n" +
+	"categories\x12;\n" +
+	"\fprice_ranges\x18\x02 \x03(\v2\x18.product.PriceRangeFacetR\vpriceRanges\x12\x19\n" +
+	"\bin_stock\x18\x03 \x01(\x05R\ainStock\x12\x1f\n" +
+	"\von_discount\x18\x04 \x01(\x05R\n" +
+	"onDiscount\"Z\n" +
+	"\rCategoryFacet\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"K\n" +
+	"\x0fPriceRangeFacet\x12\x10\n" +
+	"\x03min\x18\x01 \x01(\x02R\x03min\x12\x10\n" +
+	"\x03max\x18\x02 \x01(\x02R\x03max\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\"\xe1\x05\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12+\n" +
@@ -1362,10 +9353,515 @@ const file_shared_proto_v1_product_proto_rawDesc = "" +
 	"\rdiscount_type\x18\x06 \x01(\tR\fdiscountType\x12%\n" +
 	"\x0ediscount_value\x18\a \x01(\x02R\rdiscountValue\x12\x1b\n" +
 	"\timage_url\x18\b \x01(\tR\bimageUrl\x12\x1a\n" +
-	"\bquantity\x18\t \x01(\x05R\bquantity\"M\n" +
+	"\bquantity\x18\t \x01(\x05R\bquantity\x120\n" +
+	"\aoptions\x18\n" +
+	" \x03(\v2\x16.product.ProductOptionR\aoptions\x123\n" +
+	"\bvariants\x18\v \x03(\v2\x17.product.ProductVariantR\bvariants\x12+\n" +
+	"\x11reorder_threshold\x18\f \x01(\x05R\x10reorderThreshold\x12\x10\n" +
+	"\x03sku\x18\r \x01(\tR\x03sku\x12-\n" +
+	"\x06images\x18\x0e \x03(\v2\x15.product.ProductImageR\x06images\x12%\n" +
+	"\x0erating_average\x18\x0f \x01(\x01R\rratingAverage\x12!\n" +
+	"\frating_count\x18\x10 \x01(\x05R\vratingCount\x129\n" +
+	"\n" +
+	"attributes\x18\x11 \x03(\v2\x19.product.ProductAttributeR\n" +
+	"attributes\x12\"\n" +
+	"\rmax_per_order\x18\x12 \x01(\x05R\vmaxPerOrder\x12!\n" +
+	"\ftax_category\x18\x13 \x01(\tR\vtaxCategory\x12'\n" +
+	"\x0fdiscount_active\x18\x14 \x01(\bR\x0ediscountActive\"\x84\x01\n" +
+	"\rProductOption\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x123\n" +
+	"\x06values\x18\x04 \x03(\v2\x1b.product.ProductOptionValueR\x06values\":\n" +
+	"\x12ProductOptionValue\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"\xf4\x02\n" +
+	"\x0eProductVariant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x02R\x05price\x12,\n" +
+	"\x12has_price_override\x18\x05 \x01(\bR\x10hasPriceOverride\x12\x1a\n" +
+	"\bquantity\x18\x06 \x01(\x05R\bquantity\x12\x1b\n" +
+	"\timage_url\x18\a \x01(\tR\bimageUrl\x12(\n" +
+	"\x10option_value_ids\x18\b \x03(\x03R\x0eoptionValueIds\x12>\n" +
+	"\aoptions\x18\t \x03(\v2$.product.ProductVariant.OptionsEntryR\aoptions\x1a:\n" +
+	"\fOptionsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"g\n" +
+	"\x1aCreateProductOptionRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06values\x18\x03 \x03(\tR\x06values\"G\n" +
+	"\x15ProductOptionResponse\x12.\n" +
+	"\x06option\x18\x01 \x01(\v2\x16.product.ProductOptionR\x06option\"X\n" +
+	"\x1aDeleteProductOptionRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1b\n" +
+	"\toption_id\x18\x02 \x01(\x03R\boptionId\"7\n" +
+	"\x1bDeleteProductOptionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc7\x01\n" +
+	"\x1bCreateProductVariantRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x02R\x05price\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x1b\n" +
+	"\timage_url\x18\x05 \x01(\tR\bimageUrl\x12(\n" +
+	"\x10option_value_ids\x18\x06 \x03(\x03R\x0eoptionValueIds\"*\n" +
+	"\x18GetProductVariantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xcf\x01\n" +
+	"\x1bUpdateProductVariantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x03sku\x18\x02 \x01(\tH\x00R\x03sku\x88\x01\x01\x12\x19\n" +
+	"\x05price\x18\x03 \x01(\x02H\x01R\x05price\x88\x01\x01\x12\x1f\n" +
+	"\bquantity\x18\x04 \x01(\x05H\x02R\bquantity\x88\x01\x01\x12 \n" +
+	"\timage_url\x18\x05 \x01(\tH\x03R\bimageUrl\x88\x01\x01B\x06\n" +
+	"\x04_skuB\b\n" +
+	"\x06_priceB\v\n" +
+	"\t_quantityB\f\n" +
+	"\n" +
+	"_image_url\"K\n" +
+	"\x16ProductVariantResponse\x121\n" +
+	"\avariant\x18\x01 \x01(\v2\x17.product.ProductVariantR\avariant\"-\n" +
+	"\x1bDeleteProductVariantRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"8\n" +
+	"\x1cDeleteProductVariantResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xc2\x01\n" +
+	"\x15RestockProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\x03R\tvariantId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x05 \x01(\tR\x05actor\x12!\n" +
+	"\fwarehouse_id\x18\x06 \x01(\x03R\vwarehouseId\"\xf7\x01\n" +
+	"\x1aRecordStockMovementRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\x03R\tvariantId\x12.\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1a.product.StockMovementTypeR\x04type\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x06 \x01(\tR\x05actor\x12!\n" +
+	"\fwarehouse_id\x18\a \x01(\x03R\vwarehouseId\"\xfb\x02\n" +
+	"\rStockMovement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x03 \x01(\x03R\tvariantId\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12#\n" +
+	"\rbalance_after\x18\x06 \x01(\x05R\fbalanceAfter\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\b \x01(\tR\x05actor\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12!\n" +
+	"\fwarehouse_id\x18\n" +
+	" \x01(\x03R\vwarehouseId\x12;\n" +
+	"\x17warehouse_balance_after\x18\v \x01(\x05H\x00R\x15warehouseBalanceAfter\x88\x01\x01B\x1a\n" +
+	"\x18_warehouse_balance_after\"K\n" +
+	"\x15StockMovementResponse\x122\n" +
+	"\bmovement\x18\x01 \x01(\v2\x16.product.StockMovementR\bmovement\"\xdb\x01\n" +
+	"\x19ListStockMovementsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\x03R\tvariantId\x12.\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1a.product.StockMovementTypeR\x04type\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x05 \x01(\x05R\aperPage\x12!\n" +
+	"\fwarehouse_id\x18\x06 \x01(\x03R\vwarehouseId\"s\n" +
+	"\x1aListStockMovementsResponse\x124\n" +
+	"\tmovements\x18\x01 \x03(\v2\x16.product.StockMovementR\tmovements\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"Y\n" +
+	"\x1aSetReorderThresholdRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1c\n" +
+	"\tthreshold\x18\x02 \x01(\x05R\tthreshold\"7\n" +
+	"\x1bSetReorderThresholdResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Z\n" +
+	"\x15SetMaxPerOrderRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\"\n" +
+	"\rmax_per_order\x18\x02 \x01(\x05R\vmaxPerOrder\"2\n" +
+	"\x16SetMaxPerOrderResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x1d\n" +
+	"\x1bListLowStockProductsRequest\"\xc2\x02\n" +
+	"\fLowStockItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\x03R\tvariantId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x10\n" +
+	"\x03sku\x18\x04 \x01(\tR\x03sku\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12+\n" +
+	"\x11reorder_threshold\x18\x06 \x01(\x05R\x10reorderThreshold\x12\x1d\n" +
+	"\n" +
+	"units_sold\x18\a \x01(\x05R\tunitsSold\x12(\n" +
+	"\x10daily_sales_rate\x18\b \x01(\x01R\x0edailySalesRate\x12<\n" +
+	"\x1asuggested_reorder_quantity\x18\t \x01(\x05R\x18suggestedReorderQuantity\"K\n" +
+	"\x1cListLowStockProductsResponse\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.product.LowStockItemR\x05items\"\\\n" +
+	"\x15ImportProductsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"`\n" +
+	"\x0eImportRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\xcd\x01\n" +
+	"\x16ImportProductsResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x1d\n" +
+	"\n" +
+	"total_rows\x18\x02 \x01(\x05R\ttotalRows\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x04 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x12/\n" +
+	"\x06errors\x18\x06 \x03(\v2\x17.product.ImportRowErrorR\x06errors\"/\n" +
+	"\x15ExportProductsRequest\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\")\n" +
+	"\x13ExportProductsChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"\xba\x02\n" +
+	"\fProductImage\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\x12\x19\n" +
+	"\balt_text\x18\x04 \x01(\tR\aaltText\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\x12#\n" +
+	"\rthumbnail_url\x18\x06 \x01(\tR\fthumbnailUrl\x12!\n" +
+	"\fcontent_type\x18\a \x01(\tR\vcontentType\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\b \x01(\x03R\tsizeBytes\x12\x14\n" +
+	"\x05width\x18\t \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\n" +
+	" \x01(\x05R\x06height\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\"i\n" +
+	"\x19UploadProductImageRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x19\n" +
+	"\balt_text\x18\x02 \x01(\tR\aaltText\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"C\n" +
+	"\x14ProductImageResponse\x12+\n" +
+	"\x05image\x18\x01 \x01(\v2\x15.product.ProductImageR\x05image\"9\n" +
+	"\x18ListProductImagesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\"J\n" +
+	"\x19ListProductImagesResponse\x12-\n" +
+	"\x06images\x18\x01 \x03(\v2\x15.product.ProductImageR\x06images\"F\n" +
+	"\x19UpdateProductImageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\balt_text\x18\x02 \x01(\tR\aaltText\"Y\n" +
+	"\x1bReorderProductImagesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1b\n" +
+	"\timage_ids\x18\x02 \x03(\x03R\bimageIds\"+\n" +
+	"\x19DeleteProductImageRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"6\n" +
+	"\x1aDeleteProductImageResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x91\x02\n" +
+	"\x06Review\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05title\x18\x05 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x06 \x01(\tR\x04body\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12'\n" +
+	"\x0fmoderation_note\x18\b \x01(\tR\x0emoderationNote\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\tR\tupdatedAt\"\x8f\x01\n" +
+	"\x13CreateReviewRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\"9\n" +
+	"\x0eReviewResponse\x12'\n" +
+	"\x06review\x18\x01 \x01(\v2\x0f.product.ReviewR\x06review\"i\n" +
+	"\x19ListProductReviewsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x03 \x01(\x05R\aperPage\"\x93\x01\n" +
+	"\x12ListReviewsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x05 \x01(\x05R\aperPage\"a\n" +
+	"\x13ListReviewsResponse\x12)\n" +
+	"\areviews\x18\x01 \x03(\v2\x0f.product.ReviewR\areviews\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\x80\x01\n" +
+	"\x13UpdateReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06rating\x18\x03 \x01(\x05R\x06rating\x12\x14\n" +
+	"\x05title\x18\x04 \x01(\tR\x05title\x12\x12\n" +
+	"\x04body\x18\x05 \x01(\tR\x04body\">\n" +
+	"\x13DeleteReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"0\n" +
+	"\x14DeleteReviewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"S\n" +
+	"\x15ModerateReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\"\xa6\x03\n" +
+	"\x14ScheduledPriceChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x19\n" +
+	"\x05price\x18\x03 \x01(\x02H\x00R\x05price\x88\x01\x01\x12(\n" +
+	"\rdiscount_type\x18\x04 \x01(\tH\x01R\fdiscountType\x88\x01\x01\x12*\n" +
+	"\x0ediscount_value\x18\x05 \x01(\x02H\x02R\rdiscountValue\x88\x01\x01\x12'\n" +
+	"\x0fremove_discount\x18\x06 \x01(\bR\x0eremoveDiscount\x12!\n" +
+	"\feffective_at\x18\a \x01(\tR\veffectiveAt\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"applied_at\x18\t \x01(\tR\tappliedAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\n" +
+	" \x01(\tR\tcreatedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAtB\b\n" +
+	"\x06_priceB\x10\n" +
+	"\x0e_discount_typeB\x11\n" +
+	"\x0f_discount_value\"\xdd\x02\n" +
+	"\x1aSchedulePriceChangeRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x19\n" +
+	"\x05price\x18\x02 \x01(\x02H\x00R\x05price\x88\x01\x01\x12?\n" +
+	"\rdiscount_type\x18\x03 \x01(\x0e2\x15.product.DiscountTypeH\x01R\fdiscountType\x88\x01\x01\x12*\n" +
+	"\x0ediscount_value\x18\x04 \x01(\x02H\x02R\rdiscountValue\x88\x01\x01\x12'\n" +
+	"\x0fremove_discount\x18\x05 \x01(\bR\x0eremoveDiscount\x12!\n" +
+	"\feffective_at\x18\x06 \x01(\tR\veffectiveAt\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\tR\tcreatedByB\b\n" +
+	"\x06_priceB\x10\n" +
+	"\x0e_discount_typeB\x11\n" +
+	"\x0f_discount_value\"U\n" +
+	"\x1cScheduledPriceChangeResponse\x125\n" +
+	"\x06change\x18\x01 \x01(\v2\x1d.product.ScheduledPriceChangeR\x06change\"3\n" +
+	"!CancelScheduledPriceChangeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x88\x01\n" +
+	" ListScheduledPriceChangesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x04 \x01(\x05R\aperPage\"}\n" +
+	"!ListScheduledPriceChangesResponse\x127\n" +
+	"\achanges\x18\x01 \x03(\v2\x1d.product.ScheduledPriceChangeR\achanges\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"\xdc\x01\n" +
+	"\x11PriceHistoryEntry\x12\x14\n" +
+	"\x05price\x18\x01 \x01(\x02R\x05price\x12#\n" +
+	"\rdiscount_type\x18\x02 \x01(\tR\fdiscountType\x12%\n" +
+	"\x0ediscount_value\x18\x03 \x01(\x02R\rdiscountValue\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12.\n" +
+	"\x13scheduled_change_id\x18\x05 \x01(\x03R\x11scheduledChangeId\x12\x1d\n" +
+	"\n" +
+	"changed_at\x18\x06 \x01(\tR\tchangedAt\"8\n" +
+	"\x17GetPriceTimelineRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\"\xaa\x01\n" +
+	"\x18GetPriceTimelineResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x124\n" +
+	"\ahistory\x18\x02 \x03(\v2\x1a.product.PriceHistoryEntryR\ahistory\x129\n" +
+	"\bupcoming\x18\x03 \x03(\v2\x1d.product.ScheduledPriceChangeR\bupcoming\"\x96\x01\n" +
+	"\x0eTrashedProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x02R\x05price\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x05 \x01(\tR\tdeletedAt\x12\x19\n" +
+	"\bpurge_at\x18\x06 \x01(\tR\apurgeAt\"\xb4\x01\n" +
+	"\x0fTrashedCategory\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tparent_id\x18\x02 \x01(\x03R\bparentId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04path\x18\x05 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x06 \x01(\tR\tdeletedAt\x12\x19\n" +
+	"\bpurge_at\x18\a \x01(\tR\apurgeAt\"K\n" +
+	"\x1aListTrashedProductsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\x05R\aperPage\"s\n" +
+	"\x1bListTrashedProductsResponse\x123\n" +
+	"\bproducts\x18\x01 \x03(\v2\x17.product.TrashedProductR\bproducts\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"M\n" +
+	"\x1cListTrashedCategoriesRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x02 \x01(\x05R\aperPage\"z\n" +
+	"\x1dListTrashedCategoriesResponse\x128\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x18.product.TrashedCategoryR\n" +
+	"categories\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"'\n" +
+	"\x15RestoreProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"D\n" +
+	"\x16RestoreProductResponse\x12*\n" +
+	"\aproduct\x18\x01 \x01(\v2\x10.product.ProductR\aproduct\"(\n" +
+	"\x16RestoreCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"H\n" +
+	"\x17RestoreCategoryResponse\x12-\n" +
+	"\bcategory\x18\x01 \x01(\v2\x11.product.CategoryR\bcategory\"%\n" +
+	"\x13PurgeProductRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"0\n" +
+	"\x14PurgeProductResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"&\n" +
+	"\x14PurgeCategoryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"1\n" +
+	"\x15PurgeCategoryResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x99\x02\n" +
+	"\tWarehouse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x18\n" +
+	"\acountry\x18\x04 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\x06 \x01(\tR\x04city\x12\x19\n" +
+	"\bzip_code\x18\a \x01(\tR\azipCode\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\x05R\bpriority\x12\x1b\n" +
+	"\tis_active\x18\t \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\v \x01(\tR\tupdatedAt\"\xeb\x01\n" +
+	"\x16CreateWarehouseRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\x04 \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\x05 \x01(\tR\x04city\x12\x19\n" +
+	"\bzip_code\x18\x06 \x01(\tR\azipCode\x12\x1a\n" +
+	"\bpriority\x18\a \x01(\x05R\bpriority\x12 \n" +
+	"\tis_active\x18\b \x01(\bH\x00R\bisActive\x88\x01\x01B\f\n" +
+	"\n" +
+	"_is_active\"%\n" +
+	"\x13GetWarehouseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"8\n" +
+	"\x15ListWarehousesRequest\x12\x1f\n" +
+	"\vactive_only\x18\x01 \x01(\bR\n" +
+	"activeOnly\"L\n" +
+	"\x16ListWarehousesResponse\x122\n" +
+	"\n" +
+	"warehouses\x18\x01 \x03(\v2\x12.product.WarehouseR\n" +
+	"warehouses\"\xe9\x02\n" +
+	"\x16UpdateWarehouseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\x04code\x18\x02 \x01(\tH\x00R\x04code\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x01R\x04name\x88\x01\x01\x12\x1d\n" +
+	"\acountry\x18\x04 \x01(\tH\x02R\acountry\x88\x01\x01\x12\x19\n" +
+	"\x05state\x18\x05 \x01(\tH\x03R\x05state\x88\x01\x01\x12\x17\n" +
+	"\x04city\x18\x06 \x01(\tH\x04R\x04city\x88\x01\x01\x12\x1e\n" +
+	"\bzip_code\x18\a \x01(\tH\x05R\azipCode\x88\x01\x01\x12\x1f\n" +
+	"\bpriority\x18\b \x01(\x05H\x06R\bpriority\x88\x01\x01\x12 \n" +
+	"\tis_active\x18\t \x01(\bH\aR\bisActive\x88\x01\x01B\a\n" +
+	"\x05_codeB\a\n" +
+	"\x05_nameB\n" +
+	"\n" +
+	"\b_countryB\b\n" +
+	"\x06_stateB\a\n" +
+	"\x05_cityB\v\n" +
+	"\t_zip_codeB\v\n" +
+	"\t_priorityB\f\n" +
+	"\n" +
+	"_is_active\"E\n" +
+	"\x11WarehouseResponse\x120\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x12.product.WarehouseR\twarehouse\"(\n" +
+	"\x16DeleteWarehouseRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"3\n" +
+	"\x17DeleteWarehouseResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf2\x01\n" +
+	"\x14TransferStockRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\x03R\tvariantId\x12*\n" +
+	"\x11from_warehouse_id\x18\x03 \x01(\x03R\x0ffromWarehouseId\x12&\n" +
+	"\x0fto_warehouse_id\x18\x04 \x01(\x03R\rtoWarehouseId\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\a \x01(\tR\x05actor\"M\n" +
+	"\x15TransferStockResponse\x124\n" +
+	"\tmovements\x18\x01 \x03(\v2\x16.product.StockMovementR\tmovements\"[\n" +
+	"\x1bGetStockAvailabilityRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\x03R\tvariantId\"\xbf\x01\n" +
+	"\x13WarehouseStockLevel\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\x12%\n" +
+	"\x0ewarehouse_code\x18\x02 \x01(\tR\rwarehouseCode\x12%\n" +
+	"\x0ewarehouse_name\x18\x03 \x01(\tR\rwarehouseName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\"\x80\x02\n" +
+	"\x19StockAvailabilityResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\x03R\tvariantId\x12\x17\n" +
+	"\aon_hand\x18\x03 \x01(\x05R\x06onHand\x12%\n" +
+	"\x0etotal_quantity\x18\x04 \x01(\x05R\rtotalQuantity\x12/\n" +
+	"\x13unassigned_quantity\x18\x05 \x01(\x05R\x12unassignedQuantity\x124\n" +
+	"\x06levels\x18\x06 \x03(\v2\x1c.product.WarehouseStockLevelR\x06levels\"j\n" +
+	"\x0eAllocationItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x02 \x01(\x03R\tvariantId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"\x8c\x02\n" +
+	"\x18AllocateWarehouseRequest\x12-\n" +
+	"\x05items\x18\x01 \x03(\v2\x17.product.AllocationItemR\x05items\x12\x18\n" +
+	"\acountry\x18\x02 \x01(\tR\acountry\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x12\n" +
+	"\x04city\x18\x04 \x01(\tR\x04city\x12\x19\n" +
+	"\bzip_code\x18\x05 \x01(\tR\azipCode\x12\x1a\n" +
+	"\bstrategy\x18\x06 \x01(\tR\bstrategy\x12\x18\n" +
+	"\areserve\x18\a \x01(\bR\areserve\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\t \x01(\tR\x05actor\"\xbd\x01\n" +
+	"\x19AllocateWarehouseResponse\x12\x1c\n" +
+	"\tallocated\x18\x01 \x01(\bR\tallocated\x12\x1a\n" +
+	"\bstrategy\x18\x02 \x01(\tR\bstrategy\x120\n" +
+	"\twarehouse\x18\x03 \x01(\v2\x12.product.WarehouseR\twarehouse\x124\n" +
+	"\tmovements\x18\x04 \x03(\v2\x16.product.StockMovementR\tmovements\"\x93\x01\n" +
+	"\x11StockItemsRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\x12-\n" +
+	"\x05items\x18\x02 \x03(\v2\x17.product.AllocationItemR\x05items\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05actor\x18\x04 \x01(\tR\x05actor\"J\n" +
+	"\x12StockItemsResponse\x124\n" +
+	"\tmovements\x18\x01 \x03(\v2\x16.product.StockMovementR\tmovements\"~\n" +
 	"\x15CreateCategoryRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x02 \x01(\tR\vdescription\"L\n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tparent_id\x18\x03 \x01(\x03R\bparentId\x12\x12\n" +
+	"\x04slug\x18\x04 \x01(\tR\x04slug\"L\n" +
 	"\x16CreateCategoryResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"(\n" +
@@ -1381,37 +9877,214 @@ const file_shared_proto_v1_product_proto_rawDesc = "" +
 	"categories\x18\x01 \x03(\v2\x11.product.CategoryR\n" +
 	"categories\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"]\n" +
+	"totalCount\"\xa1\x01\n" +
 	"\x15UpdateCategoryRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"L\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12 \n" +
+	"\tparent_id\x18\x04 \x01(\x03H\x00R\bparentId\x88\x01\x01\x12\x12\n" +
+	"\x04slug\x18\x05 \x01(\tR\x04slugB\f\n" +
+	"\n" +
+	"_parent_id\"L\n" +
 	"\x16UpdateCategoryResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"'\n" +
 	"\x15DeleteCategoryRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
 	"\x16DeleteCategoryResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"P\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x95\x01\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription*K\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1b\n" +
+	"\tparent_id\x18\x04 \x01(\x03R\bparentId\x12\x12\n" +
+	"\x04slug\x18\x05 \x01(\tR\x04slug\x12\x12\n" +
+	"\x04path\x18\x06 \x01(\tR\x04path\"p\n" +
+	"\fCategoryNode\x12-\n" +
+	"\bcategory\x18\x01 \x01(\v2\x11.product.CategoryR\bcategory\x121\n" +
+	"\bchildren\x18\x02 \x03(\v2\x15.product.CategoryNodeR\bchildren\"\x18\n" +
+	"\x16GetCategoryTreeRequest\"F\n" +
+	"\x17GetCategoryTreeResponse\x12+\n" +
+	"\x05roots\x18\x01 \x03(\v2\x15.product.CategoryNodeR\x05roots\"b\n" +
+	"\x1eAssignProductCategoriesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12!\n" +
+	"\fcategory_ids\x18\x02 \x03(\x03R\vcategoryIds\"d\n" +
+	" UnassignProductCategoriesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12!\n" +
+	"\fcategory_ids\x18\x02 \x03(\x03R\vcategoryIds\"m\n" +
+	"\x19ProductCategoriesResponse\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x121\n" +
+	"\n" +
+	"categories\x18\x02 \x03(\v2\x11.product.CategoryR\n" +
+	"categories\"\xda\x01\n" +
+	"\x1dListProductsByCategoryRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12/\n" +
+	"\x13include_descendants\x18\x02 \x01(\bR\x12includeDescendants\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x19\n" +
+	"\bper_page\x18\x04 \x01(\x05R\aperPage\x128\n" +
+	"\n" +
+	"attributes\x18\x05 \x03(\v2\x18.product.AttributeFilterR\n" +
+	"attributes\"\xde\x01\n" +
+	"\tAttribute\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x12\n" +
+	"\x04unit\x18\x05 \x01(\tR\x04unit\x12\x18\n" +
+	"\aoptions\x18\x06 \x03(\tR\aoptions\x12\x1f\n" +
+	"\vcategory_id\x18\a \x01(\x03R\n" +
+	"categoryId\x12\x1a\n" +
+	"\brequired\x18\b \x01(\bR\brequired\x12\x1a\n" +
+	"\bposition\x18\t \x01(\x05R\bposition\"x\n" +
+	"\x10ProductAttribute\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\tR\x05value\"{\n" +
+	"\x0fAttributeFilter\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\x12\x15\n" +
+	"\x03min\x18\x03 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x04 \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\x82\x01\n" +
+	"\x16CreateAttributeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12\x18\n" +
+	"\aoptions\x18\x05 \x03(\tR\aoptions\"E\n" +
+	"\x11AttributeResponse\x120\n" +
+	"\tattribute\x18\x01 \x01(\v2\x12.product.AttributeR\tattribute\"8\n" +
+	"\x15ListAttributesRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\"L\n" +
+	"\x16ListAttributesResponse\x122\n" +
+	"\n" +
+	"attributes\x18\x01 \x03(\v2\x12.product.AttributeR\n" +
+	"attributes\"(\n" +
+	"\x16DeleteAttributeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"3\n" +
+	"\x17DeleteAttributeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9c\x01\n" +
+	"\x1eAttachCategoryAttributeRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12!\n" +
+	"\fattribute_id\x18\x02 \x01(\x03R\vattributeId\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12\x1a\n" +
+	"\bposition\x18\x04 \x01(\x05R\bposition\"d\n" +
+	"\x1eDetachCategoryAttributeRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\x03R\n" +
+	"categoryId\x12!\n" +
+	"\fattribute_id\x18\x02 \x01(\x03R\vattributeId\";\n" +
+	"\x1fDetachCategoryAttributeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
+	"\x15ProductAttributeValue\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\"t\n" +
+	"\x1bSetProductAttributesRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x126\n" +
+	"\x06values\x18\x02 \x03(\v2\x1e.product.ProductAttributeValueR\x06values\"Y\n" +
+	"\x1cSetProductAttributesResponse\x129\n" +
+	"\n" +
+	"attributes\x18\x01 \x03(\v2\x19.product.ProductAttributeR\n" +
+	"attributes*K\n" +
 	"\fDiscountType\x12\x11\n" +
 	"\rDISCOUNT_NONE\x10\x00\x12\x14\n" +
 	"\x10DISCOUNT_PERCENT\x10\x01\x12\x12\n" +
-	"\x0eDISCOUNT_FIXED\x10\x022\xc2\x06\n" +
+	"\x0eDISCOUNT_FIXED\x10\x02*r\n" +
+	"\rProductSortBy\x12\x12\n" +
+	"\x0eSORT_RELEVANCE\x10\x00\x12\x12\n" +
+	"\x0eSORT_PRICE_ASC\x10\x01\x12\x13\n" +
+	"\x0fSORT_PRICE_DESC\x10\x02\x12\x0f\n" +
+	"\vSORT_NEWEST\x10\x03\x12\x13\n" +
+	"\x0fSORT_POPULARITY\x10\x04*\xb2\x02\n" +
+	"\x11StockMovementType\x12\x1e\n" +
+	"\x1aSTOCK_MOVEMENT_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16STOCK_MOVEMENT_RESTOCK\x10\x01\x12\x17\n" +
+	"\x13STOCK_MOVEMENT_SALE\x10\x02\x12\x1e\n" +
+	"\x1aSTOCK_MOVEMENT_RESERVATION\x10\x03\x12\x1a\n" +
+	"\x16STOCK_MOVEMENT_RELEASE\x10\x04\x12\x19\n" +
+	"\x15STOCK_MOVEMENT_RETURN\x10\x05\x12\x1d\n" +
+	"\x19STOCK_MOVEMENT_ADJUSTMENT\x10\x06\x12\x1b\n" +
+	"\x17STOCK_MOVEMENT_TRANSFER\x10\a\x12\x1a\n" +
+	"\x16STOCK_MOVEMENT_INITIAL\x10\b\x12\x19\n" +
+	"\x15STOCK_MOVEMENT_IMPORT\x10\t2\xfb.\n" +
 	"\x0eProductService\x12N\n" +
 	"\rCreateProduct\x12\x1d.product.CreateProductRequest\x1a\x1e.product.CreateProductResponse\x12Q\n" +
-	"\x0eGetProductByID\x12\x1e.product.GetProductByIDRequest\x1a\x1f.product.GetProductByIDResponse\x12K\n" +
+	"\x0eGetProductByID\x12\x1e.product.GetProductByIDRequest\x1a\x1f.product.GetProductByIDResponse\x12W\n" +
+	"\x10GetProductsByIDs\x12 .product.GetProductsByIDsRequest\x1a!.product.GetProductsByIDsResponse\x12K\n" +
 	"\fListProducts\x12\x1c.product.ListProductsRequest\x1a\x1d.product.ListProductsResponse\x12N\n" +
+	"\rGetCacheStats\x12\x1d.product.GetCacheStatsRequest\x1a\x1e.product.GetCacheStatsResponse\x12N\n" +
 	"\rUpdateProduct\x12\x1d.product.UpdateProductRequest\x1a\x1e.product.UpdateProductResponse\x12N\n" +
 	"\rDeleteProduct\x12\x1d.product.DeleteProductRequest\x1a\x1e.product.DeleteProductResponse\x12Q\n" +
+	"\x0eSearchProducts\x12\x1e.product.SearchProductsRequest\x1a\x1f.product.SearchProductsResponse\x12Q\n" +
 	"\x0eCreateCategory\x12\x1e.product.CreateCategoryRequest\x1a\x1f.product.CreateCategoryResponse\x12T\n" +
 	"\x0fGetCategoryByID\x12\x1f.product.GetCategoryByIDRequest\x1a .product.GetCategoryByIDResponse\x12Q\n" +
 	"\x0eListCategories\x12\x1e.product.ListCategoriesRequest\x1a\x1f.product.ListCategoriesResponse\x12Q\n" +
 	"\x0eUpdateCategory\x12\x1e.product.UpdateCategoryRequest\x1a\x1f.product.UpdateCategoryResponse\x12Q\n" +
-	"\x0eDeleteCategory\x12\x1e.product.DeleteCategoryRequest\x1a\x1f.product.DeleteCategoryResponseB!Z\x1fshared/proto/v1/product;productb\x06proto3"
+	"\x0eDeleteCategory\x12\x1e.product.DeleteCategoryRequest\x1a\x1f.product.DeleteCategoryResponse\x12T\n" +
+	"\x0fGetCategoryTree\x12\x1f.product.GetCategoryTreeRequest\x1a .product.GetCategoryTreeResponse\x12f\n" +
+	"\x17AssignProductCategories\x12'.product.AssignProductCategoriesRequest\x1a\".product.ProductCategoriesResponse\x12j\n" +
+	"\x19UnassignProductCategories\x12).product.UnassignProductCategoriesRequest\x1a\".product.ProductCategoriesResponse\x12_\n" +
+	"\x16ListProductsByCategory\x12&.product.ListProductsByCategoryRequest\x1a\x1d.product.ListProductsResponse\x12Z\n" +
+	"\x13CreateProductOption\x12#.product.CreateProductOptionRequest\x1a\x1e.product.ProductOptionResponse\x12`\n" +
+	"\x13DeleteProductOption\x12#.product.DeleteProductOptionRequest\x1a$.product.DeleteProductOptionResponse\x12]\n" +
+	"\x14CreateProductVariant\x12$.product.CreateProductVariantRequest\x1a\x1f.product.ProductVariantResponse\x12W\n" +
+	"\x11GetProductVariant\x12!.product.GetProductVariantRequest\x1a\x1f.product.ProductVariantResponse\x12]\n" +
+	"\x14UpdateProductVariant\x12$.product.UpdateProductVariantRequest\x1a\x1f.product.ProductVariantResponse\x12c\n" +
+	"\x14DeleteProductVariant\x12$.product.DeleteProductVariantRequest\x1a%.product.DeleteProductVariantResponse\x12P\n" +
+	"\x0eRestockProduct\x12\x1e.product.RestockProductRequest\x1a\x1e.product.StockMovementResponse\x12Z\n" +
+	"\x13RecordStockMovement\x12#.product.RecordStockMovementRequest\x1a\x1e.product.StockMovementResponse\x12]\n" +
+	"\x12ListStockMovements\x12\".product.ListStockMovementsRequest\x1a#.product.ListStockMovementsResponse\x12N\n" +
+	"\x0fCreateWarehouse\x12\x1f.product.CreateWarehouseRequest\x1a\x1a.product.WarehouseResponse\x12H\n" +
+	"\fGetWarehouse\x12\x1c.product.GetWarehouseRequest\x1a\x1a.product.WarehouseResponse\x12Q\n" +
+	"\x0eListWarehouses\x12\x1e.product.ListWarehousesRequest\x1a\x1f.product.ListWarehousesResponse\x12N\n" +
+	"\x0fUpdateWarehouse\x12\x1f.product.UpdateWarehouseRequest\x1a\x1a.product.WarehouseResponse\x12T\n" +
+	"\x0fDeleteWarehouse\x12\x1f.product.DeleteWarehouseRequest\x1a .product.DeleteWarehouseResponse\x12N\n" +
+	"\rTransferStock\x12\x1d.product.TransferStockRequest\x1a\x1e.product.TransferStockResponse\x12`\n" +
+	"\x14GetStockAvailability\x12$.product.GetStockAvailabilityRequest\x1a\".product.StockAvailabilityResponse\x12Z\n" +
+	"\x11AllocateWarehouse\x12!.product.AllocateWarehouseRequest\x1a\".product.AllocateWarehouseResponse\x12D\n" +
+	"\tTakeStock\x12\x1a.product.StockItemsRequest\x1a\x1b.product.StockItemsResponse\x12F\n" +
+	"\vReturnStock\x12\x1a.product.StockItemsRequest\x1a\x1b.product.StockItemsResponse\x12`\n" +
+	"\x13SetReorderThreshold\x12#.product.SetReorderThresholdRequest\x1a$.product.SetReorderThresholdResponse\x12Q\n" +
+	"\x0eSetMaxPerOrder\x12\x1e.product.SetMaxPerOrderRequest\x1a\x1f.product.SetMaxPerOrderResponse\x12c\n" +
+	"\x14ListLowStockProducts\x12$.product.ListLowStockProductsRequest\x1a%.product.ListLowStockProductsResponse\x12S\n" +
+	"\x0eImportProducts\x12\x1e.product.ImportProductsRequest\x1a\x1f.product.ImportProductsResponse(\x01\x12P\n" +
+	"\x0eExportProducts\x12\x1e.product.ExportProductsRequest\x1a\x1c.product.ExportProductsChunk0\x01\x12Y\n" +
+	"\x12UploadProductImage\x12\".product.UploadProductImageRequest\x1a\x1d.product.ProductImageResponse(\x01\x12Z\n" +
+	"\x11ListProductImages\x12!.product.ListProductImagesRequest\x1a\".product.ListProductImagesResponse\x12W\n" +
+	"\x12UpdateProductImage\x12\".product.UpdateProductImageRequest\x1a\x1d.product.ProductImageResponse\x12`\n" +
+	"\x14ReorderProductImages\x12$.product.ReorderProductImagesRequest\x1a\".product.ListProductImagesResponse\x12]\n" +
+	"\x12DeleteProductImage\x12\".product.DeleteProductImageRequest\x1a#.product.DeleteProductImageResponse\x12E\n" +
+	"\fCreateReview\x12\x1c.product.CreateReviewRequest\x1a\x17.product.ReviewResponse\x12V\n" +
+	"\x12ListProductReviews\x12\".product.ListProductReviewsRequest\x1a\x1c.product.ListReviewsResponse\x12H\n" +
+	"\vListReviews\x12\x1b.product.ListReviewsRequest\x1a\x1c.product.ListReviewsResponse\x12E\n" +
+	"\fUpdateReview\x12\x1c.product.UpdateReviewRequest\x1a\x17.product.ReviewResponse\x12K\n" +
+	"\fDeleteReview\x12\x1c.product.DeleteReviewRequest\x1a\x1d.product.DeleteReviewResponse\x12I\n" +
+	"\x0eModerateReview\x12\x1e.product.ModerateReviewRequest\x1a\x17.product.ReviewResponse\x12a\n" +
+	"\x13SchedulePriceChange\x12#.product.SchedulePriceChangeRequest\x1a%.product.ScheduledPriceChangeResponse\x12o\n" +
+	"\x1aCancelScheduledPriceChange\x12*.product.CancelScheduledPriceChangeRequest\x1a%.product.ScheduledPriceChangeResponse\x12r\n" +
+	"\x19ListScheduledPriceChanges\x12).product.ListScheduledPriceChangesRequest\x1a*.product.ListScheduledPriceChangesResponse\x12W\n" +
+	"\x10GetPriceTimeline\x12 .product.GetPriceTimelineRequest\x1a!.product.GetPriceTimelineResponse\x12`\n" +
+	"\x13ListTrashedProducts\x12#.product.ListTrashedProductsRequest\x1a$.product.ListTrashedProductsResponse\x12f\n" +
+	"\x15ListTrashedCategories\x12%.product.ListTrashedCategoriesRequest\x1a&.product.ListTrashedCategoriesResponse\x12Q\n" +
+	"\x0eRestoreProduct\x12\x1e.product.RestoreProductRequest\x1a\x1f.product.RestoreProductResponse\x12T\n" +
+	"\x0fRestoreCategory\x12\x1f.product.RestoreCategoryRequest\x1a .product.RestoreCategoryResponse\x12K\n" +
+	"\fPurgeProduct\x12\x1c.product.PurgeProductRequest\x1a\x1d.product.PurgeProductResponse\x12N\n" +
+	"\rPurgeCategory\x12\x1d.product.PurgeCategoryRequest\x1a\x1e.product.PurgeCategoryResponse\x12N\n" +
+	"\x0fCreateAttribute\x12\x1f.product.CreateAttributeRequest\x1a\x1a.product.AttributeResponse\x12Q\n" +
+	"\x0eListAttributes\x12\x1e.product.ListAttributesRequest\x1a\x1f.product.ListAttributesResponse\x12T\n" +
+	"\x0fDeleteAttribute\x12\x1f.product.DeleteAttributeRequest\x1a .product.DeleteAttributeResponse\x12c\n" +
+	"\x17AttachCategoryAttribute\x12'.product.AttachCategoryAttributeRequest\x1a\x1f.product.ListAttributesResponse\x12l\n" +
+	"\x17DetachCategoryAttribute\x12'.product.DetachCategoryAttributeRequest\x1a(.product.DetachCategoryAttributeResponse\x12c\n" +
+	"\x14SetProductAttributes\x12$.product.SetProductAttributesRequest\x1a%.product.SetProductAttributesResponseB!Z\x1fshared/proto/v1/product;productb\x06proto3"
 
 var (
 	file_shared_proto_v1_product_proto_rawDescOnce sync.Once
@@ -1425,67 +10098,362 @@ func file_shared_proto_v1_product_proto_rawDescGZIP() []byte {
 	return file_shared_proto_v1_product_proto_rawDescData
 }
 
-var file_shared_proto_v1_product_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_shared_proto_v1_product_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_shared_proto_v1_product_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_shared_proto_v1_product_proto_msgTypes = make([]protoimpl.MessageInfo, 146)
 var file_shared_proto_v1_product_proto_goTypes = []any{
-	(DiscountType)(0),               // 0: product.DiscountType
-	(*CreateProductRequest)(nil),    // 1: product.CreateProductRequest
-	(*CreateProductResponse)(nil),   // 2: product.CreateProductResponse
-	(*GetProductByIDRequest)(nil),   // 3: product.GetProductByIDRequest
-	(*GetProductByIDResponse)(nil),  // 4: product.GetProductByIDResponse
-	(*ListProductsRequest)(nil),     // 5: product.ListProductsRequest
-	(*ListProductsResponse)(nil),    // 6: product.ListProductsResponse
-	(*UpdateProductRequest)(nil),    // 7: product.UpdateProductRequest
-	(*UpdateProductResponse)(nil),   // 8: product.UpdateProductResponse
-	(*DeleteProductRequest)(nil),    // 9: product.DeleteProductRequest
-	(*DeleteProductResponse)(nil),   // 10: product.DeleteProductResponse
-	(*Product)(nil),                 // 11: product.Product
-	(*CreateCategoryRequest)(nil),   // 12: product.CreateCategoryRequest
-	(*CreateCategoryResponse)(nil),  // 13: product.CreateCategoryResponse
-	(*GetCategoryByIDRequest)(nil),  // 14: product.GetCategoryByIDRequest
-	(*GetCategoryByIDResponse)(nil), // 15: product.GetCategoryByIDResponse
-	(*ListCategoriesRequest)(nil),   // 16: product.ListCategoriesRequest
-	(*ListCategoriesResponse)(nil),  // 17: product.ListCategoriesResponse
-	(*UpdateCategoryRequest)(nil),   // 18: product.UpdateCategoryRequest
-	(*UpdateCategoryResponse)(nil),  // 19: product.UpdateCategoryResponse
-	(*DeleteCategoryRequest)(nil),   // 20: product.DeleteCategoryRequest
-	(*DeleteCategoryResponse)(nil),  // 21: product.DeleteCategoryResponse
-	(*Category)(nil),                // 22: product.Category
+	(DiscountType)(0),                         // 0: product.DiscountType
+	(ProductSortBy)(0),                        // 1: product.ProductSortBy
+	(StockMovementType)(0),                    // 2: product.StockMovementType
+	(*CreateProductRequest)(nil),              // 3: product.CreateProductRequest
+	(*CreateProductResponse)(nil),             // 4: product.CreateProductResponse
+	(*GetCacheStatsRequest)(nil),              // 5: product.GetCacheStatsRequest
+	(*GetCacheStatsResponse)(nil),             // 6: product.GetCacheStatsResponse
+	(*GetProductByIDRequest)(nil),             // 7: product.GetProductByIDRequest
+	(*GetProductByIDResponse)(nil),            // 8: product.GetProductByIDResponse
+	(*GetProductsByIDsRequest)(nil),           // 9: product.GetProductsByIDsRequest
+	(*GetProductsByIDsResponse)(nil),          // 10: product.GetProductsByIDsResponse
+	(*ListProductsRequest)(nil),               // 11: product.ListProductsRequest
+	(*ListProductsResponse)(nil),              // 12: product.ListProductsResponse
+	(*UpdateProductRequest)(nil),              // 13: product.UpdateProductRequest
+	(*UpdateProductResponse)(nil),             // 14: product.UpdateProductResponse
+	(*DeleteProductRequest)(nil),              // 15: product.DeleteProductRequest
+	(*DeleteProductResponse)(nil),             // 16: product.DeleteProductResponse
+	(*SearchProductsRequest)(nil),             // 17: product.SearchProductsRequest
+	(*SearchProductsResponse)(nil),            // 18: product.SearchProductsResponse
+	(*SearchFacets)(nil),                      // 19: product.SearchFacets
+	(*CategoryFacet)(nil),                     // 20: product.CategoryFacet
+	(*PriceRangeFacet)(nil),                   // 21: product.PriceRangeFacet
+	(*Product)(nil),                           // 22: product.Product
+	(*ProductOption)(nil),                     // 23: product.ProductOption
+	(*ProductOptionValue)(nil),                // 24: product.ProductOptionValue
+	(*ProductVariant)(nil),                    // 25: product.ProductVariant
+	(*CreateProductOptionRequest)(nil),        // 26: product.CreateProductOptionRequest
+	(*ProductOptionResponse)(nil),             // 27: product.ProductOptionResponse
+	(*DeleteProductOptionRequest)(nil),        // 28: product.DeleteProductOptionRequest
+	(*DeleteProductOptionResponse)(nil),       // 29: product.DeleteProductOptionResponse
+	(*CreateProductVariantRequest)(nil),       // 30: product.CreateProductVariantRequest
+	(*GetProductVariantRequest)(nil),          // 31: product.GetProductVariantRequest
+	(*UpdateProductVariantRequest)(nil),       // 32: product.UpdateProductVariantRequest
+	(*ProductVariantResponse)(nil),            // 33: product.ProductVariantResponse
+	(*DeleteProductVariantRequest)(nil),       // 34: product.DeleteProductVariantRequest
+	(*DeleteProductVariantResponse)(nil),      // 35: product.DeleteProductVariantResponse
+	(*RestockProductRequest)(nil),             // 36: product.RestockProductRequest
+	(*RecordStockMovementRequest)(nil),        // 37: product.RecordStockMovementRequest
+	(*StockMovement)(nil),                     // 38: product.StockMovement
+	(*StockMovementResponse)(nil),             // 39: product.StockMovementResponse
+	(*ListStockMovementsRequest)(nil),         // 40: product.ListStockMovementsRequest
+	(*ListStockMovementsResponse)(nil),        // 41: product.ListStockMovementsResponse
+	(*SetReorderThresholdRequest)(nil),        // 42: product.SetReorderThresholdRequest
+	(*SetReorderThresholdResponse)(nil),       // 43: product.SetReorderThresholdResponse
+	(*SetMaxPerOrderRequest)(nil),             // 44: product.SetMaxPerOrderRequest
+	(*SetMaxPerOrderResponse)(nil),            // 45: product.SetMaxPerOrderResponse
+	(*ListLowStockProductsRequest)(nil),       // 46: product.ListLowStockProductsRequest
+	(*LowStockItem)(nil),                      // 47: product.LowStockItem
+	(*ListLowStockProductsResponse)(nil),      // 48: product.ListLowStockProductsResponse
+	(*ImportProductsRequest)(nil),             // 49: product.ImportProductsRequest
+	(*ImportRowError)(nil),                    // 50: product.ImportRowError
+	(*ImportProductsResponse)(nil),            // 51: product.ImportProductsResponse
+	(*ExportProductsRequest)(nil),             // 52: product.ExportProductsRequest
+	(*ExportProductsChunk)(nil),               // 53: product.ExportProductsChunk
+	(*ProductImage)(nil),                      // 54: product.ProductImage
+	(*UploadProductImageRequest)(nil),         // 55: product.UploadProductImageRequest
+	(*ProductImageResponse)(nil),              // 56: product.ProductImageResponse
+	(*ListProductImagesRequest)(nil),          // 57: product.ListProductImagesRequest
+	(*ListProductImagesResponse)(nil),         // 58: product.ListProductImagesResponse
+	(*UpdateProductImageRequest)(nil),         // 59: product.UpdateProductImageRequest
+	(*ReorderProductImagesRequest)(nil),       // 60: product.ReorderProductImagesRequest
+	(*DeleteProductImageRequest)(nil),         // 61: product.DeleteProductImageRequest
+	(*DeleteProductImageResponse)(nil),        // 62: product.DeleteProductImageResponse
+	(*Review)(nil),                            // 63: product.Review
+	(*CreateReviewRequest)(nil),               // 64: product.CreateReviewRequest
+	(*ReviewResponse)(nil),                    // 65: product.ReviewResponse
+	(*ListProductReviewsRequest)(nil),         // 66: product.ListProductReviewsRequest
+	(*ListReviewsRequest)(nil),                // 67: product.ListReviewsRequest
+	(*ListReviewsResponse)(nil),               // 68: product.ListReviewsResponse
+	(*UpdateReviewRequest)(nil),               // 69: product.UpdateReviewRequest
+	(*DeleteReviewRequest)(nil),               // 70: product.DeleteReviewRequest
+	(*DeleteReviewResponse)(nil),              // 71: product.DeleteReviewResponse
+	(*ModerateReviewRequest)(nil),             // 72: product.ModerateReviewRequest
+	(*ScheduledPriceChange)(nil),              // 73: product.ScheduledPriceChange
+	(*SchedulePriceChangeRequest)(nil),        // 74: product.SchedulePriceChangeRequest
+	(*ScheduledPriceChangeResponse)(nil),      // 75: product.ScheduledPriceChangeResponse
+	(*CancelScheduledPriceChangeRequest)(nil), // 76: product.CancelScheduledPriceChangeRequest
+	(*ListScheduledPriceChangesRequest)(nil),  // 77: product.ListScheduledPriceChangesRequest
+	(*ListScheduledPriceChangesResponse)(nil), // 78: product.ListScheduledPriceChangesResponse
+	(*PriceHistoryEntry)(nil),                 // 79: product.PriceHistoryEntry
+	(*GetPriceTimelineRequest)(nil),           // 80: product.GetPriceTimelineRequest
+	(*GetPriceTimelineResponse)(nil),          // 81: product.GetPriceTimelineResponse
+	(*TrashedProduct)(nil),                    // 82: product.TrashedProduct
+	(*TrashedCategory)(nil),                   // 83: product.TrashedCategory
+	(*ListTrashedProductsRequest)(nil),        // 84: product.ListTrashedProductsRequest
+	(*ListTrashedProductsResponse)(nil),       // 85: product.ListTrashedProductsResponse
+	(*ListTrashedCategoriesRequest)(nil),      // 86: product.ListTrashedCategoriesRequest
+	(*ListTrashedCategoriesResponse)(nil),     // 87: product.ListTrashedCategoriesResponse
+	(*RestoreProductRequest)(nil),             // 88: product.RestoreProductRequest
+	(*RestoreProductResponse)(nil),            // 89: product.RestoreProductResponse
+	(*RestoreCategoryRequest)(nil),            // 90: product.RestoreCategoryRequest
+	(*RestoreCategoryResponse)(nil),           // 91: product.RestoreCategoryResponse
+	(*PurgeProductRequest)(nil),               // 92: product.PurgeProductRequest
+	(*PurgeProductResponse)(nil),              // 93: product.PurgeProductResponse
+	(*PurgeCategoryRequest)(nil),              // 94: product.PurgeCategoryRequest
+	(*PurgeCategoryResponse)(nil),             // 95: product.PurgeCategoryResponse
+	(*Warehouse)(nil),                         // 96: product.Warehouse
+	(*CreateWarehouseRequest)(nil),            // 97: product.CreateWarehouseRequest
+	(*GetWarehouseRequest)(nil),               // 98: product.GetWarehouseRequest
+	(*ListWarehousesRequest)(nil),             // 99: product.ListWarehousesRequest
+	(*ListWarehousesResponse)(nil),            // 100: product.ListWarehousesResponse
+	(*UpdateWarehouseRequest)(nil),            // 101: product.UpdateWarehouseRequest
+	(*WarehouseResponse)(nil),                 // 102: product.WarehouseResponse
+	(*DeleteWarehouseRequest)(nil),            // 103: product.DeleteWarehouseRequest
+	(*DeleteWarehouseResponse)(nil),           // 104: product.DeleteWarehouseResponse
+	(*TransferStockRequest)(nil),              // 105: product.TransferStockRequest
+	(*TransferStockResponse)(nil),             // 106: product.TransferStockResponse
+	(*GetStockAvailabilityRequest)(nil),       // 107: product.GetStockAvailabilityRequest
+	(*WarehouseStockLevel)(nil),               // 108: product.WarehouseStockLevel
+	(*StockAvailabilityResponse)(nil),         // 109: product.StockAvailabilityResponse
+	(*AllocationItem)(nil),                    // 110: product.AllocationItem
+	(*AllocateWarehouseRequest)(nil),          // 111: product.AllocateWarehouseRequest
+	(*AllocateWarehouseResponse)(nil),         // 112: product.AllocateWarehouseResponse
+	(*StockItemsRequest)(nil),                 // 113: product.StockItemsRequest
+	(*StockItemsResponse)(nil),                // 114: product.StockItemsResponse
+	(*CreateCategoryRequest)(nil),             // 115: product.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),            // 116: product.CreateCategoryResponse
+	(*GetCategoryByIDRequest)(nil),            // 117: product.GetCategoryByIDRequest
+	(*GetCategoryByIDResponse)(nil),           // 118: product.GetCategoryByIDResponse
+	(*ListCategoriesRequest)(nil),             // 119: product.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),            // 120: product.ListCategoriesResponse
+	(*UpdateCategoryRequest)(nil),             // 121: product.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),            // 122: product.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),             // 123: product.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),            // 124: product.DeleteCategoryResponse
+	(*Category)(nil),                          // 125: product.Category
+	(*CategoryNode)(nil),                      // 126: product.CategoryNode
+	(*GetCategoryTreeRequest)(nil),            // 127: product.GetCategoryTreeRequest
+	(*GetCategoryTreeResponse)(nil),           // 128: product.GetCategoryTreeResponse
+	(*AssignProductCategoriesRequest)(nil),    // 129: product.AssignProductCategoriesRequest
+	(*UnassignProductCategoriesRequest)(nil),  // 130: product.UnassignProductCategoriesRequest
+	(*ProductCategoriesResponse)(nil),         // 131: product.ProductCategoriesResponse
+	(*ListProductsByCategoryRequest)(nil),     // 132: product.ListProductsByCategoryRequest
+	(*Attribute)(nil),                         // 133: product.Attribute
+	(*ProductAttribute)(nil),                  // 134: product.ProductAttribute
+	(*AttributeFilter)(nil),                   // 135: product.AttributeFilter
+	(*CreateAttributeRequest)(nil),            // 136: product.CreateAttributeRequest
+	(*AttributeResponse)(nil),                 // 137: product.AttributeResponse
+	(*ListAttributesRequest)(nil),             // 138: product.ListAttributesRequest
+	(*ListAttributesResponse)(nil),            // 139: product.ListAttributesResponse
+	(*DeleteAttributeRequest)(nil),            // 140: product.DeleteAttributeRequest
+	(*DeleteAttributeResponse)(nil),           // 141: product.DeleteAttributeResponse
+	(*AttachCategoryAttributeRequest)(nil),    // 142: product.AttachCategoryAttributeRequest
+	(*DetachCategoryAttributeRequest)(nil),    // 143: product.DetachCategoryAttributeRequest
+	(*DetachCategoryAttributeResponse)(nil),   // 144: product.DetachCategoryAttributeResponse
+	(*ProductAttributeValue)(nil),             // 145: product.ProductAttributeValue
+	(*SetProductAttributesRequest)(nil),       // 146: product.SetProductAttributesRequest
+	(*SetProductAttributesResponse)(nil),      // 147: product.SetProductAttributesResponse
+	nil,                                       // 148: product.ProductVariant.OptionsEntry
 }
 var file_shared_proto_v1_product_proto_depIdxs = []int32{
-	0,  // 0: product.CreateProductRequest.discount_type:type_name -> product.DiscountType
-	11, // 1: product.CreateProductResponse.product:type_name -> product.Product
-	11, // 2: product.GetProductByIDResponse.product:type_name -> product.Product
-	11, // 3: product.ListProductsResponse.products:type_name -> product.Product
-	0,  // 4: product.UpdateProductRequest.discount_type:type_name -> product.DiscountType
-	11, // 5: product.UpdateProductResponse.product:type_name -> product.Product
-	22, // 6: product.GetCategoryByIDResponse.category:type_name -> product.Category
-	22, // 7: product.ListCategoriesResponse.categories:type_name -> product.Category
-	1,  // 8: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
-	3,  // 9: product.ProductService.GetProductByID:input_type -> product.GetProductByIDRequest
-	5,  // 10: product.ProductService.ListProducts:input_type -> product.ListProductsRequest
-	7,  // 11: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
-	9,  // 12: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
-	12, // 13: product.ProductService.CreateCategory:input_type -> product.CreateCategoryRequest
-	14, // 14: product.ProductService.GetCategoryByID:input_type -> product.GetCategoryByIDRequest
-	16, // 15: product.ProductService.ListCategories:input_type -> product.ListCategoriesRequest
-	18, // 16: product.ProductService.UpdateCategory:input_type -> product.UpdateCategoryRequest
-	20, // 17: product.ProductService.DeleteCategory:input_type -> product.DeleteCategoryRequest
-	2,  // 18: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
-	4,  // 19: product.ProductService.GetProductByID:output_type -> product.GetProductByIDResponse
-	6,  // 20: product.ProductService.ListProducts:output_type -> product.ListProductsResponse
-	8,  // 21: product.ProductService.UpdateProduct:output_type -> product.UpdateProductResponse
-	10, // 22: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
-	13, // 23: product.ProductService.CreateCategory:output_type -> product.CreateCategoryResponse
-	15, // 24: product.ProductService.GetCategoryByID:output_type -> product.GetCategoryByIDResponse
-	17, // 25: product.ProductService.ListCategories:output_type -> product.ListCategoriesResponse
-	19, // 26: product.ProductService.UpdateCategory:output_type -> product.UpdateCategoryResponse
-	21, // 27: product.ProductService.DeleteCategory:output_type -> product.DeleteCategoryResponse
-	18, // [18:28] is the sub-list for method output_type
-	8,  // [8:18] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	0,   // 0: product.CreateProductRequest.discount_type:type_name -> product.DiscountType
+	22,  // 1: product.CreateProductResponse.product:type_name -> product.Product
+	22,  // 2: product.GetProductByIDResponse.product:type_name -> product.Product
+	22,  // 3: product.GetProductsByIDsResponse.products:type_name -> product.Product
+	135, // 4: product.ListProductsRequest.attributes:type_name -> product.AttributeFilter
+	22,  // 5: product.ListProductsResponse.products:type_name -> product.Product
+	0,   // 6: product.UpdateProductRequest.discount_type:type_name -> product.DiscountType
+	22,  // 7: product.UpdateProductResponse.product:type_name -> product.Product
+	1,   // 8: product.SearchProductsRequest.sort_by:type_name -> product.ProductSortBy
+	135, // 9: product.SearchProductsRequest.attributes:type_name -> product.AttributeFilter
+	22,  // 10: product.SearchProductsResponse.products:type_name -> product.Product
+	19,  // 11: product.SearchProductsResponse.facets:type_name -> product.SearchFacets
+	20,  // 12: product.SearchFacets.categories:type_name -> product.CategoryFacet
+	21,  // 13: product.SearchFacets.price_ranges:type_name -> product.PriceRangeFacet
+	23,  // 14: product.Product.options:type_name -> product.ProductOption
+	25,  // 15: product.Product.variants:type_name -> product.ProductVariant
+	54,  // 16: product.Product.images:type_name -> product.ProductImage
+	134, // 17: product.Product.attributes:type_name -> product.ProductAttribute
+	24,  // 18: product.ProductOption.values:type_name -> product.ProductOptionValue
+	148, // 19: product.ProductVariant.options:type_name -> product.ProductVariant.OptionsEntry
+	23,  // 20: product.ProductOptionResponse.option:type_name -> product.ProductOption
+	25,  // 21: product.ProductVariantResponse.variant:type_name -> product.ProductVariant
+	2,   // 22: product.RecordStockMovementRequest.type:type_name -> product.StockMovementType
+	38,  // 23: product.StockMovementResponse.movement:type_name -> product.StockMovement
+	2,   // 24: product.ListStockMovementsRequest.type:type_name -> product.StockMovementType
+	38,  // 25: product.ListStockMovementsResponse.movements:type_name -> product.StockMovement
+	47,  // 26: product.ListLowStockProductsResponse.items:type_name -> product.LowStockItem
+	50,  // 27: product.ImportProductsResponse.errors:type_name -> product.ImportRowError
+	54,  // 28: product.ProductImageResponse.image:type_name -> product.ProductImage
+	54,  // 29: product.ListProductImagesResponse.images:type_name -> product.ProductImage
+	63,  // 30: product.ReviewResponse.review:type_name -> product.Review
+	63,  // 31: product.ListReviewsResponse.reviews:type_name -> product.Review
+	0,   // 32: product.SchedulePriceChangeRequest.discount_type:type_name -> product.DiscountType
+	73,  // 33: product.ScheduledPriceChangeResponse.change:type_name -> product.ScheduledPriceChange
+	73,  // 34: product.ListScheduledPriceChangesResponse.changes:type_name -> product.ScheduledPriceChange
+	79,  // 35: product.GetPriceTimelineResponse.history:type_name -> product.PriceHistoryEntry
+	73,  // 36: product.GetPriceTimelineResponse.upcoming:type_name -> product.ScheduledPriceChange
+	82,  // 37: product.ListTrashedProductsResponse.products:type_name -> product.TrashedProduct
+	83,  // 38: product.ListTrashedCategoriesResponse.categories:type_name -> product.TrashedCategory
+	22,  // 39: product.RestoreProductResponse.product:type_name -> product.Product
+	125, // 40: product.RestoreCategoryResponse.category:type_name -> product.Category
+	96,  // 41: product.ListWarehousesResponse.warehouses:type_name -> product.Warehouse
+	96,  // 42: product.WarehouseResponse.warehouse:type_name -> product.Warehouse
+	38,  // 43: product.TransferStockResponse.movements:type_name -> product.StockMovement
+	108, // 44: product.StockAvailabilityResponse.levels:type_name -> product.WarehouseStockLevel
+	110, // 45: product.AllocateWarehouseRequest.items:type_name -> product.AllocationItem
+	96,  // 46: product.AllocateWarehouseResponse.warehouse:type_name -> product.Warehouse
+	38,  // 47: product.AllocateWarehouseResponse.movements:type_name -> product.StockMovement
+	110, // 48: product.StockItemsRequest.items:type_name -> product.AllocationItem
+	38,  // 49: product.StockItemsResponse.movements:type_name -> product.StockMovement
+	125, // 50: product.GetCategoryByIDResponse.category:type_name -> product.Category
+	125, // 51: product.ListCategoriesResponse.categories:type_name -> product.Category
+	125, // 52: product.CategoryNode.category:type_name -> product.Category
+	126, // 53: product.CategoryNode.children:type_name -> product.CategoryNode
+	126, // 54: product.GetCategoryTreeResponse.roots:type_name -> product.CategoryNode
+	125, // 55: product.ProductCategoriesResponse.categories:type_name -> product.Category
+	135, // 56: product.ListProductsByCategoryRequest.attributes:type_name -> product.AttributeFilter
+	133, // 57: product.AttributeResponse.attribute:type_name -> product.Attribute
+	133, // 58: product.ListAttributesResponse.attributes:type_name -> product.Attribute
+	145, // 59: product.SetProductAttributesRequest.values:type_name -> product.ProductAttributeValue
+	134, // 60: product.SetProductAttributesResponse.attributes:type_name -> product.ProductAttribute
+	3,   // 61: product.ProductService.CreateProduct:input_type -> product.CreateProductRequest
+	7,   // 62: product.ProductService.GetProductByID:input_type -> product.GetProductByIDRequest
+	9,   // 63: product.ProductService.GetProductsByIDs:input_type -> product.GetProductsByIDsRequest
+	11,  // 64: product.ProductService.ListProducts:input_type -> product.ListProductsRequest
+	5,   // 65: product.ProductService.GetCacheStats:input_type -> product.GetCacheStatsRequest
+	13,  // 66: product.ProductService.UpdateProduct:input_type -> product.UpdateProductRequest
+	15,  // 67: product.ProductService.DeleteProduct:input_type -> product.DeleteProductRequest
+	17,  // 68: product.ProductService.SearchProducts:input_type -> product.SearchProductsRequest
+	115, // 69: product.ProductService.CreateCategory:input_type -> product.CreateCategoryRequest
+	117, // 70: product.ProductService.GetCategoryByID:input_type -> product.GetCategoryByIDRequest
+	119, // 71: product.ProductService.ListCategories:input_type -> product.ListCategoriesRequest
+	121, // 72: product.ProductService.UpdateCategory:input_type -> product.UpdateCategoryRequest
+	123, // 73: product.ProductService.DeleteCategory:input_type -> product.DeleteCategoryRequest
+	127, // 74: product.ProductService.GetCategoryTree:input_type -> product.GetCategoryTreeRequest
+	129, // 75: product.ProductService.AssignProductCategories:input_type -> product.AssignProductCategoriesRequest
+	130, // 76: product.ProductService.UnassignProductCategories:input_type -> product.UnassignProductCategoriesRequest
+	132, // 77: product.ProductService.ListProductsByCategory:input_type -> product.ListProductsByCategoryRequest
+	26,  // 78: product.ProductService.CreateProductOption:input_type -> product.CreateProductOptionRequest
+	28,  // 79: product.ProductService.DeleteProductOption:input_type -> product.DeleteProductOptionRequest
+	30,  // 80: product.ProductService.CreateProductVariant:input_type -> product.CreateProductVariantRequest
+	31,  // 81: product.ProductService.GetProductVariant:input_type -> product.GetProductVariantRequest
+	32,  // 82: product.ProductService.UpdateProductVariant:input_type -> product.UpdateProductVariantRequest
+	34,  // 83: product.ProductService.DeleteProductVariant:input_type -> product.DeleteProductVariantRequest
+	36,  // 84: product.ProductService.RestockProduct:input_type -> product.RestockProductRequest
+	37,  // 85: product.ProductService.RecordStockMovement:input_type -> product.RecordStockMovementRequest
+	40,  // 86: product.ProductService.ListStockMovements:input_type -> product.ListStockMovementsRequest
+	97,  // 87: product.ProductService.CreateWarehouse:input_type -> product.CreateWarehouseRequest
+	98,  // 88: product.ProductService.GetWarehouse:input_type -> product.GetWarehouseRequest
+	99,  // 89: product.ProductService.ListWarehouses:input_type -> product.ListWarehousesRequest
+	101, // 90: product.ProductService.UpdateWarehouse:input_type -> product.UpdateWarehouseRequest
+	103, // 91: product.ProductService.DeleteWarehouse:input_type -> product.DeleteWarehouseRequest
+	105, // 92: product.ProductService.TransferStock:input_type -> product.TransferStockRequest
+	107, // 93: product.ProductService.GetStockAvailability:input_type -> product.GetStockAvailabilityRequest
+	111, // 94: product.ProductService.AllocateWarehouse:input_type -> product.AllocateWarehouseRequest
+	113, // 95: product.ProductService.TakeStock:input_type -> product.StockItemsRequest
+	113, // 96: product.ProductService.ReturnStock:input_type -> product.StockItemsRequest
+	42,  // 97: product.ProductService.SetReorderThreshold:input_type -> product.SetReorderThresholdRequest
+	44,  // 98: product.ProductService.SetMaxPerOrder:input_type -> product.SetMaxPerOrderRequest
+	46,  // 99: product.ProductService.ListLowStockProducts:input_type -> product.ListLowStockProductsRequest
+	49,  // 100: product.ProductService.ImportProducts:input_type -> product.ImportProductsRequest
+	52,  // 101: product.ProductService.ExportProducts:input_type -> product.ExportProductsRequest
+	55,  // 102: product.ProductService.UploadProductImage:input_type -> product.UploadProductImageRequest
+	57,  // 103: product.ProductService.ListProductImages:input_type -> product.ListProductImagesRequest
+	59,  // 104: product.ProductService.UpdateProductImage:input_type -> product.UpdateProductImageRequest
+	60,  // 105: product.ProductService.ReorderProductImages:input_type -> product.ReorderProductImagesRequest
+	61,  // 106: product.ProductService.DeleteProductImage:input_type -> product.DeleteProductImageRequest
+	64,  // 107: product.ProductService.CreateReview:input_type -> product.CreateReviewRequest
+	66,  // 108: product.ProductService.ListProductReviews:input_type -> product.ListProductReviewsRequest
+	67,  // 109: product.ProductService.ListReviews:input_type -> product.ListReviewsRequest
+	69,  // 110: product.ProductService.UpdateReview:input_type -> product.UpdateReviewRequest
+	70,  // 111: product.ProductService.DeleteReview:input_type -> product.DeleteReviewRequest
+	72,  // 112: product.ProductService.ModerateReview:input_type -> product.ModerateReviewRequest
+	74,  // 113: product.ProductService.SchedulePriceChange:input_type -> product.SchedulePriceChangeRequest
+	76,  // 114: product.ProductService.CancelScheduledPriceChange:input_type -> product.CancelScheduledPriceChangeRequest
+	77,  // 115: product.ProductService.ListScheduledPriceChanges:input_type -> product.ListScheduledPriceChangesRequest
+	80,  // 116: product.ProductService.GetPriceTimeline:input_type -> product.GetPriceTimelineRequest
+	84,  // 117: product.ProductService.ListTrashedProducts:input_type -> product.ListTrashedProductsRequest
+	86,  // 118: product.ProductService.ListTrashedCategories:input_type -> product.ListTrashedCategoriesRequest
+	88,  // 119: product.ProductService.RestoreProduct:input_type -> product.RestoreProductRequest
+	90,  // 120: product.ProductService.RestoreCategory:input_type -> product.RestoreCategoryRequest
+	92,  // 121: product.ProductService.PurgeProduct:input_type -> product.PurgeProductRequest
+	94,  // 122: product.ProductService.PurgeCategory:input_type -> product.PurgeCategoryRequest
+	136, // 123: product.ProductService.CreateAttribute:input_type -> product.CreateAttributeRequest
+	138, // 124: product.ProductService.ListAttributes:input_type -> product.ListAttributesRequest
+	140, // 125: product.ProductService.DeleteAttribute:input_type -> product.DeleteAttributeRequest
+	142, // 126: product.ProductService.AttachCategoryAttribute:input_type -> product.AttachCategoryAttributeRequest
+	143, // 127: product.ProductService.DetachCategoryAttribute:input_type -> product.DetachCategoryAttributeRequest
+	146, // 128: product.ProductService.SetProductAttributes:input_type -> product.SetProductAttributesRequest
+	4,   // 129: product.ProductService.CreateProduct:output_type -> product.CreateProductResponse
+	8,   // 130: product.ProductService.GetProductByID:output_type -> product.GetProductByIDResponse
+	10,  // 131: product.ProductService.GetProductsByIDs:output_type -> product.GetProductsByIDsResponse
+	12,  // 132: product.ProductService.ListProducts:output_type -> product.ListProductsResponse
+	6,   // 133: product.ProductService.GetCacheStats:output_type -> product.GetCacheStatsResponse
+	14,  // 134: product.ProductService.UpdateProduct:output_type -> product.UpdateProductResponse
+	16,  // 135: product.ProductService.DeleteProduct:output_type -> product.DeleteProductResponse
+	18,  // 136: product.ProductService.SearchProducts:output_type -> product.SearchProductsResponse
+	116, // 137: product.ProductService.CreateCategory:output_type -> product.CreateCategoryResponse
+	118, // 138: product.ProductService.GetCategoryByID:output_type -> product.GetCategoryByIDResponse
+	120, // 139: product.ProductService.ListCategories:output_type -> product.ListCategoriesResponse
+	122, // 140: product.ProductService.UpdateCategory:output_type -> product.UpdateCategoryResponse
+	124, // 141: product.ProductService.DeleteCategory:output_type -> product.DeleteCategoryResponse
+	128, // 142: product.ProductService.GetCategoryTree:output_type -> product.GetCategoryTreeResponse
+	131, // 143: product.ProductService.AssignProductCategories:output_type -> product.ProductCategoriesResponse
+	131, // 144: product.ProductService.UnassignProductCategories:output_type -> product.ProductCategoriesResponse
+	12,  // 145: product.ProductService.ListProductsByCategory:output_type -> product.ListProductsResponse
+	27,  // 146: product.ProductService.CreateProductOption:output_type -> product.ProductOptionResponse
+	29,  // 147: product.ProductService.DeleteProductOption:output_type -> product.DeleteProductOptionResponse
+	33,  // 148: product.ProductService.CreateProductVariant:output_type -> product.ProductVariantResponse
+	33,  // 149: product.ProductService.GetProductVariant:output_type -> product.ProductVariantResponse
+	33,  // 150: product.ProductService.UpdateProductVariant:output_type -> product.ProductVariantResponse
+	35,  // 151: product.ProductService.DeleteProductVariant:output_type -> product.DeleteProductVariantResponse
+	39,  // 152: product.ProductService.RestockProduct:output_type -> product.StockMovementResponse
+	39,  // 153: product.ProductService.RecordStockMovement:output_type -> product.StockMovementResponse
+	41,  // 154: product.ProductService.ListStockMovements:output_type -> product.ListStockMovementsResponse
+	102, // 155: product.ProductService.CreateWarehouse:output_type -> product.WarehouseResponse
+	102, // 156: product.ProductService.GetWarehouse:output_type -> product.WarehouseResponse
+	100, // 157: product.ProductService.ListWarehouses:output_type -> product.ListWarehousesResponse
+	102, // 158: product.ProductService.UpdateWarehouse:output_type -> product.WarehouseResponse
+	104, // 159: product.ProductService.DeleteWarehouse:output_type -> product.DeleteWarehouseResponse
+	106, // 160: product.ProductService.TransferStock:output_type -> product.TransferStockResponse
+	109, // 161: product.ProductService.GetStockAvailability:output_type -> product.StockAvailabilityResponse
+	112, // 162: product.ProductService.AllocateWarehouse:output_type -> product.AllocateWarehouseResponse
+	114, // 163: product.ProductService.TakeStock:output_type -> product.StockItemsResponse
+	114, // 164: product.ProductService.ReturnStock:output_type -> product.StockItemsResponse
+	43,  // 165: product.ProductService.SetReorderThreshold:output_type -> product.SetReorderThresholdResponse
+	45,  // 166: product.ProductService.SetMaxPerOrder:output_type -> product.SetMaxPerOrderResponse
+	48,  // 167: product.ProductService.ListLowStockProducts:output_type -> product.ListLowStockProductsResponse
+	51,  // 168: product.ProductService.ImportProducts:output_type -> product.ImportProductsResponse
+	53,  // 169: product.ProductService.ExportProducts:output_type -> product.ExportProductsChunk
+	56,  // 170: product.ProductService.UploadProductImage:output_type -> product.ProductImageResponse
+	58,  // 171: product.ProductService.ListProductImages:output_type -> product.ListProductImagesResponse
+	56,  // 172: product.ProductService.UpdateProductImage:output_type -> product.ProductImageResponse
+	58,  // 173: product.ProductService.ReorderProductImages:output_type -> product.ListProductImagesResponse
+	62,  // 174: product.ProductService.DeleteProductImage:output_type -> product.DeleteProductImageResponse
+	65,  // 175: product.ProductService.CreateReview:output_type -> product.ReviewResponse
+	68,  // 176: product.ProductService.ListProductReviews:output_type -> product.ListReviewsResponse
+	68,  // 177: product.ProductService.ListReviews:output_type -> product.ListReviewsResponse
+	65,  // 178: product.ProductService.UpdateReview:output_type -> product.ReviewResponse
+	71,  // 179: product.ProductService.DeleteReview:output_type -> product.DeleteReviewResponse
+	65,  // 180: product.ProductService.ModerateReview:output_type -> product.ReviewResponse
+	75,  // 181: product.ProductService.SchedulePriceChange:output_type -> product.ScheduledPriceChangeResponse
+	75,  // 182: product.ProductService.CancelScheduledPriceChange:output_type -> product.ScheduledPriceChangeResponse
+	78,  // 183: product.ProductService.ListScheduledPriceChanges:output_type -> product.ListScheduledPriceChangesResponse
+	81,  // 184: product.ProductService.GetPriceTimeline:output_type -> product.GetPriceTimelineResponse
+	85,  // 185: product.ProductService.ListTrashedProducts:output_type -> product.ListTrashedProductsResponse
+	87,  // 186: product.ProductService.ListTrashedCategories:output_type -> product.ListTrashedCategoriesResponse
+	89,  // 187: product.ProductService.RestoreProduct:output_type -> product.RestoreProductResponse
+	91,  // 188: product.ProductService.RestoreCategory:output_type -> product.RestoreCategoryResponse
+	93,  // 189: product.ProductService.PurgeProduct:output_type -> product.PurgeProductResponse
+	95,  // 190: product.ProductService.PurgeCategory:output_type -> product.PurgeCategoryResponse
+	137, // 191: product.ProductService.CreateAttribute:output_type -> product.AttributeResponse
+	139, // 192: product.ProductService.ListAttributes:output_type -> product.ListAttributesResponse
+	141, // 193: product.ProductService.DeleteAttribute:output_type -> product.DeleteAttributeResponse
+	139, // 194: product.ProductService.AttachCategoryAttribute:output_type -> product.ListAttributesResponse
+	144, // 195: product.ProductService.DetachCategoryAttribute:output_type -> product.DetachCategoryAttributeResponse
+	147, // 196: product.ProductService.SetProductAttributes:output_type -> product.SetProductAttributesResponse
+	129, // [129:197] is the sub-list for method output_type
+	61,  // [61:129] is the sub-list for method input_type
+	61,  // [61:61] is the sub-list for extension type_name
+	61,  // [61:61] is the sub-list for extension extendee
+	0,   // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_shared_proto_v1_product_proto_init() }
@@ -1493,13 +10461,21 @@ func file_shared_proto_v1_product_proto_init() {
 	if File_shared_proto_v1_product_proto != nil {
 		return
 	}
+	file_shared_proto_v1_product_proto_msgTypes[29].OneofWrappers = []any{}
+	file_shared_proto_v1_product_proto_msgTypes[35].OneofWrappers = []any{}
+	file_shared_proto_v1_product_proto_msgTypes[70].OneofWrappers = []any{}
+	file_shared_proto_v1_product_proto_msgTypes[71].OneofWrappers = []any{}
+	file_shared_proto_v1_product_proto_msgTypes[94].OneofWrappers = []any{}
+	file_shared_proto_v1_product_proto_msgTypes[98].OneofWrappers = []any{}
+	file_shared_proto_v1_product_proto_msgTypes[118].OneofWrappers = []any{}
+	file_shared_proto_v1_product_proto_msgTypes[132].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_shared_proto_v1_product_proto_rawDesc), len(file_shared_proto_v1_product_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   22,
+			NumEnums:      3,
+			NumMessages:   146,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,16 +19,74 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_CreateProduct_FullMethodName   = "/product.ProductService/CreateProduct"
-	ProductService_GetProductByID_FullMethodName  = "/product.ProductService/GetProductByID"
-	ProductService_ListProducts_FullMethodName    = "/product.ProductService/ListProducts"
-	ProductService_UpdateProduct_FullMethodName   = "/product.ProductService/UpdateProduct"
-	ProductService_DeleteProduct_FullMethodName   = "/product.ProductService/DeleteProduct"
-	ProductService_CreateCategory_FullMethodName  = "/product.ProductService/CreateCategory"
-	ProductService_GetCategoryByID_FullMethodName = "/product.ProductService/GetCategoryByID"
-	ProductService_ListCategories_FullMethodName  = "/product.ProductService/ListCategories"
-	ProductService_UpdateCategory_FullMethodName  = "/product.ProductService/UpdateCategory"
-	ProductService_DeleteCategory_FullMethodName  = "/product.ProductService/DeleteCategory"
+	ProductService_CreateProduct_FullMethodName              = "/product.ProductService/CreateProduct"
+	ProductService_GetProductByID_FullMethodName             = "/product.ProductService/GetProductByID"
+	ProductService_GetProductsByIDs_FullMethodName           = "/product.ProductService/GetProductsByIDs"
+	ProductService_ListProducts_FullMethodName               = "/product.ProductService/ListProducts"
+	ProductService_GetCacheStats_FullMethodName              = "/product.ProductService/GetCacheStats"
+	ProductService_UpdateProduct_FullMethodName              = "/product.ProductService/UpdateProduct"
+	ProductService_DeleteProduct_FullMethodName              = "/product.ProductService/DeleteProduct"
+	ProductService_SearchProducts_FullMethodName             = "/product.ProductService/SearchProducts"
+	ProductService_CreateCategory_FullMethodName             = "/product.ProductService/CreateCategory"
+	ProductService_GetCategoryByID_FullMethodName            = "/product.ProductService/GetCategoryByID"
+	ProductService_ListCategories_FullMethodName             = "/product.ProductService/ListCategories"
+	ProductService_UpdateCategory_FullMethodName             = "/product.ProductService/UpdateCategory"
+	ProductService_DeleteCategory_FullMethodName             = "/product.ProductService/DeleteCategory"
+	ProductService_GetCategoryTree_FullMethodName            = "/product.ProductService/GetCategoryTree"
+	ProductService_AssignProductCategories_FullMethodName    = "/product.ProductService/AssignProductCategories"
+	ProductService_UnassignProductCategories_FullMethodName  = "/product.ProductService/UnassignProductCategories"
+	ProductService_ListProductsByCategory_FullMethodName     = "/product.ProductService/ListProductsByCategory"
+	ProductService_CreateProductOption_FullMethodName        = "/product.ProductService/CreateProductOption"
+	ProductService_DeleteProductOption_FullMethodName        = "/product.ProductService/DeleteProductOption"
+	ProductService_CreateProductVariant_FullMethodName       = "/product.ProductService/CreateProductVariant"
+	ProductService_GetProductVariant_FullMethodName          = "/product.ProductService/GetProductVariant"
+	ProductService_UpdateProductVariant_FullMethodName       = "/product.ProductService/UpdateProductVariant"
+	ProductService_DeleteProductVariant_FullMethodName       = "/product.ProductService/DeleteProductVariant"
+	ProductService_RestockProduct_FullMethodName             = "/product.ProductService/RestockProduct"
+	ProductService_RecordStockMovement_FullMethodName        = "/product.ProductService/RecordStockMovement"
+	ProductService_ListStockMovements_FullMethodName         = "/product.ProductService/ListStockMovements"
+	ProductService_CreateWarehouse_FullMethodName            = "/product.ProductService/CreateWarehouse"
+	ProductService_GetWarehouse_FullMethodName               = "/product.ProductService/GetWarehouse"
+	ProductService_ListWarehouses_FullMethodName             = "/product.ProductService/ListWarehouses"
+	ProductService_UpdateWarehouse_FullMethodName            = "/product.ProductService/UpdateWarehouse"
+	ProductService_DeleteWarehouse_FullMethodName            = "/product.ProductService/DeleteWarehouse"
+	ProductService_TransferStock_FullMethodName              = "/product.ProductService/TransferStock"
+	ProductService_GetStockAvailability_FullMethodName       = "/product.ProductService/GetStockAvailability"
+	ProductService_AllocateWarehouse_FullMethodName          = "/product.ProductService/AllocateWarehouse"
+	ProductService_TakeStock_FullMethodName                  = "/product.ProductService/TakeStock"
+	ProductService_ReturnStock_FullMethodName                = "/product.ProductService/ReturnStock"
+	ProductService_SetReorderThreshold_FullMethodName        = "/product.ProductService/SetReorderThreshold"
+	ProductService_SetMaxPerOrder_FullMethodName             = "/product.ProductService/SetMaxPerOrder"
+	ProductService_ListLowStockProducts_FullMethodName       = "/product.ProductService/ListLowStockProducts"
+	ProductService_ImportProducts_FullMethodName             = "/product.ProductService/ImportProducts"
+	ProductService_ExportProducts_FullMethodName             = "/product.ProductService/ExportProducts"
+	ProductService_UploadProductImage_FullMethodName         = "/product.ProductService/UploadProductImage"
+	ProductService_ListProductImages_FullMethodName          = "/product.ProductService/ListProductImages"
+	ProductService_UpdateProductImage_FullMethodName         = "/product.ProductService/UpdateProductImage"
+	ProductService_ReorderProductImages_FullMethodName       = "/product.ProductService/ReorderProductImages"
+	ProductService_DeleteProductImage_FullMethodName         = "/product.ProductService/DeleteProductImage"
+	ProductService_CreateReview_FullMethodName               = "/product.ProductService/CreateReview"
+	ProductService_ListProductReviews_FullMethodName         = "/product.ProductService/ListProductReviews"
+	ProductService_ListReviews_FullMethodName                = "/product.ProductService/ListReviews"
+	ProductService_UpdateReview_FullMethodName               = "/product.ProductService/UpdateReview"
+	ProductService_DeleteReview_FullMethodName               = "/product.ProductService/DeleteReview"
+	ProductService_ModerateReview_FullMethodName             = "/product.ProductService/ModerateReview"
+	ProductService_SchedulePriceChange_FullMethodName        = "/product.ProductService/SchedulePriceChange"
+	ProductService_CancelScheduledPriceChange_FullMethodName = "/product.ProductService/CancelScheduledPriceChange"
+	ProductService_ListScheduledPriceChanges_FullMethodName  = "/product.ProductService/ListScheduledPriceChanges"
+	ProductService_GetPriceTimeline_FullMethodName           = "/product.ProductService/GetPriceTimeline"
+	ProductService_ListTrashedProducts_FullMethodName        = "/product.ProductService/ListTrashedProducts"
+	ProductService_ListTrashedCategories_FullMethodName      = "/product.ProductService/ListTrashedCategories"
+	ProductService_RestoreProduct_FullMethodName             = "/product.ProductService/RestoreProduct"
+	ProductService_RestoreCategory_FullMethodName            = "/product.ProductService/RestoreCategory"
+	ProductService_PurgeProduct_FullMethodName               = "/product.ProductService/PurgeProduct"
+	ProductService_PurgeCategory_FullMethodName              = "/product.ProductService/PurgeCategory"
+	ProductService_CreateAttribute_FullMethodName            = "/product.ProductService/CreateAttribute"
+	ProductService_ListAttributes_FullMethodName             = "/product.ProductService/ListAttributes"
+	ProductService_DeleteAttribute_FullMethodName            = "/product.ProductService/DeleteAttribute"
+	ProductService_AttachCategoryAttribute_FullMethodName    = "/product.ProductService/AttachCategoryAttribute"
+	ProductService_DetachCategoryAttribute_FullMethodName    = "/product.ProductService/DetachCategoryAttribute"
+	ProductService_SetProductAttributes_FullMethodName       = "/product.ProductService/SetProductAttributes"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -41,12 +99,18 @@ type ProductServiceClient interface {
 	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error)
 	// retrieve product by id
 	GetProductByID(ctx context.Context, in *GetProductByIDRequest, opts ...grpc.CallOption) (*GetProductByIDResponse, error)
+	// retrieve several products in one call; unknown ids are reported in missing_ids
+	GetProductsByIDs(ctx context.Context, in *GetProductsByIDsRequest, opts ...grpc.CallOption) (*GetProductsByIDsResponse, error)
 	// lists product with pagination
 	ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	// reports the product cache hit and miss counters of the serving instance
+	GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*GetCacheStatsResponse, error)
 	// updates product
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
 	// delete specific product
 	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
+	// full-text search with filters, sorting and facet counts
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error)
 	// creates new category
 	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
 	// retrieve category by id
@@ -57,6 +121,116 @@ type ProductServiceClient interface {
 	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error)
 	// delete specific category
 	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	// returns the full category tree for navigation
+	GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error)
+	// assigns categories to a product
+	AssignProductCategories(ctx context.Context, in *AssignProductCategoriesRequest, opts ...grpc.CallOption) (*ProductCategoriesResponse, error)
+	// removes categories from a product
+	UnassignProductCategories(ctx context.Context, in *UnassignProductCategoriesRequest, opts ...grpc.CallOption) (*ProductCategoriesResponse, error)
+	// lists products in a category, optionally including its descendants
+	ListProductsByCategory(ctx context.Context, in *ListProductsByCategoryRequest, opts ...grpc.CallOption) (*ListProductsResponse, error)
+	// adds a selectable option such as size or color to a product
+	CreateProductOption(ctx context.Context, in *CreateProductOptionRequest, opts ...grpc.CallOption) (*ProductOptionResponse, error)
+	// removes an option from a product that has no variants yet
+	DeleteProductOption(ctx context.Context, in *DeleteProductOptionRequest, opts ...grpc.CallOption) (*DeleteProductOptionResponse, error)
+	// creates a variant (SKU) for one combination of option values
+	CreateProductVariant(ctx context.Context, in *CreateProductVariantRequest, opts ...grpc.CallOption) (*ProductVariantResponse, error)
+	// retrieve variant by id with its effective price
+	GetProductVariant(ctx context.Context, in *GetProductVariantRequest, opts ...grpc.CallOption) (*ProductVariantResponse, error)
+	// updates variant sku, price override, stock or image
+	UpdateProductVariant(ctx context.Context, in *UpdateProductVariantRequest, opts ...grpc.CallOption) (*ProductVariantResponse, error)
+	// delete specific variant
+	DeleteProductVariant(ctx context.Context, in *DeleteProductVariantRequest, opts ...grpc.CallOption) (*DeleteProductVariantResponse, error)
+	// adds stock to a product or variant and records a restock movement
+	RestockProduct(ctx context.Context, in *RestockProductRequest, opts ...grpc.CallOption) (*StockMovementResponse, error)
+	// records a stock movement (sale, reservation, release, return or adjustment)
+	RecordStockMovement(ctx context.Context, in *RecordStockMovementRequest, opts ...grpc.CallOption) (*StockMovementResponse, error)
+	// lists the stock movement history of a product
+	ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error)
+	// creates a warehouse (stock location)
+	CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*WarehouseResponse, error)
+	// retrieve warehouse by id
+	GetWarehouse(ctx context.Context, in *GetWarehouseRequest, opts ...grpc.CallOption) (*WarehouseResponse, error)
+	// lists warehouses ordered by priority
+	ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...grpc.CallOption) (*ListWarehousesResponse, error)
+	// updates warehouse details, location or active flag
+	UpdateWarehouse(ctx context.Context, in *UpdateWarehouseRequest, opts ...grpc.CallOption) (*WarehouseResponse, error)
+	// delete a warehouse that holds no stock
+	DeleteWarehouse(ctx context.Context, in *DeleteWarehouseRequest, opts ...grpc.CallOption) (*DeleteWarehouseResponse, error)
+	// moves stock between two warehouses
+	TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error)
+	// returns stock of a product or variant summed across warehouses
+	GetStockAvailability(ctx context.Context, in *GetStockAvailabilityRequest, opts ...grpc.CallOption) (*StockAvailabilityResponse, error)
+	// picks the warehouse that fulfills an order by the allocation strategy
+	AllocateWarehouse(ctx context.Context, in *AllocateWarehouseRequest, opts ...grpc.CallOption) (*AllocateWarehouseResponse, error)
+	// takes more stock from the warehouse an order was allocated, in one transaction
+	TakeStock(ctx context.Context, in *StockItemsRequest, opts ...grpc.CallOption) (*StockItemsResponse, error)
+	// puts stock taken by AllocateWarehouse or TakeStock back, in one transaction
+	ReturnStock(ctx context.Context, in *StockItemsRequest, opts ...grpc.CallOption) (*StockItemsResponse, error)
+	// sets the stock level below which a product is reported as low
+	SetReorderThreshold(ctx context.Context, in *SetReorderThresholdRequest, opts ...grpc.CallOption) (*SetReorderThresholdResponse, error)
+	// caps the quantity of a product per cart or order
+	SetMaxPerOrder(ctx context.Context, in *SetMaxPerOrderRequest, opts ...grpc.CallOption) (*SetMaxPerOrderResponse, error)
+	// lists products and variants below their reorder threshold with reorder suggestions
+	ListLowStockProducts(ctx context.Context, in *ListLowStockProductsRequest, opts ...grpc.CallOption) (*ListLowStockProductsResponse, error)
+	// creates or updates products by sku or name from a streamed CSV or NDJSON file
+	ImportProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportProductsRequest, ImportProductsResponse], error)
+	// streams the catalog as CSV or NDJSON
+	ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductsChunk], error)
+	// uploads a product image in chunks, validates it and stores it with a thumbnail
+	UploadProductImage(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadProductImageRequest, ProductImageResponse], error)
+	// lists the images of a product in display order
+	ListProductImages(ctx context.Context, in *ListProductImagesRequest, opts ...grpc.CallOption) (*ListProductImagesResponse, error)
+	// updates the alt text of an image
+	UpdateProductImage(ctx context.Context, in *UpdateProductImageRequest, opts ...grpc.CallOption) (*ProductImageResponse, error)
+	// sets the display order of all images of a product
+	ReorderProductImages(ctx context.Context, in *ReorderProductImagesRequest, opts ...grpc.CallOption) (*ListProductImagesResponse, error)
+	// deletes an image and its stored files
+	DeleteProductImage(ctx context.Context, in *DeleteProductImageRequest, opts ...grpc.CallOption) (*DeleteProductImageResponse, error)
+	// creates a review, only for users with a delivered order of the product
+	CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	// lists the approved reviews of a product
+	ListProductReviews(ctx context.Context, in *ListProductReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	// lists reviews in any status, filtered by product, user or status
+	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	// updates a review and sends it back to moderation
+	UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	// deletes a review
+	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error)
+	// approves or rejects a review
+	ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error)
+	// schedules a price or discount change applied at its effective time
+	SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*ScheduledPriceChangeResponse, error)
+	// cancels a pending scheduled price change
+	CancelScheduledPriceChange(ctx context.Context, in *CancelScheduledPriceChangeRequest, opts ...grpc.CallOption) (*ScheduledPriceChangeResponse, error)
+	// lists scheduled price changes, filtered by product or status
+	ListScheduledPriceChanges(ctx context.Context, in *ListScheduledPriceChangesRequest, opts ...grpc.CallOption) (*ListScheduledPriceChangesResponse, error)
+	// returns the past prices of a product and its pending changes
+	GetPriceTimeline(ctx context.Context, in *GetPriceTimelineRequest, opts ...grpc.CallOption) (*GetPriceTimelineResponse, error)
+	// lists soft-deleted products, most recently deleted first
+	ListTrashedProducts(ctx context.Context, in *ListTrashedProductsRequest, opts ...grpc.CallOption) (*ListTrashedProductsResponse, error)
+	// lists soft-deleted categories, most recently deleted first
+	ListTrashedCategories(ctx context.Context, in *ListTrashedCategoriesRequest, opts ...grpc.CallOption) (*ListTrashedCategoriesResponse, error)
+	// takes a product out of the trash
+	RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductResponse, error)
+	// takes a category out of the trash; its parent must not be trashed
+	RestoreCategory(ctx context.Context, in *RestoreCategoryRequest, opts ...grpc.CallOption) (*RestoreCategoryResponse, error)
+	// permanently deletes a trashed product
+	PurgeProduct(ctx context.Context, in *PurgeProductRequest, opts ...grpc.CallOption) (*PurgeProductResponse, error)
+	// permanently deletes a trashed category without children
+	PurgeCategory(ctx context.Context, in *PurgeCategoryRequest, opts ...grpc.CallOption) (*PurgeCategoryResponse, error)
+	// defines a typed product attribute such as ram_gb or color
+	CreateAttribute(ctx context.Context, in *CreateAttributeRequest, opts ...grpc.CallOption) (*AttributeResponse, error)
+	// lists every attribute, or those applying to a category including inherited ones
+	ListAttributes(ctx context.Context, in *ListAttributesRequest, opts ...grpc.CallOption) (*ListAttributesResponse, error)
+	// deletes an attribute with its category attachments and product values
+	DeleteAttribute(ctx context.Context, in *DeleteAttributeRequest, opts ...grpc.CallOption) (*DeleteAttributeResponse, error)
+	// attaches an attribute to a category and its descendants
+	AttachCategoryAttribute(ctx context.Context, in *AttachCategoryAttributeRequest, opts ...grpc.CallOption) (*ListAttributesResponse, error)
+	// detaches an attribute from a category
+	DetachCategoryAttribute(ctx context.Context, in *DetachCategoryAttributeRequest, opts ...grpc.CallOption) (*DetachCategoryAttributeResponse, error)
+	// replaces the attribute values of a product
+	SetProductAttributes(ctx context.Context, in *SetProductAttributesRequest, opts ...grpc.CallOption) (*SetProductAttributesResponse, error)
 }
 
 type productServiceClient struct {
@@ -87,6 +261,16 @@ func (c *productServiceClient) GetProductByID(ctx context.Context, in *GetProduc
 	return out, nil
 }
 
+func (c *productServiceClient) GetProductsByIDs(ctx context.Context, in *GetProductsByIDsRequest, opts ...grpc.CallOption) (*GetProductsByIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductsByIDsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductsByIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) ListProducts(ctx context.Context, in *ListProductsRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProductsResponse)
@@ -97,6 +281,16 @@ func (c *productServiceClient) ListProducts(ctx context.Context, in *ListProduct
 	return out, nil
 }
 
+func (c *productServiceClient) GetCacheStats(ctx context.Context, in *GetCacheStatsRequest, opts ...grpc.CallOption) (*GetCacheStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCacheStatsResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetCacheStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateProductResponse)
@@ -117,6 +311,16 @@ func (c *productServiceClient) DeleteProduct(ctx context.Context, in *DeleteProd
 	return out, nil
 }
 
+func (c *productServiceClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SearchProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateCategoryResponse)
@@ -167,269 +371,2139 @@ func (c *productServiceClient) DeleteCategory(ctx context.Context, in *DeleteCat
 	return out, nil
 }
 
-// ProductServiceServer is the server API for ProductService service.
-// All implementations must embed UnimplementedProductServiceServer
-// for forward compatibility.
-//
-// ProductService provides operations for managing products.
-type ProductServiceServer interface {
-	// creates new product
-	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error)
-	// retrieve product by id
-	GetProductByID(context.Context, *GetProductByIDRequest) (*GetProductByIDResponse, error)
-	// lists product with pagination
-	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
-	// updates product
-	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
-	// delete specific product
-	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
-	// creates new category
-	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
-	// retrieve category by id
-	GetCategoryByID(context.Context, *GetCategoryByIDRequest) (*GetCategoryByIDResponse, error)
-	// lists category with pagination
-	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
-	// updates category
-	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
-	// delete specific category
-	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
-	mustEmbedUnimplementedProductServiceServer()
+func (c *productServiceClient) GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryTreeResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetCategoryTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedProductServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedProductServiceServer struct{}
+func (c *productServiceClient) AssignProductCategories(ctx context.Context, in *AssignProductCategoriesRequest, opts ...grpc.CallOption) (*ProductCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductCategoriesResponse)
+	err := c.cc.Invoke(ctx, ProductService_AssignProductCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+func (c *productServiceClient) UnassignProductCategories(ctx context.Context, in *UnassignProductCategoriesRequest, opts ...grpc.CallOption) (*ProductCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductCategoriesResponse)
+	err := c.cc.Invoke(ctx, ProductService_UnassignProductCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetProductByID(context.Context, *GetProductByIDRequest) (*GetProductByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProductByID not implemented")
+
+func (c *productServiceClient) ListProductsByCategory(ctx context.Context, in *ListProductsByCategoryRequest, opts ...grpc.CallOption) (*ListProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductsByCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+
+func (c *productServiceClient) CreateProductOption(ctx context.Context, in *CreateProductOptionRequest, opts ...grpc.CallOption) (*ProductOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductOptionResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateProductOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+
+func (c *productServiceClient) DeleteProductOption(ctx context.Context, in *DeleteProductOptionRequest, opts ...grpc.CallOption) (*DeleteProductOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductOptionResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProductOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+
+func (c *productServiceClient) CreateProductVariant(ctx context.Context, in *CreateProductVariantRequest, opts ...grpc.CallOption) (*ProductVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateProductVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+
+func (c *productServiceClient) GetProductVariant(ctx context.Context, in *GetProductVariantRequest, opts ...grpc.CallOption) (*ProductVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetProductVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedProductServiceServer) GetCategoryByID(context.Context, *GetCategoryByIDRequest) (*GetCategoryByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryByID not implemented")
+
+func (c *productServiceClient) UpdateProductVariant(ctx context.Context, in *UpdateProductVariantRequest, opts ...grpc.CallOption) (*ProductVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateProductVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProductVariant(ctx context.Context, in *DeleteProductVariantRequest, opts ...grpc.CallOption) (*DeleteProductVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProductVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RestockProduct(ctx context.Context, in *RestockProductRequest, opts ...grpc.CallOption) (*StockMovementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockMovementResponse)
+	err := c.cc.Invoke(ctx, ProductService_RestockProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RecordStockMovement(ctx context.Context, in *RecordStockMovementRequest, opts ...grpc.CallOption) (*StockMovementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockMovementResponse)
+	err := c.cc.Invoke(ctx, ProductService_RecordStockMovement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStockMovementsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListStockMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*WarehouseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarehouseResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetWarehouse(ctx context.Context, in *GetWarehouseRequest, opts ...grpc.CallOption) (*WarehouseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarehouseResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...grpc.CallOption) (*ListWarehousesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWarehousesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListWarehouses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateWarehouse(ctx context.Context, in *UpdateWarehouseRequest, opts ...grpc.CallOption) (*WarehouseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WarehouseResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteWarehouse(ctx context.Context, in *DeleteWarehouseRequest, opts ...grpc.CallOption) (*DeleteWarehouseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWarehouseResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferStockResponse)
+	err := c.cc.Invoke(ctx, ProductService_TransferStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetStockAvailability(ctx context.Context, in *GetStockAvailabilityRequest, opts ...grpc.CallOption) (*StockAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockAvailabilityResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetStockAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AllocateWarehouse(ctx context.Context, in *AllocateWarehouseRequest, opts ...grpc.CallOption) (*AllocateWarehouseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AllocateWarehouseResponse)
+	err := c.cc.Invoke(ctx, ProductService_AllocateWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) TakeStock(ctx context.Context, in *StockItemsRequest, opts ...grpc.CallOption) (*StockItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockItemsResponse)
+	err := c.cc.Invoke(ctx, ProductService_TakeStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReturnStock(ctx context.Context, in *StockItemsRequest, opts ...grpc.CallOption) (*StockItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockItemsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReturnStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetReorderThreshold(ctx context.Context, in *SetReorderThresholdRequest, opts ...grpc.CallOption) (*SetReorderThresholdResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetReorderThresholdResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetReorderThreshold_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetMaxPerOrder(ctx context.Context, in *SetMaxPerOrderRequest, opts ...grpc.CallOption) (*SetMaxPerOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMaxPerOrderResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetMaxPerOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListLowStockProducts(ctx context.Context, in *ListLowStockProductsRequest, opts ...grpc.CallOption) (*ListLowStockProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLowStockProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListLowStockProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ImportProducts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportProductsRequest, ImportProductsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[0], ProductService_ImportProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportProductsRequest, ImportProductsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ImportProductsClient = grpc.ClientStreamingClient[ImportProductsRequest, ImportProductsResponse]
+
+func (c *productServiceClient) ExportProducts(ctx context.Context, in *ExportProductsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportProductsChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[1], ProductService_ExportProducts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportProductsRequest, ExportProductsChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ExportProductsClient = grpc.ServerStreamingClient[ExportProductsChunk]
+
+func (c *productServiceClient) UploadProductImage(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadProductImageRequest, ProductImageResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProductService_ServiceDesc.Streams[2], ProductService_UploadProductImage_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadProductImageRequest, ProductImageResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_UploadProductImageClient = grpc.ClientStreamingClient[UploadProductImageRequest, ProductImageResponse]
+
+func (c *productServiceClient) ListProductImages(ctx context.Context, in *ListProductImagesRequest, opts ...grpc.CallOption) (*ListProductImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductImagesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateProductImage(ctx context.Context, in *UpdateProductImageRequest, opts ...grpc.CallOption) (*ProductImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductImageResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ReorderProductImages(ctx context.Context, in *ReorderProductImagesRequest, opts ...grpc.CallOption) (*ListProductImagesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductImagesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ReorderProductImages_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteProductImage(ctx context.Context, in *DeleteProductImageRequest, opts ...grpc.CallOption) (*DeleteProductImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductImageResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteProductImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateReview(ctx context.Context, in *CreateReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListProductReviews(ctx context.Context, in *ListProductReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListProductReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateReview(ctx context.Context, in *UpdateReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, ProductService_UpdateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReviewResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*ReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewResponse)
+	err := c.cc.Invoke(ctx, ProductService_ModerateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*ScheduledPriceChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledPriceChangeResponse)
+	err := c.cc.Invoke(ctx, ProductService_SchedulePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CancelScheduledPriceChange(ctx context.Context, in *CancelScheduledPriceChangeRequest, opts ...grpc.CallOption) (*ScheduledPriceChangeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduledPriceChangeResponse)
+	err := c.cc.Invoke(ctx, ProductService_CancelScheduledPriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListScheduledPriceChanges(ctx context.Context, in *ListScheduledPriceChangesRequest, opts ...grpc.CallOption) (*ListScheduledPriceChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledPriceChangesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListScheduledPriceChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPriceTimeline(ctx context.Context, in *GetPriceTimelineRequest, opts ...grpc.CallOption) (*GetPriceTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceTimelineResponse)
+	err := c.cc.Invoke(ctx, ProductService_GetPriceTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListTrashedProducts(ctx context.Context, in *ListTrashedProductsRequest, opts ...grpc.CallOption) (*ListTrashedProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashedProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListTrashedProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListTrashedCategories(ctx context.Context, in *ListTrashedCategoriesRequest, opts ...grpc.CallOption) (*ListTrashedCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashedCategoriesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListTrashedCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RestoreProduct(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*RestoreProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_RestoreProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) RestoreCategory(ctx context.Context, in *RestoreCategoryRequest, opts ...grpc.CallOption) (*RestoreCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreCategoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_RestoreCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) PurgeProduct(ctx context.Context, in *PurgeProductRequest, opts ...grpc.CallOption) (*PurgeProductResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeProductResponse)
+	err := c.cc.Invoke(ctx, ProductService_PurgeProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) PurgeCategory(ctx context.Context, in *PurgeCategoryRequest, opts ...grpc.CallOption) (*PurgeCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PurgeCategoryResponse)
+	err := c.cc.Invoke(ctx, ProductService_PurgeCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) CreateAttribute(ctx context.Context, in *CreateAttributeRequest, opts ...grpc.CallOption) (*AttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttributeResponse)
+	err := c.cc.Invoke(ctx, ProductService_CreateAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) ListAttributes(ctx context.Context, in *ListAttributesRequest, opts ...grpc.CallOption) (*ListAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttributesResponse)
+	err := c.cc.Invoke(ctx, ProductService_ListAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteAttribute(ctx context.Context, in *DeleteAttributeRequest, opts ...grpc.CallOption) (*DeleteAttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttributeResponse)
+	err := c.cc.Invoke(ctx, ProductService_DeleteAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) AttachCategoryAttribute(ctx context.Context, in *AttachCategoryAttributeRequest, opts ...grpc.CallOption) (*ListAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAttributesResponse)
+	err := c.cc.Invoke(ctx, ProductService_AttachCategoryAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DetachCategoryAttribute(ctx context.Context, in *DetachCategoryAttributeRequest, opts ...grpc.CallOption) (*DetachCategoryAttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DetachCategoryAttributeResponse)
+	err := c.cc.Invoke(ctx, ProductService_DetachCategoryAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SetProductAttributes(ctx context.Context, in *SetProductAttributesRequest, opts ...grpc.CallOption) (*SetProductAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProductAttributesResponse)
+	err := c.cc.Invoke(ctx, ProductService_SetProductAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProductServiceServer is the server API for ProductService service.
+// All implementations must embed UnimplementedProductServiceServer
+// for forward compatibility.
+//
+// ProductService provides operations for managing products.
+type ProductServiceServer interface {
+	// creates new product
+	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error)
+	// retrieve product by id
+	GetProductByID(context.Context, *GetProductByIDRequest) (*GetProductByIDResponse, error)
+	// retrieve several products in one call; unknown ids are reported in missing_ids
+	GetProductsByIDs(context.Context, *GetProductsByIDsRequest) (*GetProductsByIDsResponse, error)
+	// lists product with pagination
+	ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error)
+	// reports the product cache hit and miss counters of the serving instance
+	GetCacheStats(context.Context, *GetCacheStatsRequest) (*GetCacheStatsResponse, error)
+	// updates product
+	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
+	// delete specific product
+	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
+	// full-text search with filters, sorting and facet counts
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
+	// creates new category
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
+	// retrieve category by id
+	GetCategoryByID(context.Context, *GetCategoryByIDRequest) (*GetCategoryByIDResponse, error)
+	// lists category with pagination
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	// updates category
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
+	// delete specific category
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	// returns the full category tree for navigation
+	GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error)
+	// assigns categories to a product
+	AssignProductCategories(context.Context, *AssignProductCategoriesRequest) (*ProductCategoriesResponse, error)
+	// removes categories from a product
+	UnassignProductCategories(context.Context, *UnassignProductCategoriesRequest) (*ProductCategoriesResponse, error)
+	// lists products in a category, optionally including its descendants
+	ListProductsByCategory(context.Context, *ListProductsByCategoryRequest) (*ListProductsResponse, error)
+	// adds a selectable option such as size or color to a product
+	CreateProductOption(context.Context, *CreateProductOptionRequest) (*ProductOptionResponse, error)
+	// removes an option from a product that has no variants yet
+	DeleteProductOption(context.Context, *DeleteProductOptionRequest) (*DeleteProductOptionResponse, error)
+	// creates a variant (SKU) for one combination of option values
+	CreateProductVariant(context.Context, *CreateProductVariantRequest) (*ProductVariantResponse, error)
+	// retrieve variant by id with its effective price
+	GetProductVariant(context.Context, *GetProductVariantRequest) (*ProductVariantResponse, error)
+	// updates variant sku, price override, stock or image
+	UpdateProductVariant(context.Context, *UpdateProductVariantRequest) (*ProductVariantResponse, error)
+	// delete specific variant
+	DeleteProductVariant(context.Context, *DeleteProductVariantRequest) (*DeleteProductVariantResponse, error)
+	// adds stock to a product or variant and records a restock movement
+	RestockProduct(context.Context, *RestockProductRequest) (*StockMovementResponse, error)
+	// records a stock movement (sale, reservation, release, return or adjustment)
+	RecordStockMovement(context.Context, *RecordStockMovementRequest) (*StockMovementResponse, error)
+	// lists the stock movement history of a product
+	ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error)
+	// creates a warehouse (stock location)
+	CreateWarehouse(context.Context, *CreateWarehouseRequest) (*WarehouseResponse, error)
+	// retrieve warehouse by id
+	GetWarehouse(context.Context, *GetWarehouseRequest) (*WarehouseResponse, error)
+	// lists warehouses ordered by priority
+	ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error)
+	// updates warehouse details, location or active flag
+	UpdateWarehouse(context.Context, *UpdateWarehouseRequest) (*WarehouseResponse, error)
+	// delete a warehouse that holds no stock
+	DeleteWarehouse(context.Context, *DeleteWarehouseRequest) (*DeleteWarehouseResponse, error)
+	// moves stock between two warehouses
+	TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error)
+	// returns stock of a product or variant summed across warehouses
+	GetStockAvailability(context.Context, *GetStockAvailabilityRequest) (*StockAvailabilityResponse, error)
+	// picks the warehouse that fulfills an order by the allocation strategy
+	AllocateWarehouse(context.Context, *AllocateWarehouseRequest) (*AllocateWarehouseResponse, error)
+	// takes more stock from the warehouse an order was allocated, in one transaction
+	TakeStock(context.Context, *StockItemsRequest) (*StockItemsResponse, error)
+	// puts stock taken by AllocateWarehouse or TakeStock back, in one transaction
+	ReturnStock(context.Context, *StockItemsRequest) (*StockItemsResponse, error)
+	// sets the stock level below which a product is reported as low
+	SetReorderThreshold(context.Context, *SetReorderThresholdRequest) (*SetReorderThresholdResponse, error)
+	// caps the quantity of a product per cart or order
+	SetMaxPerOrder(context.Context, *SetMaxPerOrderRequest) (*SetMaxPerOrderResponse, error)
+	// lists products and variants below their reorder threshold with reorder suggestions
+	ListLowStockProducts(context.Context, *ListLowStockProductsRequest) (*ListLowStockProductsResponse, error)
+	// creates or updates products by sku or name from a streamed CSV or NDJSON file
+	ImportProducts(grpc.ClientStreamingServer[ImportProductsRequest, ImportProductsResponse]) error
+	// streams the catalog as CSV or NDJSON
+	ExportProducts(*ExportProductsRequest, grpc.ServerStreamingServer[ExportProductsChunk]) error
+	// uploads a product image in chunks, validates it and stores it with a thumbnail
+	UploadProductImage(grpc.ClientStreamingServer[UploadProductImageRequest, ProductImageResponse]) error
+	// lists the images of a product in display order
+	ListProductImages(context.Context, *ListProductImagesRequest) (*ListProductImagesResponse, error)
+	// updates the alt text of an image
+	UpdateProductImage(context.Context, *UpdateProductImageRequest) (*ProductImageResponse, error)
+	// sets the display order of all images of a product
+	ReorderProductImages(context.Context, *ReorderProductImagesRequest) (*ListProductImagesResponse, error)
+	// deletes an image and its stored files
+	DeleteProductImage(context.Context, *DeleteProductImageRequest) (*DeleteProductImageResponse, error)
+	// creates a review, only for users with a delivered order of the product
+	CreateReview(context.Context, *CreateReviewRequest) (*ReviewResponse, error)
+	// lists the approved reviews of a product
+	ListProductReviews(context.Context, *ListProductReviewsRequest) (*ListReviewsResponse, error)
+	// lists reviews in any status, filtered by product, user or status
+	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
+	// updates a review and sends it back to moderation
+	UpdateReview(context.Context, *UpdateReviewRequest) (*ReviewResponse, error)
+	// deletes a review
+	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error)
+	// approves or rejects a review
+	ModerateReview(context.Context, *ModerateReviewRequest) (*ReviewResponse, error)
+	// schedules a price or discount change applied at its effective time
+	SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*ScheduledPriceChangeResponse, error)
+	// cancels a pending scheduled price change
+	CancelScheduledPriceChange(context.Context, *CancelScheduledPriceChangeRequest) (*ScheduledPriceChangeResponse, error)
+	// lists scheduled price changes, filtered by product or status
+	ListScheduledPriceChanges(context.Context, *ListScheduledPriceChangesRequest) (*ListScheduledPriceChangesResponse, error)
+	// returns the past prices of a product and its pending changes
+	GetPriceTimeline(context.Context, *GetPriceTimelineRequest) (*GetPriceTimelineResponse, error)
+	// lists soft-deleted products, most recently deleted first
+	ListTrashedProducts(context.Context, *ListTrashedProductsRequest) (*ListTrashedProductsResponse, error)
+	// lists soft-deleted categories, most recently deleted first
+	ListTrashedCategories(context.Context, *ListTrashedCategoriesRequest) (*ListTrashedCategoriesResponse, error)
+	// takes a product out of the trash
+	RestoreProduct(context.Context, *RestoreProductRequest) (*RestoreProductResponse, error)
+	// takes a category out of the trash; its parent must not be trashed
+	RestoreCategory(context.Context, *RestoreCategoryRequest) (*RestoreCategoryResponse, error)
+	// permanently deletes a trashed product
+	PurgeProduct(context.Context, *PurgeProductRequest) (*PurgeProductResponse, error)
+	// permanently deletes a trashed category without children
+	PurgeCategory(context.Context, *PurgeCategoryRequest) (*PurgeCategoryResponse, error)
+	// defines a typed product attribute such as ram_gb or color
+	CreateAttribute(context.Context, *CreateAttributeRequest) (*AttributeResponse, error)
+	// lists every attribute, or those applying to a category including inherited ones
+	ListAttributes(context.Context, *ListAttributesRequest) (*ListAttributesResponse, error)
+	// deletes an attribute with its category attachments and product values
+	DeleteAttribute(context.Context, *DeleteAttributeRequest) (*DeleteAttributeResponse, error)
+	// attaches an attribute to a category and its descendants
+	AttachCategoryAttribute(context.Context, *AttachCategoryAttributeRequest) (*ListAttributesResponse, error)
+	// detaches an attribute from a category
+	DetachCategoryAttribute(context.Context, *DetachCategoryAttributeRequest) (*DetachCategoryAttributeResponse, error)
+	// replaces the attribute values of a product
+	SetProductAttributes(context.Context, *SetProductAttributesRequest) (*SetProductAttributesResponse, error)
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+// UnimplementedProductServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProductServiceServer struct{}
+
+func (UnimplementedProductServiceServer) CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductByID(context.Context, *GetProductByIDRequest) (*GetProductByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductByID not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductsByIDs(context.Context, *GetProductsByIDsRequest) (*GetProductsByIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductsByIDs not implemented")
+}
+func (UnimplementedProductServiceServer) ListProducts(context.Context, *ListProductsRequest) (*ListProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProducts not implemented")
+}
+func (UnimplementedProductServiceServer) GetCacheStats(context.Context, *GetCacheStatsRequest) (*GetCacheStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCacheStats not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProduct not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProduct not implemented")
+}
+func (UnimplementedProductServiceServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
+func (UnimplementedProductServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedProductServiceServer) GetCategoryByID(context.Context, *GetCategoryByIDRequest) (*GetCategoryByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryByID not implemented")
+}
+func (UnimplementedProductServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedProductServiceServer) GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategoryTree not implemented")
+}
+func (UnimplementedProductServiceServer) AssignProductCategories(context.Context, *AssignProductCategoriesRequest) (*ProductCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignProductCategories not implemented")
+}
+func (UnimplementedProductServiceServer) UnassignProductCategories(context.Context, *UnassignProductCategoriesRequest) (*ProductCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignProductCategories not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductsByCategory(context.Context, *ListProductsByCategoryRequest) (*ListProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductsByCategory not implemented")
+}
+func (UnimplementedProductServiceServer) CreateProductOption(context.Context, *CreateProductOptionRequest) (*ProductOptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductOption not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProductOption(context.Context, *DeleteProductOptionRequest) (*DeleteProductOptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductOption not implemented")
+}
+func (UnimplementedProductServiceServer) CreateProductVariant(context.Context, *CreateProductVariantRequest) (*ProductVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProductVariant not implemented")
+}
+func (UnimplementedProductServiceServer) GetProductVariant(context.Context, *GetProductVariantRequest) (*ProductVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProductVariant not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProductVariant(context.Context, *UpdateProductVariantRequest) (*ProductVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductVariant not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProductVariant(context.Context, *DeleteProductVariantRequest) (*DeleteProductVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductVariant not implemented")
+}
+func (UnimplementedProductServiceServer) RestockProduct(context.Context, *RestockProductRequest) (*StockMovementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestockProduct not implemented")
+}
+func (UnimplementedProductServiceServer) RecordStockMovement(context.Context, *RecordStockMovementRequest) (*StockMovementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordStockMovement not implemented")
+}
+func (UnimplementedProductServiceServer) ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockMovements not implemented")
+}
+func (UnimplementedProductServiceServer) CreateWarehouse(context.Context, *CreateWarehouseRequest) (*WarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWarehouse not implemented")
+}
+func (UnimplementedProductServiceServer) GetWarehouse(context.Context, *GetWarehouseRequest) (*WarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWarehouse not implemented")
+}
+func (UnimplementedProductServiceServer) ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWarehouses not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateWarehouse(context.Context, *UpdateWarehouseRequest) (*WarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWarehouse not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteWarehouse(context.Context, *DeleteWarehouseRequest) (*DeleteWarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWarehouse not implemented")
+}
+func (UnimplementedProductServiceServer) TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferStock not implemented")
+}
+func (UnimplementedProductServiceServer) GetStockAvailability(context.Context, *GetStockAvailabilityRequest) (*StockAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockAvailability not implemented")
+}
+func (UnimplementedProductServiceServer) AllocateWarehouse(context.Context, *AllocateWarehouseRequest) (*AllocateWarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllocateWarehouse not implemented")
+}
+func (UnimplementedProductServiceServer) TakeStock(context.Context, *StockItemsRequest) (*StockItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TakeStock not implemented")
+}
+func (UnimplementedProductServiceServer) ReturnStock(context.Context, *StockItemsRequest) (*StockItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnStock not implemented")
+}
+func (UnimplementedProductServiceServer) SetReorderThreshold(context.Context, *SetReorderThresholdRequest) (*SetReorderThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReorderThreshold not implemented")
+}
+func (UnimplementedProductServiceServer) SetMaxPerOrder(context.Context, *SetMaxPerOrderRequest) (*SetMaxPerOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxPerOrder not implemented")
+}
+func (UnimplementedProductServiceServer) ListLowStockProducts(context.Context, *ListLowStockProductsRequest) (*ListLowStockProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLowStockProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ImportProducts(grpc.ClientStreamingServer[ImportProductsRequest, ImportProductsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ExportProducts(*ExportProductsRequest, grpc.ServerStreamingServer[ExportProductsChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportProducts not implemented")
+}
+func (UnimplementedProductServiceServer) UploadProductImage(grpc.ClientStreamingServer[UploadProductImageRequest, ProductImageResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadProductImage not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductImages(context.Context, *ListProductImagesRequest) (*ListProductImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductImages not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateProductImage(context.Context, *UpdateProductImageRequest) (*ProductImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProductImage not implemented")
+}
+func (UnimplementedProductServiceServer) ReorderProductImages(context.Context, *ReorderProductImagesRequest) (*ListProductImagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReorderProductImages not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteProductImage(context.Context, *DeleteProductImageRequest) (*DeleteProductImageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProductImage not implemented")
+}
+func (UnimplementedProductServiceServer) CreateReview(context.Context, *CreateReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReview not implemented")
+}
+func (UnimplementedProductServiceServer) ListProductReviews(context.Context, *ListProductReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProductReviews not implemented")
+}
+func (UnimplementedProductServiceServer) ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviews not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateReview(context.Context, *UpdateReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateReview not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedProductServiceServer) ModerateReview(context.Context, *ModerateReviewRequest) (*ReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModerateReview not implemented")
+}
+func (UnimplementedProductServiceServer) SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*ScheduledPriceChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePriceChange not implemented")
+}
+func (UnimplementedProductServiceServer) CancelScheduledPriceChange(context.Context, *CancelScheduledPriceChangeRequest) (*ScheduledPriceChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledPriceChange not implemented")
+}
+func (UnimplementedProductServiceServer) ListScheduledPriceChanges(context.Context, *ListScheduledPriceChangesRequest) (*ListScheduledPriceChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledPriceChanges not implemented")
+}
+func (UnimplementedProductServiceServer) GetPriceTimeline(context.Context, *GetPriceTimelineRequest) (*GetPriceTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceTimeline not implemented")
+}
+func (UnimplementedProductServiceServer) ListTrashedProducts(context.Context, *ListTrashedProductsRequest) (*ListTrashedProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedProducts not implemented")
+}
+func (UnimplementedProductServiceServer) ListTrashedCategories(context.Context, *ListTrashedCategoriesRequest) (*ListTrashedCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrashedCategories not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreProduct(context.Context, *RestoreProductRequest) (*RestoreProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreProduct not implemented")
+}
+func (UnimplementedProductServiceServer) RestoreCategory(context.Context, *RestoreCategoryRequest) (*RestoreCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreCategory not implemented")
+}
+func (UnimplementedProductServiceServer) PurgeProduct(context.Context, *PurgeProductRequest) (*PurgeProductResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeProduct not implemented")
+}
+func (UnimplementedProductServiceServer) PurgeCategory(context.Context, *PurgeCategoryRequest) (*PurgeCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeCategory not implemented")
+}
+func (UnimplementedProductServiceServer) CreateAttribute(context.Context, *CreateAttributeRequest) (*AttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAttribute not implemented")
+}
+func (UnimplementedProductServiceServer) ListAttributes(context.Context, *ListAttributesRequest) (*ListAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttributes not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteAttribute(context.Context, *DeleteAttributeRequest) (*DeleteAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttribute not implemented")
+}
+func (UnimplementedProductServiceServer) AttachCategoryAttribute(context.Context, *AttachCategoryAttributeRequest) (*ListAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttachCategoryAttribute not implemented")
+}
+func (UnimplementedProductServiceServer) DetachCategoryAttribute(context.Context, *DetachCategoryAttributeRequest) (*DetachCategoryAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DetachCategoryAttribute not implemented")
+}
+func (UnimplementedProductServiceServer) SetProductAttributes(context.Context, *SetProductAttributesRequest) (*SetProductAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProductAttributes not implemented")
+}
+func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
+func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProductServiceServer will
+// result in compilation errors.
+type UnsafeProductServiceServer interface {
+	mustEmbedUnimplementedProductServiceServer()
+}
+
+func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProductServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProductService_ServiceDesc, srv)
+}
+
+func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductByID(ctx, req.(*GetProductByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductsByIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductsByIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductsByIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductsByIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductsByIDs(ctx, req.(*GetProductsByIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCacheStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCacheStats(ctx, req.(*GetCacheStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SearchProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCategoryByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCategoryByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCategoryByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCategoryByID(ctx, req.(*GetCategoryByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetCategoryTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetCategoryTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetCategoryTree(ctx, req.(*GetCategoryTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AssignProductCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignProductCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AssignProductCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AssignProductCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AssignProductCategories(ctx, req.(*AssignProductCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UnassignProductCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignProductCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UnassignProductCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UnassignProductCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UnassignProductCategories(ctx, req.(*UnassignProductCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProductsByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductsByCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProductsByCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProductsByCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProductsByCategory(ctx, req.(*ListProductsByCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateProductOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProductOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProductOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProductOption(ctx, req.(*CreateProductOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProductOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProductOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProductOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProductOption(ctx, req.(*DeleteProductOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateProductVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateProductVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateProductVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateProductVariant(ctx, req.(*CreateProductVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetProductVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProductVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetProductVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetProductVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetProductVariant(ctx, req.(*GetProductVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProductVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProductVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProductVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProductVariant(ctx, req.(*UpdateProductVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProductVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProductVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProductVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProductVariant(ctx, req.(*DeleteProductVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RestockProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestockProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RestockProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RestockProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RestockProduct(ctx, req.(*RestockProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_RecordStockMovement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordStockMovementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).RecordStockMovement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_RecordStockMovement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).RecordStockMovement(ctx, req.(*RecordStockMovementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListStockMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListStockMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListStockMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListStockMovements(ctx, req.(*ListStockMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateWarehouse(ctx, req.(*CreateWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetWarehouse(ctx, req.(*GetWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListWarehouses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWarehousesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListWarehouses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListWarehouses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListWarehouses(ctx, req.(*ListWarehousesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateWarehouse(ctx, req.(*UpdateWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteWarehouse(ctx, req.(*DeleteWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_TransferStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).TransferStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_TransferStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).TransferStock(ctx, req.(*TransferStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetStockAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetStockAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetStockAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetStockAvailability(ctx, req.(*GetStockAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_AllocateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AllocateWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).AllocateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_AllocateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).AllocateWarehouse(ctx, req.(*AllocateWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_TakeStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).TakeStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_TakeStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).TakeStock(ctx, req.(*StockItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReturnStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReturnStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReturnStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReturnStock(ctx, req.(*StockItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetReorderThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReorderThresholdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetReorderThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetReorderThreshold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetReorderThreshold(ctx, req.(*SetReorderThresholdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SetMaxPerOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMaxPerOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SetMaxPerOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SetMaxPerOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SetMaxPerOrder(ctx, req.(*SetMaxPerOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListLowStockProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLowStockProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListLowStockProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListLowStockProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListLowStockProducts(ctx, req.(*ListLowStockProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ImportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProductServiceServer).ImportProducts(&grpc.GenericServerStream[ImportProductsRequest, ImportProductsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ImportProductsServer = grpc.ClientStreamingServer[ImportProductsRequest, ImportProductsResponse]
+
+func _ProductService_ExportProducts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportProductsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProductServiceServer).ExportProducts(m, &grpc.GenericServerStream[ExportProductsRequest, ExportProductsChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_ExportProductsServer = grpc.ServerStreamingServer[ExportProductsChunk]
+
+func _ProductService_UploadProductImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProductServiceServer).UploadProductImage(&grpc.GenericServerStream[UploadProductImageRequest, ProductImageResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProductService_UploadProductImageServer = grpc.ClientStreamingServer[UploadProductImageRequest, ProductImageResponse]
+
+func _ProductService_ListProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProductImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProductImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProductImages(ctx, req.(*ListProductImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateProductImage(ctx, req.(*UpdateProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ReorderProductImages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderProductImagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ReorderProductImages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ReorderProductImages_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ReorderProductImages(ctx, req.(*ReorderProductImagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteProductImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteProductImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteProductImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteProductImage(ctx, req.(*DeleteProductImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_CreateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CreateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CreateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CreateReview(ctx, req.(*CreateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListProductReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListProductReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListProductReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListProductReviews(ctx, req.(*ListProductReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListReviews(ctx, req.(*ListReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateReview(ctx, req.(*UpdateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_ModerateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ModerateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ModerateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ModerateReview(ctx, req.(*ModerateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SchedulePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SchedulePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SchedulePriceChange(ctx, req.(*SchedulePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+
+func _ProductService_CancelScheduledPriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledPriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).CancelScheduledPriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_CancelScheduledPriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).CancelScheduledPriceChange(ctx, req.(*CancelScheduledPriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+
+func _ProductService_ListScheduledPriceChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledPriceChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListScheduledPriceChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListScheduledPriceChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListScheduledPriceChanges(ctx, req.(*ListScheduledPriceChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+
+func _ProductService_GetPriceTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetPriceTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetPriceTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPriceTimeline(ctx, req.(*GetPriceTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
-func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
-// UnsafeProductServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to ProductServiceServer will
-// result in compilation errors.
-type UnsafeProductServiceServer interface {
-	mustEmbedUnimplementedProductServiceServer()
+func _ProductService_ListTrashedProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashedProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListTrashedProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListTrashedProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListTrashedProducts(ctx, req.(*ListTrashedProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterProductServiceServer(s grpc.ServiceRegistrar, srv ProductServiceServer) {
-	// If the following call pancis, it indicates UnimplementedProductServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _ProductService_ListTrashedCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashedCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&ProductService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(ProductServiceServer).ListTrashedCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_ListTrashedCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).ListTrashedCategories(ctx, req.(*ListTrashedCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProductRequest)
+func _ProductService_RestoreProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProductRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateProduct(ctx, in)
+		return srv.(ProductServiceServer).RestoreProduct(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateProduct_FullMethodName,
+		FullMethod: ProductService_RestoreProduct_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateProduct(ctx, req.(*CreateProductRequest))
+		return srv.(ProductServiceServer).RestoreProduct(ctx, req.(*RestoreProductRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetProductByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProductByIDRequest)
+func _ProductService_RestoreCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetProductByID(ctx, in)
+		return srv.(ProductServiceServer).RestoreCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetProductByID_FullMethodName,
+		FullMethod: ProductService_RestoreCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetProductByID(ctx, req.(*GetProductByIDRequest))
+		return srv.(ProductServiceServer).RestoreCategory(ctx, req.(*RestoreCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListProductsRequest)
+func _ProductService_PurgeProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeProductRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListProducts(ctx, in)
+		return srv.(ProductServiceServer).PurgeProduct(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListProducts_FullMethodName,
+		FullMethod: ProductService_PurgeProduct_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListProducts(ctx, req.(*ListProductsRequest))
+		return srv.(ProductServiceServer).PurgeProduct(ctx, req.(*PurgeProductRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UpdateProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProductRequest)
+func _ProductService_PurgeCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeCategoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateProduct(ctx, in)
+		return srv.(ProductServiceServer).PurgeCategory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UpdateProduct_FullMethodName,
+		FullMethod: ProductService_PurgeCategory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateProduct(ctx, req.(*UpdateProductRequest))
+		return srv.(ProductServiceServer).PurgeCategory(ctx, req.(*PurgeCategoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProductRequest)
+func _ProductService_CreateAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAttributeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteProduct(ctx, in)
+		return srv.(ProductServiceServer).CreateAttribute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteProduct_FullMethodName,
+		FullMethod: ProductService_CreateAttribute_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteProduct(ctx, req.(*DeleteProductRequest))
+		return srv.(ProductServiceServer).CreateAttribute(ctx, req.(*CreateAttributeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCategoryRequest)
+func _ProductService_ListAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttributesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).CreateCategory(ctx, in)
+		return srv.(ProductServiceServer).ListAttributes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_CreateCategory_FullMethodName,
+		FullMethod: ProductService_ListAttributes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+		return srv.(ProductServiceServer).ListAttributes(ctx, req.(*ListAttributesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_GetCategoryByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCategoryByIDRequest)
+func _ProductService_DeleteAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttributeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).GetCategoryByID(ctx, in)
+		return srv.(ProductServiceServer).DeleteAttribute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_GetCategoryByID_FullMethodName,
+		FullMethod: ProductService_DeleteAttribute_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).GetCategoryByID(ctx, req.(*GetCategoryByIDRequest))
+		return srv.(ProductServiceServer).DeleteAttribute(ctx, req.(*DeleteAttributeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListCategoriesRequest)
+func _ProductService_AttachCategoryAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachCategoryAttributeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).ListCategories(ctx, in)
+		return srv.(ProductServiceServer).AttachCategoryAttribute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_ListCategories_FullMethodName,
+		FullMethod: ProductService_AttachCategoryAttribute_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+		return srv.(ProductServiceServer).AttachCategoryAttribute(ctx, req.(*AttachCategoryAttributeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCategoryRequest)
+func _ProductService_DetachCategoryAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DetachCategoryAttributeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).UpdateCategory(ctx, in)
+		return srv.(ProductServiceServer).DetachCategoryAttribute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_UpdateCategory_FullMethodName,
+		FullMethod: ProductService_DetachCategoryAttribute_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+		return srv.(ProductServiceServer).DetachCategoryAttribute(ctx, req.(*DetachCategoryAttributeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ProductService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCategoryRequest)
+func _ProductService_SetProductAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProductAttributesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ProductServiceServer).DeleteCategory(ctx, in)
+		return srv.(ProductServiceServer).SetProductAttributes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ProductService_DeleteCategory_FullMethodName,
+		FullMethod: ProductService_SetProductAttributes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProductServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+		return srv.(ProductServiceServer).SetProductAttributes(ctx, req.(*SetProductAttributesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -449,10 +2523,18 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetProductByID",
 			Handler:    _ProductService_GetProductByID_Handler,
 		},
+		{
+			MethodName: "GetProductsByIDs",
+			Handler:    _ProductService_GetProductsByIDs_Handler,
+		},
 		{
 			MethodName: "ListProducts",
 			Handler:    _ProductService_ListProducts_Handler,
 		},
+		{
+			MethodName: "GetCacheStats",
+			Handler:    _ProductService_GetCacheStats_Handler,
+		},
 		{
 			MethodName: "UpdateProduct",
 			Handler:    _ProductService_UpdateProduct_Handler,
@@ -461,6 +2543,10 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProduct",
 			Handler:    _ProductService_DeleteProduct_Handler,
 		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductService_SearchProducts_Handler,
+		},
 		{
 			MethodName: "CreateCategory",
 			Handler:    _ProductService_CreateCategory_Handler,
@@ -481,7 +2567,231 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCategory",
 			Handler:    _ProductService_DeleteCategory_Handler,
 		},
+		{
+			MethodName: "GetCategoryTree",
+			Handler:    _ProductService_GetCategoryTree_Handler,
+		},
+		{
+			MethodName: "AssignProductCategories",
+			Handler:    _ProductService_AssignProductCategories_Handler,
+		},
+		{
+			MethodName: "UnassignProductCategories",
+			Handler:    _ProductService_UnassignProductCategories_Handler,
+		},
+		{
+			MethodName: "ListProductsByCategory",
+			Handler:    _ProductService_ListProductsByCategory_Handler,
+		},
+		{
+			MethodName: "CreateProductOption",
+			Handler:    _ProductService_CreateProductOption_Handler,
+		},
+		{
+			MethodName: "DeleteProductOption",
+			Handler:    _ProductService_DeleteProductOption_Handler,
+		},
+		{
+			MethodName: "CreateProductVariant",
+			Handler:    _ProductService_CreateProductVariant_Handler,
+		},
+		{
+			MethodName: "GetProductVariant",
+			Handler:    _ProductService_GetProductVariant_Handler,
+		},
+		{
+			MethodName: "UpdateProductVariant",
+			Handler:    _ProductService_UpdateProductVariant_Handler,
+		},
+		{
+			MethodName: "DeleteProductVariant",
+			Handler:    _ProductService_DeleteProductVariant_Handler,
+		},
+		{
+			MethodName: "RestockProduct",
+			Handler:    _ProductService_RestockProduct_Handler,
+		},
+		{
+			MethodName: "RecordStockMovement",
+			Handler:    _ProductService_RecordStockMovement_Handler,
+		},
+		{
+			MethodName: "ListStockMovements",
+			Handler:    _ProductService_ListStockMovements_Handler,
+		},
+		{
+			MethodName: "CreateWarehouse",
+			Handler:    _ProductService_CreateWarehouse_Handler,
+		},
+		{
+			MethodName: "GetWarehouse",
+			Handler:    _ProductService_GetWarehouse_Handler,
+		},
+		{
+			MethodName: "ListWarehouses",
+			Handler:    _ProductService_ListWarehouses_Handler,
+		},
+		{
+			MethodName: "UpdateWarehouse",
+			Handler:    _ProductService_UpdateWarehouse_Handler,
+		},
+		{
+			MethodName: "DeleteWarehouse",
+			Handler:    _ProductService_DeleteWarehouse_Handler,
+		},
+		{
+			MethodName: "TransferStock",
+			Handler:    _ProductService_TransferStock_Handler,
+		},
+		{
+			MethodName: "GetStockAvailability",
+			Handler:    _ProductService_GetStockAvailability_Handler,
+		},
+		{
+			MethodName: "AllocateWarehouse",
+			Handler:    _ProductService_AllocateWarehouse_Handler,
+		},
+		{
+			MethodName: "TakeStock",
+			Handler:    _ProductService_TakeStock_Handler,
+		},
+		{
+			MethodName: "ReturnStock",
+			Handler:    _ProductService_ReturnStock_Handler,
+		},
+		{
+			MethodName: "SetReorderThreshold",
+			Handler:    _ProductService_SetReorderThreshold_Handler,
+		},
+		{
+			MethodName: "SetMaxPerOrder",
+			Handler:    _ProductService_SetMaxPerOrder_Handler,
+		},
+		{
+			MethodName: "ListLowStockProducts",
+			Handler:    _ProductService_ListLowStockProducts_Handler,
+		},
+		{
+			MethodName: "ListProductImages",
+			Handler:    _ProductService_ListProductImages_Handler,
+		},
+		{
+			MethodName: "UpdateProductImage",
+			Handler:    _ProductService_UpdateProductImage_Handler,
+		},
+		{
+			MethodName: "ReorderProductImages",
+			Handler:    _ProductService_ReorderProductImages_Handler,
+		},
+		{
+			MethodName: "DeleteProductImage",
+			Handler:    _ProductService_DeleteProductImage_Handler,
+		},
+		{
+			MethodName: "CreateReview",
+			Handler:    _ProductService_CreateReview_Handler,
+		},
+		{
+			MethodName: "ListProductReviews",
+			Handler:    _ProductService_ListProductReviews_Handler,
+		},
+		{
+			MethodName: "ListReviews",
+			Handler:    _ProductService_ListReviews_Handler,
+		},
+		{
+			MethodName: "UpdateReview",
+			Handler:    _ProductService_UpdateReview_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _ProductService_DeleteReview_Handler,
+		},
+		{
+			MethodName: "ModerateReview",
+			Handler:    _ProductService_ModerateReview_Handler,
+		},
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _ProductService_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "CancelScheduledPriceChange",
+			Handler:    _ProductService_CancelScheduledPriceChange_Handler,
+		},
+		{
+			MethodName: "ListScheduledPriceChanges",
+			Handler:    _ProductService_ListScheduledPriceChanges_Handler,
+		},
+		{
+			MethodName: "GetPriceTimeline",
+			Handler:    _ProductService_GetPriceTimeline_Handler,
+		},
+		{
+			MethodName: "ListTrashedProducts",
+			Handler:    _ProductService_ListTrashedProducts_Handler,
+		},
+		{
+			MethodName: "ListTrashedCategories",
+			Handler:    _ProductService_ListTrashedCategories_Handler,
+		},
+		{
+			MethodName: "RestoreProduct",
+			Handler:    _ProductService_RestoreProduct_Handler,
+		},
+		{
+			MethodName: "RestoreCategory",
+			Handler:    _ProductService_RestoreCategory_Handler,
+		},
+		{
+			MethodName: "PurgeProduct",
+			Handler:    _ProductService_PurgeProduct_Handler,
+		},
+		{
+			MethodName: "PurgeCategory",
+			Handler:    _ProductService_PurgeCategory_Handler,
+		},
+		{
+			MethodName: "CreateAttribute",
+			Handler:    _ProductService_CreateAttribute_Handler,
+		},
+		{
+			MethodName: "ListAttributes",
+			Handler:    _ProductService_ListAttributes_Handler,
+		},
+		{
+			MethodName: "DeleteAttribute",
+			Handler:    _ProductService_DeleteAttribute_Handler,
+		},
+		{
+			MethodName: "AttachCategoryAttribute",
+			Handler:    _ProductService_AttachCategoryAttribute_Handler,
+		},
+		{
+			MethodName: "DetachCategoryAttribute",
+			Handler:    _ProductService_DetachCategoryAttribute_Handler,
+		},
+		{
+			MethodName: "SetProductAttributes",
+			Handler:    _ProductService_SetProductAttributes_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportProducts",
+			Handler:       _ProductService_ImportProducts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportProducts",
+			Handler:       _ProductService_ExportProducts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadProductImage",
+			Handler:       _ProductService_UploadProductImage_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "shared/proto/v1/product.proto",
 }